@@ -16,6 +16,8 @@ const (
 	AnomalyDatabaseBackupPolicyViolation AnomalyType = "bb.anomaly.database.backup.policy-violation"
 	// AnomalyDatabaseBackupMissing is the anomaly type for missing backups.
 	AnomalyDatabaseBackupMissing AnomalyType = "bb.anomaly.database.backup.missing"
+	// AnomalyDatabaseBackupRestoreDrillFailure is the anomaly type for failed backup restore drills.
+	AnomalyDatabaseBackupRestoreDrillFailure AnomalyType = "bb.anomaly.database.backup.restore-drill-failure"
 	// AnomalyDatabaseConnection is the anomaly type for database connections.
 	AnomalyDatabaseConnection AnomalyType = "bb.anomaly.database.connection"
 	// AnomalyDatabaseSchemaDrift is the anomaly type for database schema drifts.
@@ -41,6 +43,8 @@ func AnomalySeverityFromType(anomalyType AnomalyType) AnomalySeverity {
 		return AnomalySeverityMedium
 	case AnomalyDatabaseBackupMissing:
 		return AnomalySeverityHigh
+	case AnomalyDatabaseBackupRestoreDrillFailure:
+		return AnomalySeverityHigh
 	case AnomalyInstanceConnection:
 	case AnomalyInstanceMigrationSchema:
 	case AnomalyDatabaseConnection:
@@ -70,6 +74,16 @@ type AnomalyDatabaseBackupMissingPayload struct {
 	LastBackupTs int64 `json:"lastBackupTs,omitempty"`
 }
 
+// AnomalyDatabaseBackupRestoreDrillFailurePayload is the API message for failed backup restore drill payloads.
+type AnomalyDatabaseBackupRestoreDrillFailurePayload struct {
+	BackupID   int    `json:"backupId,omitempty"`
+	BackupName string `json:"backupName,omitempty"`
+	// The instance where the backup is restored.
+	DrillInstanceID int `json:"drillInstanceId,omitempty"`
+	// The failure details, such as the restore error or the schema and row count differences.
+	DetailList []string `json:"detailList,omitempty"`
+}
+
 // AnomalyDatabaseConnectionPayload is the API message for database connection payloads.
 type AnomalyDatabaseConnectionPayload struct {
 	// Connection failure detail
@@ -80,12 +94,90 @@ type AnomalyDatabaseConnectionPayload struct {
 type AnomalyDatabaseSchemaDriftPayload struct {
 	// The schema version corresponds to the expected schema
 	Version string `json:"version,omitempty"`
-	// The expected latest schema stored in the migration history table
+	// The ID of the issue reconciling the drift
+	IssueID int `json:"issueId,omitempty"`
+	// The object level drifts between the expected and the actual database metadata.
+	// It's empty if the drift is detected by comparing the schema dumps, because the expected metadata is stale.
+	DriftList []*SchemaDriftItem `json:"driftList,omitempty"`
+}
+
+// SchemaDriftAction is the action of a schema drift item.
+type SchemaDriftAction string
+
+const (
+	// SchemaDriftAdded means the object exists in the actual schema but not in the expected schema.
+	SchemaDriftAdded SchemaDriftAction = "ADDED"
+	// SchemaDriftRemoved means the object exists in the expected schema but not in the actual schema.
+	SchemaDriftRemoved SchemaDriftAction = "REMOVED"
+	// SchemaDriftChanged means the object exists in both schemas with different definitions.
+	SchemaDriftChanged SchemaDriftAction = "CHANGED"
+)
+
+// SchemaDriftObjectType is the type of the drifted object.
+type SchemaDriftObjectType string
+
+const (
+	// SchemaDriftObjectTable is the drifted object type for tables.
+	SchemaDriftObjectTable SchemaDriftObjectType = "TABLE"
+	// SchemaDriftObjectColumn is the drifted object type for columns.
+	SchemaDriftObjectColumn SchemaDriftObjectType = "COLUMN"
+	// SchemaDriftObjectIndex is the drifted object type for indexes.
+	SchemaDriftObjectIndex SchemaDriftObjectType = "INDEX"
+	// SchemaDriftObjectForeignKey is the drifted object type for foreign keys.
+	SchemaDriftObjectForeignKey SchemaDriftObjectType = "FOREIGN_KEY"
+	// SchemaDriftObjectView is the drifted object type for views.
+	SchemaDriftObjectView SchemaDriftObjectType = "VIEW"
+	// SchemaDriftObjectTrigger is the drifted object type for triggers.
+	SchemaDriftObjectTrigger SchemaDriftObjectType = "TRIGGER"
+	// SchemaDriftObjectFunction is the drifted object type for functions.
+	SchemaDriftObjectFunction SchemaDriftObjectType = "FUNCTION"
+	// SchemaDriftObjectProcedure is the drifted object type for stored procedures.
+	SchemaDriftObjectProcedure SchemaDriftObjectType = "PROCEDURE"
+	// SchemaDriftObjectSequence is the drifted object type for sequences.
+	SchemaDriftObjectSequence SchemaDriftObjectType = "SEQUENCE"
+	// SchemaDriftObjectEnumType is the drifted object type for enum types.
+	SchemaDriftObjectEnumType SchemaDriftObjectType = "ENUM_TYPE"
+)
+
+// SchemaDriftItem is an object level schema drift.
+type SchemaDriftItem struct {
+	Action     SchemaDriftAction     `json:"action"`
+	ObjectType SchemaDriftObjectType `json:"objectType"`
+	// Schema is only used by the engines having the schema level, such as PostgreSQL.
+	Schema string `json:"schema,omitempty"`
+	// Table is the table or view name. It's empty for the schema level objects such as functions.
+	Table string `json:"table,omitempty"`
+	// Name is the name of the column, index, foreign key, trigger or the schema level object. It's empty for tables and views.
+	// The arguments are part of the function and procedure names, such as "f(integer)".
+	Name string `json:"name,omitempty"`
+	// Expect is the expected definition summary. It's empty for added objects.
 	Expect string `json:"expect,omitempty"`
-	// The actual schema dumped from the database
+	// Actual is the actual definition summary. It's empty for removed objects.
 	Actual string `json:"actual,omitempty"`
 }
 
+// AnomalyReconcileDirection is the direction of reconciling a schema drift.
+type AnomalyReconcileDirection string
+
+const (
+	// AnomalyReconcileRevert reverts the actual schema back to the expected schema with the diff DDL.
+	AnomalyReconcileRevert AnomalyReconcileDirection = "REVERT"
+	// AnomalyReconcileAccept accepts the actual schema as the expected schema by establishing a new baseline.
+	AnomalyReconcileAccept AnomalyReconcileDirection = "ACCEPT"
+)
+
+// AnomalyReconcile is the API message for reconciling a schema drift anomaly.
+type AnomalyReconcile struct {
+	ID int `jsonapi:"primary,anomalyReconcile"`
+
+	// Standard fields
+	// Value is assigned from the jwt subject field passed by the client.
+	CreatorID int
+
+	// Domain specific fields
+	Direction AnomalyReconcileDirection `jsonapi:"attr,direction"`
+}
+
 // Anomaly is the API message for an anomaly.
 type Anomaly struct {
 	ID int `jsonapi:"primary,anomaly"`
@@ -128,6 +220,8 @@ type AnomalyUpsert struct {
 
 // AnomalyFind is the API message for finding anomalies.
 type AnomalyFind struct {
+	ID *int
+
 	// Standard fields
 	RowStatus *RowStatus
 
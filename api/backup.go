@@ -42,14 +42,24 @@ type BackupStorageBackend string
 const (
 	// BackupStorageBackendLocal is the local storage backend for a backup.
 	BackupStorageBackendLocal BackupStorageBackend = "LOCAL"
-	// BackupStorageBackendS3 is the AWS S3 storage backend for a backup. Not used yet.
+	// BackupStorageBackendS3 is the AWS S3 storage backend for a backup.
 	BackupStorageBackendS3 BackupStorageBackend = "S3"
-	// BackupStorageBackendGCS is the Google Cloud Storage (GCS) storage backend for a backup. Not used yet.
+	// BackupStorageBackendGCS is the Google Cloud Storage (GCS) storage backend for a backup.
 	BackupStorageBackendGCS BackupStorageBackend = "GCS"
-	// BackupStorageBackendOSS is the AliCloud Object Storage Service (OSS) storage backend for a backup. Not used yet.
+	// BackupStorageBackendOSS is the AliCloud Object Storage Service (OSS) storage backend for a backup.
 	BackupStorageBackendOSS BackupStorageBackend = "OSS"
 )
 
+// BackupCompression is the compression algorithm of a backup.
+type BackupCompression string
+
+const (
+	// BackupCompressionGzip is the gzip compression algorithm for a backup.
+	BackupCompressionGzip BackupCompression = "GZIP"
+	// BackupCompressionZstd is the Zstandard compression algorithm for a backup.
+	BackupCompressionZstd BackupCompression = "ZSTD"
+)
+
 // BinlogInfo is the binlog coordination for MySQL.
 type BinlogInfo struct {
 	FileName string `json:"fileName"`
@@ -69,6 +79,14 @@ type BackupPayload struct {
 	// It is recorded within the same transaction as the dump so that the binlog position is consistent with the dump.
 	// Please refer to https://github.com/bytebase/bytebase/blob/main/docs/design/pitr-mysql.md#full-backup for details.
 	BinlogInfo BinlogInfo `json:"binlogInfo"`
+
+	// Compression is the compression algorithm of the backup file. Empty means not compressed.
+	Compression BackupCompression `json:"compression,omitempty"`
+	// Encrypted is true if the backup file is encrypted by the workspace backup encryption key.
+	Encrypted bool `json:"encrypted,omitempty"`
+	// Checksum is the hex encoded SHA-256 checksum of the backup file.
+	// It's verified by re-reading the backup file before marking the backup as done.
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // Backup is the API message for a backup.
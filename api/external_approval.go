@@ -3,8 +3,35 @@ package api
 // ExternalApprovalType is the type of the ExternalApproval.
 type ExternalApprovalType string
 
-// ExternalApprovalTypeFeishu is the ExternalApproval from feishu.
-const ExternalApprovalTypeFeishu = "bb.plugin.app.feishu"
+const (
+	// ExternalApprovalTypeFeishu is the ExternalApproval from feishu.
+	ExternalApprovalTypeFeishu ExternalApprovalType = "bb.plugin.app.feishu"
+	// ExternalApprovalTypeDingTalk is the ExternalApproval from DingTalk.
+	ExternalApprovalTypeDingTalk ExternalApprovalType = "bb.plugin.app.dingtalk"
+	// ExternalApprovalTypeWeCom is the ExternalApproval from WeCom.
+	ExternalApprovalTypeWeCom ExternalApprovalType = "bb.plugin.app.wecom"
+	// ExternalApprovalTypeSlack is the ExternalApproval from Slack.
+	ExternalApprovalTypeSlack ExternalApprovalType = "bb.plugin.app.slack"
+	// ExternalApprovalTypeHTTP is the ExternalApproval from the generic HTTP callback.
+	ExternalApprovalTypeHTTP ExternalApprovalType = "bb.plugin.app.http"
+)
+
+// ExternalApprovalTypeOf returns the ExternalApproval type of the IM type.
+func ExternalApprovalTypeOf(imType IMType) (ExternalApprovalType, bool) {
+	switch imType {
+	case IMTypeFeishu:
+		return ExternalApprovalTypeFeishu, true
+	case IMTypeDingTalk:
+		return ExternalApprovalTypeDingTalk, true
+	case IMTypeWeCom:
+		return ExternalApprovalTypeWeCom, true
+	case IMTypeSlack:
+		return ExternalApprovalTypeSlack, true
+	case IMTypeHTTP:
+		return ExternalApprovalTypeHTTP, true
+	}
+	return "", false
+}
 
 // ExternalApproval is the API message of ExternalApproval.
 // It only lives in the backend.
@@ -28,15 +55,18 @@ type ExternalApproval struct {
 	Payload string
 }
 
-// ExternalApprovalPayloadFeishu is the payload for feishu type ExternalApproval.
-type ExternalApprovalPayloadFeishu struct {
+// ExternalApprovalPayload is the payload of ExternalApproval.
+type ExternalApprovalPayload struct {
 	StageID    int
 	AssigneeID int
 
-	// Feishu
+	// InstanceCode identifies the approval instance on the IM application.
 	InstanceCode string
-	RequesterID  string
-	// Rejected tells if the approval has been rejected on Feishu.
+	// RequesterID is the user ID of the requester on the IM application.
+	RequesterID string
+	// Approved tells if the approval has been approved by the callback from the IM application.
+	Approved bool
+	// Rejected tells if the approval has been rejected on the IM application.
 	Rejected bool
 }
 
@@ -55,6 +85,7 @@ type ExternalApprovalCreate struct {
 // ExternalApprovalFind is the API message for finding ExternalApprovals.
 type ExternalApprovalFind struct {
 	IssueID *int
+	Type    *ExternalApprovalType
 }
 
 // ExternalApprovalPatch is the API message for patching an ExternalApproval.
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/common"
+)
+
+// GroupMapping maps an external group, such as an identity provider group or an LDAP group,
+// to a workspace role and/or a project membership.
+type GroupMapping struct {
+	Group string `json:"group"`
+	// Role is the workspace role, empty means no workspace role is granted.
+	Role Role `json:"role,omitempty"`
+	// ProjectID and ProjectRole are the project membership, zero means no project membership is granted.
+	ProjectID   int                `json:"projectId,omitempty"`
+	ProjectRole common.ProjectRole `json:"projectRole,omitempty"`
+}
+
+// ValidateGroupMappingList validates the group mappings.
+func ValidateGroupMappingList(groupMappingList []*GroupMapping) error {
+	for _, mapping := range groupMappingList {
+		if mapping.Group == "" {
+			return errors.New("group mapping requires the group")
+		}
+		switch mapping.Role {
+		case "", Owner, DBA, Developer:
+		default:
+			return errors.Errorf("invalid workspace role %q in group mapping %q", mapping.Role, mapping.Group)
+		}
+		if mapping.ProjectID != 0 && mapping.ProjectRole != common.ProjectOwner && mapping.ProjectRole != common.ProjectDeveloper {
+			return errors.Errorf("invalid project role %q in group mapping %q", mapping.ProjectRole, mapping.Group)
+		}
+		if mapping.ProjectID == 0 && mapping.ProjectRole != "" {
+			return errors.Errorf("project role in group mapping %q requires the project", mapping.Group)
+		}
+	}
+	return nil
+}
+
+// GetMappedRoles returns the highest workspace role and the highest role in each project mapped from the groups.
+// The workspace role is empty if no group is mapped to a workspace role.
+func GetMappedRoles(groupMappingList []*GroupMapping, groups []string) (Role, map[int]common.ProjectRole) {
+	roleRank := map[Role]int{Developer: 1, DBA: 2, Owner: 3}
+	groupSet := make(map[string]bool)
+	for _, group := range groups {
+		groupSet[group] = true
+	}
+
+	var role Role
+	projectRoles := make(map[int]common.ProjectRole)
+	for _, mapping := range groupMappingList {
+		if !groupSet[mapping.Group] {
+			continue
+		}
+		if roleRank[mapping.Role] > roleRank[role] {
+			role = mapping.Role
+		}
+		if mapping.ProjectID != 0 && projectRoles[mapping.ProjectID] != common.ProjectOwner {
+			projectRoles[mapping.ProjectID] = mapping.ProjectRole
+		}
+	}
+	return role, projectRoles
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/common"
+)
+
+func TestGetMappedRoles(t *testing.T) {
+	groupMappingList := []*GroupMapping{
+		{Group: "dba", Role: DBA},
+		{Group: "admin", Role: Owner},
+		{Group: "dev", Role: Developer, ProjectID: 101, ProjectRole: common.ProjectDeveloper},
+		{Group: "lead", ProjectID: 101, ProjectRole: common.ProjectOwner},
+		{Group: "ops", ProjectID: 102, ProjectRole: common.ProjectDeveloper},
+	}
+
+	tests := []struct {
+		groups           []string
+		wantRole         Role
+		wantProjectRoles map[int]common.ProjectRole
+	}{
+		{
+			groups:           nil,
+			wantRole:         "",
+			wantProjectRoles: map[int]common.ProjectRole{},
+		},
+		{
+			groups:           []string{"dev", "dba"},
+			wantRole:         DBA,
+			wantProjectRoles: map[int]common.ProjectRole{101: common.ProjectDeveloper},
+		},
+		{
+			groups:           []string{"lead", "dev", "ops", "admin"},
+			wantRole:         Owner,
+			wantProjectRoles: map[int]common.ProjectRole{101: common.ProjectOwner, 102: common.ProjectDeveloper},
+		},
+		{
+			groups:           []string{"unknown"},
+			wantRole:         "",
+			wantProjectRoles: map[int]common.ProjectRole{},
+		},
+	}
+
+	for _, test := range tests {
+		role, projectRoles := GetMappedRoles(groupMappingList, test.groups)
+		require.Equal(t, test.wantRole, role)
+		require.Equal(t, test.wantProjectRoles, projectRoles)
+	}
+}
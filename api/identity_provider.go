@@ -0,0 +1,121 @@
+package api
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/plugin/idp/saml"
+)
+
+// IdentityProviderType is the type of an identity provider.
+type IdentityProviderType string
+
+const (
+	// IdentityProviderTypeOIDC is the OpenID Connect identity provider.
+	IdentityProviderTypeOIDC IdentityProviderType = "OIDC"
+	// IdentityProviderTypeSAML is the SAML 2.0 identity provider.
+	IdentityProviderTypeSAML IdentityProviderType = "SAML"
+)
+
+// IdentityProvider is the API message for an identity provider used by single sign-on.
+type IdentityProvider struct {
+	ID int `jsonapi:"primary,identityProvider"`
+
+	// Standard fields
+	RowStatus RowStatus `jsonapi:"attr,rowStatus"`
+
+	// Domain specific fields
+	Name string               `jsonapi:"attr,name"`
+	Type IdentityProviderType `jsonapi:"attr,type"`
+	// Config is the JSON encoded IdentityProviderConfig.
+	// For safety concerns, the OIDC client secret is redacted.
+	Config string `jsonapi:"attr,config"`
+}
+
+// IdentityProviderCreate is the API message for creating an identity provider.
+type IdentityProviderCreate struct {
+	// Standard fields
+	// Value is assigned from the jwt subject field passed by the client.
+	CreatorID int
+
+	// Domain specific fields
+	Name   string               `jsonapi:"attr,name"`
+	Type   IdentityProviderType `jsonapi:"attr,type"`
+	Config string               `jsonapi:"attr,config"`
+}
+
+// IdentityProviderPatch is the API message for patching an identity provider.
+type IdentityProviderPatch struct {
+	ID int
+
+	// Standard fields
+	RowStatus *string `jsonapi:"attr,rowStatus"`
+	// Value is assigned from the jwt subject field passed by the client.
+	UpdaterID int
+
+	// Domain specific fields
+	Name *string `jsonapi:"attr,name"`
+	// Config is the JSON encoded IdentityProviderConfig.
+	// An empty OIDC client secret means keeping the existing one.
+	Config *string `jsonapi:"attr,config"`
+}
+
+// IdentityProviderConfig is the configuration of an identity provider.
+type IdentityProviderConfig struct {
+	// Only one of OIDC and SAML is set according to the identity provider type.
+	OIDC *oidc.IdentityProviderConfig `json:"oidc,omitempty"`
+	SAML *saml.IdentityProviderConfig `json:"saml,omitempty"`
+	// GroupMappingList maps the identity provider groups to the workspace roles and the project memberships.
+	// The mappings are applied each time the user signs in.
+	GroupMappingList []*GroupMapping `json:"groupMappingList"`
+	// AllowLinkingExistingUser allows the user of the identity provider to sign in as the existing Bytebase user
+	// with the same email on the first sign in. Otherwise, the sign in is refused, because the email may not be
+	// owned by the user of the identity provider.
+	AllowLinkingExistingUser bool `json:"allowLinkingExistingUser"`
+}
+
+// UnmarshalIdentityProviderConfig unmarshals and validates the identity provider config of the given type.
+func UnmarshalIdentityProviderConfig(identityProviderType IdentityProviderType, s string) (*IdentityProviderConfig, error) {
+	config := &IdentityProviderConfig{}
+	if err := json.Unmarshal([]byte(s), config); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal identity provider config")
+	}
+	switch identityProviderType {
+	case IdentityProviderTypeOIDC:
+		if config.OIDC == nil || config.SAML != nil {
+			return nil, errors.New("OIDC identity provider requires the OIDC config only")
+		}
+	case IdentityProviderTypeSAML:
+		if config.SAML == nil || config.OIDC != nil {
+			return nil, errors.New("SAML identity provider requires the SAML config only")
+		}
+	default:
+		return nil, errors.Errorf("unsupported identity provider type %q", identityProviderType)
+	}
+	if err := ValidateGroupMappingList(config.GroupMappingList); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// String returns the JSON encoded config.
+func (config *IdentityProviderConfig) String() (string, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal identity provider config")
+	}
+	return string(b), nil
+}
+
+// Redact returns the JSON encoded config without the secrets.
+func (config *IdentityProviderConfig) Redact() (string, error) {
+	redacted := *config
+	if config.OIDC != nil {
+		oidcConfig := *config.OIDC
+		oidcConfig.ClientSecret = ""
+		redacted.OIDC = &oidcConfig
+	}
+	return redacted.String()
+}
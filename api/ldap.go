@@ -0,0 +1,11 @@
+package api
+
+// LDAPSyncResult is the API message for the result of an LDAP directory sync.
+type LDAPSyncResult struct {
+	// CreatedCount is the number of the users created from the directory.
+	CreatedCount int `json:"createdCount"`
+	// UpdatedCount is the number of the existing users whose roles or statuses are changed.
+	UpdatedCount int `json:"updatedCount"`
+	// DeactivatedCount is the number of the users deactivated since they are removed from the directory.
+	DeactivatedCount int `json:"deactivatedCount"`
+}
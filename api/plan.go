@@ -59,6 +59,14 @@ const (
 	// - Workspace level RBAC
 	// - Project level RBAC.
 	FeatureRBAC FeatureType = "bb.feature.rbac"
+	// FeatureSSO allows user to login via the OIDC and SAML identity providers.
+	//
+	// The groups of the identity providers could be mapped to the workspace roles and project memberships.
+	FeatureSSO FeatureType = "bb.feature.sso"
+	// FeatureLDAP allows user to sync the members from the LDAP directories, such as OpenLDAP and Active Directory.
+	//
+	// The LDAP groups could be mapped to the workspace roles and project memberships.
+	FeatureLDAP FeatureType = "bb.feature.ldap"
 
 	// Branding.
 
@@ -150,6 +158,10 @@ func (e FeatureType) Name() string {
 		return "3rd party auth"
 	case FeatureRBAC:
 		return "RBAC"
+	case FeatureSSO:
+		return "Single sign-on"
+	case FeatureLDAP:
+		return "LDAP directory sync"
 	// Branding
 	case FeatureBranding:
 		return "Branding"
@@ -217,6 +229,8 @@ var featureMatrix = map[FeatureType][3]bool{
 	// Admin & Security
 	Feature3rdPartyAuth: {false, true, true},
 	FeatureRBAC:         {false, true, true},
+	FeatureSSO:          {false, false, true},
+	FeatureLDAP:         {false, false, true},
 	// Branding
 	FeatureBranding: {false, false, true},
 	// Change Workflow
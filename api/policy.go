@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"path"
 
 	"github.com/pkg/errors"
 
@@ -42,6 +43,8 @@ const (
 	PolicyTypeSensitiveData PolicyType = "bb.policy.sensitive-data"
 	// PolicyTypeAccessControl is the access control policy type.
 	PolicyTypeAccessControl PolicyType = "bb.policy.access-control"
+	// PolicyTypeSchemaDrift is the schema drift policy type.
+	PolicyTypeSchemaDrift PolicyType = "bb.policy.schema-drift"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -88,6 +91,7 @@ var (
 		PolicyTypeEnvironmentTier:  {PolicyResourceTypeEnvironment},
 		PolicyTypeSensitiveData:    {PolicyResourceTypeDatabase},
 		PolicyTypeAccessControl:    {PolicyResourceTypeEnvironment, PolicyResourceTypeDatabase},
+		PolicyTypeSchemaDrift:      {PolicyResourceTypeDatabase},
 	}
 )
 
@@ -207,6 +211,13 @@ type BackupPlanPolicy struct {
 	Schedule BackupPlanPolicySchedule `json:"schedule"`
 	// RetentionPeriodTs is the minimum allowed period that backup data is kept for databases in an environment.
 	RetentionPeriodTs int `json:"retentionPeriodTs"`
+	// StorageBackend is the storage backend of the automatic backups in an environment.
+	// Empty means the workspace default storage backend.
+	StorageBackend BackupStorageBackend `json:"storageBackend,omitempty"`
+	// Compression is the compression algorithm of the automatic backups in an environment. Empty means not compressed.
+	Compression BackupCompression `json:"compression,omitempty"`
+	// Encryption is true if the automatic backups in an environment are encrypted by the workspace backup encryption key.
+	Encryption bool `json:"encryption,omitempty"`
 }
 
 func (bp *BackupPlanPolicy) String() (string, error) {
@@ -256,9 +267,24 @@ type SensitiveDataPolicy struct {
 
 // SensitiveData is the value for sensitive data.
 type SensitiveData struct {
+	// Schema is only used by the engines having the schema level, such as PostgreSQL.
+	// An empty schema refers to the public schema for PostgreSQL.
+	Schema string                `json:"schema"`
 	Table  string                `json:"table"`
 	Column string                `json:"column"`
 	Type   SensitiveDataMaskType `json:"maskType"`
+	// MaskOption is the option for the mask types requiring parameters, such as PARTIAL and RANGE.
+	MaskOption *SensitiveDataMaskOption `json:"maskOption,omitempty"`
+}
+
+// SensitiveDataMaskOption is the option for the mask types requiring parameters.
+type SensitiveDataMaskOption struct {
+	// PrefixLength is the number of leading characters kept by the PARTIAL mask type.
+	PrefixLength int `json:"prefixLength"`
+	// SuffixLength is the number of trailing characters kept by the PARTIAL mask type.
+	SuffixLength int `json:"suffixLength"`
+	// RangeWidth is the width of the range used by the RANGE mask type.
+	RangeWidth float64 `json:"rangeWidth"`
 }
 
 // SensitiveDataMaskType is the mask type for sensitive data.
@@ -268,8 +294,35 @@ const (
 	// SensitiveDataMaskTypeDefault is the sensitive data type to hide data with a default method.
 	// The default method is subject to change.
 	SensitiveDataMaskTypeDefault SensitiveDataMaskType = "DEFAULT"
+	// SensitiveDataMaskTypePartial is the sensitive data type to keep the leading and trailing characters only.
+	SensitiveDataMaskTypePartial SensitiveDataMaskType = "PARTIAL"
+	// SensitiveDataMaskTypeEmail is the sensitive data type to keep the first character of the local part and the domain of an email.
+	SensitiveDataMaskTypeEmail SensitiveDataMaskType = "EMAIL"
+	// SensitiveDataMaskTypePhone is the sensitive data type to keep the last four digits of a phone number.
+	SensitiveDataMaskTypePhone SensitiveDataMaskType = "PHONE"
+	// SensitiveDataMaskTypeHash is the sensitive data type to replace data with its HMAC-SHA256 digest keyed by a workspace secret.
+	SensitiveDataMaskTypeHash SensitiveDataMaskType = "HASH"
+	// SensitiveDataMaskTypeRange is the sensitive data type to replace a number with the range it falls into.
+	SensitiveDataMaskTypeRange SensitiveDataMaskType = "RANGE"
 )
 
+func (data SensitiveData) validate() error {
+	switch data.Type {
+	case SensitiveDataMaskTypeDefault, SensitiveDataMaskTypeEmail, SensitiveDataMaskTypePhone, SensitiveDataMaskTypeHash:
+	case SensitiveDataMaskTypePartial:
+		if data.MaskOption == nil || data.MaskOption.PrefixLength < 0 || data.MaskOption.SuffixLength < 0 {
+			return errors.Errorf("sensitive data policy rule with mask type %q must have non-negative prefix and suffix length", data.Type)
+		}
+	case SensitiveDataMaskTypeRange:
+		if data.MaskOption == nil || data.MaskOption.RangeWidth <= 0 {
+			return errors.Errorf("sensitive data policy rule with mask type %q must have positive range width", data.Type)
+		}
+	default:
+		return errors.Errorf("invalid sensitive data mask type %q", data.Type)
+	}
+	return nil
+}
+
 // UnmarshalSensitiveDataPolicy will unmarshal payload to sensitive data policy.
 func UnmarshalSensitiveDataPolicy(payload string) (*SensitiveDataPolicy, error) {
 	var p SensitiveDataPolicy
@@ -319,6 +372,70 @@ func (p *AccessControlPolicy) String() (string, error) {
 	return string(s), nil
 }
 
+// SchemaDriftPolicy is the policy configuration for schema drift detection.
+// It is only applicable to database resource type.
+type SchemaDriftPolicy struct {
+	// IgnoreList is the list of objects expected to drift, which are excluded from the drift report.
+	IgnoreList []SchemaDriftIgnore `json:"ignoreList"`
+}
+
+// SchemaDriftIgnore matches the objects excluded from schema drift detection.
+// Each field is a glob pattern in path.Match syntax, and an empty field matches anything.
+type SchemaDriftIgnore struct {
+	// Schema is only used by the engines having the schema level, such as PostgreSQL.
+	Schema string `json:"schema"`
+	// Table matches table and view names. Leave it empty to match the schema level objects such as functions.
+	Table string `json:"table"`
+	// Object matches column, index, foreign key and trigger names, and the names of the schema level objects
+	// such as functions, procedures, sequences and enum types. An empty object ignores the whole table.
+	Object string `json:"object"`
+}
+
+// Match returns true if the object is ignored by the rule.
+func (ignore SchemaDriftIgnore) Match(schema, table, object string) bool {
+	return matchSchemaDriftPattern(ignore.Schema, schema) &&
+		matchSchemaDriftPattern(ignore.Table, table) &&
+		matchSchemaDriftPattern(ignore.Object, object)
+}
+
+func matchSchemaDriftPattern(pattern, name string) bool {
+	if pattern == "" {
+		return true
+	}
+	matched, err := path.Match(pattern, name)
+	return err == nil && matched
+}
+
+// IsIgnored returns true if the object is ignored by any rule of the policy.
+func (p *SchemaDriftPolicy) IsIgnored(schema, table, object string) bool {
+	if p == nil {
+		return false
+	}
+	for _, ignore := range p.IgnoreList {
+		if ignore.Match(schema, table, object) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalSchemaDriftPolicy will unmarshal payload to schema drift policy.
+func UnmarshalSchemaDriftPolicy(payload string) (*SchemaDriftPolicy, error) {
+	var p SchemaDriftPolicy
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal schema drift policy %q", payload)
+	}
+	return &p, nil
+}
+
+func (p *SchemaDriftPolicy) String() (string, error) {
+	s, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
+
 // UnmarshalEnvironmentTierPolicy will unmarshal payload to environment tier policy.
 func UnmarshalEnvironmentTierPolicy(payload string) (*EnvironmentTierPolicy, error) {
 	var p EnvironmentTierPolicy
@@ -382,6 +499,16 @@ func ValidatePolicy(resourceType PolicyResourceType, pType PolicyType, payload *
 		if bp.Schedule != BackupPlanPolicyScheduleUnset && bp.Schedule != BackupPlanPolicyScheduleDaily && bp.Schedule != BackupPlanPolicyScheduleWeekly {
 			return errors.Errorf("invalid backup plan policy schedule: %q", bp.Schedule)
 		}
+		switch bp.StorageBackend {
+		case "", BackupStorageBackendLocal, BackupStorageBackendS3, BackupStorageBackendGCS, BackupStorageBackendOSS:
+		default:
+			return errors.Errorf("invalid backup plan policy storage backend: %q", bp.StorageBackend)
+		}
+		switch bp.Compression {
+		case "", BackupCompressionGzip, BackupCompressionZstd:
+		default:
+			return errors.Errorf("invalid backup plan policy compression: %q", bp.Compression)
+		}
 		return nil
 	case PolicyTypeSQLReview:
 		sr, err := UnmarshalSQLReviewPolicy(*payload)
@@ -410,8 +537,8 @@ func ValidatePolicy(resourceType PolicyResourceType, pType PolicyType, payload *
 			if v.Table == "" || v.Column == "" {
 				return errors.Errorf("sensitive data policy rule cannot have empty table or column name")
 			}
-			if v.Type != SensitiveDataMaskTypeDefault {
-				return errors.Errorf("sensitive data policy rule must have mask type %q", SensitiveDataMaskTypeDefault)
+			if err := v.validate(); err != nil {
+				return err
 			}
 		}
 		return nil
@@ -420,6 +547,22 @@ func ValidatePolicy(resourceType PolicyResourceType, pType PolicyType, payload *
 			return err
 		}
 		return nil
+	case PolicyTypeSchemaDrift:
+		p, err := UnmarshalSchemaDriftPolicy(*payload)
+		if err != nil {
+			return err
+		}
+		for _, v := range p.IgnoreList {
+			if v.Schema == "" && v.Table == "" && v.Object == "" {
+				return errors.Errorf("schema drift policy ignore rule cannot be empty")
+			}
+			for _, pattern := range []string{v.Schema, v.Table, v.Object} {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return errors.Errorf("invalid schema drift policy ignore pattern %q", pattern)
+				}
+			}
+		}
+		return nil
 	}
 	return nil
 }
@@ -449,6 +592,9 @@ func GetDefaultPolicy(pType PolicyType) (string, error) {
 	case PolicyTypeSensitiveData:
 		policy := SensitiveDataPolicy{}
 		return policy.String()
+	case PolicyTypeSchemaDrift:
+		policy := SchemaDriftPolicy{}
+		return policy.String()
 	}
 	return "", nil
 }
@@ -35,6 +35,8 @@ const (
 	PrincipalAuthProviderGitlabSelfHost PrincipalAuthProvider = "GITLAB_SELF_HOST"
 	// PrincipalAuthProviderGitHubCom is the GitHub.com authentication provider.
 	PrincipalAuthProviderGitHubCom PrincipalAuthProvider = "GITHUB_COM"
+	// PrincipalAuthProviderGitHubEnterprise is the GitHub Enterprise Server authentication provider.
+	PrincipalAuthProviderGitHubEnterprise PrincipalAuthProvider = "GITHUB_ENTERPRISE"
 )
 
 // Principal is the API message for principals.
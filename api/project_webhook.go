@@ -17,6 +17,10 @@ type ProjectWebhook struct {
 	Name         string   `jsonapi:"attr,name"`
 	URL          string   `jsonapi:"attr,url"`
 	ActivityList []string `jsonapi:"attr,activityList"`
+	// Secret signs the custom webhook requests. It's write-only and never returned to the client.
+	Secret string
+	// HasSecret tells if the secret is set.
+	HasSecret bool `jsonapi:"attr,hasSecret"`
 }
 
 // ProjectWebhookCreate is the API message for creating a project webhook.
@@ -33,6 +37,7 @@ type ProjectWebhookCreate struct {
 	Name         string   `jsonapi:"attr,name"`
 	URL          string   `jsonapi:"attr,url"`
 	ActivityList []string `jsonapi:"attr,activityList"`
+	Secret       string   `jsonapi:"attr,secret"`
 }
 
 // ProjectWebhookFind is the API message for finding project webhooks.
@@ -64,6 +69,7 @@ type ProjectWebhookPatch struct {
 	Name         *string `jsonapi:"attr,name"`
 	URL          *string `jsonapi:"attr,url"`
 	ActivityList *string `jsonapi:"attr,activityList"`
+	Secret       *string `jsonapi:"attr,secret"`
 }
 
 // ProjectWebhookDelete is the API message for deleting a project webhook.
@@ -79,3 +85,40 @@ type ProjectWebhookDelete struct {
 type ProjectWebhookTestResult struct {
 	Error string `jsonapi:"attr,error"`
 }
+
+// ProjectWebhookDeliveryStatus is the status of a project webhook delivery.
+type ProjectWebhookDeliveryStatus string
+
+const (
+	// ProjectWebhookDeliveryPending is the status of the deliveries waiting for the next attempt.
+	ProjectWebhookDeliveryPending ProjectWebhookDeliveryStatus = "PENDING"
+	// ProjectWebhookDeliverySucceeded is the status of the succeeded deliveries.
+	ProjectWebhookDeliverySucceeded ProjectWebhookDeliveryStatus = "SUCCEEDED"
+	// ProjectWebhookDeliveryFailed is the status of the deliveries failed after all attempts.
+	ProjectWebhookDeliveryFailed ProjectWebhookDeliveryStatus = "FAILED"
+)
+
+// ProjectWebhookDelivery is the API message for a project webhook delivery.
+type ProjectWebhookDelivery struct {
+	ID int `jsonapi:"primary,projectWebhookDelivery"`
+
+	// Standard fields
+	CreatedTs int64 `jsonapi:"attr,createdTs"`
+	UpdatedTs int64 `jsonapi:"attr,updatedTs"`
+
+	// Related fields
+	ProjectWebhookID int `jsonapi:"attr,projectWebhookId"`
+
+	// Domain specific fields
+	Status        ProjectWebhookDeliveryStatus `jsonapi:"attr,status"`
+	Attempt       int                          `jsonapi:"attr,attempt"`
+	NextAttemptTs int64                        `jsonapi:"attr,nextAttemptTs"`
+	ActivityType  string                       `jsonapi:"attr,activityType"`
+	Title         string                       `jsonapi:"attr,title"`
+	// The result of the latest attempt.
+	Request      string `jsonapi:"attr,request"`
+	ResponseCode int    `jsonapi:"attr,responseCode"`
+	ResponseBody string `jsonapi:"attr,responseBody"`
+	LatencyMs    int    `jsonapi:"attr,latencyMs"`
+	Error        string `jsonapi:"attr,error"`
+}
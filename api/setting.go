@@ -2,6 +2,8 @@ package api
 
 import (
 	"encoding/json"
+
+	"github.com/bytebase/bytebase/plugin/ldap"
 )
 
 // SettingName is the name of a setting.
@@ -20,13 +22,29 @@ const (
 	SettingEnterpriseTrial SettingName = "bb.enterprise.trial"
 	// SettingAppIM is the setting name for IM applications.
 	SettingAppIM SettingName = "bb.app.im"
+	// SettingBackupEncryptionKey is the setting name for the key to encrypt the backups.
+	SettingBackupEncryptionKey SettingName = "bb.backup.encryption-key"
+	// SettingBackupRestoreDrill is the setting name for the backup restore drills.
+	SettingBackupRestoreDrill SettingName = "bb.backup.restore-drill"
+	// SettingWorkspaceLDAP is the setting name for the LDAP directory sync.
+	SettingWorkspaceLDAP SettingName = "bb.workspace.ldap"
 )
 
 // IMType is the type of IM.
 type IMType string
 
-// IMTypeFeishu is IM feishu.
-const IMTypeFeishu IMType = "im.feishu"
+const (
+	// IMTypeFeishu is IM feishu.
+	IMTypeFeishu IMType = "im.feishu"
+	// IMTypeDingTalk is IM DingTalk.
+	IMTypeDingTalk IMType = "im.dingtalk"
+	// IMTypeWeCom is IM WeCom.
+	IMTypeWeCom IMType = "im.wecom"
+	// IMTypeSlack is IM Slack.
+	IMTypeSlack IMType = "im.slack"
+	// IMTypeHTTP is the generic HTTP callback.
+	IMTypeHTTP IMType = "im.http"
+)
 
 // Setting is the API message for a setting.
 type Setting struct {
@@ -71,11 +89,35 @@ func (find *SettingFind) String() string {
 
 // SettingAppIMValue is the setting value of SettingAppIM type setting.
 type SettingAppIMValue struct {
-	IMType           IMType `json:"imType"`
-	AppID            string `json:"appId"`
-	AppSecret        string `json:"appSecret"`
+	IMType    IMType `json:"imType"`
+	AppID     string `json:"appId"`
+	AppSecret string `json:"appSecret"`
+	// SigningSecret verifies the callbacks from Slack and the generic HTTP callback.
+	SigningSecret string `json:"signingSecret"`
+	// URL is the endpoint of the generic HTTP callback.
+	URL              string `json:"url"`
 	ExternalApproval struct {
 		Enabled              bool   `json:"enabled"`
 		ApprovalDefinitionID string `json:"approvalDefinitionID"`
 	} `json:"externalApproval"`
 }
+
+// SettingBackupRestoreDrillValue is the setting value of SettingBackupRestoreDrill type setting.
+type SettingBackupRestoreDrillValue struct {
+	// InstanceID is the ID of the designated instance to restore the backups into scratch databases.
+	InstanceID int `json:"instanceId"`
+	// DatabaseIDList is the list of the databases whose latest backups are restored in the drills.
+	DatabaseIDList []int `json:"databaseIdList"`
+	// IntervalTs is the interval in seconds between two drills of a database.
+	IntervalTs int `json:"intervalTs"`
+}
+
+// SettingWorkspaceLDAPValue is the setting value of SettingWorkspaceLDAP type setting.
+type SettingWorkspaceLDAPValue struct {
+	Enabled bool        `json:"enabled"`
+	Config  ldap.Config `json:"config"`
+	// IntervalTs is the interval in seconds between two syncs.
+	IntervalTs int `json:"intervalTs"`
+	// GroupMappingList maps the LDAP groups to the workspace roles and the project memberships.
+	GroupMappingList []*GroupMapping `json:"groupMappingList"`
+}
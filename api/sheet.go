@@ -26,6 +26,12 @@ const (
 	SheetFromGitLabSelfHost SheetSource = "GITLAB_SELF_HOST"
 	// SheetFromGitHubCom is the sheet synced from github.com.
 	SheetFromGitHubCom SheetSource = "GITHUB_COM"
+	// SheetFromGitHubEnterprise is the sheet synced from GitHub Enterprise Server.
+	SheetFromGitHubEnterprise SheetSource = "GITHUB_ENTERPRISE"
+	// SheetFromGitea is the sheet synced from Gitea.
+	SheetFromGitea SheetSource = "GITEA"
+	// SheetFromBitbucket is the sheet synced from Bitbucket.
+	SheetFromBitbucket SheetSource = "BITBUCKET"
 )
 
 // SheetType is the type of sheet.
@@ -37,7 +43,7 @@ const (
 )
 
 // SheetVCSPayload is the additional data payload of the VCS sheet.
-// The sheet source should be one of SheetFromGitLabSelfHost and SheetFromGitHubCom.
+// The sheet source should be one of SheetFromGitLabSelfHost, SheetFromGitHubCom, SheetFromGitHubEnterprise, SheetFromGitea and SheetFromBitbucket.
 type SheetVCSPayload struct {
 	FileName     string `json:"fileName"`
 	FilePath     string `json:"filePath"`
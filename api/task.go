@@ -189,7 +189,20 @@ type TaskDatabaseDataUpdatePayload struct {
 	BinlogFileEnd   string `json:"binlogFileEnd,omitempty"`
 	BinlogPosStart  int64  `json:"binlogPosStart,omitempty"`
 	BinlogPosEnd    int64  `json:"binlogPosEnd,omitempty"`
-	RollbackError   string `json:"rollbackError,omitempty"`
+
+	// PostgreSQL rollback SQL related.
+
+	// TransactionID is the ID of the migration transaction.
+	// We use it to filter the logical decoding changes of the migration transaction.
+	TransactionID string `json:"transactionId,omitempty"`
+	// ReplicationSlot is the logical replication slot created before executing the migration.
+	// It captures the changes of the migration transaction and is dropped after generating the rollback SQL.
+	ReplicationSlot string `json:"replicationSlot,omitempty"`
+	// LSNEnd is the WAL location after executing the migration.
+	// We decode the changes till it.
+	LSNEnd string `json:"lsnEnd,omitempty"`
+
+	RollbackError string `json:"rollbackError,omitempty"`
 	// RollbackStatement is the generated rollback SQL statement for the DML task.
 	RollbackStatement string `json:"rollbackStatement,omitempty"`
 	// RollbackFromIssueID is the issue ID containing the original task from which the rollback SQL statement is generated for this task.
@@ -205,6 +218,10 @@ type TaskDatabaseBackupPayload struct {
 	SkippedReason string `json:"skippedReason,omitempty"`
 
 	BackupID int `json:"backupId,omitempty"`
+	// Compression is the compression algorithm of the backup file. Empty means not compressed.
+	Compression BackupCompression `json:"compression,omitempty"`
+	// Encryption is true if the backup file should be encrypted by the workspace backup encryption key.
+	Encryption bool `json:"encryption,omitempty"`
 }
 
 // Task is the API message for a task.
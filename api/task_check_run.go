@@ -79,6 +79,8 @@ const (
 	TaskCheckIssueLGTM TaskCheckType = "bb.task-check.issue.lgtm"
 	// TaskCheckPITRMySQL is the task check type for MySQL PITR.
 	TaskCheckPITRMySQL TaskCheckType = "bb.task-check.pitr.mysql"
+	// TaskCheckPITRPostgres is the task check type for PostgreSQL PITR.
+	TaskCheckPITRPostgres TaskCheckType = "bb.task-check.pitr.postgres"
 )
 
 // TaskCheckEarliestAllowedTimePayload is the task check payload for earliest allowed time.
@@ -124,6 +126,9 @@ type TaskCheckResult struct {
 	Status    TaskCheckStatus `json:"status,omitempty"`
 	Title     string          `json:"title,omitempty"`
 	Content   string          `json:"content,omitempty"`
+	// Suppressed is true if the advice is suppressed by the bytebase:disable comment in the statement.
+	Suppressed     bool   `json:"suppressed,omitempty"`
+	SuppressReason string `json:"suppressReason,omitempty"`
 }
 
 // TaskCheckRunResultPayload is the result payload of a task check run.
@@ -19,6 +19,8 @@ type VCS struct {
 	ApplicationID string   `jsonapi:"attr,applicationId"`
 	// For safety concerns, we will not return the secret, and all relevant logic is dealt in the backend.
 	Secret string
+	// CACert is the PEM encoded CA bundle to verify the TLS certificate of the self-hosted VCS instance.
+	CACert string `jsonapi:"attr,caCert"`
 }
 
 // VCSCreate is the API message for creating a VCS.
@@ -35,6 +37,7 @@ type VCSCreate struct {
 	APIURL        string
 	ApplicationID string `jsonapi:"attr,applicationId"`
 	Secret        string `jsonapi:"attr,secret"`
+	CACert        string `jsonapi:"attr,caCert"`
 }
 
 // VCSFind is the API message for finding VCSs.
@@ -62,6 +65,7 @@ type VCSPatch struct {
 	Name          *string `jsonapi:"attr,name"`
 	ApplicationID *string `jsonapi:"attr,applicationId"`
 	Secret        *string `jsonapi:"attr,secret"`
+	CACert        *string `jsonapi:"attr,caCert"`
 }
 
 // VCSDelete is the API message for deleting a VCS.
@@ -89,6 +93,8 @@ type VCSExchangeToken struct {
 	InstanceURL  string   `jsonapi:"attr,instanceUrl"`
 	ClientID     string   `jsonapi:"attr,clientId"`
 	ClientSecret string   `jsonapi:"attr,clientSecret"`
+	// CACert is only used when exchanging the token for a VCS being created.
+	CACert string `jsonapi:"attr,caCert"`
 }
 
 // VCSSQLReviewResult the is SQL review result in VCS workflow.
@@ -103,7 +109,7 @@ type VCSSQLReviewRequest struct {
 	RepositoryID  string `json:"repositoryId"`
 	PullRequestID string `json:"pullRequestId"`
 	// WebURL is the server URL for GitOps CI.
-	// In GitHub, the URL should be "https://github.com". Docs: https://docs.github.com/en/actions/learn-github-actions/environment-variables
+	// In GitHub, the URL should be "https://github.com" or the URL of the GitHub Enterprise Server. Docs: https://docs.github.com/en/actions/learn-github-actions/environment-variables
 	// In GitLab, the URL should be the base URL of the GitLab instance like "https://gitlab.bytebase.com". Docs: https://docs.gitlab.com/ee/ci/variables/predefined_variables.html
 	WebURL string `json:"webURL"`
 }
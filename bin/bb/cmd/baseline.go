@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/plugin/db"
+)
+
+func newBaselineCmd() *cobra.Command {
+	var dsn string
+	opts := &migrationOptions{migrationType: db.Baseline}
+	baselineCmd := &cobra.Command{
+		Use:   "baseline",
+		Short: "Baseline the database schema.",
+		Long: `Baseline the database schema.
+It records the current schema as the baseline of the migration history without executing any statement,
+which is usually used to start managing an existing database or to accept a schema drift.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			u, err := parseDSN(dsn)
+			if err != nil {
+				return err
+			}
+			return migrateDatabase(context.Background(), u, opts, strings.NewReader(""))
+		},
+	}
+
+	baselineCmd.Flags().StringVar(&dsn, "dsn", "", dsnUsage)
+	addMigrationFlags(baselineCmd, opts)
+	return baselineCmd
+}
@@ -0,0 +1,187 @@
+// Package cmd is the command surface of Bytebase bb tool provided by bytebase.com.
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/plugin/parser"
+	"github.com/bytebase/bytebase/plugin/parser/differ"
+
+	// Register pingcap parser driver.
+	_ "github.com/pingcap/tidb/types/parser_driver"
+	// Register mysql differ driver.
+	_ "github.com/bytebase/bytebase/plugin/parser/differ/mysql"
+	// Register postgres differ driver.
+	_ "github.com/bytebase/bytebase/plugin/parser/differ/pg"
+	// Register postgres parser driver.
+	_ "github.com/bytebase/bytebase/plugin/parser/engine/pg"
+)
+
+// errSchemaDrift is returned when the source and target schemas differ, so that bb exits with exitCodeSchemaDrift.
+var errSchemaDrift = &exitError{
+	code: exitCodeSchemaDrift,
+	err:  errors.New("schema drift detected between source and target"),
+}
+
+const diffSchemaUsage = `Database connection string or schema file.
+It's treated as a DSN if it contains "://", and a schema file otherwise.`
+
+func newDiffCmd() *cobra.Command {
+	var (
+		source string
+		target string
+		engine string
+		file   string
+	)
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Generates the migration script from the source schema to the target schema.",
+		Long: `Generates the migration script from the source schema to the target schema.
+The source and target could be either a live database or a schema file.
+bb exits with code 2 if the schemas differ, and code 1 on errors, so that it could be used to gate CI.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := context.Background()
+			diff, err := diffSchema(ctx, source, target, engine)
+			if err != nil {
+				return err
+			}
+			if diff == "" {
+				fmt.Fprintln(cmd.ErrOrStderr(), "No schema drift detected.")
+				return nil
+			}
+
+			out := cmd.OutOrStdout()
+			if file != "" {
+				f, err := os.Create(file)
+				if err != nil {
+					return errors.Wrapf(err, "failed to create migration file %s", file)
+				}
+				defer f.Close()
+				out = f
+			}
+			if _, err := fmt.Fprint(out, diff); err != nil {
+				return errors.Wrap(err, "failed to write migration script")
+			}
+			// The drift isn't a usage error, so don't print the usage.
+			cmd.SilenceUsage = true
+			return errSchemaDrift
+		},
+	}
+
+	diffCmd.Flags().StringVar(&source, "source", "", "Source "+diffSchemaUsage)
+	diffCmd.Flags().StringVar(&target, "target", "", "Target "+diffSchemaUsage)
+	diffCmd.Flags().StringVar(&engine, "engine", "", "Database engine of the schema files, mysql or postgres. Inferred from the DSN if unspecified.")
+	diffCmd.Flags().StringVar(&file, "file", "", "File to store the migration script. Output to stdout if unspecified")
+	if err := diffCmd.MarkFlagRequired("source"); err != nil {
+		panic(err)
+	}
+	if err := diffCmd.MarkFlagRequired("target"); err != nil {
+		panic(err)
+	}
+	return diffCmd
+}
+
+// schemaSource is the schema of either a live database or a schema file.
+type schemaSource struct {
+	// engine is empty for schema files.
+	engine parser.EngineType
+	schema string
+}
+
+// diffSchema returns the migration script from the source schema to the target schema.
+// The returned script is empty if there is no difference.
+func diffSchema(ctx context.Context, source, target, engine string) (string, error) {
+	sourceSchema, err := loadSchema(ctx, source)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to load source schema %q", source)
+	}
+	targetSchema, err := loadSchema(ctx, target)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to load target schema %q", target)
+	}
+
+	engineType, err := getDiffEngine(engine, sourceSchema.engine, targetSchema.engine)
+	if err != nil {
+		return "", err
+	}
+	diff, err := differ.SchemaDiff(engineType, sourceSchema.schema, targetSchema.schema)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to compute schema diff")
+	}
+	if strings.TrimSpace(diff) == "" {
+		return "", nil
+	}
+	return diff, nil
+}
+
+// loadSchema dumps the schema of the database if s is a DSN, and reads the schema file otherwise.
+func loadSchema(ctx context.Context, s string) (*schemaSource, error) {
+	if !strings.Contains(s, "://") {
+		content, err := os.ReadFile(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read schema file %q", s)
+		}
+		return &schemaSource{schema: string(content)}, nil
+	}
+
+	u, err := parseDSN(s)
+	if err != nil {
+		return nil, err
+	}
+	dbType, err := getDBType(u)
+	if err != nil {
+		return nil, err
+	}
+	engineType, err := getEngineType(string(dbType))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := dumpDatabase(ctx, u, &buf, true /* schemaOnly */); err != nil {
+		return nil, err
+	}
+	return &schemaSource{engine: engineType, schema: buf.String()}, nil
+}
+
+func getEngineType(name string) (parser.EngineType, error) {
+	switch strings.ToLower(name) {
+	case "mysql", "tidb":
+		return parser.MySQL, nil
+	case "postgres", "postgresql", "pg":
+		return parser.Postgres, nil
+	default:
+		return "", errors.Errorf("database engine %q not supported; supported engines: mysql, postgres", name)
+	}
+}
+
+// getDiffEngine resolves the engine from the --engine flag and the engines of the DSNs, which must be consistent.
+func getDiffEngine(engine string, engineList ...parser.EngineType) (parser.EngineType, error) {
+	var engineType parser.EngineType
+	if engine != "" {
+		tp, err := getEngineType(engine)
+		if err != nil {
+			return "", err
+		}
+		engineType = tp
+	}
+	for _, tp := range engineList {
+		if tp == "" {
+			continue
+		}
+		if engineType != "" && engineType != tp {
+			return "", errors.Errorf("mismatched database engines %q and %q", engineType, tp)
+		}
+		engineType = tp
+	}
+	if engineType == "" {
+		return "", errors.New("--engine is required when both source and target are schema files")
+	}
+	return engineType, nil
+}
@@ -23,9 +23,9 @@ func newDumpCmd() *cobra.Command {
 		Use:   "dump",
 		Short: "Exports schema and data of a database.",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			u, err := dburl.Parse(dsn)
+			u, err := parseDSN(dsn)
 			if err != nil {
-				return errors.Wrap(err, "failed to parse dsn, got error")
+				return err
 			}
 			out := cmd.OutOrStdout()
 			if file != "" {
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/xo/dburl"
+
+	"github.com/bytebase/bytebase/plugin/db"
+)
+
+func newHistoryCmd() *cobra.Command {
+	var (
+		dsn              string
+		migrationVersion string
+		limit            int
+		format           string
+	)
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "Lists the migration history of a database.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			u, err := parseDSN(dsn)
+			if err != nil {
+				return err
+			}
+			if format != "text" && format != "json" {
+				return errors.Errorf("invalid format %q; supported formats: text, json", format)
+			}
+			historyList, err := findMigrationHistoryList(context.Background(), u, migrationVersion, limit)
+			if err != nil {
+				return err
+			}
+			if format == "json" {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(historyList)
+			}
+			return writeMigrationHistoryTable(cmd.OutOrStdout(), historyList)
+		},
+	}
+
+	historyCmd.Flags().StringVar(&dsn, "dsn", "", dsnUsage)
+	historyCmd.Flags().StringVar(&migrationVersion, "version", "", "Only list the migration history of the version.")
+	historyCmd.Flags().IntVar(&limit, "limit", 0, "Only list the most recent migration histories. List all if unspecified.")
+	historyCmd.Flags().StringVar(&format, "format", "text", "Output format, text or json.")
+	return historyCmd
+}
+
+// findMigrationHistoryList returns the migration histories of the database in the dsn, from the most recent one.
+// The version is filtered here rather than by the driver, which only supports non-semantic versions.
+func findMigrationHistoryList(ctx context.Context, u *dburl.URL, migrationVersion string, limit int) ([]*db.MigrationHistory, error) {
+	driver, err := open(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	find := &db.MigrationHistoryFind{}
+	if database := getDatabase(u); database != "" {
+		find.Database = &database
+	}
+	if limit > 0 && migrationVersion == "" {
+		find.Limit = &limit
+	}
+	list, err := driver.FindMigrationHistoryList(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find migration history")
+	}
+
+	historyList := []*db.MigrationHistory{}
+	for _, history := range list {
+		if migrationVersion != "" && history.Version != migrationVersion {
+			continue
+		}
+		if limit > 0 && len(historyList) >= limit {
+			break
+		}
+		historyList = append(historyList, history)
+	}
+	return historyList, nil
+}
+
+func writeMigrationHistoryTable(out io.Writer, historyList []*db.MigrationHistory) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "VERSION\tTYPE\tSTATUS\tSOURCE\tDATABASE\tCREATOR\tCREATED\tDURATION\tISSUE\tDESCRIPTION"); err != nil {
+		return err
+	}
+	for _, history := range historyList {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			history.Version,
+			history.Type,
+			history.Status,
+			history.Source,
+			history.Namespace,
+			history.Creator,
+			time.Unix(history.CreatedTs, 0).Format(time.RFC3339),
+			time.Duration(history.ExecutionDurationNs).Round(time.Millisecond),
+			history.IssueID,
+			history.Description,
+		); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
@@ -14,23 +14,34 @@ import (
 
 	"github.com/bytebase/bytebase/common"
 	"github.com/bytebase/bytebase/plugin/db"
+	"github.com/bytebase/bytebase/plugin/db/util"
 )
 
+// migrationOptions is the options of a migration.
+type migrationOptions struct {
+	migrationType db.MigrationType
+	// version is the migration version. It's set to the current timestamp if unspecified.
+	version            string
+	useSemanticVersion bool
+	description        string
+	issueID            string
+	createDatabase     bool
+}
+
 func newMigrateCmd() *cobra.Command {
 	var (
 		dsn         string
 		fileList    []string
 		commandList []string
-		description string
-		issueID     string
 	)
+	opts := &migrationOptions{migrationType: db.Migrate}
 	migrateCmd := &cobra.Command{
 		Use:   "migrate",
 		Short: "Migrate the database schema.",
 		RunE: func(_ *cobra.Command, _ []string) error {
-			u, err := dburl.Parse(dsn)
+			u, err := parseDSN(dsn)
 			if err != nil {
-				return errors.Wrap(err, "failed to parse dsn")
+				return err
 			}
 
 			var sqlReaders []io.Reader
@@ -52,18 +63,50 @@ func newMigrateCmd() *cobra.Command {
 			}
 
 			sqlReader := io.MultiReader(sqlReaders...)
-			return migrateDatabase(context.Background(), u, description, issueID, false /*createDatabase*/, sqlReader)
+			return migrateDatabase(context.Background(), u, opts, sqlReader)
 		}}
 
 	migrateCmd.Flags().StringVar(&dsn, "dsn", "", dsnUsage)
 	migrateCmd.Flags().StringSliceVarP(&fileList, "file", "f", []string{}, "SQL file to execute.")
 	migrateCmd.Flags().StringSliceVarP(&commandList, "command", "c", []string{}, "SQL command to execute.")
-	migrateCmd.Flags().StringVar(&description, "description", "", "Description of migration.")
-	migrateCmd.Flags().StringVar(&issueID, "issue-id", "", "Issue ID of migration.")
+	addMigrationFlags(migrateCmd, opts)
 	return migrateCmd
 }
 
-func migrateDatabase(ctx context.Context, u *dburl.URL, description, issueID string, createDatabase bool, sqlReader io.Reader) error {
+// addMigrationFlags adds the flags shared by the migrate and baseline commands.
+func addMigrationFlags(cmd *cobra.Command, opts *migrationOptions) {
+	cmd.Flags().StringVar(&opts.version, "version", "", "Version of migration. The current timestamp is used if unspecified.")
+	cmd.Flags().BoolVar(&opts.useSemanticVersion, "semantic-version", false, "Whether the version is a semantic version such as 1.2.0.")
+	cmd.Flags().StringVar(&opts.description, "description", "", "Description of migration.")
+	cmd.Flags().StringVar(&opts.issueID, "issue-id", "", "Issue ID of migration.")
+}
+
+// getMigrationVersion returns the version and the semantic version suffix of the migration.
+func getMigrationVersion(opts *migrationOptions) (string, string, error) {
+	if !opts.useSemanticVersion {
+		if opts.version == "" {
+			return common.DefaultMigrationVersion(), "", nil
+		}
+		return opts.version, "", nil
+	}
+
+	if opts.version == "" {
+		return "", "", errors.New("--version is required for semantic version")
+	}
+	// The suffix keeps the stored version unique when baselining to the same semantic version for fixing schema drift.
+	suffix := common.DefaultMigrationVersion()
+	if _, err := util.ToStoredVersion(true, opts.version, suffix); err != nil {
+		return "", "", errors.Wrapf(err, "invalid semantic version %q", opts.version)
+	}
+	return opts.version, suffix, nil
+}
+
+func migrateDatabase(ctx context.Context, u *dburl.URL, opts *migrationOptions, sqlReader io.Reader) error {
+	migrationVersion, semanticVersionSuffix, err := getMigrationVersion(opts)
+	if err != nil {
+		return err
+	}
+
 	driver, err := open(ctx, u)
 	if err != nil {
 		return err
@@ -83,19 +126,26 @@ func migrateDatabase(ctx context.Context, u *dburl.URL, description, issueID str
 	if _, err := io.Copy(&buf, sqlReader); err != nil {
 		return errors.Wrap(err, "failed to read sql file")
 	}
-	// TODO(d): support semantic versioning.
+	statement := strings.TrimSpace(buf.String())
+	// Only baseline can have empty sql statement, which indicates empty database.
+	if opts.migrationType != db.Baseline && statement == "" {
+		return errors.New("empty statement")
+	}
 	if _, _, err := driver.ExecuteMigration(ctx, &db.MigrationInfo{
-		ReleaseVersion: version,
-		Version:        common.DefaultMigrationVersion(),
-		Database:       getDatabase(u),
-		Source:         db.LIBRARY,
-		Type:           db.Migrate,
-		Description:    description,
-		Creator:        migrationCreator,
-		IssueID:        issueID,
-		CreateDatabase: createDatabase,
-	}, buf.String()); err != nil {
-		return errors.Wrap(err, "failed to migrate database")
+		ReleaseVersion:        version,
+		Version:               migrationVersion,
+		UseSemanticVersion:    opts.useSemanticVersion,
+		SemanticVersionSuffix: semanticVersionSuffix,
+		Namespace:             getDatabase(u),
+		Database:              getDatabase(u),
+		Source:                db.LIBRARY,
+		Type:                  opts.migrationType,
+		Description:           opts.description,
+		Creator:               migrationCreator,
+		IssueID:               opts.issueID,
+		CreateDatabase:        opts.createDatabase,
+	}, statement); err != nil {
+		return errors.Wrapf(err, "failed to %s database", strings.ToLower(string(opts.migrationType)))
 	}
 	return nil
 }
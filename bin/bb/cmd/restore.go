@@ -8,26 +8,36 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/xo/dburl"
+
+	"github.com/bytebase/bytebase/plugin/storage/backupfile"
 )
 
 func newRestoreCmd() *cobra.Command {
 	var (
-		dsn  string
-		file string
+		dsn           string
+		file          string
+		encryptionKey string
 	)
 	restoreCmd := &cobra.Command{
 		Use:   "restore",
 		Short: "Restores schema and data of a database.",
 		RunE: func(_ *cobra.Command, _ []string) error {
-			u, err := dburl.Parse(dsn)
+			u, err := parseDSN(dsn)
 			if err != nil {
-				return errors.Wrap(err, "failed to parse dsn")
+				return err
+			}
+			var key []byte
+			if encryptionKey != "" {
+				if key, err = backupfile.DecodeKey(encryptionKey); err != nil {
+					return err
+				}
 			}
-			return restoreDatabase(context.Background(), u, file)
+			return restoreDatabase(context.Background(), u, file, key)
 		},
 	}
 	restoreCmd.Flags().StringVar(&dsn, "dsn", "", dsnUsage)
 	restoreCmd.Flags().StringVar(&file, "file", "", "File to store the dump.")
+	restoreCmd.Flags().StringVar(&encryptionKey, "encryption-key", "", "Base64 encoded key to decrypt the encrypted backup file, which is the bb.backup.encryption-key setting of the Bytebase workspace.")
 	if err := restoreCmd.MarkFlagRequired("file"); err != nil {
 		panic(err)
 	}
@@ -36,12 +46,18 @@ func newRestoreCmd() *cobra.Command {
 }
 
 // restoreDatabase restores the schema of a database instance.
-func restoreDatabase(ctx context.Context, u *dburl.URL, file string) error {
+// The compressed or encrypted backup file taken by Bytebase is decoded transparently.
+func restoreDatabase(ctx context.Context, u *dburl.URL, file string, key []byte) error {
 	f, err := os.Open(file)
 	if err != nil {
 		return errors.Wrapf(err, "failed to open file %q", file)
 	}
 	defer f.Close()
+	r, err := backupfile.NewReader(f, key)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode backup file %q", file)
+	}
+	defer r.Close()
 
 	db, err := open(ctx, u)
 	if err != nil {
@@ -49,7 +65,7 @@ func restoreDatabase(ctx context.Context, u *dburl.URL, file string) error {
 	}
 	defer db.Close(ctx)
 
-	if err := db.Restore(ctx, f); err != nil {
+	if err := db.Restore(ctx, r); err != nil {
 		return errors.Wrapf(err, "failed to restore from backup file %q", file)
 	}
 	return nil
@@ -0,0 +1,253 @@
+// Package cmd is the command surface of Bytebase bb tool provided by bytebase.com.
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/xo/dburl"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/plugin/advisor"
+	"github.com/bytebase/bytebase/plugin/advisor/catalog"
+	advisorDB "github.com/bytebase/bytebase/plugin/advisor/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+
+	// Register fake advisor.
+	_ "github.com/bytebase/bytebase/plugin/advisor/fake"
+	// Register mysql advisor.
+	_ "github.com/bytebase/bytebase/plugin/advisor/mysql"
+	// Register postgresql advisor.
+	_ "github.com/bytebase/bytebase/plugin/advisor/pg"
+)
+
+// errSQLReviewFailed is returned when the SQL review finds problems at or above the failing level, so that bb exits with a non-zero code.
+var errSQLReviewFailed = errors.New("SQL review failed")
+
+var (
+	_ catalog.Catalog = (*reviewCatalog)(nil)
+)
+
+// reviewCatalog is the catalog for the offline SQL review.
+type reviewCatalog struct {
+	finder *catalog.Finder
+}
+
+// GetFinder implements the catalog.Catalog interface.
+func (c *reviewCatalog) GetFinder() *catalog.Finder {
+	return c.finder
+}
+
+// reviewOptions is the options for the offline SQL review.
+type reviewOptions struct {
+	engine     string
+	config     string
+	template   string
+	dsn        string
+	charset    string
+	collation  string
+	format     string
+	file       string
+	failOnWarn bool
+	// disableSuppression ignores the bytebase:disable comments in the SQL files.
+	disableSuppression bool
+}
+
+func newReviewCmd() *cobra.Command {
+	opts := &reviewOptions{}
+	reviewCmd := &cobra.Command{
+		Use:   "review [flags] FILE...",
+		Short: "Reviews SQL files against the SQL review rules.",
+		Long: `Reviews SQL files against the SQL review rules.
+Each file is reviewed independently. The catalog is built from the database specified by --dsn if provided,
+which is required by rules depending on the existing schema, such as the column type change check.
+bb exits with a non-zero code if there are errors, or warnings with --fail-on-warning, so that it could be used to gate CI.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resultList, err := reviewFiles(ctx, opts, args)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if opts.file != "" {
+				f, err := os.Create(opts.file)
+				if err != nil {
+					return errors.Wrapf(err, "failed to create report file %s", opts.file)
+				}
+				defer f.Close()
+				out = f
+			}
+			if err := writeReviewReport(out, opts.format, resultList); err != nil {
+				return err
+			}
+
+			for _, result := range resultList {
+				for _, advice := range result.adviceList {
+					if advice.Status == advisor.Error || (opts.failOnWarn && advice.Status == advisor.Warn) {
+						// The review failure isn't a usage error, so don't print the usage.
+						cmd.SilenceUsage = true
+						return errSQLReviewFailed
+					}
+				}
+			}
+			return nil
+		},
+	}
+
+	reviewCmd.Flags().StringVar(&opts.engine, "engine", "", "Database engine of the SQL files, mysql, tidb or postgres. Inferred from the DSN if unspecified.")
+	reviewCmd.Flags().StringVar(&opts.config, "config", "", "SQL review config file in YAML format, which overrides the rules of a template. Check https://github.com/bytebase/bytebase/tree/main/plugin/advisor/config/sql-review.override.yaml for example.")
+	reviewCmd.Flags().StringVar(&opts.template, "template", "", "SQL review template id, bb.sql-review.prod, bb.sql-review.dev, bb.sql-review.postgres.prod or bb.sql-review.postgres.dev. Ignored if the config is specified.\nDefaults to the prod template of the database engine.")
+	reviewCmd.Flags().StringVar(&opts.dsn, "dsn", "", "Database connection string to build the catalog from. Optional.\n"+dsnUsage)
+	reviewCmd.Flags().StringVar(&opts.charset, "charset", "utf8mb4", "Default charset of the database.")
+	reviewCmd.Flags().StringVar(&opts.collation, "collation", "utf8mb4_general_ci", "Default collation of the database.")
+	reviewCmd.Flags().StringVar(&opts.format, "format", reviewFormatText, "Output format, text, json, sarif or junit.")
+	reviewCmd.Flags().StringVar(&opts.file, "file", "", "File to store the review report. Output to stdout if unspecified")
+	reviewCmd.Flags().BoolVar(&opts.failOnWarn, "fail-on-warning", false, "Exit with a non-zero code on warnings as well as errors.")
+	reviewCmd.Flags().BoolVar(&opts.disableSuppression, "disable-suppression", false, "Ignore the bytebase:disable comments in the SQL files, so that no problem could be suppressed.")
+	return reviewCmd
+}
+
+// reviewResult is the SQL review result of a file.
+type reviewResult struct {
+	file       string
+	adviceList []advisor.Advice
+}
+
+// reviewFiles reviews the SQL files and returns the problems found in each file.
+func reviewFiles(ctx context.Context, opts *reviewOptions, fileList []string) ([]*reviewResult, error) {
+	if !isValidReviewFormat(opts.format) {
+		return nil, errors.Errorf("invalid format %q; supported formats: text, json, sarif, junit", opts.format)
+	}
+	var u *dburl.URL
+	var err error
+	if opts.dsn != "" {
+		if u, err = parseDSN(opts.dsn); err != nil {
+			return nil, err
+		}
+	}
+	dbType, err := getReviewEngine(opts.engine, u)
+	if err != nil {
+		return nil, err
+	}
+	template := opts.template
+	if template == "" {
+		template = string(getDefaultReviewTemplate(dbType))
+	}
+	ruleList, err := getReviewRuleList(opts.config, template)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata *storepb.DatabaseMetadata
+	var connection *sql.DB
+	if u != nil {
+		if getDatabase(u) == "" {
+			return nil, errors.New("database name is required in the dsn to build the catalog")
+		}
+		driver, err := open(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		defer driver.Close(ctx)
+		if metadata, err = driver.SyncDBSchema(ctx, getDatabase(u)); err != nil {
+			return nil, errors.Wrap(err, "failed to sync database schema")
+		}
+		if connection, err = driver.GetDBConnection(ctx, getDatabase(u)); err != nil {
+			return nil, errors.Wrap(err, "failed to get database connection")
+		}
+	}
+
+	var resultList []*reviewResult
+	for _, file := range fileList {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read SQL file %q", file)
+		}
+
+		// The catalog is walked through by the review, so each file needs a fresh one.
+		finder := catalog.NewEmptyFinder(&catalog.FinderContext{CheckIntegrity: false, EngineType: dbType})
+		if metadata != nil {
+			finder = catalog.NewFinder(metadata, &catalog.FinderContext{CheckIntegrity: true, EngineType: dbType})
+		}
+		adviceList, err := advisor.SQLReviewCheck(string(content), ruleList, advisor.SQLReviewCheckContext{
+			Charset:            opts.charset,
+			Collation:          opts.collation,
+			DbType:             dbType,
+			Catalog:            &reviewCatalog{finder: finder},
+			Driver:             connection,
+			Context:            ctx,
+			DisableSuppression: opts.disableSuppression,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to review SQL file %q", file)
+		}
+
+		result := &reviewResult{file: file}
+		for _, advice := range adviceList {
+			// The suppressed advice is a success as well.
+			if advice.Status == advisor.Success {
+				continue
+			}
+			result.adviceList = append(result.adviceList, advice)
+		}
+		resultList = append(resultList, result)
+	}
+	return resultList, nil
+}
+
+// getReviewRuleList merges the config override into its template, or returns the rules of the template if there is no config.
+func getReviewRuleList(config, template string) ([]*advisor.SQLReviewRule, error) {
+	override := &advisor.SQLReviewConfigOverride{
+		Template: advisor.SQLReviewTemplateID(template),
+	}
+	if config != "" {
+		content, err := os.ReadFile(config)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read config file %q", config)
+		}
+		override = &advisor.SQLReviewConfigOverride{}
+		if err := yaml.Unmarshal(content, override); err != nil {
+			return nil, errors.Wrapf(err, "invalid config file %q", config)
+		}
+	}
+	ruleList, err := advisor.MergeSQLReviewRules(override)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot merge the config for template %q", override.Template)
+	}
+	return ruleList, nil
+}
+
+// getDefaultReviewTemplate returns the prod template of the engine.
+func getDefaultReviewTemplate(dbType advisorDB.Type) advisor.SQLReviewTemplateID {
+	if dbType == advisorDB.Postgres {
+		return advisor.TemplateForPostgreSQLProd
+	}
+	return advisor.TemplateForMySQLProd
+}
+
+// getReviewEngine resolves the advisor engine from the --engine flag, or the driver of the dsn.
+func getReviewEngine(engine string, u *dburl.URL) (advisorDB.Type, error) {
+	if engine == "" && u != nil {
+		dbType, err := getDBType(u)
+		if err != nil {
+			return "", err
+		}
+		engine = string(dbType)
+	}
+	switch engine {
+	case "":
+		return "", errors.New("--engine is required if --dsn is not specified")
+	case "postgresql", "pg":
+		engine = "postgres"
+	}
+	dbType, err := advisorDB.ConvertToAdvisorDBType(engine)
+	if err != nil {
+		return "", errors.Errorf("database engine %q not supported; supported engines: mysql, tidb, postgres", engine)
+	}
+	return dbType, nil
+}
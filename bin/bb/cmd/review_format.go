@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/advisor"
+)
+
+const (
+	reviewFormatText  = "text"
+	reviewFormatJSON  = "json"
+	reviewFormatSARIF = "sarif"
+	reviewFormatJUnit = "junit"
+)
+
+func isValidReviewFormat(format string) bool {
+	switch format {
+	case reviewFormatText, reviewFormatJSON, reviewFormatSARIF, reviewFormatJUnit:
+		return true
+	default:
+		return false
+	}
+}
+
+// writeReviewReport writes the review results in the given format.
+func writeReviewReport(out io.Writer, format string, resultList []*reviewResult) error {
+	var err error
+	switch format {
+	case reviewFormatText:
+		err = writeReviewText(out, resultList)
+	case reviewFormatJSON:
+		err = writeReviewJSON(out, resultList)
+	case reviewFormatSARIF:
+		err = writeReviewSARIF(out, resultList)
+	case reviewFormatJUnit:
+		err = writeReviewJUnit(out, resultList)
+	default:
+		return errors.Errorf("invalid format %q", format)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to write review report")
+	}
+	return nil
+}
+
+func writeReviewText(out io.Writer, resultList []*reviewResult) error {
+	errorCount, warningCount := 0, 0
+	for _, result := range resultList {
+		for _, advice := range result.adviceList {
+			if advice.Status == advisor.Error {
+				errorCount++
+			} else {
+				warningCount++
+			}
+			location := result.file
+			if advice.Line > 0 {
+				location = fmt.Sprintf("%s:%d", result.file, advice.Line)
+			}
+			message := advice.Title
+			if advice.Content != "" {
+				message = fmt.Sprintf("%s: %s", advice.Title, advice.Content)
+			}
+			if _, err := fmt.Fprintf(out, "%s: [%s] %s\n", location, advice.Status, message); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(out, "%d file(s) reviewed, %d error(s), %d warning(s).\n", len(resultList), errorCount, warningCount)
+	return err
+}
+
+// reviewJSONAdvice is the advice in the JSON report.
+type reviewJSONAdvice struct {
+	File string `json:"file"`
+	advisor.Advice
+}
+
+func writeReviewJSON(out io.Writer, resultList []*reviewResult) error {
+	adviceList := []reviewJSONAdvice{}
+	for _, result := range resultList {
+		for _, advice := range result.adviceList {
+			adviceList = append(adviceList, reviewJSONAdvice{File: result.file, Advice: advice})
+		}
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(adviceList)
+}
+
+// SARIF is the Static Analysis Results Interchange Format supported by code scanning tools such as GitHub.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+func writeReviewSARIF(out io.Writer, resultList []*reviewResult) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "bb",
+				InformationURI: "https://www.bytebase.com/docs/sql-review/review-rules",
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+	ruleSet := make(map[string]bool)
+	for _, result := range resultList {
+		for _, advice := range result.adviceList {
+			if !ruleSet[advice.Title] {
+				ruleSet[advice.Title] = true
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: advice.Title})
+			}
+			level := "warning"
+			if advice.Status == advisor.Error {
+				level = "error"
+			}
+			message := advice.Content
+			if message == "" {
+				message = advice.Title
+			}
+			location := sarifLocation{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: result.file},
+				},
+			}
+			if advice.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{StartLine: advice.Line}
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    advice.Title,
+				Level:     level,
+				Message:   sarifMessage{Text: message},
+				Locations: []sarifLocation{location},
+			})
+		}
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	})
+}
+
+// JUnit XML report is supported by most CI systems such as GitLab and Jenkins.
+// Each file is a test suite, and each advice is a failed test case.
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+func writeReviewJUnit(out io.Writer, resultList []*reviewResult) error {
+	report := junitTestSuites{}
+	for _, result := range resultList {
+		suite := junitTestSuite{Name: result.file}
+		for _, advice := range result.adviceList {
+			content := advice.Content
+			if advice.Line > 0 {
+				content = fmt.Sprintf("%s:%d: %s", result.file, advice.Line, advice.Content)
+			}
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      advice.Title,
+				ClassName: result.file,
+				Failure: &junitFailure{
+					Message: advice.Title,
+					Type:    string(advice.Status),
+					Content: content,
+				},
+			})
+			suite.Failures++
+		}
+		if len(suite.TestCases) == 0 {
+			suite.TestCases = append(suite.TestCases, junitTestCase{Name: "SQL review", ClassName: result.file})
+		}
+		suite.Tests = len(suite.TestCases)
+		report.TestSuites = append(report.TestSuites, suite)
+	}
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(out)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, "\n")
+	return err
+}
@@ -2,6 +2,7 @@
 package cmd
 
 import (
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/bytebase/bytebase/common/log"
@@ -17,7 +18,7 @@ func NewRootCmd() *cobra.Command {
 		},
 	}
 
-	rootCmd.AddCommand(newDumpCmd(), newRestoreCmd(), newVersionCmd(), newMigrateCmd())
+	rootCmd.AddCommand(newDumpCmd(), newRestoreCmd(), newVersionCmd(), newMigrateCmd(), newBaselineCmd(), newHistoryCmd(), newDiffCmd(), newReviewCmd())
 
 	return rootCmd
 }
@@ -27,3 +28,25 @@ func Execute() (err error) {
 	defer log.Sync()
 	return NewRootCmd().Execute()
 }
+
+// exitCodeSchemaDrift is the exit code of bb diff if the schemas differ, which tells the drift from the errors exiting with 1.
+const exitCodeSchemaDrift = 2
+
+// exitError is the error exiting bb with the specific code.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+// ExitCode returns the exit code for the error returned by Execute.
+func ExitCode(err error) int {
+	var e *exitError
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return 1
+}
@@ -2,23 +2,103 @@ package cmd
 
 import (
 	"context"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/xo/dburl"
 
 	"github.com/bytebase/bytebase/plugin/db"
+	"github.com/bytebase/bytebase/resources/mongoutil"
 	"github.com/bytebase/bytebase/resources/mysqlutil"
 	"github.com/bytebase/bytebase/resources/postgres"
 
-	// install mysql driver.
+	// Register clickhouse driver.
+	_ "github.com/bytebase/bytebase/plugin/db/clickhouse"
+	// Register mongodb driver.
+	_ "github.com/bytebase/bytebase/plugin/db/mongodb"
+	// Register mysql and tidb driver.
 	_ "github.com/bytebase/bytebase/plugin/db/mysql"
-	// register pg driver.
+	// Register postgres driver.
 	_ "github.com/bytebase/bytebase/plugin/db/pg"
-	// install pg driver.
+	// Register snowflake driver.
+	_ "github.com/bytebase/bytebase/plugin/db/snowflake"
+	// Register spanner driver.
+	_ "github.com/bytebase/bytebase/plugin/db/spanner"
+	// Register sqlite driver.
+	_ "github.com/bytebase/bytebase/plugin/db/sqlite"
 )
 
+// parseDSN parses the database connection string.
+// MongoDB connection strings aren't supported by dburl, so they are parsed as plain URLs.
+func parseDSN(dsn string) (*dburl.URL, error) {
+	if strings.HasPrefix(dsn, "mongodb://") || strings.HasPrefix(dsn, "mongodb+srv://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse dsn")
+		}
+		return &dburl.URL{URL: *u, OriginalScheme: u.Scheme, Driver: "mongodb", Unaliased: "mongodb"}, nil
+	}
+	u, err := dburl.Parse(dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse dsn")
+	}
+	if _, err := getDBType(u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// getDBType returns the database type of the dsn.
+func getDBType(u *dburl.URL) (db.Type, error) {
+	switch u.Unaliased {
+	case "mysql":
+		return db.MySQL, nil
+	case "tidb":
+		return db.TiDB, nil
+	case "postgres":
+		return db.Postgres, nil
+	case "clickhouse":
+		return db.ClickHouse, nil
+	case "snowflake":
+		return db.Snowflake, nil
+	case "sqlite3":
+		return db.SQLite, nil
+	case "spanner":
+		return db.Spanner, nil
+	case "mongodb":
+		return db.MongoDB, nil
+	default:
+		return "", errors.Errorf("database type %q not supported; supported types: mysql, tidb, postgres, clickhouse, snowflake, sqlite, spanner, mongodb", u.OriginalScheme)
+	}
+}
+
+// getSQLitePath returns the path of the SQLite database file without the ".db" extension.
+func getSQLitePath(u *dburl.URL) string {
+	p := u.Opaque
+	if p == "" {
+		p = u.Path
+	}
+	return strings.TrimSuffix(p, ".db")
+}
+
+// getSpannerPath returns the database path, such as projects/<project>/instances/<instance>/databases/<database>.
+func getSpannerPath(u *dburl.URL) string {
+	return strings.Trim(u.Host+u.Path, "/")
+}
+
 func getDatabase(u *dburl.URL) string {
+	switch u.Unaliased {
+	case "sqlite3":
+		return filepath.Base(getSQLitePath(u))
+	case "spanner":
+		if _, database, ok := strings.Cut(getSpannerPath(u), "/databases/"); ok {
+			return database
+		}
+		return ""
+	}
 	if u.Path == "" {
 		return ""
 	}
@@ -26,46 +106,75 @@ func getDatabase(u *dburl.URL) string {
 }
 
 func open(ctx context.Context, u *dburl.URL) (db.Driver, error) {
-	var dbType db.Type
+	dbType, err := getDBType(u)
+	if err != nil {
+		return nil, err
+	}
+
 	var dbBinDir string
 	resourceDir := os.TempDir()
-	switch u.Driver {
-	case "mysql":
-		dbType = db.MySQL
+	switch dbType {
+	case db.MySQL, db.TiDB:
 		dir, err := mysqlutil.Install(resourceDir)
 		if err != nil {
 			return nil, errors.Wrapf(err, "cannot install mysqlutil in directory %q", resourceDir)
 		}
 		dbBinDir = dir
-	case "postgres":
-		dbType = db.Postgres
+	case db.Postgres:
 		dir, err := postgres.Install(resourceDir)
 		if err != nil {
 			return nil, errors.Wrapf(err, "cannot install postgres in directory %q", resourceDir)
 		}
 		dbBinDir = dir
-	default:
-		return nil, errors.Errorf("database type %q not supported; supported types: mysql, pg", u.Driver)
+	case db.MongoDB:
+		dir, err := mongoutil.Install(resourceDir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot install mongoutil in directory %q", resourceDir)
+		}
+		dbBinDir = dir
 	}
+
 	passwd, _ := u.User.Password()
+	connCfg := db.ConnectionConfig{
+		Host:     u.Hostname(),
+		Port:     u.Port(),
+		Username: u.User.Username(),
+		Password: passwd,
+		Database: getDatabase(u),
+		TLSConfig: db.TLSConfig{
+			SslCA:   u.Query().Get("ssl-ca"),
+			SslCert: u.Query().Get("ssl-cert"),
+			SslKey:  u.Query().Get("ssl-key"),
+		},
+	}
+	switch dbType {
+	case db.SQLite:
+		// The host is the directory containing the SQLite database files.
+		connCfg.Host = filepath.Dir(getSQLitePath(u))
+	case db.Spanner:
+		// The host is the instance path, and the password is the content of the service account credentials file.
+		instance, _, _ := strings.Cut(getSpannerPath(u), "/databases/")
+		connCfg.Host = instance
+		connCfg.Port = ""
+		if credentials := u.Query().Get("credentials"); credentials != "" {
+			content, err := os.ReadFile(credentials)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read credentials file %q", credentials)
+			}
+			connCfg.Password = string(content)
+		}
+	case db.MongoDB:
+		connCfg.SRV = u.Scheme == "mongodb+srv"
+		connCfg.AuthenticationDatabase = u.Query().Get("authSource")
+	}
+
 	driver, err := db.Open(
 		ctx,
 		dbType,
 		db.DriverConfig{
 			DbBinDir: dbBinDir,
 		},
-		db.ConnectionConfig{
-			Host:     u.Hostname(),
-			Port:     u.Port(),
-			Username: u.User.Username(),
-			Password: passwd,
-			Database: getDatabase(u),
-			TLSConfig: db.TLSConfig{
-				SslCA:   u.Query().Get("ssl-ca"),
-				SslCert: u.Query().Get("ssl-cert"),
-				SslKey:  u.Query().Get("ssl-key"),
-			},
-		},
+		connCfg,
 		db.ConnectionContext{},
 	)
 	if err != nil {
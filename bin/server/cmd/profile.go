@@ -20,7 +20,7 @@ func getBaseProfile() config.Profile {
 	}
 	backupStorageBackend := api.BackupStorageBackendLocal
 	if flags.backupBucket != "" {
-		backupStorageBackend = api.BackupStorageBackendS3
+		backupStorageBackend = flags.backupStorageBackend
 	}
 
 	return config.Profile{
@@ -35,10 +35,12 @@ func getBaseProfile() config.Profile {
 		GitCommit:            gitcommit,
 		PgURL:                flags.pgURL,
 		DisableMetric:        flags.disableMetric,
+		EnablePrometheus:     flags.enablePrometheus,
 		BackupStorageBackend: backupStorageBackend,
 		BackupRegion:         flags.backupRegion,
 		BackupBucket:         flags.backupBucket,
 		BackupCredentialFile: flags.backupCredential,
+		BackupEndpoint:       flags.backupEndpoint,
 		FeishuAPIURL:         feishu.APIPath,
 	}
 }
@@ -146,3 +146,14 @@ func TruncateStringWithDescription(str string) string {
 func GetBinlogAbsDir(dataDir string, instanceID int) string {
 	return filepath.Join(dataDir, "backup", "instance", fmt.Sprintf("%d", instanceID))
 }
+
+// GetWALArchiveAbsDir gets the WAL archive directory for a PostgreSQL instance.
+func GetWALArchiveAbsDir(dataDir string, instanceID int) string {
+	return filepath.Join(GetBinlogAbsDir(dataDir, instanceID), "wal-archive")
+}
+
+// GetWALArchiveRelativeDir composes the relative directory for the WAL archive.
+// It's useful to convert a local absolute WAL archive directory path to the cloud path.
+func GetWALArchiveRelativeDir(walArchiveDir string) string {
+	return filepath.Join(GetBinlogRelativeDir(filepath.Dir(walArchiveDir)), filepath.Base(walArchiveDir))
+}
@@ -81,6 +81,38 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    {
+                        "description": "The default charset of the database. Default to utf8mb4.",
+                        "name": "charset",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "The default collation of the database. Default to utf8mb4_general_ci.",
+                        "name": "collation",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "The schema dump of the database, which is loaded into the catalog for the rules depending on the existing schema. Cannot be specified with the metadata.",
+                        "name": "schema",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "The database metadata in JSON format, which is loaded into the catalog for the rules depending on the existing schema. Cannot be specified with the schema.",
+                        "name": "metadata",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
@@ -17,9 +17,18 @@ import { useI18n } from "vue-i18n";
 import { semverCompare } from "@/utils";
 
 export const MIN_PITR_SUPPORT_MYSQL_VERSION = "8.0.0";
+// PITR_SUPPORT_POSTGRES_MAJOR_VERSION is the major version of the bundled PostgreSQL replaying the WAL files.
+export const PITR_SUPPORT_POSTGRES_MAJOR_VERSION = "15";
+
+const isPITRSupportedPostgresVersion = (engineVersion: string): boolean => {
+  return engineVersion.split(".")[0] === PITR_SUPPORT_POSTGRES_MAJOR_VERSION;
+};
 
 export const isPITRAvailableOnInstance = (instance: Instance): boolean => {
   const { engine, engineVersion } = instance;
+  if (engine === "POSTGRES") {
+    return true;
+  }
   return (
     engine === "MYSQL" &&
     semverCompare(engineVersion, MIN_PITR_SUPPORT_MYSQL_VERSION)
@@ -40,6 +49,19 @@ export const usePITRLogic = (database: Ref<Database>) => {
 
   const pitrAvailable = computed((): { result: boolean; message: string } => {
     const { engine, engineVersion } = database.value.instance;
+    if (engine === "POSTGRES") {
+      // PostgreSQL PITR replays the archived WAL files on the base backups, which doesn't rely on the logical backups.
+      // The WAL archiving settings are verified by the PITR task check.
+      if (isPITRSupportedPostgresVersion(engineVersion)) {
+        return { result: true, message: "ok" };
+      }
+      return {
+        result: false,
+        message: t("database.pitr.postgres-major-version-required", {
+          version: PITR_SUPPORT_POSTGRES_MAJOR_VERSION,
+        }),
+      };
+    }
     if (
       engine === "MYSQL" &&
       semverCompare(engineVersion, MIN_PITR_SUPPORT_MYSQL_VERSION)
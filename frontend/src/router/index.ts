@@ -342,6 +342,20 @@ const routes: Array<RouteRecordRaw> = [
                   import("../views/SettingWorkspaceIMIntegration.vue"),
                 props: true,
               },
+              {
+                path: "sso",
+                name: "setting.workspace.sso",
+                meta: { title: () => t("settings.sidebar.sso") },
+                component: () => import("../views/SettingWorkspaceSSO.vue"),
+                props: true,
+              },
+              {
+                path: "ldap",
+                name: "setting.workspace.ldap",
+                meta: { title: () => t("settings.sidebar.ldap") },
+                component: () => import("../views/SettingWorkspaceLDAP.vue"),
+                props: true,
+              },
               {
                 path: "sensitive-data",
                 name: "setting.workspace.sensitive-data",
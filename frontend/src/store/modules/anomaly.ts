@@ -5,6 +5,9 @@ import { computed, ref, unref, watch } from "vue";
 import {
   Anomaly,
   AnomalyFind,
+  AnomalyId,
+  AnomalyReconcileDirection,
+  IssueId,
   ResourceObject,
   ResourceObjects,
   ResponseWithData,
@@ -77,6 +80,26 @@ export const useAnomalyStore = defineStore("anomaly", {
       );
       return anomalyList;
     },
+    async reconcileAnomaly(
+      anomalyId: AnomalyId,
+      direction: AnomalyReconcileDirection
+    ): Promise<{ id: IssueId; name: string }> {
+      const data = (
+        await axios.post(`/api/anomaly/${anomalyId}/reconcile`, {
+          data: {
+            type: "anomalyReconcile",
+            attributes: {
+              direction,
+            },
+          },
+        })
+      ).data;
+      // Returns the issue reconciling the anomaly.
+      return {
+        id: parseInt(data.data.id),
+        name: data.data.attributes.name as string,
+      };
+    },
   },
 });
 
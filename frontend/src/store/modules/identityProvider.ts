@@ -0,0 +1,79 @@
+import { defineStore } from "pinia";
+import axios from "axios";
+import {
+  IdentityProvider,
+  IdentityProviderCreate,
+  IdentityProviderId,
+  IdentityProviderPatch,
+  ResourceObject,
+} from "@/types";
+
+function convert(identityProvider: ResourceObject): IdentityProvider {
+  return {
+    ...(identityProvider.attributes as Omit<IdentityProvider, "id">),
+    id: parseInt(identityProvider.id),
+  };
+}
+
+interface IdentityProviderState {
+  identityProviderList: IdentityProvider[];
+  // loginIdentityProviderList is the list shown on the sign in page, which doesn't contain the config.
+  loginIdentityProviderList: IdentityProvider[];
+}
+
+export const useIdentityProviderStore = defineStore("identityProvider", {
+  state: (): IdentityProviderState => ({
+    identityProviderList: [],
+    loginIdentityProviderList: [],
+  }),
+
+  actions: {
+    async fetchLoginIdentityProviderList() {
+      const data = (await axios.get("/api/auth/idp")).data;
+      const identityProviderList = data.data.map(convert);
+      this.loginIdentityProviderList = identityProviderList;
+      return identityProviderList;
+    },
+
+    async fetchIdentityProviderList() {
+      const data = (await axios.get("/api/idp")).data;
+      const identityProviderList = data.data.map(convert);
+      this.identityProviderList = identityProviderList;
+      return identityProviderList;
+    },
+
+    async createIdentityProvider(create: IdentityProviderCreate) {
+      const data = (
+        await axios.post("/api/idp", {
+          data: {
+            type: "IdentityProviderCreate",
+            attributes: create,
+          },
+        })
+      ).data;
+      const identityProvider = convert(data.data);
+      this.identityProviderList.push(identityProvider);
+      return identityProvider;
+    },
+
+    async patchIdentityProvider(
+      id: IdentityProviderId,
+      patch: IdentityProviderPatch
+    ) {
+      const data = (
+        await axios.patch(`/api/idp/${id}`, {
+          data: {
+            type: "IdentityProviderPatch",
+            attributes: patch,
+          },
+        })
+      ).data;
+      const identityProvider = convert(data.data);
+      const i = this.identityProviderList.findIndex((item) => item.id === id);
+      if (i >= 0) {
+        this.identityProviderList[i] = identityProvider;
+      }
+      return identityProvider;
+    },
+  },
+});
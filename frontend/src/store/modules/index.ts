@@ -13,6 +13,7 @@ export * from "./deployment";
 export * from "./environment";
 export * from "./gitlab";
 export * from "./help";
+export * from "./identityProvider";
 export * from "./issue";
 export * from "./issueSubscriber";
 export * from "./inbox";
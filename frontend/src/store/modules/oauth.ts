@@ -50,12 +50,14 @@ export const useOAuthStore = defineStore("oauth", {
       instanceUrl,
       clientId,
       clientSecret,
+      caCert,
       code,
     }: {
       vcsType: VCSType;
       instanceUrl: string;
       clientId: string;
       clientSecret: string;
+      caCert: string;
       code: string;
     }): Promise<OAuthToken> {
       const data = (
@@ -67,6 +69,7 @@ export const useOAuthStore = defineStore("oauth", {
               instanceUrl,
               clientId,
               clientSecret,
+              caCert,
               code,
             },
           },
@@ -18,6 +18,7 @@ import {
   PolicyResourceType,
   PolicyType,
   PolicyUpsert,
+  SchemaDriftPolicyPayload,
   SensitiveDataPolicyPayload,
 } from "@/types/policy";
 import { getPrincipalFromIncludedList } from "./principal";
@@ -87,6 +88,13 @@ function convert(
       payload.sensitiveDataList = [];
     }
   }
+  if (result.type === "bb.policy.schema-drift") {
+    const payload = result.payload as SchemaDriftPolicyPayload;
+    if (!payload.ignoreList) {
+      // The array might be null, fill it with empty array to fallback.
+      payload.ignoreList = [];
+    }
+  }
 
   return result;
 }
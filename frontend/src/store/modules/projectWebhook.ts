@@ -4,6 +4,7 @@ import {
   ProjectId,
   ProjectWebhook,
   ProjectWebhookCreate,
+  ProjectWebhookDelivery,
   ProjectWebhookId,
   ProjectWebhookPatch,
   ProjectWebhookState,
@@ -22,6 +23,13 @@ function convert(
   };
 }
 
+function convertDelivery(delivery: ResourceObject): ProjectWebhookDelivery {
+  return {
+    ...(delivery.attributes as Omit<ProjectWebhookDelivery, "id">),
+    id: parseInt(delivery.id),
+  };
+}
+
 function convertTestResult(
   testResult: ResourceObject
 ): ProjectWebhookTestResult {
@@ -181,6 +189,42 @@ export const useProjectWebhookStore = defineStore("projectWebhook", {
 
       return convertTestResult(data.data);
     },
+    async fetchProjectWebhookDeliveryList({
+      projectId,
+      projectWebhookId,
+    }: {
+      projectId: ProjectId;
+      projectWebhookId: ProjectWebhookId;
+    }): Promise<ProjectWebhookDelivery[]> {
+      const data = (
+        await axios.get(
+          `/api/project/${projectId}/webhook/${projectWebhookId}/delivery`
+        )
+      ).data;
+
+      return data.data.map((delivery: ResourceObject) =>
+        convertDelivery(delivery)
+      );
+    },
+
+    async redeliverProjectWebhookDelivery({
+      projectId,
+      projectWebhookId,
+      deliveryId,
+    }: {
+      projectId: ProjectId;
+      projectWebhookId: ProjectWebhookId;
+      deliveryId: number;
+    }): Promise<ProjectWebhookDelivery> {
+      const data = (
+        await axios.post(
+          `/api/project/${projectId}/webhook/${projectWebhookId}/delivery/${deliveryId}/redeliver`
+        )
+      ).data;
+
+      return convertDelivery(data.data);
+    },
+
     setProjectWebhookListByProjectId({
       projectId,
       projectWebhookList,
@@ -1,7 +1,7 @@
 import { defineStore } from "pinia";
 import axios from "axios";
 import { ResourceObject, SettingState } from "@/types";
-import { Setting, SettingName } from "@/types/setting";
+import { LDAPSyncResult, Setting, SettingName } from "@/types/setting";
 
 function convert(
   setting: ResourceObject,
@@ -63,5 +63,8 @@ export const useSettingStore = defineStore("setting", {
 
       return setting;
     },
+    async syncLDAP(): Promise<LDAPSyncResult> {
+      return (await axios.post(`/api/ldap/sync`)).data;
+    },
   },
 });
@@ -47,6 +47,7 @@ const convertToSQLReviewPolicy = (
     environment: policy.environment,
     name: payload.name,
     ruleList,
+    disableSuppression: payload.disableSuppression ?? false,
   };
 };
 
@@ -103,10 +104,12 @@ export const useSQLReviewStore = defineStore("sqlReview", {
       name,
       environmentId,
       ruleList,
+      disableSuppression,
     }: {
       name: string;
       environmentId: number;
       ruleList: SchemaPolicyRule[];
+      disableSuppression?: boolean;
     }) {
       const payload: SQLReviewPolicyPayload = {
         name,
@@ -114,6 +117,7 @@ export const useSQLReviewStore = defineStore("sqlReview", {
           ...r,
           payload: r.payload ? JSON.stringify(r.payload) : "{}",
         })),
+        disableSuppression: disableSuppression ?? false,
       };
 
       const policyStore = usePolicyStore();
@@ -150,11 +154,13 @@ export const useSQLReviewStore = defineStore("sqlReview", {
       name,
       rowStatus,
       ruleList,
+      disableSuppression,
     }: {
       id: PolicyId;
       name?: string;
       rowStatus?: RowStatus;
       ruleList?: SchemaPolicyRule[];
+      disableSuppression?: boolean;
     }) {
       const index = this.reviewPolicyList.findIndex((g) => g.id === id);
       if (index < 0) {
@@ -174,6 +180,9 @@ export const useSQLReviewStore = defineStore("sqlReview", {
             ...r,
             payload: r.payload ? JSON.stringify(r.payload) : "{}",
           })),
+          // Keep the current setting if it isn't changed.
+          disableSuppression:
+            disableSuppression ?? targetPolicy.disableSuppression,
         };
         policyUpsert.payload = payload;
       }
@@ -6,6 +6,7 @@ import {
   EnvironmentId,
   Instance,
   InstanceId,
+  IssueId,
   Principal,
   RowStatus,
 } from ".";
@@ -16,7 +17,8 @@ export type AnomalyType =
   | "bb.anomaly.database.backup.policy-violation"
   | "bb.anomaly.database.backup.missing"
   | "bb.anomaly.database.connection"
-  | "bb.anomaly.database.schema.drift";
+  | "bb.anomaly.database.schema.drift"
+  | "bb.anomaly.database.backup.restore-drill-failure";
 
 export type AnomalyInstanceConnectionPayload = {
   detail: string;
@@ -39,15 +41,55 @@ export type AnomalyDatabaseConnectionPayload = {
 
 export type AnomalyDatabaseSchemaDriftPayload = {
   version: string;
-  expect: string;
-  actual: string;
+  // The ID of the issue reconciling the drift.
+  issueId?: IssueId;
+  // The object level drifts, absent for the drifts detected by comparing the schema dumps.
+  driftList?: SchemaDriftItem[];
+};
+
+export type SchemaDriftAction = "ADDED" | "REMOVED" | "CHANGED";
+
+export type SchemaDriftObjectType =
+  | "TABLE"
+  | "COLUMN"
+  | "INDEX"
+  | "FOREIGN_KEY"
+  | "VIEW"
+  | "TRIGGER"
+  | "FUNCTION"
+  | "PROCEDURE"
+  | "SEQUENCE"
+  | "ENUM_TYPE";
+
+export type SchemaDriftItem = {
+  action: SchemaDriftAction;
+  objectType: SchemaDriftObjectType;
+  schema?: string;
+  // table is the table or view name, absent for the schema level objects such as functions.
+  table?: string;
+  // name is the column, index, foreign key, trigger or schema level object name.
+  name?: string;
+  expect?: string;
+  actual?: string;
+};
+
+// REVERT applies the DDL reverting the actual schema to the expected one,
+// ACCEPT establishes a new baseline accepting the actual schema.
+export type AnomalyReconcileDirection = "REVERT" | "ACCEPT";
+
+export type AnomalyDatabaseBackupRestoreDrillFailurePayload = {
+  backupId: number;
+  backupName: string;
+  drillInstanceId: number;
+  detailList: string[];
 };
 
 export type AnomalyPayload =
   | AnomalyDatabaseBackupPolicyViolationPayload
   | AnomalyDatabaseBackupMissingPayload
   | AnomalyDatabaseConnectionPayload
-  | AnomalyDatabaseSchemaDriftPayload;
+  | AnomalyDatabaseSchemaDriftPayload
+  | AnomalyDatabaseBackupRestoreDrillFailurePayload;
 
 export type AnomalySeverity = "MEDIUM" | "HIGH" | "CRITICAL";
 
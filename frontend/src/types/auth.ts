@@ -2,8 +2,12 @@
 
 import { VCSId } from "./id";
 
-// For now, a single user's auth provider should either belong to GITLAB_SELF_HOST, GITHUB_COM or BYTEBASE
-export type AuthProviderType = "GITLAB_SELF_HOST" | "GITHUB_COM" | "BYTEBASE";
+// For now, a single user's auth provider should either belong to GITLAB_SELF_HOST, GITHUB_COM, GITHUB_ENTERPRISE or BYTEBASE
+export type AuthProviderType =
+  | "GITLAB_SELF_HOST"
+  | "GITHUB_COM"
+  | "GITHUB_ENTERPRISE"
+  | "BYTEBASE";
 
 export type SignupInfo = {
   email: string;
@@ -4,7 +4,9 @@ export type BackupStatus = "PENDING_CREATE" | "DONE" | "FAILED";
 
 export type BackupType = "MANUAL" | "AUTOMATIC" | "PITR";
 
-export type BackupStorageBackend = "LOCAL" | "S3" | "GCS";
+export type BackupStorageBackend = "LOCAL" | "S3" | "GCS" | "OSS";
+
+export type BackupCompression = "GZIP" | "ZSTD";
 
 // Backup
 export type Backup = {
@@ -406,6 +406,7 @@ const makeUnknown = (type: ResourceType) => {
     apiUrl: "",
     applicationId: "",
     secret: "",
+    caCert: "",
   };
 
   const UNKNOWN_REPOSITORY: Repository = {
@@ -485,6 +486,7 @@ const makeUnknown = (type: ResourceType) => {
     environment: UNKNOWN_ENVIRONMENT,
     name: "",
     ruleList: [],
+    disableSuppression: false,
   };
 
   switch (type) {
@@ -786,6 +788,7 @@ const makeEmpty = (type: ResourceType) => {
     apiUrl: "",
     applicationId: "",
     secret: "",
+    caCert: "",
   };
 
   const EMPTY_REPOSITORY: Repository = {
@@ -865,6 +868,7 @@ const makeEmpty = (type: ResourceType) => {
     environment: EMPTY_ENVIRONMENT,
     name: "",
     ruleList: [],
+    disableSuppression: false,
   };
 
   const EMPTY_AUDIT_LOG: AuditLog = {
@@ -1,4 +1,9 @@
-export type ExternalApprovalType = "bb.plugin.app.feishu";
+export type ExternalApprovalType =
+  | "bb.plugin.app.feishu"
+  | "bb.plugin.app.dingtalk"
+  | "bb.plugin.app.wecom"
+  | "bb.plugin.app.slack"
+  | "bb.plugin.app.http";
 
 export type ExternalApprovalEvent = {
   type: ExternalApprovalType;
@@ -70,3 +70,5 @@ export type ContainerId = IssueId;
 export type LabelId = IdType;
 
 export type DeploymentConfigId = IdType;
+
+export type IdentityProviderId = IdType;
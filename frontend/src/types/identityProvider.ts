@@ -0,0 +1,65 @@
+import { IdentityProviderId } from "./id";
+import { GroupMapping } from "./member";
+import { RowStatus } from "./common";
+
+export type IdentityProviderType = "OIDC" | "SAML";
+
+export type IdentityProviderFieldMapping = {
+  email: string;
+  displayName: string;
+  groups: string;
+};
+
+export type OIDCIdentityProviderConfig = {
+  issuer: string;
+  clientId: string;
+  // clientSecret is redacted in the response, leave it empty to keep the existing one.
+  clientSecret: string;
+  scopes: string[];
+  fieldMapping: IdentityProviderFieldMapping;
+};
+
+export type SAMLIdentityProviderConfig = {
+  entityId: string;
+  ssoUrl: string;
+  // certificate is the PEM encoded certificate to verify the signed assertions.
+  certificate: string;
+  fieldMapping: IdentityProviderFieldMapping;
+};
+
+export type IdentityProviderConfig = {
+  oidc?: OIDCIdentityProviderConfig;
+  saml?: SAMLIdentityProviderConfig;
+  groupMappingList: GroupMapping[];
+  // allowLinkingExistingUser allows signing in as the existing user with the same email on the first sign in.
+  allowLinkingExistingUser?: boolean;
+};
+
+export type IdentityProvider = {
+  id: IdentityProviderId;
+
+  // Standard fields
+  rowStatus: RowStatus;
+
+  // Domain specific fields
+  name: string;
+  type: IdentityProviderType;
+  // config is the JSON encoded IdentityProviderConfig.
+  config: string;
+};
+
+export type IdentityProviderCreate = {
+  // Domain specific fields
+  name: string;
+  type: IdentityProviderType;
+  config: string;
+};
+
+export type IdentityProviderPatch = {
+  // Standard fields
+  rowStatus?: RowStatus;
+
+  // Domain specific fields
+  name?: string;
+  config?: string;
+};
@@ -15,6 +15,7 @@ export * from "./error";
 export * from "./errorList";
 export * from "./help";
 export * from "./id";
+export * from "./identityProvider";
 export * from "./inbox";
 export * from "./instance";
 export * from "./issue";
@@ -1,6 +1,7 @@
 import { RowStatus } from "./common";
-import { MemberId, PrincipalId } from "./id";
+import { MemberId, PrincipalId, ProjectId } from "./id";
 import { Principal } from "./principal";
+import { ProjectRoleType } from "./project";
 
 export type MemberStatus = "INVITED" | "ACTIVE";
 
@@ -30,3 +31,12 @@ export type MemberPatch = {
   // Domain specific fields
   role?: RoleType;
 };
+
+// GroupMapping maps an external group, such as an identity provider group or an LDAP group,
+// to a workspace role and/or a project membership.
+export type GroupMapping = {
+  group: string;
+  role?: RoleType;
+  projectId?: ProjectId;
+  projectRole?: ProjectRoleType;
+};
@@ -44,7 +44,7 @@ export function openWindowForOAuth(
   const stateQueryParameter = `${type}-${randomString(20)}`;
   sessionStorage.setItem(OAuthStateSessionKey, stateQueryParameter);
 
-  if (vcsType == "GITHUB_COM") {
+  if (vcsType == "GITHUB_COM" || vcsType == "GITHUB_ENTERPRISE") {
     // GitHub OAuth App scopes: https://docs.github.com/en/developers/apps/building-oauth-apps/scopes-for-oauth-apps
     // We need the workflow scope to update GitHub action files.
     return window.open(
@@ -55,6 +55,21 @@ export function openWindowForOAuth(
       "location=yes,left=200,top=200,height=640,width=480,scrollbars=yes,status=yes"
     );
   }
+  if (vcsType == "BITBUCKET") {
+    // Bitbucket Cloud takes the scopes configured in the OAuth consumer, while
+    // Bitbucket Data Center requires the scope in the authorization request.
+    // https://confluence.atlassian.com/bitbucketserver/bitbucket-oauth-2-0-provider-api-1108483661.html
+    const scope = endpoint.startsWith("https://bitbucket.org/")
+      ? ""
+      : "&scope=REPO_ADMIN";
+    return window.open(
+      `${endpoint}?client_id=${applicationId}&redirect_uri=${encodeURIComponent(
+        redirectUrl()
+      )}&state=${stateQueryParameter}&response_type=code${scope}`,
+      "oauth",
+      "location=yes,left=200,top=200,height=640,width=480,scrollbars=yes,status=yes"
+    );
+  }
   // GITLAB_SELF_HOST
   // GitLab OAuth App scopes: https://docs.gitlab.com/ee/integration/oauth_provider.html#authorized-applications
   return window.open(
@@ -257,7 +257,8 @@ export type TaskCheckType =
   | "bb.task-check.instance.migration-schema"
   | "bb.task-check.database.ghost.sync"
   | "bb.task-check.issue.lgtm"
-  | "bb.task-check.pitr.mysql";
+  | "bb.task-check.pitr.mysql"
+  | "bb.task-check.pitr.postgres";
 
 export type TaskCheckDatabaseStatementAdvisePayload = {
   statement: string;
@@ -290,6 +291,9 @@ export type TaskCheckResult = {
   title: string;
   content: string;
   namespace: TaskCheckNamespace;
+  // The advice suppressed by the bytebase:disable comment in the statement.
+  suppressed?: boolean;
+  suppressReason?: string;
 };
 
 export type TaskCheckRunResultPayload = {
@@ -6,6 +6,8 @@ export type FeatureType =
   // Admin & Security
   | "bb.feature.3rd-party-auth"
   | "bb.feature.rbac"
+  | "bb.feature.sso"
+  | "bb.feature.ldap"
   // Branding
   | "bb.feature.branding"
   // Change Workflow
@@ -80,6 +82,8 @@ export const FEATURE_MATRIX: Map<FeatureType, boolean[]> = new Map([
   // Admin & Security
   ["bb.feature.3rd-party-auth", [false, true, true]],
   ["bb.feature.rbac", [false, true, true]],
+  ["bb.feature.sso", [false, false, true]],
+  ["bb.feature.ldap", [false, false, true]],
   // Branding
   ["bb.feature.branding", [false, false, true]],
   // Change Workflow
@@ -1,4 +1,6 @@
 import {
+  BackupCompression,
+  BackupStorageBackend,
   RowStatus,
   Environment,
   IssueType,
@@ -15,7 +17,8 @@ export type PolicyType =
   | "bb.policy.sql-review"
   | "bb.policy.environment-tier"
   | "bb.policy.sensitive-data"
-  | "bb.policy.access-control";
+  | "bb.policy.access-control"
+  | "bb.policy.schema-drift";
 
 export type PipelineApprovalPolicyValue =
   | "MANUAL_APPROVAL_NEVER"
@@ -41,6 +44,11 @@ export type BackupPlanPolicySchedule = "UNSET" | "DAILY" | "WEEKLY";
 
 export type BackupPlanPolicyPayload = {
   schedule: BackupPlanPolicySchedule;
+  // Empty means the workspace default storage backend.
+  storageBackend?: BackupStorageBackend;
+  // Empty means not compressed.
+  compression?: BackupCompression;
+  encryption?: boolean;
 };
 
 export const DefaultSchedulePolicy: BackupPlanPolicySchedule = "UNSET";
@@ -53,6 +61,7 @@ export type SQLReviewPolicyPayload = {
     level: RuleLevel;
     payload: string;
   }[];
+  disableSuppression?: boolean;
 };
 
 export type AssigneeGroupValue = "WORKSPACE_OWNER_OR_DBA" | "PROJECT_OWNER";
@@ -70,12 +79,29 @@ export type AssigneeGroup = {
   value: AssigneeGroupValue;
 };
 
-export type SensitiveDataMaskType = "DEFAULT";
+export type SensitiveDataMaskType =
+  | "DEFAULT"
+  | "PARTIAL"
+  | "EMAIL"
+  | "PHONE"
+  | "HASH"
+  | "RANGE";
+
+export type SensitiveDataMaskOption = {
+  // prefixLength and suffixLength are used by the PARTIAL mask type.
+  prefixLength: number;
+  suffixLength: number;
+  // rangeWidth is used by the RANGE mask type.
+  rangeWidth: number;
+};
 
 export type SensitiveData = {
+  // schema is only used by engines having the schema level, such as PostgreSQL.
+  schema?: string;
   table: string;
   column: string;
   maskType: SensitiveDataMaskType;
+  maskOption?: SensitiveDataMaskOption;
 };
 
 export type SensitiveDataPolicyPayload = {
@@ -90,13 +116,27 @@ export type AccessControlPolicyPayload = {
   disallowRuleList: AccessControlRule[];
 };
 
+// Each field of SchemaDriftIgnore is a glob pattern, and an empty field matches anything.
+export type SchemaDriftIgnore = {
+  // schema is only used by engines having the schema level, such as PostgreSQL.
+  schema?: string;
+  table?: string;
+  // object matches column, index and foreign key names. An empty object ignores the whole table.
+  object?: string;
+};
+
+export type SchemaDriftPolicyPayload = {
+  ignoreList: SchemaDriftIgnore[];
+};
+
 export type PolicyPayload =
   | PipelineApprovalPolicyPayload
   | BackupPlanPolicyPayload
   | SQLReviewPolicyPayload
   | EnvironmentTierPolicyPayload
   | SensitiveDataPolicyPayload
-  | AccessControlPolicyPayload;
+  | AccessControlPolicyPayload
+  | SchemaDriftPolicyPayload;
 
 export type PolicyResourceType =
   | ""
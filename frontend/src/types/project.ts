@@ -16,6 +16,9 @@ export type ProjectTenantMode = "DISABLED" | "TENANT";
 export type ProjectRoleProvider =
   | "GITLAB_SELF_HOST"
   | "GITHUB_COM"
+  | "GITHUB_ENTERPRISE"
+  | "GITEA"
+  | "BITBUCKET"
   | "BYTEBASE";
 
 export type SchemaChangeType = "DDL" | "SDL";
@@ -1,5 +1,5 @@
 import { ActivityType } from "./activity";
-import { MemberId, ProjectId } from "./id";
+import { MemberId, ProjectId, ProjectWebhookId } from "./id";
 import { t } from "../plugins/i18n";
 
 type ProjectWebhookTypeItem = {
@@ -100,6 +100,8 @@ export type ProjectWebhook = {
   name: string;
   url: string;
   activityList: ActivityType[];
+  // The secret itself is write-only.
+  hasSecret: boolean;
 };
 
 export type ProjectWebhookCreate = {
@@ -108,6 +110,7 @@ export type ProjectWebhookCreate = {
   name: string;
   url: string;
   activityList: ActivityType[];
+  secret: string;
 };
 
 export type ProjectWebhookPatch = {
@@ -116,8 +119,35 @@ export type ProjectWebhookPatch = {
   url?: string;
   // Comma separated list. Server doesn't support deserialize into pointer to string array (*[]string in Golang)
   activityList?: string;
+  secret?: string;
 };
 
 export type ProjectWebhookTestResult = {
   error?: string;
 };
+
+export type ProjectWebhookDeliveryStatus = "PENDING" | "SUCCEEDED" | "FAILED";
+
+export type ProjectWebhookDelivery = {
+  id: number;
+
+  // Standard fields
+  createdTs: number;
+  updatedTs: number;
+
+  // Related fields
+  projectWebhookId: ProjectWebhookId;
+
+  // Domain specific fields
+  status: ProjectWebhookDeliveryStatus;
+  attempt: number;
+  nextAttemptTs: number;
+  activityType: ActivityType;
+  title: string;
+  // The result of the latest attempt.
+  request: string;
+  responseCode: number;
+  responseBody: string;
+  latencyMs: number;
+  error: string;
+};
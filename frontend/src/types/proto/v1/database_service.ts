@@ -162,6 +162,20 @@ export interface SchemaMetadata {
   tables: TableMetadata[];
   /** The views is the list of views in a schema. */
   views: ViewMetadata[];
+  /** The functions is the list of functions in a schema. */
+  functions: FunctionMetadata[];
+  /** The procedures is the list of stored procedures in a schema. */
+  procedures: ProcedureMetadata[];
+  /**
+   * The sequences is the list of sequences in a schema.
+   * It's empty for databases without such concept such as MySQL.
+   */
+  sequences: SequenceMetadata[];
+  /**
+   * The enum_types is the list of enum types in a schema.
+   * It's empty for databases without such concept such as MySQL.
+   */
+  enumTypes: EnumTypeMetadata[];
 }
 
 /** TableMetadata is the metadata for tables. */
@@ -190,6 +204,39 @@ export interface TableMetadata {
   comment: string;
   /** The foreign_keys is the list of foreign keys in a table. */
   foreignKeys: ForeignKeyMetadata[];
+  /** The triggers is the list of triggers in a table. */
+  triggers: TriggerMetadata[];
+  /**
+   * The partition_type is the partition type of a partitioned table, such as RANGE, LIST and HASH.
+   * It's empty for tables that are not partitioned.
+   */
+  partitionType: string;
+  /** The partition_expression is the partition key expression of a partitioned table. */
+  partitionExpression: string;
+  /** The partitions is the list of partitions of a partitioned table. */
+  partitions: TablePartitionMetadata[];
+}
+
+/** TablePartitionMetadata is the metadata for table partitions. */
+export interface TablePartitionMetadata {
+  /** The name is the name of a table partition. */
+  name: string;
+  /** The value is the partition bound of a table partition, such as the value list of a LIST partition. */
+  value: string;
+  /** The subpartitions is the list of subpartitions of a table partition. */
+  subpartitions: TablePartitionMetadata[];
+}
+
+/** TriggerMetadata is the metadata for triggers. */
+export interface TriggerMetadata {
+  /** The name is the name of a trigger. */
+  name: string;
+  /** The timing is the timing of a trigger, such as BEFORE and AFTER. */
+  timing: string;
+  /** The event is the event of a trigger, such as INSERT, UPDATE and DELETE. */
+  event: string;
+  /** The definition is the definition of a trigger. */
+  definition: string;
 }
 
 /** ColumnMetadata is the metadata for columns. */
@@ -222,6 +269,65 @@ export interface ViewMetadata {
   comment: string;
 }
 
+/** FunctionMetadata is the metadata for functions. */
+export interface FunctionMetadata {
+  /** The name is the name of a function. */
+  name: string;
+  /**
+   * The arguments is the argument list of a function.
+   * It's used to distinguish the overloaded functions in PostgreSQL.
+   */
+  arguments: string;
+  /** The return_type is the return type of a function. */
+  returnType: string;
+  /** The definition is the definition of a function. */
+  definition: string;
+  /** The comment is the comment of a function. */
+  comment: string;
+}
+
+/** ProcedureMetadata is the metadata for stored procedures. */
+export interface ProcedureMetadata {
+  /** The name is the name of a procedure. */
+  name: string;
+  /** The arguments is the argument list of a procedure. */
+  arguments: string;
+  /** The definition is the definition of a procedure. */
+  definition: string;
+  /** The comment is the comment of a procedure. */
+  comment: string;
+}
+
+/** SequenceMetadata is the metadata for sequences. */
+export interface SequenceMetadata {
+  /** The name is the name of a sequence. */
+  name: string;
+  /** The data_type is the data type of a sequence. */
+  dataType: string;
+  /** The start is the start value of a sequence. */
+  start: number;
+  /** The increment is the increment of a sequence. */
+  increment: number;
+  /** The min_value is the minimum value of a sequence. */
+  minValue: number;
+  /** The max_value is the maximum value of a sequence. */
+  maxValue: number;
+  /** The cycle is whether a sequence wraps around when it reaches the limit. */
+  cycle: boolean;
+  /** The owner_table is the table owning a sequence, such as the table of a serial column. */
+  ownerTable: string;
+  /** The owner_column is the column owning a sequence. */
+  ownerColumn: string;
+}
+
+/** EnumTypeMetadata is the metadata for enum types. */
+export interface EnumTypeMetadata {
+  /** The name is the name of an enum type. */
+  name: string;
+  /** The values is the ordered list of labels of an enum type. */
+  values: string[];
+}
+
 /** IndexMetadata is the metadata for indexes. */
 export interface IndexMetadata {
   /** The name is the name of an index. */
@@ -1044,7 +1150,7 @@ export const DatabaseMetadata = {
 };
 
 function createBaseSchemaMetadata(): SchemaMetadata {
-  return { name: "", tables: [], views: [] };
+  return { name: "", tables: [], views: [], functions: [], procedures: [], sequences: [], enumTypes: [] };
 }
 
 export const SchemaMetadata = {
@@ -1058,6 +1164,18 @@ export const SchemaMetadata = {
     for (const v of message.views) {
       ViewMetadata.encode(v!, writer.uint32(26).fork()).ldelim();
     }
+    for (const v of message.functions) {
+      FunctionMetadata.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    for (const v of message.procedures) {
+      ProcedureMetadata.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    for (const v of message.sequences) {
+      SequenceMetadata.encode(v!, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.enumTypes) {
+      EnumTypeMetadata.encode(v!, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1077,6 +1195,18 @@ export const SchemaMetadata = {
         case 3:
           message.views.push(ViewMetadata.decode(reader, reader.uint32()));
           break;
+        case 4:
+          message.functions.push(FunctionMetadata.decode(reader, reader.uint32()));
+          break;
+        case 5:
+          message.procedures.push(ProcedureMetadata.decode(reader, reader.uint32()));
+          break;
+        case 6:
+          message.sequences.push(SequenceMetadata.decode(reader, reader.uint32()));
+          break;
+        case 7:
+          message.enumTypes.push(EnumTypeMetadata.decode(reader, reader.uint32()));
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1090,6 +1220,12 @@ export const SchemaMetadata = {
       name: isSet(object.name) ? String(object.name) : "",
       tables: Array.isArray(object?.tables) ? object.tables.map((e: any) => TableMetadata.fromJSON(e)) : [],
       views: Array.isArray(object?.views) ? object.views.map((e: any) => ViewMetadata.fromJSON(e)) : [],
+      functions: Array.isArray(object?.functions) ? object.functions.map((e: any) => FunctionMetadata.fromJSON(e)) : [],
+      procedures: Array.isArray(object?.procedures)
+        ? object.procedures.map((e: any) => ProcedureMetadata.fromJSON(e))
+        : [],
+      sequences: Array.isArray(object?.sequences) ? object.sequences.map((e: any) => SequenceMetadata.fromJSON(e)) : [],
+      enumTypes: Array.isArray(object?.enumTypes) ? object.enumTypes.map((e: any) => EnumTypeMetadata.fromJSON(e)) : [],
     };
   },
 
@@ -1106,6 +1242,26 @@ export const SchemaMetadata = {
     } else {
       obj.views = [];
     }
+    if (message.functions) {
+      obj.functions = message.functions.map((e) => e ? FunctionMetadata.toJSON(e) : undefined);
+    } else {
+      obj.functions = [];
+    }
+    if (message.procedures) {
+      obj.procedures = message.procedures.map((e) => e ? ProcedureMetadata.toJSON(e) : undefined);
+    } else {
+      obj.procedures = [];
+    }
+    if (message.sequences) {
+      obj.sequences = message.sequences.map((e) => e ? SequenceMetadata.toJSON(e) : undefined);
+    } else {
+      obj.sequences = [];
+    }
+    if (message.enumTypes) {
+      obj.enumTypes = message.enumTypes.map((e) => e ? EnumTypeMetadata.toJSON(e) : undefined);
+    } else {
+      obj.enumTypes = [];
+    }
     return obj;
   },
 
@@ -1114,6 +1270,10 @@ export const SchemaMetadata = {
     message.name = object.name ?? "";
     message.tables = object.tables?.map((e) => TableMetadata.fromPartial(e)) || [];
     message.views = object.views?.map((e) => ViewMetadata.fromPartial(e)) || [];
+    message.functions = object.functions?.map((e) => FunctionMetadata.fromPartial(e)) || [];
+    message.procedures = object.procedures?.map((e) => ProcedureMetadata.fromPartial(e)) || [];
+    message.sequences = object.sequences?.map((e) => SequenceMetadata.fromPartial(e)) || [];
+    message.enumTypes = object.enumTypes?.map((e) => EnumTypeMetadata.fromPartial(e)) || [];
     return message;
   },
 };
@@ -1132,6 +1292,10 @@ function createBaseTableMetadata(): TableMetadata {
     createOptions: "",
     comment: "",
     foreignKeys: [],
+    triggers: [],
+    partitionType: "",
+    partitionExpression: "",
+    partitions: [],
   };
 }
 
@@ -1173,6 +1337,18 @@ export const TableMetadata = {
     for (const v of message.foreignKeys) {
       ForeignKeyMetadata.encode(v!, writer.uint32(98).fork()).ldelim();
     }
+    for (const v of message.triggers) {
+      TriggerMetadata.encode(v!, writer.uint32(106).fork()).ldelim();
+    }
+    if (message.partitionType !== "") {
+      writer.uint32(114).string(message.partitionType);
+    }
+    if (message.partitionExpression !== "") {
+      writer.uint32(122).string(message.partitionExpression);
+    }
+    for (const v of message.partitions) {
+      TablePartitionMetadata.encode(v!, writer.uint32(130).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1219,6 +1395,18 @@ export const TableMetadata = {
         case 12:
           message.foreignKeys.push(ForeignKeyMetadata.decode(reader, reader.uint32()));
           break;
+        case 13:
+          message.triggers.push(TriggerMetadata.decode(reader, reader.uint32()));
+          break;
+        case 14:
+          message.partitionType = reader.string();
+          break;
+        case 15:
+          message.partitionExpression = reader.string();
+          break;
+        case 16:
+          message.partitions.push(TablePartitionMetadata.decode(reader, reader.uint32()));
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1243,6 +1431,12 @@ export const TableMetadata = {
       foreignKeys: Array.isArray(object?.foreignKeys)
         ? object.foreignKeys.map((e: any) => ForeignKeyMetadata.fromJSON(e))
         : [],
+      triggers: Array.isArray(object?.triggers) ? object.triggers.map((e: any) => TriggerMetadata.fromJSON(e)) : [],
+      partitionType: isSet(object.partitionType) ? String(object.partitionType) : "",
+      partitionExpression: isSet(object.partitionExpression) ? String(object.partitionExpression) : "",
+      partitions: Array.isArray(object?.partitions)
+        ? object.partitions.map((e: any) => TablePartitionMetadata.fromJSON(e))
+        : [],
     };
   },
 
@@ -1272,6 +1466,18 @@ export const TableMetadata = {
     } else {
       obj.foreignKeys = [];
     }
+    if (message.triggers) {
+      obj.triggers = message.triggers.map((e) => e ? TriggerMetadata.toJSON(e) : undefined);
+    } else {
+      obj.triggers = [];
+    }
+    message.partitionType !== undefined && (obj.partitionType = message.partitionType);
+    message.partitionExpression !== undefined && (obj.partitionExpression = message.partitionExpression);
+    if (message.partitions) {
+      obj.partitions = message.partitions.map((e) => e ? TablePartitionMetadata.toJSON(e) : undefined);
+    } else {
+      obj.partitions = [];
+    }
     return obj;
   },
 
@@ -1289,6 +1495,159 @@ export const TableMetadata = {
     message.createOptions = object.createOptions ?? "";
     message.comment = object.comment ?? "";
     message.foreignKeys = object.foreignKeys?.map((e) => ForeignKeyMetadata.fromPartial(e)) || [];
+    message.triggers = object.triggers?.map((e) => TriggerMetadata.fromPartial(e)) || [];
+    message.partitionType = object.partitionType ?? "";
+    message.partitionExpression = object.partitionExpression ?? "";
+    message.partitions = object.partitions?.map((e) => TablePartitionMetadata.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTablePartitionMetadata(): TablePartitionMetadata {
+  return { name: "", value: "", subpartitions: [] };
+}
+
+export const TablePartitionMetadata = {
+  encode(message: TablePartitionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    for (const v of message.subpartitions) {
+      TablePartitionMetadata.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TablePartitionMetadata {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTablePartitionMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.value = reader.string();
+          break;
+        case 3:
+          message.subpartitions.push(TablePartitionMetadata.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TablePartitionMetadata {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      value: isSet(object.value) ? String(object.value) : "",
+      subpartitions: Array.isArray(object?.subpartitions)
+        ? object.subpartitions.map((e: any) => TablePartitionMetadata.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: TablePartitionMetadata): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.value !== undefined && (obj.value = message.value);
+    if (message.subpartitions) {
+      obj.subpartitions = message.subpartitions.map((e) => e ? TablePartitionMetadata.toJSON(e) : undefined);
+    } else {
+      obj.subpartitions = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<TablePartitionMetadata>): TablePartitionMetadata {
+    const message = createBaseTablePartitionMetadata();
+    message.name = object.name ?? "";
+    message.value = object.value ?? "";
+    message.subpartitions = object.subpartitions?.map((e) => TablePartitionMetadata.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTriggerMetadata(): TriggerMetadata {
+  return { name: "", timing: "", event: "", definition: "" };
+}
+
+export const TriggerMetadata = {
+  encode(message: TriggerMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.timing !== "") {
+      writer.uint32(18).string(message.timing);
+    }
+    if (message.event !== "") {
+      writer.uint32(26).string(message.event);
+    }
+    if (message.definition !== "") {
+      writer.uint32(34).string(message.definition);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TriggerMetadata {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTriggerMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.timing = reader.string();
+          break;
+        case 3:
+          message.event = reader.string();
+          break;
+        case 4:
+          message.definition = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TriggerMetadata {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      timing: isSet(object.timing) ? String(object.timing) : "",
+      event: isSet(object.event) ? String(object.event) : "",
+      definition: isSet(object.definition) ? String(object.definition) : "",
+    };
+  },
+
+  toJSON(message: TriggerMetadata): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.timing !== undefined && (obj.timing = message.timing);
+    message.event !== undefined && (obj.event = message.event);
+    message.definition !== undefined && (obj.definition = message.definition);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<TriggerMetadata>): TriggerMetadata {
+    const message = createBaseTriggerMetadata();
+    message.name = object.name ?? "";
+    message.timing = object.timing ?? "";
+    message.event = object.event ?? "";
+    message.definition = object.definition ?? "";
     return message;
   },
 };
@@ -1481,6 +1840,360 @@ export const ViewMetadata = {
   },
 };
 
+function createBaseFunctionMetadata(): FunctionMetadata {
+  return { name: "", arguments: "", returnType: "", definition: "", comment: "" };
+}
+
+export const FunctionMetadata = {
+  encode(message: FunctionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.arguments !== "") {
+      writer.uint32(18).string(message.arguments);
+    }
+    if (message.returnType !== "") {
+      writer.uint32(26).string(message.returnType);
+    }
+    if (message.definition !== "") {
+      writer.uint32(34).string(message.definition);
+    }
+    if (message.comment !== "") {
+      writer.uint32(42).string(message.comment);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FunctionMetadata {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFunctionMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.arguments = reader.string();
+          break;
+        case 3:
+          message.returnType = reader.string();
+          break;
+        case 4:
+          message.definition = reader.string();
+          break;
+        case 5:
+          message.comment = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FunctionMetadata {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      arguments: isSet(object.arguments) ? String(object.arguments) : "",
+      returnType: isSet(object.returnType) ? String(object.returnType) : "",
+      definition: isSet(object.definition) ? String(object.definition) : "",
+      comment: isSet(object.comment) ? String(object.comment) : "",
+    };
+  },
+
+  toJSON(message: FunctionMetadata): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.arguments !== undefined && (obj.arguments = message.arguments);
+    message.returnType !== undefined && (obj.returnType = message.returnType);
+    message.definition !== undefined && (obj.definition = message.definition);
+    message.comment !== undefined && (obj.comment = message.comment);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<FunctionMetadata>): FunctionMetadata {
+    const message = createBaseFunctionMetadata();
+    message.name = object.name ?? "";
+    message.arguments = object.arguments ?? "";
+    message.returnType = object.returnType ?? "";
+    message.definition = object.definition ?? "";
+    message.comment = object.comment ?? "";
+    return message;
+  },
+};
+
+function createBaseProcedureMetadata(): ProcedureMetadata {
+  return { name: "", arguments: "", definition: "", comment: "" };
+}
+
+export const ProcedureMetadata = {
+  encode(message: ProcedureMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.arguments !== "") {
+      writer.uint32(18).string(message.arguments);
+    }
+    if (message.definition !== "") {
+      writer.uint32(26).string(message.definition);
+    }
+    if (message.comment !== "") {
+      writer.uint32(34).string(message.comment);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProcedureMetadata {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcedureMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.arguments = reader.string();
+          break;
+        case 3:
+          message.definition = reader.string();
+          break;
+        case 4:
+          message.comment = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProcedureMetadata {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      arguments: isSet(object.arguments) ? String(object.arguments) : "",
+      definition: isSet(object.definition) ? String(object.definition) : "",
+      comment: isSet(object.comment) ? String(object.comment) : "",
+    };
+  },
+
+  toJSON(message: ProcedureMetadata): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.arguments !== undefined && (obj.arguments = message.arguments);
+    message.definition !== undefined && (obj.definition = message.definition);
+    message.comment !== undefined && (obj.comment = message.comment);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<ProcedureMetadata>): ProcedureMetadata {
+    const message = createBaseProcedureMetadata();
+    message.name = object.name ?? "";
+    message.arguments = object.arguments ?? "";
+    message.definition = object.definition ?? "";
+    message.comment = object.comment ?? "";
+    return message;
+  },
+};
+
+function createBaseSequenceMetadata(): SequenceMetadata {
+  return {
+    name: "",
+    dataType: "",
+    start: 0,
+    increment: 0,
+    minValue: 0,
+    maxValue: 0,
+    cycle: false,
+    ownerTable: "",
+    ownerColumn: "",
+  };
+}
+
+export const SequenceMetadata = {
+  encode(message: SequenceMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.dataType !== "") {
+      writer.uint32(18).string(message.dataType);
+    }
+    if (message.start !== 0) {
+      writer.uint32(24).int64(message.start);
+    }
+    if (message.increment !== 0) {
+      writer.uint32(32).int64(message.increment);
+    }
+    if (message.minValue !== 0) {
+      writer.uint32(40).int64(message.minValue);
+    }
+    if (message.maxValue !== 0) {
+      writer.uint32(48).int64(message.maxValue);
+    }
+    if (message.cycle === true) {
+      writer.uint32(56).bool(message.cycle);
+    }
+    if (message.ownerTable !== "") {
+      writer.uint32(66).string(message.ownerTable);
+    }
+    if (message.ownerColumn !== "") {
+      writer.uint32(74).string(message.ownerColumn);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SequenceMetadata {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSequenceMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.dataType = reader.string();
+          break;
+        case 3:
+          message.start = longToNumber(reader.int64() as Long);
+          break;
+        case 4:
+          message.increment = longToNumber(reader.int64() as Long);
+          break;
+        case 5:
+          message.minValue = longToNumber(reader.int64() as Long);
+          break;
+        case 6:
+          message.maxValue = longToNumber(reader.int64() as Long);
+          break;
+        case 7:
+          message.cycle = reader.bool();
+          break;
+        case 8:
+          message.ownerTable = reader.string();
+          break;
+        case 9:
+          message.ownerColumn = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SequenceMetadata {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      dataType: isSet(object.dataType) ? String(object.dataType) : "",
+      start: isSet(object.start) ? Number(object.start) : 0,
+      increment: isSet(object.increment) ? Number(object.increment) : 0,
+      minValue: isSet(object.minValue) ? Number(object.minValue) : 0,
+      maxValue: isSet(object.maxValue) ? Number(object.maxValue) : 0,
+      cycle: isSet(object.cycle) ? Boolean(object.cycle) : false,
+      ownerTable: isSet(object.ownerTable) ? String(object.ownerTable) : "",
+      ownerColumn: isSet(object.ownerColumn) ? String(object.ownerColumn) : "",
+    };
+  },
+
+  toJSON(message: SequenceMetadata): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.dataType !== undefined && (obj.dataType = message.dataType);
+    message.start !== undefined && (obj.start = Math.round(message.start));
+    message.increment !== undefined && (obj.increment = Math.round(message.increment));
+    message.minValue !== undefined && (obj.minValue = Math.round(message.minValue));
+    message.maxValue !== undefined && (obj.maxValue = Math.round(message.maxValue));
+    message.cycle !== undefined && (obj.cycle = message.cycle);
+    message.ownerTable !== undefined && (obj.ownerTable = message.ownerTable);
+    message.ownerColumn !== undefined && (obj.ownerColumn = message.ownerColumn);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<SequenceMetadata>): SequenceMetadata {
+    const message = createBaseSequenceMetadata();
+    message.name = object.name ?? "";
+    message.dataType = object.dataType ?? "";
+    message.start = object.start ?? 0;
+    message.increment = object.increment ?? 0;
+    message.minValue = object.minValue ?? 0;
+    message.maxValue = object.maxValue ?? 0;
+    message.cycle = object.cycle ?? false;
+    message.ownerTable = object.ownerTable ?? "";
+    message.ownerColumn = object.ownerColumn ?? "";
+    return message;
+  },
+};
+
+function createBaseEnumTypeMetadata(): EnumTypeMetadata {
+  return { name: "", values: [] };
+}
+
+export const EnumTypeMetadata = {
+  encode(message: EnumTypeMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.values) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EnumTypeMetadata {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEnumTypeMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.values.push(reader.string());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EnumTypeMetadata {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      values: Array.isArray(object?.values) ? object.values.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: EnumTypeMetadata): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    if (message.values) {
+      obj.values = message.values.map((e) => e);
+    } else {
+      obj.values = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<EnumTypeMetadata>): EnumTypeMetadata {
+    const message = createBaseEnumTypeMetadata();
+    message.name = object.name ?? "";
+    message.values = object.values?.map((e) => e) || [];
+    return message;
+  },
+};
+
 function createBaseIndexMetadata(): IndexMetadata {
   return { name: "", expressions: [], type: "", unique: false, primary: false, visible: false, comment: "" };
 }
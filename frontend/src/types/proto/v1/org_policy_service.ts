@@ -189,9 +189,109 @@ export function backupPlanScheduleToJSON(object: BackupPlanSchedule): string {
   }
 }
 
+export enum BackupStorageBackend {
+  BACKUP_STORAGE_BACKEND_UNSPECIFIED = 0,
+  LOCAL = 1,
+  S3 = 2,
+  GCS = 3,
+  OSS = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function backupStorageBackendFromJSON(object: any): BackupStorageBackend {
+  switch (object) {
+    case 0:
+    case "BACKUP_STORAGE_BACKEND_UNSPECIFIED":
+      return BackupStorageBackend.BACKUP_STORAGE_BACKEND_UNSPECIFIED;
+    case 1:
+    case "LOCAL":
+      return BackupStorageBackend.LOCAL;
+    case 2:
+    case "S3":
+      return BackupStorageBackend.S3;
+    case 3:
+    case "GCS":
+      return BackupStorageBackend.GCS;
+    case 4:
+    case "OSS":
+      return BackupStorageBackend.OSS;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BackupStorageBackend.UNRECOGNIZED;
+  }
+}
+
+export function backupStorageBackendToJSON(object: BackupStorageBackend): string {
+  switch (object) {
+    case BackupStorageBackend.BACKUP_STORAGE_BACKEND_UNSPECIFIED:
+      return "BACKUP_STORAGE_BACKEND_UNSPECIFIED";
+    case BackupStorageBackend.LOCAL:
+      return "LOCAL";
+    case BackupStorageBackend.S3:
+      return "S3";
+    case BackupStorageBackend.GCS:
+      return "GCS";
+    case BackupStorageBackend.OSS:
+      return "OSS";
+    case BackupStorageBackend.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export enum BackupCompression {
+  BACKUP_COMPRESSION_UNSPECIFIED = 0,
+  GZIP = 1,
+  ZSTD = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function backupCompressionFromJSON(object: any): BackupCompression {
+  switch (object) {
+    case 0:
+    case "BACKUP_COMPRESSION_UNSPECIFIED":
+      return BackupCompression.BACKUP_COMPRESSION_UNSPECIFIED;
+    case 1:
+    case "GZIP":
+      return BackupCompression.GZIP;
+    case 2:
+    case "ZSTD":
+      return BackupCompression.ZSTD;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BackupCompression.UNRECOGNIZED;
+  }
+}
+
+export function backupCompressionToJSON(object: BackupCompression): string {
+  switch (object) {
+    case BackupCompression.BACKUP_COMPRESSION_UNSPECIFIED:
+      return "BACKUP_COMPRESSION_UNSPECIFIED";
+    case BackupCompression.GZIP:
+      return "GZIP";
+    case BackupCompression.ZSTD:
+      return "ZSTD";
+    case BackupCompression.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export enum SensitiveDataMaskType {
   MASK_TYPE_UNSPECIFIED = 0,
   DEFAULT = 1,
+  /** PARTIAL - Keep the leading and trailing characters only. */
+  PARTIAL = 2,
+  /** EMAIL - Keep the first character of the local part and the domain of an email. */
+  EMAIL = 3,
+  /** PHONE - Keep the last four digits of a phone number. */
+  PHONE = 4,
+  /** HASH - Replace the value with its HMAC-SHA256 digest keyed by a workspace secret. */
+  HASH = 5,
+  /** RANGE - Replace the number with the range it falls into. */
+  RANGE = 6,
   UNRECOGNIZED = -1,
 }
 
@@ -203,6 +303,21 @@ export function sensitiveDataMaskTypeFromJSON(object: any): SensitiveDataMaskTyp
     case 1:
     case "DEFAULT":
       return SensitiveDataMaskType.DEFAULT;
+    case 2:
+    case "PARTIAL":
+      return SensitiveDataMaskType.PARTIAL;
+    case 3:
+    case "EMAIL":
+      return SensitiveDataMaskType.EMAIL;
+    case 4:
+    case "PHONE":
+      return SensitiveDataMaskType.PHONE;
+    case 5:
+    case "HASH":
+      return SensitiveDataMaskType.HASH;
+    case 6:
+    case "RANGE":
+      return SensitiveDataMaskType.RANGE;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -216,6 +331,16 @@ export function sensitiveDataMaskTypeToJSON(object: SensitiveDataMaskType): stri
       return "MASK_TYPE_UNSPECIFIED";
     case SensitiveDataMaskType.DEFAULT:
       return "DEFAULT";
+    case SensitiveDataMaskType.PARTIAL:
+      return "PARTIAL";
+    case SensitiveDataMaskType.EMAIL:
+      return "EMAIL";
+    case SensitiveDataMaskType.PHONE:
+      return "PHONE";
+    case SensitiveDataMaskType.HASH:
+      return "HASH";
+    case SensitiveDataMaskType.RANGE:
+      return "RANGE";
     case SensitiveDataMaskType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -405,6 +530,18 @@ export interface DeploymentApprovalStrategy {
 export interface BackupPlanPolicy {
   schedule: BackupPlanSchedule;
   retentionDuration?: Duration;
+  /**
+   * The storage backend of the automatic backups.
+   * BACKUP_STORAGE_BACKEND_UNSPECIFIED means the workspace default storage backend.
+   */
+  storageBackend: BackupStorageBackend;
+  /**
+   * The compression algorithm of the automatic backups.
+   * BACKUP_COMPRESSION_UNSPECIFIED means not compressed.
+   */
+  compression: BackupCompression;
+  /** Whether the automatic backups are encrypted by the workspace backup encryption key. */
+  encryption: boolean;
 }
 
 export interface SensitiveDataPolicy {
@@ -416,6 +553,17 @@ export interface SensitiveData {
   table: string;
   column: string;
   maskType: SensitiveDataMaskType;
+  /** The option for the mask types requiring parameters, such as PARTIAL and RANGE. */
+  maskOption?: SensitiveDataMaskOption;
+}
+
+export interface SensitiveDataMaskOption {
+  /** The number of leading characters kept by the PARTIAL mask type. */
+  prefixLength: number;
+  /** The number of trailing characters kept by the PARTIAL mask type. */
+  suffixLength: number;
+  /** The width of the range used by the RANGE mask type. */
+  rangeWidth: number;
 }
 
 export interface AccessControlPolicy {
@@ -1155,7 +1303,7 @@ export const DeploymentApprovalStrategy = {
 };
 
 function createBaseBackupPlanPolicy(): BackupPlanPolicy {
-  return { schedule: 0, retentionDuration: undefined };
+  return { schedule: 0, retentionDuration: undefined, storageBackend: 0, compression: 0, encryption: false };
 }
 
 export const BackupPlanPolicy = {
@@ -1166,6 +1314,15 @@ export const BackupPlanPolicy = {
     if (message.retentionDuration !== undefined) {
       Duration.encode(message.retentionDuration, writer.uint32(18).fork()).ldelim();
     }
+    if (message.storageBackend !== 0) {
+      writer.uint32(24).int32(message.storageBackend);
+    }
+    if (message.compression !== 0) {
+      writer.uint32(32).int32(message.compression);
+    }
+    if (message.encryption === true) {
+      writer.uint32(40).bool(message.encryption);
+    }
     return writer;
   },
 
@@ -1182,6 +1339,15 @@ export const BackupPlanPolicy = {
         case 2:
           message.retentionDuration = Duration.decode(reader, reader.uint32());
           break;
+        case 3:
+          message.storageBackend = reader.int32() as any;
+          break;
+        case 4:
+          message.compression = reader.int32() as any;
+          break;
+        case 5:
+          message.encryption = reader.bool();
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1194,6 +1360,9 @@ export const BackupPlanPolicy = {
     return {
       schedule: isSet(object.schedule) ? backupPlanScheduleFromJSON(object.schedule) : 0,
       retentionDuration: isSet(object.retentionDuration) ? Duration.fromJSON(object.retentionDuration) : undefined,
+      storageBackend: isSet(object.storageBackend) ? backupStorageBackendFromJSON(object.storageBackend) : 0,
+      compression: isSet(object.compression) ? backupCompressionFromJSON(object.compression) : 0,
+      encryption: isSet(object.encryption) ? Boolean(object.encryption) : false,
     };
   },
 
@@ -1202,6 +1371,9 @@ export const BackupPlanPolicy = {
     message.schedule !== undefined && (obj.schedule = backupPlanScheduleToJSON(message.schedule));
     message.retentionDuration !== undefined &&
       (obj.retentionDuration = message.retentionDuration ? Duration.toJSON(message.retentionDuration) : undefined);
+    message.storageBackend !== undefined && (obj.storageBackend = backupStorageBackendToJSON(message.storageBackend));
+    message.compression !== undefined && (obj.compression = backupCompressionToJSON(message.compression));
+    message.encryption !== undefined && (obj.encryption = message.encryption);
     return obj;
   },
 
@@ -1211,6 +1383,9 @@ export const BackupPlanPolicy = {
     message.retentionDuration = (object.retentionDuration !== undefined && object.retentionDuration !== null)
       ? Duration.fromPartial(object.retentionDuration)
       : undefined;
+    message.storageBackend = object.storageBackend ?? 0;
+    message.compression = object.compression ?? 0;
+    message.encryption = object.encryption ?? false;
     return message;
   },
 };
@@ -1271,7 +1446,7 @@ export const SensitiveDataPolicy = {
 };
 
 function createBaseSensitiveData(): SensitiveData {
-  return { schema: "", table: "", column: "", maskType: 0 };
+  return { schema: "", table: "", column: "", maskType: 0, maskOption: undefined };
 }
 
 export const SensitiveData = {
@@ -1288,6 +1463,9 @@ export const SensitiveData = {
     if (message.maskType !== 0) {
       writer.uint32(32).int32(message.maskType);
     }
+    if (message.maskOption !== undefined) {
+      SensitiveDataMaskOption.encode(message.maskOption, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1310,6 +1488,9 @@ export const SensitiveData = {
         case 4:
           message.maskType = reader.int32() as any;
           break;
+        case 5:
+          message.maskOption = SensitiveDataMaskOption.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1324,6 +1505,7 @@ export const SensitiveData = {
       table: isSet(object.table) ? String(object.table) : "",
       column: isSet(object.column) ? String(object.column) : "",
       maskType: isSet(object.maskType) ? sensitiveDataMaskTypeFromJSON(object.maskType) : 0,
+      maskOption: isSet(object.maskOption) ? SensitiveDataMaskOption.fromJSON(object.maskOption) : undefined,
     };
   },
 
@@ -1333,6 +1515,8 @@ export const SensitiveData = {
     message.table !== undefined && (obj.table = message.table);
     message.column !== undefined && (obj.column = message.column);
     message.maskType !== undefined && (obj.maskType = sensitiveDataMaskTypeToJSON(message.maskType));
+    message.maskOption !== undefined &&
+      (obj.maskOption = message.maskOption ? SensitiveDataMaskOption.toJSON(message.maskOption) : undefined);
     return obj;
   },
 
@@ -1342,6 +1526,76 @@ export const SensitiveData = {
     message.table = object.table ?? "";
     message.column = object.column ?? "";
     message.maskType = object.maskType ?? 0;
+    message.maskOption = (object.maskOption !== undefined && object.maskOption !== null)
+      ? SensitiveDataMaskOption.fromPartial(object.maskOption)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseSensitiveDataMaskOption(): SensitiveDataMaskOption {
+  return { prefixLength: 0, suffixLength: 0, rangeWidth: 0 };
+}
+
+export const SensitiveDataMaskOption = {
+  encode(message: SensitiveDataMaskOption, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.prefixLength !== 0) {
+      writer.uint32(8).int32(message.prefixLength);
+    }
+    if (message.suffixLength !== 0) {
+      writer.uint32(16).int32(message.suffixLength);
+    }
+    if (message.rangeWidth !== 0) {
+      writer.uint32(25).double(message.rangeWidth);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SensitiveDataMaskOption {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSensitiveDataMaskOption();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.prefixLength = reader.int32();
+          break;
+        case 2:
+          message.suffixLength = reader.int32();
+          break;
+        case 3:
+          message.rangeWidth = reader.double();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SensitiveDataMaskOption {
+    return {
+      prefixLength: isSet(object.prefixLength) ? Number(object.prefixLength) : 0,
+      suffixLength: isSet(object.suffixLength) ? Number(object.suffixLength) : 0,
+      rangeWidth: isSet(object.rangeWidth) ? Number(object.rangeWidth) : 0,
+    };
+  },
+
+  toJSON(message: SensitiveDataMaskOption): unknown {
+    const obj: any = {};
+    message.prefixLength !== undefined && (obj.prefixLength = Math.round(message.prefixLength));
+    message.suffixLength !== undefined && (obj.suffixLength = Math.round(message.suffixLength));
+    message.rangeWidth !== undefined && (obj.rangeWidth = message.rangeWidth);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<SensitiveDataMaskOption>): SensitiveDataMaskOption {
+    const message = createBaseSensitiveDataMaskOption();
+    message.prefixLength = object.prefixLength ?? 0;
+    message.suffixLength = object.suffixLength ?? 0;
+    message.rangeWidth = object.rangeWidth ?? 0;
     return message;
   },
 };
@@ -130,6 +130,9 @@ export enum RoleProvider {
   BYTEBASE = 1,
   GITLAB_SELF_HOST = 2,
   GITHUB_COM = 3,
+  GITEA = 4,
+  BITBUCKET = 5,
+  GITHUB_ENTERPRISE = 6,
   UNRECOGNIZED = -1,
 }
 
@@ -147,6 +150,15 @@ export function roleProviderFromJSON(object: any): RoleProvider {
     case 3:
     case "GITHUB_COM":
       return RoleProvider.GITHUB_COM;
+    case 4:
+    case "GITEA":
+      return RoleProvider.GITEA;
+    case 5:
+    case "BITBUCKET":
+      return RoleProvider.BITBUCKET;
+    case 6:
+    case "GITHUB_ENTERPRISE":
+      return RoleProvider.GITHUB_ENTERPRISE;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -164,6 +176,12 @@ export function roleProviderToJSON(object: RoleProvider): string {
       return "GITLAB_SELF_HOST";
     case RoleProvider.GITHUB_COM:
       return "GITHUB_COM";
+    case RoleProvider.GITEA:
+      return "GITEA";
+    case RoleProvider.BITBUCKET:
+      return "BITBUCKET";
+    case RoleProvider.GITHUB_ENTERPRISE:
+      return "GITHUB_ENTERPRISE";
     case RoleProvider.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -96,16 +96,37 @@ export function baseDirectoryWebUrl(
     return repository.webUrl;
   }
   let url = "";
+  // Bitbucket Data Center takes the branch as the query parameter, which
+  // should be appended after the path.
+  let query = "";
   if (repository.vcs.type == "GITLAB_SELF_HOST") {
     url = `${repository.webUrl}/-/tree/${repository.branchFilter}`;
     if (!isEmpty(repository.baseDirectory)) {
       url += `/${repository.baseDirectory}`;
     }
-  } else if (repository.vcs.type == "GITHUB_COM") {
+  } else if (
+    repository.vcs.type == "GITHUB_COM" ||
+    repository.vcs.type == "GITHUB_ENTERPRISE"
+  ) {
     url = `${repository.webUrl}/tree/${repository.branchFilter}`;
     if (!isEmpty(repository.baseDirectory)) {
       url += `/${repository.baseDirectory}`;
     }
+  } else if (repository.vcs.type == "GITEA") {
+    url = `${repository.webUrl}/src/branch/${repository.branchFilter}`;
+    if (!isEmpty(repository.baseDirectory)) {
+      url += `/${repository.baseDirectory}`;
+    }
+  } else if (repository.vcs.type == "BITBUCKET") {
+    if (isBitbucketCloudUrl(repository.webUrl)) {
+      url = `${repository.webUrl}/src/${repository.branchFilter}`;
+    } else {
+      url = `${repository.webUrl}/browse`;
+      query = bitbucketBranchQuery(repository.branchFilter);
+    }
+    if (!isEmpty(repository.baseDirectory)) {
+      url += `/${repository.baseDirectory}`;
+    }
   }
   if (url) {
     // Replace the patterns in the filePathTemplate if possible.
@@ -142,7 +163,7 @@ export function baseDirectoryWebUrl(
       url += `/${replaced}`;
     }
 
-    return url;
+    return url + query;
   }
 
   // Fallback for other types of VCS.
@@ -164,3 +185,20 @@ const replaceParams = (
   });
   return replaced;
 };
+
+const isBitbucketCloudUrl = (url: string): boolean => {
+  return url.startsWith("https://bitbucket.org/");
+};
+
+const bitbucketBranchQuery = (branch: string): string => {
+  return `?at=${encodeURIComponent(`refs/heads/${branch}`)}`;
+};
+
+// bitbucketBranchUrl returns the source browsing URL of the branch in the
+// Bitbucket Cloud or Bitbucket Data Center repository.
+export function bitbucketBranchUrl(repositoryUrl: string, branch: string) {
+  if (isBitbucketCloudUrl(repositoryUrl)) {
+    return `${repositoryUrl}/src/${branch}`;
+  }
+  return `${repositoryUrl}/browse${bitbucketBranchQuery(branch)}`;
+}
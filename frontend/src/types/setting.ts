@@ -1,6 +1,10 @@
 import { SettingId } from "./id";
+import { GroupMapping } from "./member";
 
-export type SettingName = "bb.branding.logo" | "bb.app.im";
+export type SettingName =
+  | "bb.branding.logo"
+  | "bb.app.im"
+  | "bb.workspace.ldap";
 
 export type Setting = {
   id: SettingId;
@@ -11,13 +15,71 @@ export type Setting = {
   description: string;
 };
 
-type IMType = "im.feishu";
+export type IMType =
+  | "im.feishu"
+  | "im.dingtalk"
+  | "im.wecom"
+  | "im.slack"
+  | "im.http";
+
+export const IM_TYPE_LIST: IMType[] = [
+  "im.feishu",
+  "im.dingtalk",
+  "im.wecom",
+  "im.slack",
+  "im.http",
+];
+
+// imTypeLocaleKey returns the locale key of the IM name.
+export const imTypeLocaleKey = (imType: IMType): string => {
+  if (imType === "im.http") {
+    return "common.custom";
+  }
+  return `common.${imType.substring("im.".length)}`;
+};
 
 export interface SettingAppIMValue {
   imType: IMType;
   appId: string;
   appSecret: string;
+  // signingSecret verifies the callbacks from Slack and the generic HTTP callback.
+  signingSecret?: string;
+  // url is the endpoint of the generic HTTP callback.
+  url?: string;
   externalApproval: {
     enabled: boolean;
+    // approvalDefinitionID is the process code of DingTalk or the template ID of WeCom.
+    // It's created by Bytebase for Feishu.
+    approvalDefinitionID?: string;
   };
 }
+
+export interface LDAPConfig {
+  url: string;
+  startTls: boolean;
+  insecureSkipVerify: boolean;
+  bindDn: string;
+  // bindPassword is redacted in the responses, leave it empty to keep the existing one.
+  bindPassword: string;
+  userBaseDn: string;
+  userFilter: string;
+  emailAttribute: string;
+  nameAttribute: string;
+  groupBaseDn: string;
+  groupFilter: string;
+  groupNameAttribute: string;
+  groupMemberAttribute: string;
+}
+
+export interface SettingWorkspaceLDAPValue {
+  enabled: boolean;
+  config: LDAPConfig;
+  intervalTs: number;
+  groupMappingList: GroupMapping[];
+}
+
+export interface LDAPSyncResult {
+  createdCount: number;
+  updatedCount: number;
+  deactivatedCount: number;
+}
@@ -194,6 +194,8 @@ export interface SQLReviewPolicy {
   name: string;
   ruleList: SchemaPolicyRule[];
   environment: Environment;
+  // disableSuppression ignores the bytebase:disable comments in the statements.
+  disableSuppression: boolean;
 }
 
 // RuleTemplate is the rule template. Used by the frontend
@@ -1,6 +1,11 @@
 import { VCSId } from "./id";
 
-export type VCSType = "GITLAB_SELF_HOST" | "GITHUB_COM";
+export type VCSType =
+  | "GITLAB_SELF_HOST"
+  | "GITHUB_COM"
+  | "GITHUB_ENTERPRISE"
+  | "GITEA"
+  | "BITBUCKET";
 
 export interface VCSConfig {
   type: VCSType;
@@ -8,6 +13,7 @@ export interface VCSConfig {
   instanceUrl: string;
   applicationId: string;
   secret: string;
+  caCert: string;
 }
 
 export type VCS = {
@@ -20,6 +26,9 @@ export type VCS = {
   apiUrl: string;
   applicationId: string;
   secret: string;
+  // The PEM-encoded CA certificates to verify self-hosted instances using
+  // self-signed certificates.
+  caCert: string;
 };
 
 export type VCSCreate = {
@@ -29,6 +38,7 @@ export type VCSCreate = {
   instanceUrl: string;
   applicationId: string;
   secret: string;
+  caCert: string;
 };
 
 export type VCSPatch = {
@@ -36,6 +46,7 @@ export type VCSPatch = {
   name?: string;
   applicationId?: string;
   secret?: string;
+  caCert?: string;
 };
 
 export type VCSTokenCreate = {
@@ -69,8 +80,30 @@ export function isValidVCSApplicationIdOrSecret(
 ): boolean {
   if (vcsType == "GITLAB_SELF_HOST") {
     return /^[a-zA-Z0-9_]{64}$/.test(str);
-  } else if (vcsType == "GITHUB_COM") {
+  } else if (vcsType == "GITHUB_COM" || vcsType == "GITHUB_ENTERPRISE") {
     return /^[a-zA-Z0-9_]{20}$|^[a-zA-Z0-9_]{40}$/.test(str);
+  } else if (vcsType == "GITEA") {
+    // Gitea application ID is a UUID and the secret has a "gto_" prefix.
+    return /^[a-zA-Z0-9_-]{36,64}$/.test(str);
+  } else if (vcsType == "BITBUCKET") {
+    // Bitbucket Cloud issues an 18-character key and a 32-character secret,
+    // while Bitbucket Data Center issues a 32 and a 64-character ones.
+    return /^[a-zA-Z0-9]{18,64}$/.test(str);
   }
   return false;
 }
+
+// isBitbucketCloud returns true if the instance URL is the Bitbucket Cloud,
+// otherwise it's a Bitbucket Data Center instance.
+export function isBitbucketCloud(instanceUrl: string): boolean {
+  return instanceUrl.replace(/\/+$/, "") == "https://bitbucket.org";
+}
+
+// bitbucketAuthorizeUrl returns the OAuth authorize URL of the Bitbucket Cloud
+// or Bitbucket Data Center instance.
+export function bitbucketAuthorizeUrl(instanceUrl: string): string {
+  if (isBitbucketCloud(instanceUrl)) {
+    return "https://bitbucket.org/site/oauth2/authorize";
+  }
+  return `${instanceUrl}/rest/oauth2/latest/authorize`;
+}
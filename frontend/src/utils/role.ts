@@ -16,6 +16,8 @@ export type WorkspacePermissionType =
   | "bb.permission.workspace.manage-member"
   | "bb.permission.workspace.manage-im-integration"
   | "bb.permission.workspace.manage-vcs-provider"
+  | "bb.permission.workspace.manage-sso"
+  | "bb.permission.workspace.manage-ldap"
   | "bb.permission.workspace.manage-general"
   | "bb.permission.workspace.manage-sensitive-data"
   | "bb.permission.workspace.manage-access-control"
@@ -42,6 +44,8 @@ export const WORKSPACE_PERMISSION_MATRIX: Map<
   ["bb.permission.workspace.manage-sql-review-policy", [false, true, true]],
   ["bb.permission.workspace.manage-member", [false, false, true]],
   ["bb.permission.workspace.manage-vcs-provider", [false, false, true]],
+  ["bb.permission.workspace.manage-sso", [false, false, true]],
+  ["bb.permission.workspace.manage-ldap", [false, false, true]],
   ["bb.permission.workspace.manage-general", [false, false, true]],
   ["bb.permission.workspace.manage-im-integration", [false, false, true]],
   ["bb.permission.workspace.manage-sensitive-data", [false, true, true]],
@@ -95,3 +95,9 @@ type MemberCountMetric struct {
 	Status    api.MemberStatus
 	RowStatus api.RowStatus
 }
+
+// AnomalyCountMetric is the API message for anomaly count metric.
+type AnomalyCountMetric struct {
+	Type  api.AnomalyType
+	Count int
+}
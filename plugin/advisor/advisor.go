@@ -309,6 +309,10 @@ type Advice struct {
 	Title   string `json:"title"`
 	Content string `json:"content"`
 	Line    int    `json:"line"`
+	// Suppressed is true if the advice is suppressed by the bytebase:disable comment of the statement.
+	// The status of a suppressed advice is "SUCCESS".
+	Suppressed     bool   `json:"suppressed,omitempty"`
+	SuppressReason string `json:"suppressReason,omitempty"`
 }
 
 // MarshalLogObject constructs a field that carries Advice.
@@ -318,6 +322,10 @@ func (a Advice) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("title", a.Title)
 	enc.AddString("content", a.Content)
 	enc.AddInt("line", a.Line)
+	if a.Suppressed {
+		enc.AddBool("suppressed", a.Suppressed)
+		enc.AddString("suppressReason", a.SuppressReason)
+	}
 	return nil
 }
 
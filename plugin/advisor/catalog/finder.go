@@ -49,6 +49,25 @@ func NewEmptyFinder(ctx *FinderContext) *Finder {
 	return &Finder{Origin: newDatabaseState(&storepb.DatabaseMetadata{}, ctx), Final: newDatabaseState(&storepb.DatabaseMetadata{}, ctx)}
 }
 
+// NewFinderFromSchema creates a finder with the database built from the schema statements, such as a schema dump.
+// It's used when the database metadata isn't available, such as reviewing the statements in CI.
+func NewFinderFromSchema(schema string, ctx *FinderContext) (*Finder, error) {
+	database := &storepb.DatabaseMetadata{}
+	if ctx.EngineType == db.Postgres {
+		database.Schemas = []*storepb.SchemaMetadata{{Name: "public"}}
+	}
+	// The origin and final states are walked through separately since the walk-through changes the state.
+	origin := newDatabaseState(database, ctx)
+	if err := origin.WalkThrough(schema); err != nil {
+		return nil, err
+	}
+	final := newDatabaseState(database, ctx)
+	if err := final.WalkThrough(schema); err != nil {
+		return nil, err
+	}
+	return &Finder{Origin: origin, Final: final}, nil
+}
+
 // WalkThrough does the walk through.
 func (f *Finder) WalkThrough(statements string) error {
 	return f.Final.WalkThrough(statements)
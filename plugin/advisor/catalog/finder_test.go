@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/plugin/advisor/db"
+)
+
+func TestNewFinderFromSchema(t *testing.T) {
+	tests := []struct {
+		engineType db.Type
+		schema     string
+		schemaName string
+	}{
+		{
+			engineType: db.MySQL,
+			schema: `
+SET character_set_client = utf8mb4;
+CREATE TABLE t1 (id int NOT NULL, name varchar(255), PRIMARY KEY (id));
+CREATE INDEX idx_name ON t1 (name);`,
+			schemaName: "",
+		},
+		{
+			engineType: db.Postgres,
+			schema: `
+CREATE TABLE public.t1 (id integer NOT NULL, name text);
+ALTER TABLE ONLY public.t1 ADD CONSTRAINT t1_pkey PRIMARY KEY (id);
+CREATE INDEX idx_name ON public.t1 USING btree (name);`,
+			schemaName: "public",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		finder, err := NewFinderFromSchema(test.schema, &FinderContext{CheckIntegrity: true, EngineType: test.engineType})
+		a.NoError(err)
+		for _, state := range []*DatabaseState{finder.Origin, finder.Final} {
+			table := state.FindTable(&TableFind{SchemaName: test.schemaName, TableName: "t1"})
+			a.NotNil(table)
+			a.NotNil(state.FindColumn(&ColumnFind{SchemaName: test.schemaName, TableName: "t1", ColumnName: "name"}))
+			a.NotNil(state.FindPrimaryKey(&PrimaryKeyFind{SchemaName: test.schemaName, TableName: "t1"}))
+			_, index := state.FindIndex(&IndexFind{SchemaName: test.schemaName, TableName: "t1", IndexName: "idx_name"})
+			a.NotNil(index)
+		}
+
+		// The walk-through of the final state doesn't change the origin state.
+		a.NoError(finder.WalkThrough("DROP TABLE t1;"))
+		a.NotNil(finder.Origin.FindTable(&TableFind{SchemaName: test.schemaName, TableName: "t1"}))
+	}
+}
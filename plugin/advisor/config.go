@@ -14,6 +14,12 @@ var sqlReviewDevTemplateStr string
 //go:embed config/sql-review.prod.yaml
 var sqlReviewProdTemplateStr string
 
+//go:embed config/sql-review.postgres.dev.yaml
+var sqlReviewPostgreSQLDevTemplateStr string
+
+//go:embed config/sql-review.postgres.prod.yaml
+var sqlReviewPostgreSQLProdTemplateStr string
+
 // SQLReviewTemplateID is the template id for SQL review rules.
 type SQLReviewTemplateID string
 
@@ -22,6 +28,10 @@ const (
 	TemplateForMySQLProd SQLReviewTemplateID = "bb.sql-review.prod"
 	// TemplateForMySQLDev is the template id for mysql dev template.
 	TemplateForMySQLDev SQLReviewTemplateID = "bb.sql-review.dev"
+	// TemplateForPostgreSQLProd is the template id for postgresql prod template.
+	TemplateForPostgreSQLProd SQLReviewTemplateID = "bb.sql-review.postgres.prod"
+	// TemplateForPostgreSQLDev is the template id for postgresql dev template.
+	TemplateForPostgreSQLDev SQLReviewTemplateID = "bb.sql-review.postgres.dev"
 )
 
 // SQLReviewTemplateData is the API message for SQL review rule template.
@@ -75,20 +85,20 @@ func MergeSQLReviewRules(override *SQLReviewConfigOverride) ([]*SQLReviewRule, e
 }
 
 func parseSQLReviewTemplateList() ([]*SQLReviewTemplateData, error) {
-	prodTemplate := &SQLReviewTemplateData{}
-	devTemplate := &SQLReviewTemplateData{}
-
-	if err := yaml.Unmarshal([]byte(sqlReviewProdTemplateStr), prodTemplate); err != nil {
-		return nil, err
-	}
-	if err := yaml.Unmarshal([]byte(sqlReviewDevTemplateStr), devTemplate); err != nil {
-		return nil, err
+	var templateList []*SQLReviewTemplateData
+	for _, templateStr := range []string{
+		sqlReviewProdTemplateStr,
+		sqlReviewDevTemplateStr,
+		sqlReviewPostgreSQLProdTemplateStr,
+		sqlReviewPostgreSQLDevTemplateStr,
+	} {
+		template := &SQLReviewTemplateData{}
+		if err := yaml.Unmarshal([]byte(templateStr), template); err != nil {
+			return nil, err
+		}
+		templateList = append(templateList, template)
 	}
-
-	return []*SQLReviewTemplateData{
-		prodTemplate,
-		devTemplate,
-	}, nil
+	return templateList, nil
 }
 
 func findTemplate(templateList []*SQLReviewTemplateData, id SQLReviewTemplateID) *SQLReviewTemplateData {
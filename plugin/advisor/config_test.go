@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/plugin/advisor/db"
 )
 
 var mockConfigOverrideYAMLStr = `
@@ -77,3 +79,16 @@ func TestConfigOverride(t *testing.T) {
 		}
 	}
 }
+
+func TestPostgreSQLTemplates(t *testing.T) {
+	for _, template := range []SQLReviewTemplateID{TemplateForPostgreSQLProd, TemplateForPostgreSQLDev} {
+		ruleList, err := MergeSQLReviewRules(&SQLReviewConfigOverride{Template: template})
+		require.NoError(t, err)
+		require.NotEmpty(t, ruleList)
+		// The templates only contain the rules supported by PostgreSQL.
+		for _, rule := range ruleList {
+			_, err := getAdvisorTypeByRule(rule.Type, db.Postgres)
+			require.NoError(t, err, "%s: %s", template, rule.Type)
+		}
+	}
+}
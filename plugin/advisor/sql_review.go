@@ -194,6 +194,8 @@ var (
 type SQLReviewPolicy struct {
 	Name     string           `json:"name"`
 	RuleList []*SQLReviewRule `json:"ruleList"`
+	// DisableSuppression ignores the bytebase:disable comments in the statements, so that no advice could be suppressed.
+	DisableSuppression bool `json:"disableSuppression"`
 }
 
 // Validate validates the SQLReviewPolicy. It also validates the each review rule.
@@ -423,6 +425,8 @@ type SQLReviewCheckContext struct {
 	Catalog   catalog.Catalog
 	Driver    *sql.DB
 	Context   context.Context
+	// DisableSuppression ignores the bytebase:disable comments in the statements.
+	DisableSuppression bool
 }
 
 // SQLReviewCheck checks the statements with sql review rules.
@@ -437,6 +441,11 @@ func SQLReviewCheck(statements string, ruleList []*SQLReviewRule, checkContext S
 		}
 	}
 
+	var suppressions suppressionMap
+	if !checkContext.DisableSuppression {
+		suppressions = getSuppressionMap(checkContext.DbType, statements)
+	}
+
 	for _, rule := range ruleList {
 		if rule.Level == SchemaRuleLevelDisabled {
 			continue
@@ -465,6 +474,9 @@ func SQLReviewCheck(statements string, ruleList []*SQLReviewRule, checkContext S
 			return nil, errors.Wrap(err, "failed to check statement")
 		}
 
+		for i := range adviceList {
+			suppressAdvice(&adviceList[i], rule.Type, suppressions)
+		}
 		result = append(result, adviceList...)
 	}
 
@@ -0,0 +1,99 @@
+package advisor
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/bytebase/plugin/advisor/db"
+	"github.com/bytebase/bytebase/plugin/parser"
+)
+
+const suppressionDirective = "bytebase:disable"
+
+var (
+	// suppressionRegexp matches the suppression directive at the beginning of a comment, such as
+	//   -- bytebase:disable naming.index.idx,column.required reason="legacy"
+	// The first group is the comma separated rule type list, and the second one is the optional reason.
+	suppressionRegexp = regexp.MustCompile(`^(?:--|/\*|#)\s*bytebase:disable\s+([\w.\-]+(?:\s*,\s*[\w.\-]+)*)(?:\s+reason="([^"]*)")?`)
+)
+
+// suppression suppresses the advice of a rule within the lines of a statement.
+type suppression struct {
+	firstLine int
+	lastLine  int
+	reason    string
+}
+
+// suppressionMap is the map from the SQL review rule type to its suppressions.
+type suppressionMap map[SQLReviewRuleType][]*suppression
+
+// getSuppressionMap collects the suppression directives in the comments of each statement.
+// A directive applies to the whole statement it belongs to, including the comments before it
+// and the trailing comment on its last line.
+// The comments are taken from the tokenizer of the engine, so the directives in the string literals
+// and the "#" in PostgreSQL are not taken as the suppression.
+func getSuppressionMap(dbType db.Type, statements string) suppressionMap {
+	if !strings.Contains(statements, suppressionDirective) {
+		return nil
+	}
+	// Leave the syntax error to the advisors.
+	singleSQLList, err := parser.SplitMultiSQL(parser.EngineType(dbType), statements)
+	if err != nil {
+		return nil
+	}
+	commentList, err := parser.ExtractComments(parser.EngineType(dbType), statements)
+	if err != nil {
+		return nil
+	}
+
+	m := make(suppressionMap)
+	firstLine := 1
+	for _, singleSQL := range singleSQLList {
+		for _, comment := range commentList {
+			if comment.Line < firstLine || comment.Line > singleSQL.LastLine {
+				continue
+			}
+			match := suppressionRegexp.FindStringSubmatch(comment.Text)
+			if match == nil {
+				continue
+			}
+			for _, ruleType := range strings.Split(match[1], ",") {
+				ruleType := SQLReviewRuleType(strings.TrimSpace(ruleType))
+				m[ruleType] = append(m[ruleType], &suppression{
+					firstLine: firstLine,
+					lastLine:  singleSQL.LastLine,
+					reason:    match[2],
+				})
+			}
+		}
+		if singleSQL.LastLine >= firstLine {
+			firstLine = singleSQL.LastLine + 1
+		}
+	}
+	return m
+}
+
+// find returns the suppression of the rule type covering the line, or nil if there is none.
+func (m suppressionMap) find(ruleType SQLReviewRuleType, line int) *suppression {
+	for _, s := range m[ruleType] {
+		if s.firstLine <= line && line <= s.lastLine {
+			return s
+		}
+	}
+	return nil
+}
+
+// suppressAdvice turns the advice into a suppressed success if it's covered by a suppression of the rule type.
+func suppressAdvice(advice *Advice, ruleType SQLReviewRuleType, suppressions suppressionMap) {
+	// The syntax error isn't produced by the rule, so it can't be suppressed.
+	if advice.Status == Success || advice.Title == SyntaxErrorTitle || advice.Line <= 0 {
+		return
+	}
+	s := suppressions.find(ruleType, advice.Line)
+	if s == nil {
+		return
+	}
+	advice.Status = Success
+	advice.Suppressed = true
+	advice.SuppressReason = s.reason
+}
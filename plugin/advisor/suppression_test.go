@@ -0,0 +1,117 @@
+package advisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/plugin/advisor/db"
+)
+
+func TestGetSuppressionMap(t *testing.T) {
+	type suppressionCase struct {
+		ruleType   SQLReviewRuleType
+		line       int
+		suppressed bool
+		reason     string
+	}
+	tests := []struct {
+		dbType     db.Type
+		statements string
+		want       []suppressionCase
+	}{
+		{
+			dbType: db.MySQL,
+			statements: `CREATE TABLE t(a int);
+-- bytebase:disable naming.index.idx reason="legacy"
+CREATE INDEX i ON t(a);
+CREATE INDEX j ON t(a);`,
+			want: []suppressionCase{
+				{ruleType: SchemaRuleIDXNaming, line: 1, suppressed: false},
+				{ruleType: SchemaRuleIDXNaming, line: 3, suppressed: true, reason: "legacy"},
+				{ruleType: SchemaRuleIDXNaming, line: 4, suppressed: false},
+				{ruleType: SchemaRuleRequiredColumn, line: 3, suppressed: false},
+			},
+		},
+		{
+			dbType: db.MySQL,
+			statements: `CREATE TABLE t(
+  a int
+); # bytebase:disable column.required, table.require-pk
+CREATE TABLE u(a int);`,
+			want: []suppressionCase{
+				{ruleType: SchemaRuleRequiredColumn, line: 1, suppressed: true},
+				{ruleType: SchemaRuleTableRequirePK, line: 3, suppressed: true},
+				{ruleType: SchemaRuleTableRequirePK, line: 4, suppressed: false},
+			},
+		},
+		{
+			dbType: db.Postgres,
+			statements: `/* bytebase:disable naming.index.idx reason="created by the ORM" */
+CREATE INDEX i ON t(a);
+CREATE INDEX j ON t(a);`,
+			want: []suppressionCase{
+				{ruleType: SchemaRuleIDXNaming, line: 2, suppressed: true, reason: "created by the ORM"},
+				{ruleType: SchemaRuleIDXNaming, line: 3, suppressed: false},
+			},
+		},
+		{
+			dbType:     db.Postgres,
+			statements: `CREATE INDEX i ON t(a); -- bytebase disable naming.index.idx`,
+			want: []suppressionCase{
+				{ruleType: SchemaRuleIDXNaming, line: 1, suppressed: false},
+			},
+		},
+		{
+			dbType:     db.MySQL,
+			statements: `INSERT INTO t VALUES ('-- bytebase:disable statement.where.require', "/* bytebase:disable statement.where.require */");`,
+			want: []suppressionCase{
+				{ruleType: SchemaRuleStatementRequireWhere, line: 1, suppressed: false},
+			},
+		},
+		{
+			dbType: db.Postgres,
+			statements: `INSERT INTO t VALUES ('-- bytebase:disable statement.where.require');
+DELETE FROM t; -- bytebase:disable statement.where.require`,
+			want: []suppressionCase{
+				{ruleType: SchemaRuleStatementRequireWhere, line: 1, suppressed: false},
+				{ruleType: SchemaRuleStatementRequireWhere, line: 2, suppressed: true},
+			},
+		},
+		{
+			// "#" isn't a comment in PostgreSQL.
+			dbType:     db.Postgres,
+			statements: `CREATE INDEX i ON t(a); # bytebase:disable naming.index.idx`,
+			want: []suppressionCase{
+				{ruleType: SchemaRuleIDXNaming, line: 1, suppressed: false},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		m := getSuppressionMap(test.dbType, test.statements)
+		for _, want := range test.want {
+			s := m.find(want.ruleType, want.line)
+			require.Equal(t, want.suppressed, s != nil, "%s: %s at line %d", test.statements, want.ruleType, want.line)
+			if s != nil {
+				require.Equal(t, want.reason, s.reason)
+			}
+		}
+	}
+}
+
+func TestSuppressAdvice(t *testing.T) {
+	m := getSuppressionMap(db.MySQL, "-- bytebase:disable naming.index.idx reason=\"legacy\"\nCREATE INDEX i ON t(a);")
+
+	advice := Advice{Status: Warn, Code: NamingIndexConventionMismatch, Title: "naming.index.idx", Line: 2}
+	suppressAdvice(&advice, SchemaRuleIDXNaming, m)
+	require.Equal(t, Advice{Status: Success, Code: NamingIndexConventionMismatch, Title: "naming.index.idx", Line: 2, Suppressed: true, SuppressReason: "legacy"}, advice)
+
+	advice = Advice{Status: Error, Code: StatementSyntaxError, Title: SyntaxErrorTitle, Line: 2}
+	suppressAdvice(&advice, SchemaRuleIDXNaming, m)
+	require.False(t, advice.Suppressed)
+
+	advice = Advice{Status: Warn, Code: NamingIndexConventionMismatch, Title: "naming.index.idx", Line: 2}
+	suppressAdvice(&advice, SchemaRuleIDXNaming, nil)
+	require.False(t, advice.Suppressed)
+}
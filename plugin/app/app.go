@@ -0,0 +1,168 @@
+// Package app provides the interface of the IM applications approving the Bytebase stages externally.
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const timeout = 30 * time.Second
+
+// Type is the type of the IM application.
+type Type string
+
+const (
+	// Feishu is the IM application Feishu (Lark).
+	Feishu Type = "im.feishu"
+	// DingTalk is the IM application DingTalk.
+	DingTalk Type = "im.dingtalk"
+	// WeCom is the IM application WeCom (WeChat Work).
+	WeCom Type = "im.wecom"
+	// Slack is the IM application Slack with interactive messages.
+	Slack Type = "im.slack"
+	// HTTP is the generic HTTP callback application.
+	HTTP Type = "im.http"
+)
+
+// ApprovalStatus is the status of an approval instance.
+type ApprovalStatus string
+
+const (
+	// ApprovalStatusPending is the approval status for pending approvals.
+	ApprovalStatusPending ApprovalStatus = "PENDING"
+	// ApprovalStatusApproved is the approval status for approved approvals.
+	ApprovalStatusApproved ApprovalStatus = "APPROVED"
+	// ApprovalStatusRejected is the approval status for rejected approvals.
+	ApprovalStatusRejected ApprovalStatus = "REJECTED"
+	// ApprovalStatusCanceled is the approval status for canceled approvals.
+	ApprovalStatusCanceled ApprovalStatus = "CANCELED"
+)
+
+// Config is the application configuration in the IM setting.
+type Config struct {
+	// AppID is the application ID, e.g. the app key of DingTalk or the corp ID of WeCom.
+	AppID string
+	// AppSecret is the application secret, e.g. the bot token of Slack.
+	AppSecret string
+	// ApprovalDefinitionID is the approval definition on the IM application,
+	// e.g. the approval code of Feishu, the process code of DingTalk or the template ID of WeCom.
+	ApprovalDefinitionID string
+	// SigningSecret is used to verify the callbacks from the IM application.
+	SigningSecret string
+	// URL is the endpoint of the generic HTTP application.
+	URL string
+	// CallbackURL is the Bytebase URL receiving the callbacks from the IM application.
+	CallbackURL string
+}
+
+// Task is the content of a task in the approval.
+type Task struct {
+	Name      string
+	Status    string
+	Statement string
+}
+
+// Content is the content of the approval.
+type Content struct {
+	Issue    string
+	Stage    string
+	Link     string
+	TaskList []Task
+}
+
+// Text returns the plain text summary of the approval content.
+func (c Content) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Issue: %s\n", c.Issue)
+	fmt.Fprintf(&b, "Stage: %s\n", c.Stage)
+	fmt.Fprintf(&b, "Link: %s\n", c.Link)
+	fmt.Fprintf(&b, "Stage %q has %d task(s).\n", c.Stage, len(c.TaskList))
+	for i, task := range c.TaskList {
+		fmt.Fprintf(&b, "%d. [%s] %s.\n", i+1, task.Status, task.Name)
+	}
+	return b.String()
+}
+
+// Approval is the approval instance created on the IM application.
+type Approval struct {
+	// InstanceID identifies the approval instance on the IM application.
+	InstanceID string
+	// RequesterID is the user ID of the requester on the IM application.
+	RequesterID string
+}
+
+// Provider is the interface of the IM application handling external approvals.
+type Provider interface {
+	// Setup validates the config and creates or updates the approval definition if the IM application supports it.
+	// It returns the approval definition ID to save in the IM setting.
+	Setup(ctx context.Context, config Config) (string, error)
+	// CreateApproval creates an approval instance requested by the requester and approved by the approver.
+	CreateApproval(ctx context.Context, config Config, content Content, requesterEmail, approverEmail string) (*Approval, error)
+	// GetApprovalStatus polls the status of the approval instance.
+	// Providers receiving the status by callbacks return ApprovalStatusPending.
+	GetApprovalStatus(ctx context.Context, config Config, approval *Approval) (ApprovalStatus, error)
+	// CancelApproval cancels the approval instance with the reason.
+	CancelApproval(ctx context.Context, config Config, approval *Approval, reason string) error
+}
+
+// CallbackEvent is the approval status change pushed by the IM application.
+type CallbackEvent struct {
+	InstanceID string
+	Status     ApprovalStatus
+}
+
+// CallbackReceiver is the interface of the providers pushing the approval status to Bytebase.
+type CallbackReceiver interface {
+	// ParseCallback verifies the callback request and parses the approval status change.
+	// It returns nil event for the requests not changing the approval status, e.g. URL verification.
+	ParseCallback(config Config, header http.Header, body []byte) (*CallbackEvent, error)
+}
+
+// ProviderConfig is the provider configuration.
+type ProviderConfig struct {
+	// APIURL overrides the default API server URL of the IM application.
+	APIURL string
+	Client *http.Client
+}
+
+var (
+	providerMu sync.RWMutex
+	providers  = make(map[Type]providerFunc)
+)
+
+type providerFunc func(ProviderConfig) Provider
+
+// Register makes an IM application provider available by the provided type.
+// If Register is called twice with the same name or if provider is nil,
+// it panics.
+func Register(appType Type, f providerFunc) {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+	if f == nil {
+		panic("app: Register provider is nil")
+	}
+	if _, dup := providers[appType]; dup {
+		panic("app: Register called twice for provider " + appType)
+	}
+	providers[appType] = f
+}
+
+// Get returns an IM application provider specified by its type.
+func Get(appType Type, providerConfig ProviderConfig) (Provider, error) {
+	providerMu.RLock()
+	f, ok := providers[appType]
+	providerMu.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("unknown IM application type %s", appType)
+	}
+	if providerConfig.Client == nil {
+		providerConfig.Client = &http.Client{Timeout: timeout}
+	}
+	return f(providerConfig), nil
+}
@@ -0,0 +1,331 @@
+// Package dingtalk implements the DingTalk approval provider.
+package dingtalk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+const (
+	// apiURL is the URL of the DingTalk API server.
+	apiURL = "https://api.dingtalk.com"
+	// oapiURL is the URL of the legacy DingTalk API server, which still serves the contact APIs.
+	oapiURL = "https://oapi.dingtalk.com"
+	// rootDepartmentID is the ID of the root department of an organization.
+	rootDepartmentID = 1
+	userListPageSize = 100
+)
+
+// Form component names of the approval process. The DingTalk approval process
+// must contain the text components with these names.
+const (
+	formIssue  = "Issue"
+	formLink   = "Link"
+	formStage  = "Stage"
+	formDetail = "Detail"
+)
+
+var _ app.Provider = (*Provider)(nil)
+
+func init() {
+	app.Register(app.DingTalk, newProvider)
+}
+
+// Provider is the provider for DingTalk approvals.
+type Provider struct {
+	apiURL  string
+	oapiURL string
+	client  *http.Client
+}
+
+func newProvider(config app.ProviderConfig) app.Provider {
+	p := &Provider{
+		apiURL:  apiURL,
+		oapiURL: oapiURL,
+		client:  config.Client,
+	}
+	if config.APIURL != "" {
+		p.apiURL = config.APIURL
+		p.oapiURL = config.APIURL
+	}
+	return p
+}
+
+// Setup checks the application credentials. DingTalk approval processes are designed in
+// the DingTalk admin console, so the approval definition ID is the process code provided by the user.
+func (p *Provider) Setup(ctx context.Context, config app.Config) (string, error) {
+	if config.AppID == "" || config.AppSecret == "" {
+		return "", errors.New("app key and secret cannot be empty")
+	}
+	if config.ApprovalDefinitionID == "" {
+		return "", errors.New("approval process code cannot be empty")
+	}
+	if _, err := p.getAccessToken(ctx, config); err != nil {
+		return "", err
+	}
+	return config.ApprovalDefinitionID, nil
+}
+
+// CreateApproval creates an approval process instance.
+// https://open.dingtalk.com/document/orgapp/create-an-approval-instance
+func (p *Provider) CreateApproval(ctx context.Context, config app.Config, content app.Content, requesterEmail, approverEmail string) (*app.Approval, error) {
+	token, err := p.getAccessToken(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	users, err := p.getUserIDByEmail(ctx, token, []string{requesterEmail, approverEmail})
+	if err != nil {
+		return nil, err
+	}
+	approverID, ok := users[approverEmail]
+	if !ok {
+		return nil, errors.Errorf("failed to get user id for approver, email: %s", approverEmail)
+	}
+	// DingTalk requires the originator to be a member of the organization, so the approver
+	// originates the approval on behalf of the requester if the requester is not found.
+	requesterID, ok := users[requesterEmail]
+	if !ok {
+		requesterID = approverID
+	}
+
+	type formComponentValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	type approver struct {
+		ActionType string   `json:"actionType"`
+		UserIDs    []string `json:"userIds"`
+	}
+	body, err := json.Marshal(struct {
+		ProcessCode         string               `json:"processCode"`
+		OriginatorUserID    string               `json:"originatorUserId"`
+		Approvers           []approver           `json:"approvers"`
+		FormComponentValues []formComponentValue `json:"formComponentValues"`
+	}{
+		ProcessCode:      config.ApprovalDefinitionID,
+		OriginatorUserID: requesterID,
+		Approvers: []approver{
+			{ActionType: "NONE", UserIDs: []string{approverID}},
+		},
+		FormComponentValues: []formComponentValue{
+			{Name: formIssue, Value: content.Issue},
+			{Name: formLink, Value: content.Link},
+			{Name: formStage, Value: content.Stage},
+			{Name: formDetail, Value: content.Text()},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal create process instance request")
+	}
+	var resp struct {
+		InstanceID string `json:"instanceId"`
+	}
+	if err := p.do(ctx, http.MethodPost, fmt.Sprintf("%s/v1.0/workflow/processInstances", p.apiURL), token, body, &resp); err != nil {
+		return nil, err
+	}
+	return &app.Approval{
+		InstanceID:  resp.InstanceID,
+		RequesterID: requesterID,
+	}, nil
+}
+
+// GetApprovalStatus gets the status of the approval process instance.
+// https://open.dingtalk.com/document/orgapp/obtains-the-details-of-a-single-approval-instance-pop
+func (p *Provider) GetApprovalStatus(ctx context.Context, config app.Config, approval *app.Approval) (app.ApprovalStatus, error) {
+	token, err := p.getAccessToken(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Result struct {
+			// Status is one of RUNNING, TERMINATED and COMPLETED.
+			Status string `json:"status"`
+			// Result is one of agree and refuse for the completed instances.
+			Result string `json:"result"`
+		} `json:"result"`
+	}
+	u := fmt.Sprintf("%s/v1.0/workflow/processInstances?processInstanceId=%s", p.apiURL, url.QueryEscape(approval.InstanceID))
+	if err := p.do(ctx, http.MethodGet, u, token, nil, &resp); err != nil {
+		return "", err
+	}
+	switch resp.Result.Status {
+	case "COMPLETED":
+		if resp.Result.Result == "agree" {
+			return app.ApprovalStatusApproved, nil
+		}
+		return app.ApprovalStatusRejected, nil
+	case "TERMINATED":
+		return app.ApprovalStatusCanceled, nil
+	default:
+		return app.ApprovalStatusPending, nil
+	}
+}
+
+// CancelApproval terminates the approval process instance.
+// https://open.dingtalk.com/document/orgapp/revoke-an-approval-instance
+func (p *Provider) CancelApproval(ctx context.Context, config app.Config, approval *app.Approval, reason string) error {
+	token, err := p.getAccessToken(ctx, config)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(struct {
+		ProcessInstanceID string `json:"processInstanceId"`
+		IsSystem          bool   `json:"isSystem"`
+		Remark            string `json:"remark"`
+	}{
+		ProcessInstanceID: approval.InstanceID,
+		IsSystem:          true,
+		Remark:            reason,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal terminate process instance request")
+	}
+	return p.do(ctx, http.MethodPost, fmt.Sprintf("%s/v1.0/workflow/processInstances/terminate", p.apiURL), token, body, nil)
+}
+
+// getAccessToken gets the access token of the internal application.
+// https://open.dingtalk.com/document/orgapp/obtain-the-access_token-of-an-internal-app
+func (p *Provider) getAccessToken(ctx context.Context, config app.Config) (string, error) {
+	body, err := json.Marshal(struct {
+		AppKey    string `json:"appKey"`
+		AppSecret string `json:"appSecret"`
+	}{
+		AppKey:    config.AppID,
+		AppSecret: config.AppSecret,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal access token request")
+	}
+	var resp struct {
+		AccessToken string `json:"accessToken"`
+	}
+	if err := p.do(ctx, http.MethodPost, fmt.Sprintf("%s/v1.0/oauth2/accessToken", p.apiURL), "", body, &resp); err != nil {
+		return "", errors.Wrap(err, "failed to get access token")
+	}
+	return resp.AccessToken, nil
+}
+
+// getUserIDByEmail walks through the departments of the organization and returns the email to user ID mapping
+// of the given emails. Both the personal email and the organization email of a user are matched.
+// https://open.dingtalk.com/document/orgapp/queries-the-complete-information-of-a-department-user
+func (p *Provider) getUserIDByEmail(ctx context.Context, token string, emails []string) (map[string]string, error) {
+	wanted := make(map[string]string)
+	for _, email := range emails {
+		wanted[strings.ToLower(email)] = email
+	}
+	userID := make(map[string]string)
+
+	departmentIDs := []int{rootDepartmentID}
+	for len(departmentIDs) > 0 && len(userID) < len(wanted) {
+		departmentID := departmentIDs[0]
+		departmentIDs = departmentIDs[1:]
+
+		cursor := 0
+		for {
+			body, err := json.Marshal(map[string]int{"dept_id": departmentID, "cursor": cursor, "size": userListPageSize})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to marshal user list request")
+			}
+			var resp struct {
+				Result struct {
+					HasMore    bool `json:"has_more"`
+					NextCursor int  `json:"next_cursor"`
+					List       []struct {
+						UserID   string `json:"userid"`
+						Email    string `json:"email"`
+						OrgEmail string `json:"org_email"`
+					} `json:"list"`
+				} `json:"result"`
+			}
+			if err := p.doLegacy(ctx, fmt.Sprintf("%s/topapi/v2/user/list", p.oapiURL), token, body, &resp); err != nil {
+				return nil, err
+			}
+			for _, user := range resp.Result.List {
+				for _, email := range []string{user.Email, user.OrgEmail} {
+					if origin, ok := wanted[strings.ToLower(email)]; ok && email != "" {
+						userID[origin] = user.UserID
+					}
+				}
+			}
+			if !resp.Result.HasMore {
+				break
+			}
+			cursor = resp.Result.NextCursor
+		}
+
+		body, err := json.Marshal(map[string]int{"dept_id": departmentID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal sub-department list request")
+		}
+		var resp struct {
+			Result struct {
+				DeptIDList []int `json:"dept_id_list"`
+			} `json:"result"`
+		}
+		if err := p.doLegacy(ctx, fmt.Sprintf("%s/topapi/v2/department/listsubid", p.oapiURL), token, body, &resp); err != nil {
+			return nil, err
+		}
+		departmentIDs = append(departmentIDs, resp.Result.DeptIDList...)
+	}
+	return userID, nil
+}
+
+// do calls the DingTalk API and decodes the response into v if v is not nil.
+func (p *Provider) do(ctx context.Context, method, u, token string, body []byte, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "construct %s %s", method, u)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("x-acs-dingtalk-access-token", token)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", method, u)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "read body of %s %s", method, u)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("non-200 %s status code %d with body %q", method, resp.StatusCode, b)
+	}
+	if v == nil {
+		return nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errors.Wrapf(err, "unmarshal body from %s %s", method, u)
+	}
+	return nil
+}
+
+// doLegacy calls the legacy DingTalk API, which reports errors by the errcode in the response body.
+func (p *Provider) doLegacy(ctx context.Context, u, token string, body []byte, v interface{}) error {
+	b := json.RawMessage{}
+	if err := p.do(ctx, http.MethodPost, fmt.Sprintf("%s?access_token=%s", u, url.QueryEscape(token)), "", body, &b); err != nil {
+		return err
+	}
+	var resp struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return errors.Wrapf(err, "unmarshal body from POST %s", u)
+	}
+	if resp.ErrCode != 0 {
+		return errors.Errorf("POST %s failed, code %d, msg %s", u, resp.ErrCode, resp.ErrMsg)
+	}
+	return json.Unmarshal(b, v)
+}
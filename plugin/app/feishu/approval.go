@@ -0,0 +1,125 @@
+package feishu
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+var _ app.Provider = (*Provider)(nil)
+
+func init() {
+	app.Register(app.Feishu, newProvider)
+}
+
+func newProvider(config app.ProviderConfig) app.Provider {
+	apiPath := config.APIURL
+	if apiPath == "" {
+		apiPath = APIPath
+	}
+	p := NewProvider(apiPath)
+	p.client = config.Client
+	return p
+}
+
+func tokenCtxOf(config app.Config) TokenCtx {
+	return TokenCtx{
+		AppID:     config.AppID,
+		AppSecret: config.AppSecret,
+	}
+}
+
+// Setup checks the bot and creates the approval definition, or updates it if the approval definition ID is present.
+func (p *Provider) Setup(ctx context.Context, config app.Config) (string, error) {
+	if config.AppID == "" || config.AppSecret == "" {
+		return "", errors.New("application ID and secret cannot be empty")
+	}
+	// clear token cache so that we won't use the previous token.
+	p.ClearTokenCache()
+	if _, err := p.GetBotID(ctx, tokenCtxOf(config)); err != nil {
+		return "", errors.Wrap(err, "failed to get bot id, check if bot is enabled")
+	}
+	approvalDefinitionID, err := p.CreateApprovalDefinition(ctx, tokenCtxOf(config), config.ApprovalDefinitionID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create approval definition")
+	}
+	return approvalDefinitionID, nil
+}
+
+// CreateApproval creates an approval instance.
+// If the requester is not found on Feishu, the application bot will represent the requester.
+func (p *Provider) CreateApproval(ctx context.Context, config app.Config, content app.Content, requesterEmail, approverEmail string) (*app.Approval, error) {
+	users, err := p.GetIDByEmail(ctx, tokenCtxOf(config), []string{requesterEmail, approverEmail})
+	if err != nil {
+		return nil, err
+	}
+	// assignee who approves the external approval is a must-have, so we returns error if we failed to find the user id.
+	if _, ok := users[approverEmail]; !ok {
+		return nil, errors.Errorf("failed to get user_id for approver, email: %s", approverEmail)
+	}
+	if _, ok := users[requesterEmail]; !ok {
+		botID, err := p.GetBotID(ctx, tokenCtxOf(config))
+		if err != nil {
+			return nil, err
+		}
+		users[requesterEmail] = botID
+	}
+
+	var taskList []Task
+	for _, task := range content.TaskList {
+		taskList = append(taskList, Task{
+			Name:      task.Name,
+			Status:    task.Status,
+			Statement: task.Statement,
+		})
+	}
+	instanceCode, err := p.CreateExternalApproval(ctx, tokenCtxOf(config),
+		Content{
+			Issue:    content.Issue,
+			Stage:    content.Stage,
+			Link:     content.Link,
+			TaskList: taskList,
+		},
+		config.ApprovalDefinitionID,
+		users[requesterEmail],
+		users[approverEmail])
+	if err != nil {
+		return nil, err
+	}
+	return &app.Approval{
+		InstanceID:  instanceCode,
+		RequesterID: users[requesterEmail],
+	}, nil
+}
+
+// GetApprovalStatus gets the status of the approval instance.
+func (p *Provider) GetApprovalStatus(ctx context.Context, config app.Config, approval *app.Approval) (app.ApprovalStatus, error) {
+	status, err := p.GetExternalApprovalStatus(ctx, tokenCtxOf(config), approval.InstanceID)
+	if err != nil {
+		return "", err
+	}
+	switch status {
+	case ApprovalStatusApproved:
+		return app.ApprovalStatusApproved, nil
+	case ApprovalStatusRejected:
+		return app.ApprovalStatusRejected, nil
+	case ApprovalStatusCanceled, ApprovalStatusDeleted:
+		return app.ApprovalStatusCanceled, nil
+	default:
+		return app.ApprovalStatusPending, nil
+	}
+}
+
+// CancelApproval cancels the approval instance and comments the reason by the application bot.
+func (p *Provider) CancelApproval(ctx context.Context, config app.Config, approval *app.Approval, reason string) error {
+	botID, err := p.GetBotID(ctx, tokenCtxOf(config))
+	if err != nil {
+		return err
+	}
+	if err := p.CancelExternalApproval(ctx, tokenCtxOf(config), config.ApprovalDefinitionID, approval.InstanceID, approval.RequesterID); err != nil {
+		return err
+	}
+	return p.CreateExternalApprovalComment(ctx, tokenCtxOf(config), approval.InstanceID, botID, reason)
+}
@@ -0,0 +1,200 @@
+// Package httpcallback implements the generic HTTP approval provider.
+//
+// Bytebase sends the signed approval requests to the configured URL, and the
+// external system sends the signed approval results back to Bytebase.
+// Both directions are signed by the HMAC-SHA256 of the request body with the
+// signing secret, hex encoded in the X-Bytebase-Signature header.
+package httpcallback
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+const (
+	// SignatureHeader is the header carrying the request signature.
+	SignatureHeader = "X-Bytebase-Signature"
+
+	// ActionCreate is the action to create an approval.
+	ActionCreate = "CREATE"
+	// ActionCancel is the action to cancel an approval.
+	ActionCancel = "CANCEL"
+)
+
+var (
+	_ app.Provider         = (*Provider)(nil)
+	_ app.CallbackReceiver = (*Provider)(nil)
+)
+
+func init() {
+	app.Register(app.HTTP, newProvider)
+}
+
+// Task is the task in the approval request.
+type Task struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Statement string `json:"statement"`
+}
+
+// Request is the approval request sent to the external system.
+type Request struct {
+	// Action is either CREATE or CANCEL.
+	Action string `json:"action"`
+	// InstanceID is the approval instance to cancel, only set for CANCEL.
+	InstanceID string `json:"instanceId,omitempty"`
+	// Reason is the cancel reason, only set for CANCEL.
+	Reason string `json:"reason,omitempty"`
+
+	Issue          string `json:"issue,omitempty"`
+	Stage          string `json:"stage,omitempty"`
+	Link           string `json:"link,omitempty"`
+	TaskList       []Task `json:"taskList,omitempty"`
+	RequesterEmail string `json:"requesterEmail,omitempty"`
+	ApproverEmail  string `json:"approverEmail,omitempty"`
+	// CallbackURL is where the external system posts the approval result.
+	CallbackURL string `json:"callbackUrl,omitempty"`
+}
+
+// Response is the response of the external system to the CREATE request.
+type Response struct {
+	InstanceID string `json:"instanceId"`
+}
+
+// Callback is the approval result posted by the external system.
+type Callback struct {
+	InstanceID string `json:"instanceId"`
+	// Status is either APPROVED or REJECTED.
+	Status app.ApprovalStatus `json:"status"`
+}
+
+// Provider is the provider for the generic HTTP approvals.
+type Provider struct {
+	client *http.Client
+}
+
+func newProvider(config app.ProviderConfig) app.Provider {
+	return &Provider{
+		client: config.Client,
+	}
+}
+
+// Setup checks the URL and the signing secret.
+func (*Provider) Setup(_ context.Context, config app.Config) (string, error) {
+	if config.URL == "" {
+		return "", errors.New("URL cannot be empty")
+	}
+	if config.SigningSecret == "" {
+		return "", errors.New("signing secret cannot be empty")
+	}
+	return "", nil
+}
+
+// CreateApproval sends the CREATE request to the external system.
+func (p *Provider) CreateApproval(ctx context.Context, config app.Config, content app.Content, requesterEmail, approverEmail string) (*app.Approval, error) {
+	req := &Request{
+		Action:         ActionCreate,
+		Issue:          content.Issue,
+		Stage:          content.Stage,
+		Link:           content.Link,
+		RequesterEmail: requesterEmail,
+		ApproverEmail:  approverEmail,
+		CallbackURL:    config.CallbackURL,
+	}
+	for _, task := range content.TaskList {
+		req.TaskList = append(req.TaskList, Task{
+			Name:      task.Name,
+			Status:    task.Status,
+			Statement: task.Statement,
+		})
+	}
+	var resp Response
+	if err := p.do(ctx, config, req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.InstanceID == "" {
+		return nil, errors.New("empty instance ID in the response")
+	}
+	return &app.Approval{InstanceID: resp.InstanceID}, nil
+}
+
+// GetApprovalStatus returns pending since the approval status is received by the callbacks.
+func (*Provider) GetApprovalStatus(_ context.Context, _ app.Config, _ *app.Approval) (app.ApprovalStatus, error) {
+	return app.ApprovalStatusPending, nil
+}
+
+// CancelApproval sends the CANCEL request to the external system.
+func (p *Provider) CancelApproval(ctx context.Context, config app.Config, approval *app.Approval, reason string) error {
+	return p.do(ctx, config, &Request{
+		Action:     ActionCancel,
+		InstanceID: approval.InstanceID,
+		Reason:     reason,
+	}, nil)
+}
+
+// ParseCallback verifies the signature of the callback and parses the approval result.
+func (*Provider) ParseCallback(config app.Config, header http.Header, body []byte) (*app.CallbackEvent, error) {
+	if !hmac.Equal([]byte(Sign(config.SigningSecret, body)), []byte(header.Get(SignatureHeader))) {
+		return nil, errors.New("invalid request signature")
+	}
+	var callback Callback
+	if err := json.Unmarshal(body, &callback); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the callback")
+	}
+	if callback.Status != app.ApprovalStatusApproved && callback.Status != app.ApprovalStatusRejected {
+		return nil, errors.Errorf("invalid approval status %q, should be %s or %s", callback.Status, app.ApprovalStatusApproved, app.ApprovalStatusRejected)
+	}
+	return &app.CallbackEvent{
+		InstanceID: callback.InstanceID,
+		Status:     callback.Status,
+	}, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the body.
+func Sign(signingSecret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (p *Provider) do(ctx context.Context, config app.Config, request *Request, v interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s request", request.Action)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "construct POST %s", config.URL)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(config.SigningSecret, body))
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", config.URL)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "read body of POST %s", config.URL)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("non-200 POST status code %d with body %q", resp.StatusCode, b)
+	}
+	if v == nil {
+		return nil
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errors.Wrapf(err, "unmarshal body from POST %s", config.URL)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package httpcallback
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+func TestProvider_CreateApproval(t *testing.T) {
+	a := require.New(t)
+	config := app.Config{
+		URL:           "https://approval.example.com/bytebase",
+		SigningSecret: "secret",
+		CallbackURL:   "https://bytebase.example.com/hook/app/im.http",
+	}
+	p := &Provider{
+		client: &http.Client{
+			Transport: &common.MockRoundTripper{
+				MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(r.Body)
+					a.NoError(err)
+					a.Equal(Sign(config.SigningSecret, body), r.Header.Get(SignatureHeader))
+					var req Request
+					a.NoError(json.Unmarshal(body, &req))
+					a.Equal(ActionCreate, req.Action)
+					a.Equal("dba@example.com", req.ApproverEmail)
+					a.Equal(config.CallbackURL, req.CallbackURL)
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"instanceId":"approval-1"}`)),
+					}, nil
+				},
+			},
+		},
+	}
+	approval, err := p.CreateApproval(context.Background(), config, app.Content{Issue: "#1 Add table"}, "dev@example.com", "dba@example.com")
+	a.NoError(err)
+	a.Equal("approval-1", approval.InstanceID)
+}
+
+func TestProvider_ParseCallback(t *testing.T) {
+	tests := []struct {
+		body    string
+		secret  string
+		want    *app.CallbackEvent
+		wantErr bool
+	}{
+		{
+			body:   `{"instanceId":"approval-1","status":"APPROVED"}`,
+			secret: "secret",
+			want:   &app.CallbackEvent{InstanceID: "approval-1", Status: app.ApprovalStatusApproved},
+		},
+		{
+			body:    `{"instanceId":"approval-1","status":"APPROVED"}`,
+			secret:  "wrong secret",
+			wantErr: true,
+		},
+		{
+			body:    `{"instanceId":"approval-1","status":"PENDING"}`,
+			secret:  "secret",
+			wantErr: true,
+		},
+	}
+
+	a := require.New(t)
+	p := &Provider{}
+	for _, test := range tests {
+		header := http.Header{}
+		header.Set(SignatureHeader, Sign(test.secret, []byte(test.body)))
+		event, err := p.ParseCallback(app.Config{SigningSecret: "secret"}, header, []byte(test.body))
+		if test.wantErr {
+			a.Error(err)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(test.want, event)
+	}
+}
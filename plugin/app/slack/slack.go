@@ -0,0 +1,296 @@
+// Package slack implements the Slack approval provider with interactive messages.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+const (
+	// apiURL is the URL of the Slack Web API.
+	apiURL = "https://slack.com/api"
+	// maxRequestAge is the maximum age of the callback requests to prevent replay attacks.
+	maxRequestAge = 5 * time.Minute
+
+	approveActionID = "bb.approval.approve"
+	rejectActionID  = "bb.approval.reject"
+)
+
+var (
+	_ app.Provider         = (*Provider)(nil)
+	_ app.CallbackReceiver = (*Provider)(nil)
+
+	// now is the clock to verify the callback timestamps, replaced in tests.
+	now = time.Now
+)
+
+func init() {
+	app.Register(app.Slack, newProvider)
+}
+
+// Provider is the provider for Slack approvals.
+// The approval is an interactive message sent to the approver with approve and reject buttons,
+// and the button clicks are sent back to Bytebase as callbacks.
+type Provider struct {
+	apiURL string
+	client *http.Client
+}
+
+func newProvider(config app.ProviderConfig) app.Provider {
+	p := &Provider{
+		apiURL: apiURL,
+		client: config.Client,
+	}
+	if config.APIURL != "" {
+		p.apiURL = config.APIURL
+	}
+	return p
+}
+
+// Setup checks the bot token. The signing secret is required to verify the interactive callbacks.
+// https://api.slack.com/methods/auth.test
+func (p *Provider) Setup(ctx context.Context, config app.Config) (string, error) {
+	if config.AppSecret == "" {
+		return "", errors.New("bot token cannot be empty")
+	}
+	if config.SigningSecret == "" {
+		return "", errors.New("signing secret cannot be empty")
+	}
+	if err := p.do(ctx, config, "auth.test", nil, nil); err != nil {
+		return "", errors.Wrap(err, "failed to verify the bot token")
+	}
+	return "", nil
+}
+
+// CreateApproval sends the interactive approval message to the approver.
+// The instance ID of the approval is the channel and the timestamp of the message joined by a colon.
+// https://api.slack.com/methods/chat.postMessage
+func (p *Provider) CreateApproval(ctx context.Context, config app.Config, content app.Content, requesterEmail, approverEmail string) (*app.Approval, error) {
+	approverID, err := p.lookupUserByEmail(ctx, config, approverEmail)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user id for approver, email: %s", approverEmail)
+	}
+	// the requester is optional, it's only mentioned in the message.
+	requesterID, _ := p.lookupUserByEmail(ctx, config, requesterEmail)
+	requester := requesterEmail
+	if requesterID != "" {
+		requester = fmt.Sprintf("<@%s>", requesterID)
+	}
+
+	text := fmt.Sprintf("%s requests your approval for stage %q of issue %s.", requester, content.Stage, content.Issue)
+	body := map[string]interface{}{
+		// Posting to a user ID sends the message to the direct message channel between the app and the user.
+		"channel": approverID,
+		"text":    text,
+		"blocks": []interface{}{
+			sectionBlock(fmt.Sprintf("%s\n<%s|View the issue in Bytebase>", text, content.Link)),
+			sectionBlock(fmt.Sprintf("```%s```", content.Text())),
+			map[string]interface{}{
+				"type": "actions",
+				"elements": []interface{}{
+					buttonElement(approveActionID, "Approve", "primary"),
+					buttonElement(rejectActionID, "Reject", "danger"),
+				},
+			},
+		},
+	}
+	var resp struct {
+		Channel string `json:"channel"`
+		TS      string `json:"ts"`
+	}
+	if err := p.do(ctx, config, "chat.postMessage", body, &resp); err != nil {
+		return nil, err
+	}
+	return &app.Approval{
+		InstanceID:  fmt.Sprintf("%s:%s", resp.Channel, resp.TS),
+		RequesterID: requesterID,
+	}, nil
+}
+
+// GetApprovalStatus returns pending since the approval status is received by the interactive callbacks.
+func (*Provider) GetApprovalStatus(_ context.Context, _ app.Config, _ *app.Approval) (app.ApprovalStatus, error) {
+	return app.ApprovalStatusPending, nil
+}
+
+// CancelApproval replaces the interactive message with the cancel reason.
+// https://api.slack.com/methods/chat.update
+func (p *Provider) CancelApproval(ctx context.Context, config app.Config, approval *app.Approval, reason string) error {
+	channel, ts, ok := strings.Cut(approval.InstanceID, ":")
+	if !ok {
+		return errors.Errorf("invalid Slack approval instance ID %q", approval.InstanceID)
+	}
+	body := map[string]interface{}{
+		"channel": channel,
+		"ts":      ts,
+		"text":    reason,
+		"blocks":  []interface{}{sectionBlock(reason)},
+	}
+	return p.do(ctx, config, "chat.update", body, nil)
+}
+
+// ParseCallback verifies the signature of the interactive callback and parses the button click.
+// https://api.slack.com/authentication/verifying-requests-from-slack
+// https://api.slack.com/reference/interaction-payloads/block-actions
+func (*Provider) ParseCallback(config app.Config, header http.Header, body []byte) (*app.CallbackEvent, error) {
+	if err := verifySignature(config.SigningSecret, header, body); err != nil {
+		return nil, err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the callback form")
+	}
+	var payload struct {
+		Type      string `json:"type"`
+		Container struct {
+			ChannelID string `json:"channel_id"`
+			MessageTS string `json:"message_ts"`
+		} `json:"container"`
+		Actions []struct {
+			ActionID string `json:"action_id"`
+		} `json:"actions"`
+	}
+	if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the callback payload")
+	}
+	if payload.Type != "block_actions" {
+		return nil, nil
+	}
+	for _, action := range payload.Actions {
+		var status app.ApprovalStatus
+		switch action.ActionID {
+		case approveActionID:
+			status = app.ApprovalStatusApproved
+		case rejectActionID:
+			status = app.ApprovalStatusRejected
+		default:
+			continue
+		}
+		return &app.CallbackEvent{
+			InstanceID: fmt.Sprintf("%s:%s", payload.Container.ChannelID, payload.Container.MessageTS),
+			Status:     status,
+		}, nil
+	}
+	return nil, nil
+}
+
+func verifySignature(signingSecret string, header http.Header, body []byte) error {
+	if signingSecret == "" {
+		return errors.New("signing secret is not configured")
+	}
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid request timestamp %q", timestamp)
+	}
+	if age := now().Sub(time.Unix(ts, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return errors.Errorf("request timestamp %q is too old", timestamp)
+	}
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	if _, err := fmt.Fprintf(mac, "v0:%s:%s", timestamp, body); err != nil {
+		return err
+	}
+	want := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(want), []byte(header.Get("X-Slack-Signature"))) {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+func sectionBlock(text string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "section",
+		"text": map[string]string{
+			"type": "mrkdwn",
+			"text": text,
+		},
+	}
+}
+
+func buttonElement(actionID, text, style string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":      "button",
+		"action_id": actionID,
+		"style":     style,
+		"text": map[string]string{
+			"type": "plain_text",
+			"text": text,
+		},
+	}
+}
+
+// lookupUserByEmail returns the Slack user ID by email.
+// https://api.slack.com/methods/users.lookupByEmail
+func (p *Provider) lookupUserByEmail(ctx context.Context, config app.Config, email string) (string, error) {
+	var resp struct {
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+	if err := p.do(ctx, config, "users.lookupByEmail?email="+url.QueryEscape(email), nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.User.ID, nil
+}
+
+// do calls the Slack Web API method with the bot token, and decodes the response into v if v is not nil.
+// The request is a GET if body is nil, otherwise a JSON POST.
+func (p *Provider) do(ctx context.Context, config app.Config, method string, body interface{}, v interface{}) error {
+	u := fmt.Sprintf("%s/%s", p.apiURL, method)
+	httpMethod := http.MethodGet
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal %s request", method)
+		}
+		httpMethod = http.MethodPost
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u, reader)
+	if err != nil {
+		return errors.Wrapf(err, "construct %s %s", httpMethod, u)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", config.AppSecret))
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", httpMethod, u)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "read body of %s %s", httpMethod, u)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("non-200 %s status code %d with body %q", httpMethod, resp.StatusCode, b)
+	}
+	var errResp struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(b, &errResp); err != nil {
+		return errors.Wrapf(err, "unmarshal body from %s %s", httpMethod, u)
+	}
+	if !errResp.OK {
+		return errors.Errorf("Slack %s failed: %s", method, errResp.Error)
+	}
+	if v == nil {
+		return nil
+	}
+	return json.Unmarshal(b, v)
+}
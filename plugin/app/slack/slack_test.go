@@ -0,0 +1,50 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+func TestProvider_ParseCallback(t *testing.T) {
+	a := require.New(t)
+	ts := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return ts }
+	defer func() { now = time.Now }()
+
+	config := app.Config{SigningSecret: "8f742231b10e8888abcd99yyyzzz85a5"}
+	payload := `{"type":"block_actions","container":{"channel_id":"D0123","message_ts":"1672531200.000100"},"actions":[{"action_id":"bb.approval.reject"}]}`
+	body := []byte("payload=" + url.QueryEscape(payload))
+	sign := func(timestamp, secret string) http.Header {
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = fmt.Fprintf(mac, "v0:%s:%s", timestamp, body)
+		header := http.Header{}
+		header.Set("X-Slack-Request-Timestamp", timestamp)
+		header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+		return header
+	}
+	p := &Provider{}
+
+	event, err := p.ParseCallback(config, sign(strconv.FormatInt(ts.Unix(), 10), config.SigningSecret), body)
+	a.NoError(err)
+	a.Equal(&app.CallbackEvent{
+		InstanceID: "D0123:1672531200.000100",
+		Status:     app.ApprovalStatusRejected,
+	}, event)
+
+	_, err = p.ParseCallback(config, sign(strconv.FormatInt(ts.Unix(), 10), "wrong secret"), body)
+	a.Error(err)
+
+	_, err = p.ParseCallback(config, sign(strconv.FormatInt(ts.Add(-10*time.Minute).Unix(), 10), config.SigningSecret), body)
+	a.Error(err)
+}
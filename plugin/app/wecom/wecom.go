@@ -0,0 +1,306 @@
+// Package wecom implements the WeCom (WeChat Work) approval provider.
+package wecom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+const (
+	// apiURL is the URL of the WeCom API server.
+	apiURL = "https://qyapi.weixin.qq.com/cgi-bin"
+	// emailTypeCorp and emailTypePersonal are the email types to look up users.
+	emailTypeCorp     = 1
+	emailTypePersonal = 2
+)
+
+// The approval status of WeCom approval instances.
+// https://developer.work.weixin.qq.com/document/path/91983
+const (
+	spStatusApproved             = 2
+	spStatusRejected             = 3
+	spStatusRevoked              = 4
+	spStatusRevokedAfterApproved = 6
+	spStatusDeleted              = 7
+)
+
+var _ app.Provider = (*Provider)(nil)
+
+func init() {
+	app.Register(app.WeCom, newProvider)
+}
+
+// Provider is the provider for WeCom approvals.
+type Provider struct {
+	apiURL string
+	client *http.Client
+}
+
+func newProvider(config app.ProviderConfig) app.Provider {
+	p := &Provider{
+		apiURL: apiURL,
+		client: config.Client,
+	}
+	if config.APIURL != "" {
+		p.apiURL = config.APIURL
+	}
+	return p
+}
+
+// Setup checks the application credentials and the approval template. WeCom approval templates are
+// designed in the WeCom admin console, so the approval definition ID is the template ID provided by the user.
+func (p *Provider) Setup(ctx context.Context, config app.Config) (string, error) {
+	if config.AppID == "" || config.AppSecret == "" {
+		return "", errors.New("corp ID and secret cannot be empty")
+	}
+	if config.ApprovalDefinitionID == "" {
+		return "", errors.New("approval template ID cannot be empty")
+	}
+	token, err := p.getAccessToken(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	if _, err := p.getTextControl(ctx, token, config.ApprovalDefinitionID); err != nil {
+		return "", err
+	}
+	return config.ApprovalDefinitionID, nil
+}
+
+// CreateApproval creates an approval instance with the template.
+// https://developer.work.weixin.qq.com/document/path/91853
+func (p *Provider) CreateApproval(ctx context.Context, config app.Config, content app.Content, requesterEmail, approverEmail string) (*app.Approval, error) {
+	token, err := p.getAccessToken(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	approverID, err := p.getUserIDByEmail(ctx, token, approverEmail)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user id for approver, email: %s", approverEmail)
+	}
+	// the approver creates the approval on behalf of the requester if the requester is not found.
+	requesterID, err := p.getUserIDByEmail(ctx, token, requesterEmail)
+	if err != nil {
+		requesterID = approverID
+	}
+	control, err := p.getTextControl(ctx, token, config.ApprovalDefinitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	type text struct {
+		Text string `json:"text"`
+		Lang string `json:"lang,omitempty"`
+	}
+	type contentValue struct {
+		Control string `json:"control"`
+		ID      string `json:"id"`
+		Value   struct {
+			Text string `json:"text"`
+		} `json:"value"`
+	}
+	value := contentValue{Control: control.Control, ID: control.ID}
+	value.Value.Text = content.Text()
+	type summary struct {
+		SummaryInfo []text `json:"summary_info"`
+	}
+	type approver struct {
+		Attr   int      `json:"attr"`
+		UserID []string `json:"userid"`
+	}
+	req := struct {
+		CreatorUserID       string     `json:"creator_userid"`
+		TemplateID          string     `json:"template_id"`
+		UseTemplateApprover int        `json:"use_template_approver"`
+		Approver            []approver `json:"approver"`
+		ApplyData           struct {
+			Contents []contentValue `json:"contents"`
+		} `json:"apply_data"`
+		SummaryList []summary `json:"summary_list"`
+	}{
+		CreatorUserID:       requesterID,
+		TemplateID:          config.ApprovalDefinitionID,
+		UseTemplateApprover: 0,
+		// attr 2 means any of the approvers can approve.
+		Approver: []approver{{Attr: 2, UserID: []string{approverID}}},
+		SummaryList: []summary{
+			{SummaryInfo: []text{{Text: content.Issue, Lang: "zh_CN"}}},
+			{SummaryInfo: []text{{Text: content.Stage, Lang: "zh_CN"}}},
+		},
+	}
+	req.ApplyData.Contents = []contentValue{value}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal apply event request")
+	}
+	var resp struct {
+		SpNo string `json:"sp_no"`
+	}
+	if err := p.do(ctx, fmt.Sprintf("%s/oa/applyevent", p.apiURL), token, body, &resp); err != nil {
+		return nil, err
+	}
+	return &app.Approval{
+		InstanceID:  resp.SpNo,
+		RequesterID: requesterID,
+	}, nil
+}
+
+// GetApprovalStatus gets the status of the approval instance.
+// https://developer.work.weixin.qq.com/document/path/91983
+func (p *Provider) GetApprovalStatus(ctx context.Context, config app.Config, approval *app.Approval) (app.ApprovalStatus, error) {
+	token, err := p.getAccessToken(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(map[string]string{"sp_no": approval.InstanceID})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal approval detail request")
+	}
+	var resp struct {
+		Info struct {
+			SpStatus int `json:"sp_status"`
+		} `json:"info"`
+	}
+	if err := p.do(ctx, fmt.Sprintf("%s/oa/getapprovaldetail", p.apiURL), token, body, &resp); err != nil {
+		return "", err
+	}
+	switch resp.Info.SpStatus {
+	case spStatusApproved:
+		return app.ApprovalStatusApproved, nil
+	case spStatusRejected:
+		return app.ApprovalStatusRejected, nil
+	case spStatusRevoked, spStatusRevokedAfterApproved, spStatusDeleted:
+		return app.ApprovalStatusCanceled, nil
+	default:
+		return app.ApprovalStatusPending, nil
+	}
+}
+
+// CancelApproval is a no-op because WeCom doesn't allow applications to revoke approval instances.
+// The approval instance is archived by Bytebase and its result is ignored.
+func (*Provider) CancelApproval(_ context.Context, _ app.Config, _ *app.Approval, _ string) error {
+	return nil
+}
+
+// getAccessToken gets the access token of the application.
+// https://developer.work.weixin.qq.com/document/path/91039
+func (p *Provider) getAccessToken(ctx context.Context, config app.Config) (string, error) {
+	u := fmt.Sprintf("%s/gettoken?corpid=%s&corpsecret=%s", p.apiURL, url.QueryEscape(config.AppID), url.QueryEscape(config.AppSecret))
+	var resp struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := p.call(ctx, http.MethodGet, u, nil, &resp); err != nil {
+		return "", errors.Wrap(err, "failed to get access token")
+	}
+	return resp.AccessToken, nil
+}
+
+// getUserIDByEmail gets the user ID by the corp email, falling back to the personal email.
+// https://developer.work.weixin.qq.com/document/path/95895
+func (p *Provider) getUserIDByEmail(ctx context.Context, token, email string) (string, error) {
+	var lastErr error
+	for _, emailType := range []int{emailTypeCorp, emailTypePersonal} {
+		body, err := json.Marshal(struct {
+			Email     string `json:"email"`
+			EmailType int    `json:"email_type"`
+		}{
+			Email:     email,
+			EmailType: emailType,
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal user id request")
+		}
+		var resp struct {
+			UserID string `json:"userid"`
+		}
+		if err := p.do(ctx, fmt.Sprintf("%s/user/get_userid_by_email", p.apiURL), token, body, &resp); err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.UserID != "" {
+			return resp.UserID, nil
+		}
+	}
+	if lastErr != nil {
+		return "", lastErr
+	}
+	return "", errors.Errorf("user with email %s not found", email)
+}
+
+type templateControl struct {
+	Control string
+	ID      string
+}
+
+// getTextControl returns the first text or textarea control of the approval template to fill the approval content in.
+// https://developer.work.weixin.qq.com/document/path/91982
+func (p *Provider) getTextControl(ctx context.Context, token, templateID string) (*templateControl, error) {
+	body, err := json.Marshal(map[string]string{"template_id": templateID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal template detail request")
+	}
+	var resp struct {
+		TemplateContent struct {
+			Controls []struct {
+				Property struct {
+					Control string `json:"control"`
+					ID      string `json:"id"`
+				} `json:"property"`
+			} `json:"controls"`
+		} `json:"template_content"`
+	}
+	if err := p.do(ctx, fmt.Sprintf("%s/oa/gettemplatedetail", p.apiURL), token, body, &resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to get approval template %s", templateID)
+	}
+	for _, control := range resp.TemplateContent.Controls {
+		if control.Property.Control == "Textarea" || control.Property.Control == "Text" {
+			return &templateControl{Control: control.Property.Control, ID: control.Property.ID}, nil
+		}
+	}
+	return nil, errors.Errorf("approval template %s has no text control", templateID)
+}
+
+func (p *Provider) do(ctx context.Context, u, token string, body []byte, v interface{}) error {
+	return p.call(ctx, http.MethodPost, fmt.Sprintf("%s?access_token=%s", u, url.QueryEscape(token)), body, v)
+}
+
+// call calls the WeCom API, which reports errors by the errcode in the response body.
+// The URL is left out of the errors since it carries the secret or the access token.
+func (p *Provider) call(ctx context.Context, method, u string, body []byte, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "construct %s", method)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s", method)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "read body of %s", method)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("non-200 %s status code %d with body %q", method, resp.StatusCode, b)
+	}
+	var errResp struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := json.Unmarshal(b, &errResp); err != nil {
+		return errors.Wrapf(err, "unmarshal body from %s", method)
+	}
+	if errResp.ErrCode != 0 {
+		return errors.Errorf("%s failed, code %d, msg %s", method, errResp.ErrCode, errResp.ErrMsg)
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,71 @@
+package wecom
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/app"
+)
+
+func TestProvider_GetApprovalStatus(t *testing.T) {
+	tests := []struct {
+		spStatus string
+		want     app.ApprovalStatus
+	}{
+		{spStatus: "1", want: app.ApprovalStatusPending},
+		{spStatus: "2", want: app.ApprovalStatusApproved},
+		{spStatus: "3", want: app.ApprovalStatusRejected},
+		{spStatus: "4", want: app.ApprovalStatusCanceled},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		p := &Provider{
+			apiURL: apiURL,
+			client: &http.Client{
+				Transport: &common.MockRoundTripper{
+					MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+						body := `{"errcode":0,"errmsg":"ok","access_token":"token"}`
+						if strings.HasSuffix(r.URL.Path, "/oa/getapprovaldetail") {
+							body = `{"errcode":0,"errmsg":"ok","info":{"sp_no":"202301010001","sp_status":` + test.spStatus + `}}`
+						}
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       io.NopCloser(strings.NewReader(body)),
+						}, nil
+					},
+				},
+			},
+		}
+		status, err := p.GetApprovalStatus(context.Background(), app.Config{AppID: "corp", AppSecret: "secret"}, &app.Approval{InstanceID: "202301010001"})
+		a.NoError(err)
+		a.Equal(test.want, status)
+	}
+}
+
+func TestProvider_GetApprovalStatusError(t *testing.T) {
+	a := require.New(t)
+	p := &Provider{
+		apiURL: apiURL,
+		client: &http.Client{
+			Transport: &common.MockRoundTripper{
+				MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"errcode":40013,"errmsg":"invalid corpid"}`)),
+					}, nil
+				},
+			},
+		},
+	}
+	_, err := p.GetApprovalStatus(context.Background(), app.Config{AppID: "corp", AppSecret: "secret"}, &app.Approval{InstanceID: "202301010001"})
+	a.Error(err)
+	// the error must not leak the secret in the URL.
+	a.NotContains(err.Error(), "secret")
+}
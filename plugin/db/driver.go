@@ -76,6 +76,9 @@ type DriverConfig struct {
 	// NOTE, introducing db specific fields is the last resort.
 	// MySQL specific
 	BinlogDir string
+	// PostgreSQL specific
+	// WALArchiveDir is the directory for the archived WAL files and base backups used by PITR.
+	WALArchiveDir string
 }
 
 type driverFunc func(DriverConfig) Driver
@@ -367,10 +370,9 @@ type ConnectionContext struct {
 // QueryContext is the context to query.
 type QueryContext struct {
 	// Limit is the maximum row count returned. No limit enforced if limit <= 0
-	Limit                 int
-	ReadOnly              bool
-	SensitiveDataMaskType SensitiveDataMaskType
-	SensitiveSchemaInfo   *SensitiveSchemaInfo
+	Limit               int
+	ReadOnly            bool
+	SensitiveSchemaInfo *SensitiveSchemaInfo
 
 	// CurrentDatabase is for MySQL
 	CurrentDatabase string
@@ -543,8 +545,38 @@ const (
 	// SensitiveDataMaskTypeDefault is the sensitive data type to hide data with a default method.
 	// The default method is subject to change.
 	SensitiveDataMaskTypeDefault SensitiveDataMaskType = "DEFAULT"
+	// SensitiveDataMaskTypePartial is the sensitive data type to keep the leading and trailing characters only.
+	SensitiveDataMaskTypePartial SensitiveDataMaskType = "PARTIAL"
+	// SensitiveDataMaskTypeEmail is the sensitive data type to keep the first character of the local part and the domain of an email.
+	SensitiveDataMaskTypeEmail SensitiveDataMaskType = "EMAIL"
+	// SensitiveDataMaskTypePhone is the sensitive data type to keep the last four digits of a phone number.
+	SensitiveDataMaskTypePhone SensitiveDataMaskType = "PHONE"
+	// SensitiveDataMaskTypeHash is the sensitive data type to replace data with its HMAC-SHA256 digest keyed by a workspace secret.
+	// The same value always has the same digest, so the masked values can still be compared.
+	SensitiveDataMaskTypeHash SensitiveDataMaskType = "HASH"
+	// SensitiveDataMaskTypeRange is the sensitive data type to replace a number with the range it falls into.
+	SensitiveDataMaskTypeRange SensitiveDataMaskType = "RANGE"
 )
 
+// SensitiveDataMaskOption is the option for the mask types requiring parameters.
+type SensitiveDataMaskOption struct {
+	// PrefixLength is the number of leading characters kept by the PARTIAL mask type.
+	PrefixLength int
+	// SuffixLength is the number of trailing characters kept by the PARTIAL mask type.
+	SuffixLength int
+	// RangeWidth is the width of the range used by the RANGE mask type.
+	RangeWidth float64
+}
+
+// SensitiveDataMask is the mask for the sensitive data.
+// The zero value means the DEFAULT mask type.
+type SensitiveDataMask struct {
+	Type   SensitiveDataMaskType
+	Option SensitiveDataMaskOption
+	// HashKey is the key of the HMAC-SHA256 used by the HASH mask type, which must be kept secret.
+	HashKey string
+}
+
 // SensitiveSchemaInfo is the schema info using to extract sensitive fields.
 type SensitiveSchemaInfo struct {
 	DatabaseList []DatabaseSchema
@@ -554,6 +586,14 @@ type SensitiveSchemaInfo struct {
 type DatabaseSchema struct {
 	Name      string
 	TableList []TableSchema
+	// SchemaList is for the engines having the schema level, such as PostgreSQL.
+	SchemaList []SchemaSchema
+}
+
+// SchemaSchema is the schema using to extract sensitive fields.
+type SchemaSchema struct {
+	Name      string
+	TableList []TableSchema
 }
 
 // TableSchema is the table schema using to extract sensitive fields.
@@ -566,10 +606,14 @@ type TableSchema struct {
 type ColumnInfo struct {
 	Name      string
 	Sensitive bool
+	// Mask is the mask for the sensitive column.
+	Mask SensitiveDataMask
 }
 
 // SensitiveField is the struct about SELECT fields.
 type SensitiveField struct {
 	Name      string
 	Sensitive bool
+	// Mask is the mask for the sensitive field.
+	Mask SensitiveDataMask
 }
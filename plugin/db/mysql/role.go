@@ -2,39 +2,366 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
-	"github.com/pkg/errors"
-
+	"github.com/bytebase/bytebase/common"
 	"github.com/bytebase/bytebase/plugin/db"
 	"github.com/bytebase/bytebase/plugin/db/util"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+// Privilege is the global privilege that backs a role attribute.
+type Privilege string
+
+const (
+	// SuperPrivilege is the privilege for SuperUser attribute.
+	SuperPrivilege Privilege = "SUPER"
+	// CreateUserPrivilege is the privilege for CreateRole attribute.
+	CreateUserPrivilege Privilege = "CREATE USER"
+	// CreatePrivilege is the privilege for CreateDb attribute.
+	CreatePrivilege Privilege = "CREATE"
+	// ReplicationSlavePrivilege is the privilege for Replication attribute.
+	ReplicationSlavePrivilege Privilege = "REPLICATION SLAVE"
+
+	// defaultRoleHost is the host used if the role name doesn't specify one.
+	defaultRoleHost = "%"
+)
+
+// ToString returns the string value for privilege.
+func (p Privilege) ToString() string {
+	return string(p)
+}
+
+type roleFind struct {
+	User *string
+	Host *string
+}
+
 // CreateRole creates the role.
-func (*Driver) CreateRole(_ context.Context, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("create role for MySQL is not implemented yet")
+// The role name is in the "user@host" format, and the host defaults to "%" if omitted.
+// The role that cannot login and has no password is created by CREATE ROLE, which is a locked account in MySQL 8.0 and TiDB.
+func (driver *Driver) CreateRole(ctx context.Context, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	user, host := parseRoleName(upsert.Name)
+	// The password of the new user is changed now, which is where the password lifetime starts.
+	optionStatement := convertToOptionStatement(upsert, time.Now())
+	var statementList []string
+	if upsert.Password == nil && upsert.Attribute != nil && !upsert.Attribute.CanLogin {
+		// CREATE ROLE doesn't take the options of CREATE USER.
+		statementList = append(statementList, fmt.Sprintf("CREATE ROLE %s;", formatUserHost(user, host)))
+		if optionStatement != "" {
+			statementList = append(statementList, fmt.Sprintf("ALTER USER %s%s;", formatUserHost(user, host), optionStatement))
+		}
+	} else {
+		statementList = append(statementList, fmt.Sprintf("CREATE USER %s%s;", formatUserHost(user, host), optionStatement))
+	}
+	for _, statement := range statementList {
+		if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+			return nil, util.FormatErrorWithQuery(err, statement)
+		}
+	}
+
+	if upsert.Attribute != nil {
+		if err := driver.alterPrivileges(ctx, user, host, &db.DatabaseRoleAttributeMessage{}, upsert.Attribute); err != nil {
+			return nil, err
+		}
+	}
+
+	return driver.FindRole(ctx, upsert.Name)
 }
 
 // UpdateRole updates the role.
-func (*Driver) UpdateRole(_ context.Context, _ string, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("update role for MySQL is not implemented yet")
+func (driver *Driver) UpdateRole(ctx context.Context, roleName string, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	role, err := driver.FindRole(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	user, host := parseRoleName(roleName)
+	newUser, newHost := parseRoleName(upsert.Name)
+	if user != newUser || host != newHost {
+		renameStatement := fmt.Sprintf("RENAME USER %s TO %s;", formatUserHost(user, host), formatUserHost(newUser, newHost))
+		if _, err := driver.db.ExecContext(ctx, renameStatement); err != nil {
+			return nil, util.FormatErrorWithQuery(err, renameStatement)
+		}
+	}
+
+	// MySQL counts the password lifetime from the last password change, which restarts if the password is changed by this statement.
+	// In that case we keep the existing expiration by converting it to the lifetime from now.
+	passwordLastChanged := time.Now()
+	if upsert.Password == nil {
+		if passwordLastChanged, err = driver.getPasswordLastChanged(ctx, newUser, newHost); err != nil {
+			return nil, err
+		}
+	} else if upsert.ValidUntil == nil && role.ValidUntil != nil {
+		copied := *upsert
+		copied.ValidUntil = role.ValidUntil
+		upsert = &copied
+	}
+	if optionStatement := convertToOptionStatement(upsert, passwordLastChanged); optionStatement != "" {
+		statement := fmt.Sprintf("ALTER USER %s%s;", formatUserHost(newUser, newHost), optionStatement)
+		if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+			return nil, util.FormatErrorWithQuery(err, statement)
+		}
+	}
+
+	if upsert.Attribute != nil {
+		if err := driver.alterPrivileges(ctx, newUser, newHost, role.Attribute, upsert.Attribute); err != nil {
+			return nil, err
+		}
+	}
+
+	return driver.FindRole(ctx, upsert.Name)
+}
+
+// getPasswordLastChanged returns the time when the password of the user was changed last time.
+func (driver *Driver) getPasswordLastChanged(ctx context.Context, user, host string) (time.Time, error) {
+	// TiDB doesn't track the password lifetime, see findRoleImpl.
+	if driver.dbType == db.TiDB {
+		return time.Now(), nil
+	}
+	// UNIX_TIMESTAMP() converts the TIMESTAMP in the session time zone, so it doesn't depend on the server time zone.
+	query := "SELECT UNIX_TIMESTAMP(password_last_changed) FROM mysql.user WHERE user = ? AND host = ?"
+	var lastChanged sql.NullInt64
+	if err := driver.db.QueryRowContext(ctx, query, user, host).Scan(&lastChanged); err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", formatUserHost(user, host)))
+		}
+		return time.Time{}, util.FormatErrorWithQuery(err, query)
+	}
+	if !lastChanged.Valid {
+		return time.Now(), nil
+	}
+	return time.Unix(lastChanged.Int64, 0), nil
 }
 
 // FindRole finds the role by name.
-func (*Driver) FindRole(_ context.Context, _ string) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("find role for MySQL is not implemented yet")
+func (driver *Driver) FindRole(ctx context.Context, roleName string) (*db.DatabaseRoleMessage, error) {
+	user, host := parseRoleName(roleName)
+	roles, err := driver.findRoleImpl(ctx, &roleFind{
+		User: &user,
+		Host: &host,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", roleName))
+	}
+	return roles[0], nil
 }
 
 // ListRole lists the role.
-func (*Driver) ListRole(_ context.Context) ([]*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("list role for MySQL is not implemented yet")
+// The grants of the roles aren't part of the role message. They are synced from SHOW GRANTS with the instance
+// metadata, and listed by the instance user API.
+func (driver *Driver) ListRole(ctx context.Context) ([]*db.DatabaseRoleMessage, error) {
+	return driver.findRoleImpl(ctx, &roleFind{})
 }
 
 // DeleteRole deletes the role by name.
-func (*Driver) DeleteRole(_ context.Context, _ string) error {
-	return errors.Errorf("delete role for MySQL is not implemented yet")
+func (driver *Driver) DeleteRole(ctx context.Context, roleName string) error {
+	user, host := parseRoleName(roleName)
+	statement := fmt.Sprintf("DROP USER IF EXISTS %s;", formatUserHost(user, host))
+	if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+		return util.FormatErrorWithQuery(err, statement)
+	}
+	return nil
+}
+
+func (driver *Driver) findRoleImpl(ctx context.Context, find *roleFind) ([]*db.DatabaseRoleMessage, error) {
+	where := []string{"user NOT LIKE 'mysql.%'"}
+	var args []interface{}
+	if v := find.User; v != nil {
+		where, args = append(where, "user = ?"), append(args, *v)
+	}
+	if v := find.Host; v != nil {
+		where, args = append(where, "host = ?"), append(args, *v)
+	}
+
+	// TiDB doesn't track the password lifetime and the connection limit in mysql.user for versions we support.
+	passwordColumns := "UNIX_TIMESTAMP(password_last_changed), password_lifetime, max_user_connections"
+	if driver.dbType == db.TiDB {
+		passwordColumns = "NULL, NULL, 0"
+	}
+	statement := fmt.Sprintf(`
+		SELECT
+			user,
+			host,
+			super_priv,
+			create_user_priv,
+			create_priv,
+			repl_slave_priv,
+			account_locked,
+			%s
+		FROM mysql.user
+		WHERE %s
+		ORDER BY user, host
+	`, passwordColumns, strings.Join(where, " AND "))
+
+	rows, err := driver.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, statement)
+	}
+	defer rows.Close()
+
+	var roleList []*db.DatabaseRoleMessage
+	for rows.Next() {
+		var user, host, superPriv, createUserPriv, createPriv, replSlavePriv, accountLocked string
+		var passwordLastChanged sql.NullInt64
+		var passwordLifetime sql.NullInt32
+		var maxUserConnections int32
+		if err := rows.Scan(
+			&user,
+			&host,
+			&superPriv,
+			&createUserPriv,
+			&createPriv,
+			&replSlavePriv,
+			&accountLocked,
+			&passwordLastChanged,
+			&passwordLifetime,
+			&maxUserConnections,
+		); err != nil {
+			return nil, util.FormatErrorWithQuery(err, statement)
+		}
+
+		role := &db.DatabaseRoleMessage{
+			Name: fmt.Sprintf("%s@%s", user, host),
+			// MySQL uses 0 for unlimited connections, while the role API uses -1.
+			ConnectionLimit: -1,
+			Attribute: &db.DatabaseRoleAttributeMessage{
+				SuperUser:   superPriv == "Y",
+				CreateRole:  createUserPriv == "Y",
+				CreateDb:    createPriv == "Y",
+				CanLogin:    accountLocked != "Y",
+				Replication: replSlavePriv == "Y",
+			},
+		}
+		if maxUserConnections > 0 {
+			role.ConnectionLimit = maxUserConnections
+		}
+		if passwordLastChanged.Valid && passwordLifetime.Valid && passwordLifetime.Int32 > 0 {
+			validUntil := time.Unix(passwordLastChanged.Int64, 0).UTC().AddDate(0, 0, int(passwordLifetime.Int32)).Format(time.RFC3339)
+			role.ValidUntil = &validUntil
+		}
+		roleList = append(roleList, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, statement)
+	}
+	return roleList, nil
+}
+
+// alterPrivileges grants or revokes the global privileges backing the role attributes that changed.
+func (driver *Driver) alterPrivileges(ctx context.Context, user, host string, oldAttribute, newAttribute *db.DatabaseRoleAttributeMessage) error {
+	for _, statement := range convertToPrivilegeStatements(formatUserHost(user, host), oldAttribute, newAttribute) {
+		if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+			return util.FormatErrorWithQuery(err, statement)
+		}
+	}
+	return nil
+}
+
+func convertToPrivilegeStatements(userHost string, oldAttribute, newAttribute *db.DatabaseRoleAttributeMessage) []string {
+	var grantList, revokeList []string
+	for _, item := range []struct {
+		privilege Privilege
+		old       bool
+		new       bool
+	}{
+		{privilege: SuperPrivilege, old: oldAttribute.SuperUser, new: newAttribute.SuperUser},
+		{privilege: CreateUserPrivilege, old: oldAttribute.CreateRole, new: newAttribute.CreateRole},
+		{privilege: CreatePrivilege, old: oldAttribute.CreateDb, new: newAttribute.CreateDb},
+		{privilege: ReplicationSlavePrivilege, old: oldAttribute.Replication, new: newAttribute.Replication},
+	} {
+		switch {
+		case item.new && !item.old:
+			grantList = append(grantList, item.privilege.ToString())
+		case !item.new && item.old:
+			revokeList = append(revokeList, item.privilege.ToString())
+		}
+	}
+
+	var statements []string
+	if len(grantList) > 0 {
+		statements = append(statements, fmt.Sprintf("GRANT %s ON *.* TO %s;", strings.Join(grantList, ", "), userHost))
+	}
+	if len(revokeList) > 0 {
+		statements = append(statements, fmt.Sprintf("REVOKE %s ON *.* FROM %s;", strings.Join(revokeList, ", "), userHost))
+	}
+	return statements
+}
+
+// convertToOptionStatement converts the upsert to the options shared by CREATE USER and ALTER USER.
+// NoInherit and BypassRls have no MySQL counterpart and are ignored.
+// passwordLastChanged is the time of the last password change after the statement, from which MySQL counts the
+// password lifetime of PASSWORD EXPIRE INTERVAL.
+func convertToOptionStatement(r *db.DatabaseRoleUpsertMessage, passwordLastChanged time.Time) string {
+	var optionList []string
+
+	if v := r.Password; v != nil {
+		optionList = append(optionList, fmt.Sprintf("IDENTIFIED BY '%s'", escapeString(*v)))
+	}
+	if v := r.ConnectionLimit; v != nil {
+		limit := *v
+		if limit < 0 {
+			limit = 0
+		}
+		optionList = append(optionList, fmt.Sprintf("WITH MAX_USER_CONNECTIONS %d", limit))
+	}
+	if v := r.ValidUntil; v != nil {
+		// MySQL only supports password lifetime in days, so we round up to the next whole day.
+		// The validation of the timestamp format is done by the API layer.
+		if validUntil, err := time.Parse(time.RFC3339, *v); err == nil {
+			days := int(math.Ceil(validUntil.Sub(passwordLastChanged).Hours() / 24))
+			if days > 0 && validUntil.After(time.Now()) {
+				optionList = append(optionList, fmt.Sprintf("PASSWORD EXPIRE INTERVAL %d DAY", days))
+			} else {
+				optionList = append(optionList, "PASSWORD EXPIRE")
+			}
+		}
+	}
+	if r.Attribute != nil {
+		if r.Attribute.CanLogin {
+			optionList = append(optionList, "ACCOUNT UNLOCK")
+		} else {
+			optionList = append(optionList, "ACCOUNT LOCK")
+		}
+	}
+
+	if len(optionList) == 0 {
+		return ""
+	}
+	return " " + strings.Join(optionList, " ")
+}
+
+// parseRoleName parses the role name in the "user@host" format to user and host.
+// Quoted names such as 'user'@'host' are accepted as well.
+func parseRoleName(name string) (string, string) {
+	user, host := name, defaultRoleHost
+	if i := strings.LastIndex(name, "@"); i >= 0 {
+		user, host = name[:i], name[i+1:]
+	}
+	user = strings.Trim(user, "'`\"")
+	host = strings.Trim(host, "'`\"")
+	if host == "" {
+		host = defaultRoleHost
+	}
+	return user, host
+}
+
+func formatUserHost(user, host string) string {
+	// Uses single quote instead of backtick, see the comment in getInstanceRoles.
+	return fmt.Sprintf("'%s'@'%s'", escapeString(user), escapeString(host))
+}
+
+// escapeString escapes the string in single quotes. MySQL treats the backslash as the escape character
+// unless NO_BACKSLASH_ESCAPES is enabled, so it must be escaped as well as the single quote.
+func escapeString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "'", "''").Replace(s)
 }
 
 func (driver *Driver) getInstanceRoles(ctx context.Context) ([]*storepb.InstanceRoleMetadata, error) {
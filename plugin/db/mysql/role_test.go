@@ -0,0 +1,155 @@
+package mysql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/plugin/db"
+)
+
+func TestParseRoleName(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		name string
+		user string
+		host string
+	}{
+		{
+			name: "bytebase",
+			user: "bytebase",
+			host: "%",
+		},
+		{
+			name: "bytebase@localhost",
+			user: "bytebase",
+			host: "localhost",
+		},
+		{
+			name: "'bytebase'@'10.0.0.%'",
+			user: "bytebase",
+			host: "10.0.0.%",
+		},
+		{
+			name: "user@example.com@%",
+			user: "user@example.com",
+			host: "%",
+		},
+	}
+
+	for _, test := range tests {
+		user, host := parseRoleName(test.name)
+		a.Equal(test.user, user)
+		a.Equal(test.host, host)
+	}
+}
+
+func TestConvertToOptionStatement(t *testing.T) {
+	a := require.New(t)
+	password := "it's secret"
+	backslashPassword := `secret\'; DROP USER root; --`
+	unlimited := int32(-1)
+	limit := int32(10)
+	// The password lifetime is counted from the last password change rather than now.
+	passwordLastChanged := time.Now().Add(-10 * 24 * time.Hour)
+	validUntil := passwordLastChanged.Add(30 * 24 * time.Hour).Format(time.RFC3339)
+	partialDayValidUntil := passwordLastChanged.Add(30*24*time.Hour + time.Hour).Format(time.RFC3339)
+	expired := passwordLastChanged.Add(24 * time.Hour).Format(time.RFC3339)
+	tests := []struct {
+		upsert *db.DatabaseRoleUpsertMessage
+		want   string
+	}{
+		{
+			upsert: &db.DatabaseRoleUpsertMessage{Name: "bytebase"},
+			want:   "",
+		},
+		{
+			upsert: &db.DatabaseRoleUpsertMessage{
+				Name:            "bytebase",
+				Password:        &password,
+				ConnectionLimit: &limit,
+				Attribute:       &db.DatabaseRoleAttributeMessage{CanLogin: true},
+			},
+			want: " IDENTIFIED BY 'it''s secret' WITH MAX_USER_CONNECTIONS 10 ACCOUNT UNLOCK",
+		},
+		{
+			upsert: &db.DatabaseRoleUpsertMessage{
+				Name:            "bytebase",
+				ConnectionLimit: &unlimited,
+				Attribute:       &db.DatabaseRoleAttributeMessage{},
+			},
+			want: " WITH MAX_USER_CONNECTIONS 0 ACCOUNT LOCK",
+		},
+		{
+			upsert: &db.DatabaseRoleUpsertMessage{
+				Name:     "bytebase",
+				Password: &backslashPassword,
+			},
+			want: ` IDENTIFIED BY 'secret\\''; DROP USER root; --'`,
+		},
+		{
+			upsert: &db.DatabaseRoleUpsertMessage{
+				Name:       "bytebase",
+				ValidUntil: &validUntil,
+			},
+			want: " PASSWORD EXPIRE INTERVAL 30 DAY",
+		},
+		{
+			upsert: &db.DatabaseRoleUpsertMessage{
+				Name:       "bytebase",
+				ValidUntil: &partialDayValidUntil,
+			},
+			want: " PASSWORD EXPIRE INTERVAL 31 DAY",
+		},
+		{
+			upsert: &db.DatabaseRoleUpsertMessage{
+				Name:       "bytebase",
+				ValidUntil: &expired,
+			},
+			want: " PASSWORD EXPIRE",
+		},
+	}
+
+	for _, test := range tests {
+		a.Equal(test.want, convertToOptionStatement(test.upsert, passwordLastChanged))
+	}
+}
+
+func TestFormatUserHost(t *testing.T) {
+	a := require.New(t)
+	a.Equal(`'bytebase'@'%'`, formatUserHost("bytebase", "%"))
+	a.Equal(`'it''s'@'\\''@'`, formatUserHost("it's", `\'@`))
+}
+
+func TestConvertToPrivilegeStatements(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		oldAttribute *db.DatabaseRoleAttributeMessage
+		newAttribute *db.DatabaseRoleAttributeMessage
+		want         []string
+	}{
+		{
+			oldAttribute: &db.DatabaseRoleAttributeMessage{},
+			newAttribute: &db.DatabaseRoleAttributeMessage{},
+			want:         nil,
+		},
+		{
+			oldAttribute: &db.DatabaseRoleAttributeMessage{},
+			newAttribute: &db.DatabaseRoleAttributeMessage{SuperUser: true, CreateDb: true},
+			want:         []string{"GRANT SUPER, CREATE ON *.* TO 'bytebase'@'%';"},
+		},
+		{
+			oldAttribute: &db.DatabaseRoleAttributeMessage{CreateRole: true, Replication: true},
+			newAttribute: &db.DatabaseRoleAttributeMessage{CreateRole: true, CreateDb: true},
+			want: []string{
+				"GRANT CREATE ON *.* TO 'bytebase'@'%';",
+				"REVOKE REPLICATION SLAVE ON *.* FROM 'bytebase'@'%';",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		a.Equal(test.want, convertToPrivilegeStatements("'bytebase'@'%'", test.oldAttribute, test.newAttribute))
+	}
+}
@@ -268,6 +268,28 @@ func (driver *Driver) SyncDBSchema(ctx context.Context, databaseName string) (*s
 		return nil, err
 	}
 
+	// Query partition info.
+	partitionMap, err := driver.getPartitionMap(ctx, databaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	// TiDB doesn't support stored routines and triggers.
+	triggersMap := make(map[db.TableKey][]*storepb.TriggerMetadata)
+	if driver.dbType != db.TiDB {
+		// Query routine info.
+		schemaMetadata.Functions, schemaMetadata.Procedures, err = driver.getRoutineList(ctx, databaseName)
+		if err != nil {
+			return nil, err
+		}
+
+		// Query trigger info.
+		triggersMap, err = driver.getTriggerMap(ctx, databaseName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Query table info.
 	tableQuery := `
 		SELECT
@@ -324,6 +346,12 @@ func (driver *Driver) SyncDBSchema(ctx context.Context, databaseName string) (*s
 				DataFree:      dataFree,
 				CreateOptions: createOptions,
 				Comment:       comment,
+				Triggers:      triggersMap[key],
+			}
+			if partition, ok := partitionMap[key]; ok {
+				tableMetadata.PartitionType = partition.partitionType
+				tableMetadata.PartitionExpression = partition.expression
+				tableMetadata.Partitions = partition.partitions
 			}
 			if tableCollation.Valid {
 				tableMetadata.Collation = tableCollation.String
@@ -450,3 +478,193 @@ func (driver *Driver) getForeignKeyList(ctx context.Context, databaseName string
 
 	return foreignKeysMap, nil
 }
+
+// getRoutineList gets the functions and procedures of a database.
+func (driver *Driver) getRoutineList(ctx context.Context, databaseName string) ([]*storepb.FunctionMetadata, []*storepb.ProcedureMetadata, error) {
+	parameterQuery := `
+		SELECT
+			SPECIFIC_NAME,
+			GROUP_CONCAT(CONCAT_WS(' ', PARAMETER_MODE, PARAMETER_NAME, DTD_IDENTIFIER) ORDER BY ORDINAL_POSITION SEPARATOR ', ')
+		FROM information_schema.PARAMETERS
+		WHERE SPECIFIC_SCHEMA = ? AND ORDINAL_POSITION > 0
+		GROUP BY SPECIFIC_NAME`
+	parameterRows, err := driver.db.QueryContext(ctx, parameterQuery, databaseName)
+	if err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, parameterQuery)
+	}
+	defer parameterRows.Close()
+	argumentMap := make(map[string]string)
+	for parameterRows.Next() {
+		var name, arguments string
+		if err := parameterRows.Scan(&name, &arguments); err != nil {
+			return nil, nil, err
+		}
+		argumentMap[name] = arguments
+	}
+	if err := parameterRows.Err(); err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, parameterQuery)
+	}
+
+	routineQuery := `
+		SELECT
+			ROUTINE_NAME,
+			ROUTINE_TYPE,
+			DTD_IDENTIFIER,
+			ROUTINE_DEFINITION,
+			ROUTINE_COMMENT
+		FROM information_schema.ROUTINES
+		WHERE ROUTINE_SCHEMA = ?
+		ORDER BY ROUTINE_NAME`
+	routineRows, err := driver.db.QueryContext(ctx, routineQuery, databaseName)
+	if err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, routineQuery)
+	}
+	defer routineRows.Close()
+	var functions []*storepb.FunctionMetadata
+	var procedures []*storepb.ProcedureMetadata
+	for routineRows.Next() {
+		var name, routineType, comment string
+		var returnType, definition sql.NullString
+		if err := routineRows.Scan(
+			&name,
+			&routineType,
+			&returnType,
+			&definition,
+			&comment,
+		); err != nil {
+			return nil, nil, err
+		}
+		switch routineType {
+		case "FUNCTION":
+			functions = append(functions, &storepb.FunctionMetadata{
+				Name:       name,
+				Arguments:  argumentMap[name],
+				ReturnType: returnType.String,
+				Definition: definition.String,
+				Comment:    comment,
+			})
+		case "PROCEDURE":
+			procedures = append(procedures, &storepb.ProcedureMetadata{
+				Name:       name,
+				Arguments:  argumentMap[name],
+				Definition: definition.String,
+				Comment:    comment,
+			})
+		}
+	}
+	if err := routineRows.Err(); err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, routineQuery)
+	}
+
+	return functions, procedures, nil
+}
+
+// getTriggerMap gets the triggers of the tables in a database.
+func (driver *Driver) getTriggerMap(ctx context.Context, databaseName string) (map[db.TableKey][]*storepb.TriggerMetadata, error) {
+	triggerQuery := `
+		SELECT
+			EVENT_OBJECT_TABLE,
+			TRIGGER_NAME,
+			ACTION_TIMING,
+			EVENT_MANIPULATION,
+			ACTION_STATEMENT
+		FROM information_schema.TRIGGERS
+		WHERE TRIGGER_SCHEMA = ?
+		ORDER BY EVENT_OBJECT_TABLE, TRIGGER_NAME`
+	triggerRows, err := driver.db.QueryContext(ctx, triggerQuery, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, triggerQuery)
+	}
+	defer triggerRows.Close()
+	triggersMap := make(map[db.TableKey][]*storepb.TriggerMetadata)
+	for triggerRows.Next() {
+		var tableName string
+		trigger := &storepb.TriggerMetadata{}
+		if err := triggerRows.Scan(
+			&tableName,
+			&trigger.Name,
+			&trigger.Timing,
+			&trigger.Event,
+			&trigger.Definition,
+		); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: "", Table: tableName}
+		triggersMap[key] = append(triggersMap[key], trigger)
+	}
+	if err := triggerRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, triggerQuery)
+	}
+
+	return triggersMap, nil
+}
+
+type tablePartition struct {
+	partitionType string
+	expression    string
+	partitions    []*storepb.TablePartitionMetadata
+}
+
+// getPartitionMap gets the partitions of the partitioned tables in a database.
+func (driver *Driver) getPartitionMap(ctx context.Context, databaseName string) (map[db.TableKey]*tablePartition, error) {
+	partitionQuery := `
+		SELECT
+			TABLE_NAME,
+			PARTITION_NAME,
+			IFNULL(SUBPARTITION_NAME, ''),
+			IFNULL(PARTITION_METHOD, ''),
+			IFNULL(PARTITION_EXPRESSION, ''),
+			IFNULL(PARTITION_DESCRIPTION, '')
+		FROM information_schema.PARTITIONS
+		WHERE TABLE_SCHEMA = ? AND PARTITION_NAME IS NOT NULL
+		ORDER BY TABLE_NAME, PARTITION_ORDINAL_POSITION, SUBPARTITION_ORDINAL_POSITION`
+	partitionRows, err := driver.db.QueryContext(ctx, partitionQuery, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, partitionQuery)
+	}
+	defer partitionRows.Close()
+	partitionMap := make(map[db.TableKey]*tablePartition)
+	for partitionRows.Next() {
+		var tableName, partitionName, subpartitionName, method, expression, description string
+		if err := partitionRows.Scan(
+			&tableName,
+			&partitionName,
+			&subpartitionName,
+			&method,
+			&expression,
+			&description,
+		); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: "", Table: tableName}
+		table, ok := partitionMap[key]
+		if !ok {
+			table = &tablePartition{
+				partitionType: method,
+				expression:    expression,
+			}
+			partitionMap[key] = table
+		}
+		// The rows of the subpartitions of a partition are adjacent.
+		var partition *storepb.TablePartitionMetadata
+		if n := len(table.partitions); n > 0 && table.partitions[n-1].Name == partitionName {
+			partition = table.partitions[n-1]
+		} else {
+			partition = &storepb.TablePartitionMetadata{
+				Name:  partitionName,
+				Value: description,
+			}
+			table.partitions = append(table.partitions, partition)
+		}
+		if subpartitionName != "" {
+			partition.Subpartitions = append(partition.Subpartitions, &storepb.TablePartitionMetadata{
+				Name: subpartitionName,
+			})
+		}
+	}
+	if err := partitionRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, partitionQuery)
+	}
+
+	return partitionMap, nil
+}
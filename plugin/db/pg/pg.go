@@ -53,6 +53,7 @@ func init() {
 // Driver is the Postgres driver.
 type Driver struct {
 	dbBinDir      string
+	walArchiveDir string
 	connectionCtx db.ConnectionContext
 	config        db.ConnectionConfig
 
@@ -65,11 +66,16 @@ type Driver struct {
 
 	// strictDatabase should be used only if the user gives only a database instead of a whole instance to access.
 	strictDatabase string
+	// captureTransactionID is true if Execute records the transaction ID, see EnableTransactionIDCapture.
+	captureTransactionID bool
+	// lastTransactionID is the ID of the last transaction committed by Execute.
+	lastTransactionID string
 }
 
 func newDriver(config db.DriverConfig) db.Driver {
 	return &Driver{
-		dbBinDir: config.DbBinDir,
+		dbBinDir:      config.DbBinDir,
+		walArchiveDir: config.WALArchiveDir,
 	}
 }
 
@@ -371,9 +377,20 @@ func (driver *Driver) Execute(ctx context.Context, statement string, createDatab
 	if err != nil {
 		return 0, err
 	}
+	// Record the transaction ID to filter the changes of the transaction for rollback SQL generation.
+	// txid_current() assigns a transaction ID even if the transaction is read-only, so it's only called if required.
+	var txid int64
+	if driver.captureTransactionID {
+		if err := tx.QueryRowContext(ctx, "SELECT txid_current()").Scan(&txid); err != nil {
+			return 0, err
+		}
+	}
 	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
+	if driver.captureTransactionID {
+		driver.lastTransactionID = formatTransactionID(txid)
+	}
 	rowsAffected, err := sqlResult.RowsAffected()
 	if err != nil {
 		// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
@@ -49,3 +49,44 @@ func TestGetDatabaseInCreateDatabaseStatement(t *testing.T) {
 		require.Equal(t, test.want, got)
 	}
 }
+
+func TestGetTriggerTimingAndEvent(t *testing.T) {
+	tests := []struct {
+		triggerType int
+		timing      string
+		event       string
+	}{
+		// BEFORE INSERT FOR EACH ROW.
+		{7, "BEFORE", "INSERT"},
+		// AFTER INSERT OR UPDATE OR DELETE FOR EACH ROW.
+		{29, "AFTER", "INSERT OR UPDATE OR DELETE"},
+		// AFTER TRUNCATE FOR EACH STATEMENT.
+		{32, "AFTER", "TRUNCATE"},
+		// INSTEAD OF UPDATE FOR EACH ROW.
+		{81, "INSTEAD OF", "UPDATE"},
+	}
+
+	for _, test := range tests {
+		timing, event := getTriggerTimingAndEvent(test.triggerType)
+		require.Equal(t, test.timing, timing)
+		require.Equal(t, test.event, event)
+	}
+}
+
+func TestSplitPartitionKey(t *testing.T) {
+	tests := []struct {
+		partitionKey  string
+		partitionType string
+		expression    string
+	}{
+		{"RANGE (created_ts)", "RANGE", "created_ts"},
+		{"LIST (lower(region))", "LIST", "lower(region)"},
+		{"HASH (id, tenant_id)", "HASH", "id, tenant_id"},
+	}
+
+	for _, test := range tests {
+		partitionType, expression := splitPartitionKey(test.partitionKey)
+		require.Equal(t, test.partitionType, partitionType)
+		require.Equal(t, test.expression, expression)
+	}
+}
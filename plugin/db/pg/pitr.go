@@ -0,0 +1,896 @@
+package pg
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/common/log"
+	"github.com/bytebase/bytebase/plugin/db"
+	"github.com/bytebase/bytebase/plugin/db/util"
+	bbs3 "github.com/bytebase/bytebase/plugin/storage/s3"
+	"github.com/bytebase/bytebase/resources/postgres"
+)
+
+const (
+	// WALArchiveSlotName is the physical replication slot retaining the WAL files on the server until Bytebase archives them.
+	// Bytebase never creates the slot by itself, because an abandoned slot retains the WAL files forever and may fill the disk of the server.
+	// Creating the slot is the way users opt in to the WAL archiving for an instance.
+	WALArchiveSlotName = "bytebase_wal_archive"
+	// BaseBackupInterval is the interval to take a new base backup, which bounds the WAL files to replay in PITR.
+	BaseBackupInterval = 24 * time.Hour
+	// defaultWALSegmentTimeout is the age of the current WAL segment to switch to a new one if archive_timeout is not set on the server.
+	// The WAL file is archived only if it's completed, so the segment switch bounds the latest time to recover to.
+	defaultWALSegmentTimeout = 1 * time.Hour
+
+	walDirName             = "wal"
+	baseBackupDirName      = "basebackup"
+	baseBackupFileName     = "base.tar.gz"
+	baseBackupMetaFileName = "meta.json"
+	walPartialSuffix       = ".partial"
+	walSegmentFileName     = "wal_segment.json"
+	// recoveryWALDirName is the directory in the data directory of the recovery instance to hold the WAL files to replay.
+	recoveryWALDirName  = "bytebase_recovery_wal"
+	recoveryLogFileName = "bytebase_recovery.log"
+)
+
+var (
+	walSegmentFileRegexp = regexp.MustCompile(`^[0-9A-F]{24}$`)
+	walHistoryFileRegexp = regexp.MustCompile(`^[0-9A-F]{8}\.history$`)
+
+	// recoveryRequiredSettings are the settings must be no less than the ones on the primary server to run a hot standby.
+	// https://www.postgresql.org/docs/current/hot-standby.html#HOT-STANDBY-ADMIN
+	recoveryRequiredSettings = []string{
+		"max_connections",
+		"max_worker_processes",
+		"max_wal_senders",
+		"max_prepared_transactions",
+		"max_locks_per_transaction",
+	}
+)
+
+// BaseBackupMeta is the metadata of a base backup.
+type BaseBackupMeta struct {
+	// Name is the directory name of the base backup.
+	Name string `json:"name"`
+	// StartWALFile is the WAL file no later than the one containing the start position of the base backup.
+	StartWALFile string `json:"startWalFile"`
+	// StopTs is the time when the base backup finished, and it is the earliest time to recover to using this base backup.
+	StopTs int64 `json:"stopTs"`
+}
+
+// walSegmentState records when the current WAL segment on the server is first seen by the archiving.
+type walSegmentState struct {
+	// Segment is the WAL file name of the segment, such as 000000010000000000000001.
+	Segment string `json:"segment"`
+	StartTs int64  `json:"startTs"`
+}
+
+// CheckPITRSettings checks whether the instance is ready for PITR.
+func (driver *Driver) CheckPITRSettings(ctx context.Context) error {
+	if err := driver.checkServerVersionForPITR(ctx); err != nil {
+		return err
+	}
+
+	walLevel, err := driver.getSetting(ctx, "wal_level")
+	if err != nil {
+		return err
+	}
+	if walLevel != "replica" && walLevel != "logical" {
+		return errors.Errorf("wal_level must be \"replica\" or \"logical\" for PITR, but found %q", walLevel)
+	}
+
+	maxWALSenders, err := driver.getSetting(ctx, "max_wal_senders")
+	if err != nil {
+		return err
+	}
+	if maxWALSenders == "0" {
+		return errors.Errorf("max_wal_senders must be greater than 0 for PITR to archive the WAL files")
+	}
+
+	query := "SELECT rolsuper OR rolreplication FROM pg_roles WHERE rolname = current_user"
+	var replication bool
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&replication); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	if !replication {
+		return errors.Errorf("the user %q must have the REPLICATION attribute for PITR to archive the WAL files", driver.config.Username)
+	}
+
+	exist, err := driver.walArchiveSlotExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.Errorf("the physical replication slot %q for archiving the WAL files does not exist, please create it by \"SELECT pg_create_physical_replication_slot('%s', true);\"", WALArchiveSlotName, WALArchiveSlotName)
+	}
+	return nil
+}
+
+// checkServerVersionForPITR checks that the server has the same major version as the bundled postgres, which replays the WAL files.
+// The WAL format changes between major versions, so the WAL files cannot be replayed by postgres of another major version.
+func (driver *Driver) checkServerVersionForPITR(ctx context.Context) error {
+	serverVersion, err := driver.getSetting(ctx, "server_version_num")
+	if err != nil {
+		return err
+	}
+	serverMajorVersion, err := parseServerVersionNum(serverVersion)
+	if err != nil {
+		return err
+	}
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, filepath.Join(driver.dbBinDir, "postgres"), "--version")
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(err, "failed to get the version of the bundled postgres")
+	}
+	bundledMajorVersion, err := parseBinaryMajorVersion(out.String())
+	if err != nil {
+		return err
+	}
+
+	if serverMajorVersion != bundledMajorVersion {
+		return errors.Errorf("PITR requires PostgreSQL %d, but the server version is %d. Bytebase replays the WAL files with the bundled PostgreSQL %d, which cannot read the WAL files of another major version", bundledMajorVersion, serverMajorVersion, bundledMajorVersion)
+	}
+	return nil
+}
+
+// parseServerVersionNum parses the major version from server_version_num, such as 150001.
+func parseServerVersionNum(versionNum string) (int, error) {
+	num, err := strconv.Atoi(versionNum)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse server_version_num %q", versionNum)
+	}
+	return num / 10000, nil
+}
+
+// parseBinaryMajorVersion parses the major version from the output of "postgres --version", such as "postgres (PostgreSQL) 15.1".
+func parseBinaryMajorVersion(output string) (int, error) {
+	fields := strings.Fields(output)
+	if len(fields) == 0 {
+		return 0, errors.Errorf("failed to parse postgres version from %q", output)
+	}
+	version := fields[len(fields)-1]
+	major, err := strconv.Atoi(strings.Split(version, ".")[0])
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse postgres version from %q", output)
+	}
+	return major, nil
+}
+
+func (driver *Driver) getSetting(ctx context.Context, name string) (string, error) {
+	query := "SELECT setting FROM pg_settings WHERE name = $1"
+	var setting string
+	if err := driver.db.QueryRowContext(ctx, query, name).Scan(&setting); err != nil {
+		if err == sql.ErrNoRows {
+			return "", common.FormatDBErrorEmptyRowWithQuery(query)
+		}
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	return setting, nil
+}
+
+func (driver *Driver) walArchiveSlotExists(ctx context.Context) (bool, error) {
+	query := "SELECT count(*) FROM pg_replication_slots WHERE slot_name = $1 AND slot_type = 'physical'"
+	var count int
+	if err := driver.db.QueryRowContext(ctx, query, WALArchiveSlotName).Scan(&count); err != nil {
+		return false, util.FormatErrorWithQuery(err, query)
+	}
+	return count > 0, nil
+}
+
+// getConnectionArgs returns the connection arguments for the PostgreSQL utilities.
+func (driver *Driver) getConnectionArgs() []string {
+	args := []string{
+		fmt.Sprintf("--username=%s", driver.config.Username),
+		fmt.Sprintf("--host=%s", driver.config.Host),
+		fmt.Sprintf("--port=%s", driver.config.Port),
+	}
+	if driver.config.Password == "" {
+		args = append(args, "--no-password")
+	}
+	return args
+}
+
+func (driver *Driver) runUtility(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, filepath.Join(driver.dbBinDir, name), args...)
+	if driver.config.Password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", driver.config.Password))
+	}
+	cmd.Env = append(cmd.Env, "OPENSSL_CONF=/etc/ssl/")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	log.Debug("Running PostgreSQL utility", zap.String("cmd", cmd.String()))
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to run %s: %s", name, stderr.String())
+	}
+	return nil
+}
+
+// FetchWALFiles archives the WAL files on the server to the WAL archive directory with pg_receivewal.
+// The completed WAL files are uploaded to the cloud storage and removed locally if the client is not nil.
+// It does nothing if the WAL archive slot does not exist, see WALArchiveSlotName.
+func (driver *Driver) FetchWALFiles(ctx context.Context, client *bbs3.Client) error {
+	exist, err := driver.walArchiveSlotExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		log.Debug("Skip archiving WAL files because the WAL archive slot does not exist", zap.String("instance", driver.connectionCtx.InstanceID))
+		return nil
+	}
+
+	walDir := filepath.Join(driver.walArchiveDir, walDirName)
+	if err := os.MkdirAll(walDir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to create WAL directory %q", walDir)
+	}
+
+	if err := driver.switchStaleWALSegment(ctx); err != nil {
+		return err
+	}
+
+	// pg_receivewal waits for the end position forever if there's no more WAL on the server,
+	// so we only run it if the flushed position is ahead of the position confirmed by the slot.
+	query := "SELECT pg_current_wal_flush_lsn(), COALESCE(pg_current_wal_flush_lsn() > restart_lsn, TRUE) FROM pg_replication_slots WHERE slot_name = $1"
+	var endLSN string
+	var hasNewWAL bool
+	if err := driver.db.QueryRowContext(ctx, query, WALArchiveSlotName).Scan(&endLSN, &hasNewWAL); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	if hasNewWAL {
+		args := driver.getConnectionArgs()
+		args = append(args,
+			fmt.Sprintf("--directory=%s", walDir),
+			fmt.Sprintf("--slot=%s", WALArchiveSlotName),
+			fmt.Sprintf("--endpos=%s", endLSN),
+			"--no-loop",
+		)
+		if err := driver.runUtility(ctx, "pg_receivewal", args...); err != nil {
+			return err
+		}
+	}
+
+	if client == nil {
+		return nil
+	}
+	entries, err := os.ReadDir(walDir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read WAL directory %q", walDir)
+	}
+	walDirOnCloud := path.Join(common.GetWALArchiveRelativeDir(driver.walArchiveDir), walDirName)
+	for _, entry := range entries {
+		// The partial WAL file is still being written, we will upload it after it's completed.
+		if !isWALArchiveFile(entry.Name()) {
+			continue
+		}
+		if err := uploadFileToCloud(ctx, client, filepath.Join(walDir, entry.Name()), path.Join(walDirOnCloud, entry.Name())); err != nil {
+			return err
+		}
+		if err := os.Remove(filepath.Join(walDir, entry.Name())); err != nil {
+			return errors.Wrapf(err, "failed to remove the uploaded WAL file %q", entry.Name())
+		}
+	}
+	return nil
+}
+
+// switchStaleWALSegment switches to a new WAL segment by pg_switch_wal() if the current one is older than archive_timeout,
+// so that the WAL files are completed and archived even if the server is idle. It follows the semantics of archive_timeout,
+// and falls back to defaultWALSegmentTimeout if archive_timeout is not set.
+func (driver *Driver) switchStaleWALSegment(ctx context.Context) error {
+	archiveTimeout, err := driver.getSetting(ctx, "archive_timeout")
+	if err != nil {
+		return err
+	}
+	timeout := defaultWALSegmentTimeout
+	if seconds, err := strconv.Atoi(archiveTimeout); err == nil && seconds > 0 {
+		timeout = time.Duration(seconds) * time.Second
+	}
+
+	query := "SELECT pg_walfile_name(pg_current_wal_flush_lsn())"
+	var segment string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&segment); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+
+	statePath := filepath.Join(driver.walArchiveDir, walSegmentFileName)
+	var state walSegmentState
+	if stateBytes, err := os.ReadFile(statePath); err == nil {
+		if err := json.Unmarshal(stateBytes, &state); err != nil {
+			log.Warn("Failed to parse the WAL segment state", zap.String("path", statePath), zap.Error(err))
+		}
+	} else if !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to read the WAL segment state %q", statePath)
+	}
+
+	state, stale := updateWALSegmentState(state, segment, time.Now(), timeout)
+	if stale {
+		// pg_switch_wal() does nothing if there's no WAL written since the last switch.
+		// It requires the superuser or the EXECUTE privilege, the segment is completed by the server itself otherwise.
+		if _, err := driver.db.ExecContext(ctx, "SELECT pg_switch_wal()"); err != nil {
+			log.Warn("Failed to switch the stale WAL segment", zap.String("instance", driver.connectionCtx.InstanceID), zap.String("segment", segment), zap.Error(err))
+		}
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the WAL segment state")
+	}
+	if err := os.WriteFile(statePath, stateBytes, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write the WAL segment state %q", statePath)
+	}
+	return nil
+}
+
+// updateWALSegmentState returns the state for the current segment, and whether the segment is older than the timeout.
+func updateWALSegmentState(state walSegmentState, segment string, now time.Time, timeout time.Duration) (walSegmentState, bool) {
+	if state.Segment != segment {
+		return walSegmentState{Segment: segment, StartTs: now.Unix()}, false
+	}
+	if now.Sub(time.Unix(state.StartTs, 0)) < timeout {
+		return state, false
+	}
+	// Restart the timer, in case the switch does nothing or fails.
+	return walSegmentState{Segment: segment, StartTs: now.Unix()}, true
+}
+
+func isWALArchiveFile(name string) bool {
+	return walSegmentFileRegexp.MatchString(name) || walHistoryFileRegexp.MatchString(name)
+}
+
+// isWALFileNeeded returns true if the WAL file is needed to replay from the start WAL file.
+// The timeline history files are always needed.
+func isWALFileNeeded(name, startWALFile string) bool {
+	if walHistoryFileRegexp.MatchString(name) {
+		return true
+	}
+	if !walSegmentFileRegexp.MatchString(name) {
+		return false
+	}
+	// The WAL segment file name consists of the timeline ID and the position, each part has 8 hex digits.
+	// We compare the position only, because the timeline switches keep the position.
+	return name[8:] >= startWALFile[8:]
+}
+
+func uploadFileToCloud(ctx context.Context, client *bbs3.Client, filePathLocal, filePathOnCloud string) error {
+	file, err := os.Open(filePathLocal)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open file %q for uploading", filePathLocal)
+	}
+	defer file.Close()
+	if _, err := client.UploadObject(ctx, filePathOnCloud, file); err != nil {
+		return errors.Wrapf(err, "failed to upload file %q to cloud storage", filePathOnCloud)
+	}
+	return nil
+}
+
+// CreateBaseBackup takes a base backup of the instance with pg_basebackup.
+// The base backup does not contain the WAL files, which are archived by FetchWALFiles.
+// The base backup file is uploaded to the cloud storage and removed locally if the client is not nil, the metadata file is always kept locally.
+func (driver *Driver) CreateBaseBackup(ctx context.Context, client *bbs3.Client) (*BaseBackupMeta, error) {
+	// The WAL archive slot retains the WAL files since the backup starts.
+	exist, err := driver.walArchiveSlotExists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.Errorf("the WAL archive slot %q does not exist", WALArchiveSlotName)
+	}
+	query := "SELECT pg_walfile_name(pg_current_wal_lsn())"
+	var startWALFile string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&startWALFile); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	name := strconv.FormatInt(time.Now().Unix(), 10)
+	baseBackupDir := filepath.Join(driver.walArchiveDir, baseBackupDirName, name)
+	tempDir := baseBackupDir + ".tmp"
+	if err := os.RemoveAll(tempDir); err != nil {
+		return nil, errors.Wrapf(err, "failed to remove the dirty base backup directory %q", tempDir)
+	}
+	defer os.RemoveAll(tempDir)
+
+	args := driver.getConnectionArgs()
+	args = append(args,
+		fmt.Sprintf("--pgdata=%s", tempDir),
+		"--format=tar",
+		"--gzip",
+		"--wal-method=none",
+		"--checkpoint=fast",
+		"--label=bytebase",
+	)
+	if err := driver.runUtility(ctx, "pg_basebackup", args...); err != nil {
+		return nil, err
+	}
+	meta := &BaseBackupMeta{
+		Name:         name,
+		StartWALFile: startWALFile,
+		StopTs:       time.Now().Unix(),
+	}
+
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read base backup directory %q", tempDir)
+	}
+	for _, entry := range entries {
+		// pg_basebackup writes one tar file for each tablespace besides the base.tar.gz.
+		if entry.Name() != baseBackupFileName && strings.HasSuffix(entry.Name(), ".tar.gz") {
+			return nil, errors.Errorf("PITR does not support the instance with tablespaces")
+		}
+	}
+	metaBytes, err := json.Marshal(meta)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal base backup metadata %+v", meta)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, baseBackupMetaFileName), metaBytes, 0600); err != nil {
+		return nil, errors.Wrap(err, "failed to write base backup metadata file")
+	}
+	if err := os.Rename(tempDir, baseBackupDir); err != nil {
+		return nil, errors.Wrapf(err, "failed to rename %q to %q", tempDir, baseBackupDir)
+	}
+
+	if client != nil {
+		baseBackupDirOnCloud := path.Join(common.GetWALArchiveRelativeDir(driver.walArchiveDir), baseBackupDirName, name)
+		if err := uploadFileToCloud(ctx, client, filepath.Join(baseBackupDir, baseBackupFileName), path.Join(baseBackupDirOnCloud, baseBackupFileName)); err != nil {
+			return nil, err
+		}
+		// Upload the metadata file at last, so that a base backup on the cloud with metadata is always complete.
+		if err := uploadFileToCloud(ctx, client, filepath.Join(baseBackupDir, baseBackupMetaFileName), path.Join(baseBackupDirOnCloud, baseBackupMetaFileName)); err != nil {
+			return nil, err
+		}
+		if err := os.Remove(filepath.Join(baseBackupDir, baseBackupFileName)); err != nil {
+			return nil, errors.Wrap(err, "failed to remove the uploaded base backup file")
+		}
+	}
+	log.Debug("Created base backup", zap.String("instance", driver.connectionCtx.InstanceID), zap.String("name", name))
+	return meta, nil
+}
+
+// ListBaseBackups lists the base backups in ascending order by the stop time.
+func (driver *Driver) ListBaseBackups(ctx context.Context, client *bbs3.Client) ([]*BaseBackupMeta, error) {
+	return listBaseBackups(ctx, driver.walArchiveDir, client)
+}
+
+func listBaseBackups(ctx context.Context, walArchiveDir string, client *bbs3.Client) ([]*BaseBackupMeta, error) {
+	baseBackupRootDir := filepath.Join(walArchiveDir, baseBackupDirName)
+	if client != nil {
+		// Sync the metadata files from the cloud storage, such as for a new Bytebase data directory.
+		listOutput, err := client.ListObjects(ctx, path.Join(common.GetWALArchiveRelativeDir(walArchiveDir), baseBackupDirName))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list base backups in the cloud storage")
+		}
+		for _, item := range listOutput {
+			if path.Base(*item.Key) != baseBackupMetaFileName {
+				continue
+			}
+			name := path.Base(path.Dir(*item.Key))
+			metaPathLocal := filepath.Join(baseBackupRootDir, name, baseBackupMetaFileName)
+			if _, err := os.Stat(metaPathLocal); err == nil {
+				continue
+			}
+			if err := os.MkdirAll(filepath.Dir(metaPathLocal), os.ModePerm); err != nil {
+				return nil, errors.Wrapf(err, "failed to create base backup directory %q", filepath.Dir(metaPathLocal))
+			}
+			if err := client.DownloadFileFromCloud(ctx, metaPathLocal, *item.Key); err != nil {
+				return nil, errors.Wrapf(err, "failed to download base backup metadata file %q", *item.Key)
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(baseBackupRootDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read base backup directory %q", baseBackupRootDir)
+	}
+	var result []*BaseBackupMeta
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasSuffix(entry.Name(), ".tmp") {
+			continue
+		}
+		metaBytes, err := os.ReadFile(filepath.Join(baseBackupRootDir, entry.Name(), baseBackupMetaFileName))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to read base backup metadata of %q", entry.Name())
+		}
+		var meta BaseBackupMeta
+		if err := json.Unmarshal(metaBytes, &meta); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal base backup metadata of %q", entry.Name())
+		}
+		result = append(result, &meta)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StopTs < result[j].StopTs
+	})
+	return result, nil
+}
+
+// getLatestBaseBackupBeforeOrEqualTs gets the latest base backup finished before or at the target time from the sorted base backup list.
+func getLatestBaseBackupBeforeOrEqualTs(baseBackupList []*BaseBackupMeta, targetTs int64) (*BaseBackupMeta, error) {
+	var result *BaseBackupMeta
+	for _, baseBackup := range baseBackupList {
+		if baseBackup.StopTs > targetTs {
+			break
+		}
+		result = baseBackup
+	}
+	if result == nil {
+		return nil, errors.Errorf("no base backup finished before or at %s", time.Unix(targetTs, 0).UTC().Format(time.RFC3339))
+	}
+	return result, nil
+}
+
+// PurgeWALArchive removes the base backups and the WAL files which are not needed to recover to the time after retentionPeriodTs ago.
+func PurgeWALArchive(ctx context.Context, walArchiveDir string, retentionPeriodTs int, client *bbs3.Client) error {
+	baseBackupList, err := listBaseBackups(ctx, walArchiveDir, client)
+	if err != nil {
+		return err
+	}
+	cutoffTs := time.Now().Unix() - int64(retentionPeriodTs)
+	// Keep the latest base backup before the cutoff time, so that we can still recover to the cutoff time.
+	oldest, err := getLatestBaseBackupBeforeOrEqualTs(baseBackupList, cutoffTs)
+	if err != nil {
+		// All base backups are within the retention period.
+		return nil
+	}
+
+	relativeDir := common.GetWALArchiveRelativeDir(walArchiveDir)
+	var purgePathListOnCloud []string
+	for _, baseBackup := range baseBackupList {
+		if baseBackup.StopTs >= oldest.StopTs {
+			break
+		}
+		log.Debug("Deleting expired base backup", zap.String("name", baseBackup.Name))
+		if err := os.RemoveAll(filepath.Join(walArchiveDir, baseBackupDirName, baseBackup.Name)); err != nil {
+			return errors.Wrapf(err, "failed to remove expired base backup %q", baseBackup.Name)
+		}
+		purgePathListOnCloud = append(purgePathListOnCloud,
+			path.Join(relativeDir, baseBackupDirName, baseBackup.Name, baseBackupFileName),
+			path.Join(relativeDir, baseBackupDirName, baseBackup.Name, baseBackupMetaFileName),
+		)
+	}
+
+	walDir := filepath.Join(walArchiveDir, walDirName)
+	entries, err := os.ReadDir(walDir)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to read WAL directory %q", walDir)
+	}
+	for _, entry := range entries {
+		if walSegmentFileRegexp.MatchString(entry.Name()) && !isWALFileNeeded(entry.Name(), oldest.StartWALFile) {
+			if err := os.Remove(filepath.Join(walDir, entry.Name())); err != nil {
+				return errors.Wrapf(err, "failed to remove expired WAL file %q", entry.Name())
+			}
+		}
+	}
+	if client != nil {
+		listOutput, err := client.ListObjects(ctx, path.Join(relativeDir, walDirName))
+		if err != nil {
+			return errors.Wrap(err, "failed to list WAL files in the cloud storage")
+		}
+		for _, item := range listOutput {
+			name := path.Base(*item.Key)
+			if walSegmentFileRegexp.MatchString(name) && !isWALFileNeeded(name, oldest.StartWALFile) {
+				purgePathListOnCloud = append(purgePathListOnCloud, *item.Key)
+			}
+		}
+		if len(purgePathListOnCloud) > 0 {
+			if err := client.DeleteObjects(ctx, purgePathListOnCloud...); err != nil {
+				return errors.Wrapf(err, "failed to delete %d expired WAL archive files from the cloud storage", len(purgePathListOnCloud))
+			}
+		}
+	}
+	return nil
+}
+
+// DumpDatabaseAtTs dumps the database at the point in time targetTs to out.
+// It restores the latest base backup before targetTs in a temporary local instance, replays the archived WAL files till targetTs,
+// and then dumps the database from the temporary instance.
+func (driver *Driver) DumpDatabaseAtTs(ctx context.Context, database string, targetTs int64, client *bbs3.Client, out io.Writer) error {
+	if targetTs > time.Now().Unix() {
+		return errors.Errorf("cannot recover to the future time %s", time.Unix(targetTs, 0).UTC().Format(time.RFC3339))
+	}
+	// Archive the latest WAL files to cover the target time.
+	if err := driver.FetchWALFiles(ctx, client); err != nil {
+		return errors.Wrap(err, "failed to archive the latest WAL files")
+	}
+	baseBackupList, err := driver.ListBaseBackups(ctx, client)
+	if err != nil {
+		return err
+	}
+	baseBackup, err := getLatestBaseBackupBeforeOrEqualTs(baseBackupList, targetTs)
+	if err != nil {
+		return err
+	}
+	settings := make(map[string]string)
+	for _, name := range recoveryRequiredSettings {
+		value, err := driver.getSetting(ctx, name)
+		if err != nil {
+			return err
+		}
+		settings[name] = value
+	}
+
+	// The data directory of the temporary instance must have 0700 permission, which is guaranteed by os.MkdirTemp.
+	dataDir, err := os.MkdirTemp(driver.walArchiveDir, "recovery-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create the data directory for recovery")
+	}
+	defer os.RemoveAll(dataDir)
+
+	if err := driver.prepareBaseBackup(ctx, baseBackup, dataDir, client); err != nil {
+		return err
+	}
+	if err := driver.prepareRecoveryWALDir(ctx, baseBackup.StartWALFile, filepath.Join(dataDir, recoveryWALDirName), client); err != nil {
+		return err
+	}
+	port, err := getFreePort()
+	if err != nil {
+		return err
+	}
+	if err := writeRecoveryConfig(dataDir, port, targetTs, settings); err != nil {
+		return err
+	}
+
+	log.Debug("Starting the temporary instance to replay WAL files", zap.String("dataDir", dataDir), zap.String("baseBackup", baseBackup.Name), zap.Int64("targetTs", targetTs))
+	if err := postgres.StartInBackground(port, driver.dbBinDir, dataDir, filepath.Join(dataDir, recoveryLogFileName)); err != nil {
+		return err
+	}
+	defer func() {
+		if err := postgres.Stop(driver.dbBinDir, dataDir); err != nil {
+			log.Warn("Failed to stop the temporary instance for recovery", zap.String("dataDir", dataDir), zap.Error(err))
+		}
+	}()
+	if err := waitForRecovery(ctx, dataDir, port, driver.config.Username); err != nil {
+		return err
+	}
+
+	recoveryDriver := &Driver{
+		dbBinDir: driver.dbBinDir,
+		config: db.ConnectionConfig{
+			Username: driver.config.Username,
+			Host:     dataDir,
+			Port:     strconv.Itoa(port),
+		},
+	}
+	return recoveryDriver.dumpOneDatabaseWithPgDump(ctx, database, out, false /* schemaOnly */)
+}
+
+// prepareBaseBackup extracts the base backup to the data directory, it downloads the base backup from the cloud storage if the client is not nil.
+func (driver *Driver) prepareBaseBackup(ctx context.Context, baseBackup *BaseBackupMeta, dataDir string, client *bbs3.Client) error {
+	baseBackupPath := filepath.Join(driver.walArchiveDir, baseBackupDirName, baseBackup.Name, baseBackupFileName)
+	if client != nil {
+		baseBackupPath = filepath.Join(dataDir, baseBackupFileName)
+		baseBackupPathOnCloud := path.Join(common.GetWALArchiveRelativeDir(driver.walArchiveDir), baseBackupDirName, baseBackup.Name, baseBackupFileName)
+		if err := client.DownloadFileFromCloud(ctx, baseBackupPath, baseBackupPathOnCloud); err != nil {
+			return errors.Wrapf(err, "failed to download base backup %q from the cloud storage", baseBackup.Name)
+		}
+		defer os.Remove(baseBackupPath)
+	}
+
+	f, err := os.Open(baseBackupPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open base backup file %q", baseBackupPath)
+	}
+	defer f.Close()
+	if err := extractTarGz(f, dataDir); err != nil {
+		return errors.Wrapf(err, "failed to extract base backup %q", baseBackup.Name)
+	}
+	return nil
+}
+
+// prepareRecoveryWALDir collects the WAL files needed to replay from the start WAL file into dir.
+func (driver *Driver) prepareRecoveryWALDir(ctx context.Context, startWALFile, dir string, client *bbs3.Client) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create directory %q", dir)
+	}
+	if client != nil {
+		listOutput, err := client.ListObjects(ctx, path.Join(common.GetWALArchiveRelativeDir(driver.walArchiveDir), walDirName))
+		if err != nil {
+			return errors.Wrap(err, "failed to list WAL files in the cloud storage")
+		}
+		for _, item := range listOutput {
+			name := path.Base(*item.Key)
+			if !isWALFileNeeded(name, startWALFile) {
+				continue
+			}
+			if err := client.DownloadFileFromCloud(ctx, filepath.Join(dir, name), *item.Key); err != nil {
+				return errors.Wrapf(err, "failed to download WAL file %q from the cloud storage", name)
+			}
+		}
+	}
+
+	walDir := filepath.Join(driver.walArchiveDir, walDirName)
+	entries, err := os.ReadDir(walDir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read WAL directory %q", walDir)
+	}
+	for _, entry := range entries {
+		// The partial WAL file is padded to the full size by pg_receivewal, so we can replay it with the completed name.
+		name := strings.TrimSuffix(entry.Name(), walPartialSuffix)
+		if !isWALFileNeeded(name, startWALFile) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			continue
+		}
+		if err := copyFile(filepath.Join(walDir, entry.Name()), filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRecoveryConfig overrides the configuration files of the base backup to start a local instance replaying the WAL files till targetTs.
+func writeRecoveryConfig(dataDir string, port int, targetTs int64, settings map[string]string) error {
+	var buf strings.Builder
+	// Listen on the unix socket in the data directory only.
+	fmt.Fprintf(&buf, "listen_addresses = ''\n")
+	fmt.Fprintf(&buf, "port = %d\n", port)
+	fmt.Fprintf(&buf, "unix_socket_directories = '%s'\n", dataDir)
+	fmt.Fprintf(&buf, "archive_mode = off\n")
+	fmt.Fprintf(&buf, "hot_standby = on\n")
+	for _, name := range recoveryRequiredSettings {
+		if value, ok := settings[name]; ok {
+			fmt.Fprintf(&buf, "%s = %s\n", name, value)
+		}
+	}
+	fmt.Fprintf(&buf, "restore_command = 'cp \"%s/%%f\" \"%%p\"'\n", filepath.Join(dataDir, recoveryWALDirName))
+	fmt.Fprintf(&buf, "recovery_target_time = '%s'\n", formatRecoveryTargetTime(targetTs))
+	fmt.Fprintf(&buf, "recovery_target_action = 'promote'\n")
+
+	files := map[string]string{
+		"postgresql.conf": buf.String(),
+		// The settings by ALTER SYSTEM on the server may not work for the local instance.
+		"postgresql.auto.conf": "",
+		"pg_hba.conf":          "local all all trust\n",
+		"pg_ident.conf":        "",
+		"recovery.signal":      "",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dataDir, name), []byte(content), 0600); err != nil {
+			return errors.Wrapf(err, "failed to write %q for recovery", name)
+		}
+	}
+	if err := os.Remove(filepath.Join(dataDir, "standby.signal")); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to remove standby.signal")
+	}
+	return nil
+}
+
+func formatRecoveryTargetTime(targetTs int64) string {
+	return time.Unix(targetTs, 0).UTC().Format("2006-01-02 15:04:05") + "+00"
+}
+
+// waitForRecovery waits until the local instance finishes replaying the WAL files and gets promoted.
+func waitForRecovery(ctx context.Context, dataDir string, port int, username string) error {
+	// The template1 database always exists.
+	dsn := fmt.Sprintf("host=%s port=%d user=%s dbname=template1 sslmode=disable", dataDir, port, username)
+	recoveryDB, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return err
+	}
+	defer recoveryDB.Close()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			var inRecovery bool
+			if err := recoveryDB.QueryRowContext(ctx, "SELECT pg_is_in_recovery()").Scan(&inRecovery); err == nil {
+				if !inRecovery {
+					return nil
+				}
+				continue
+			}
+			// The instance cannot accept connections before reaching a consistent state, so we check whether it's still alive.
+			if _, err := os.Stat(filepath.Join(dataDir, "postmaster.pid")); os.IsNotExist(err) {
+				serverLog, _ := os.ReadFile(filepath.Join(dataDir, recoveryLogFileName))
+				return errors.Errorf("the instance for recovery exits unexpectedly: %s", tailLines(string(serverLog), 10))
+			}
+		case <-ctx.Done():
+			return errors.Errorf("context is canceled when waiting for recovery")
+		}
+	}
+}
+
+func tailLines(s string, n int) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func getFreePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find a free port")
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open file %q", src)
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create file %q", dst)
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, in); err != nil {
+		return errors.Wrapf(err, "failed to copy file %q to %q", src, dst)
+	}
+	return nil
+}
+
+// extractTarGz extracts the gzipped tar archive created by pg_basebackup to dir.
+func extractTarGz(r io.Reader, dir string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, header.Name)
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return errors.Errorf("invalid file path %q in the archive", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode)&os.ModePerm)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tarReader); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		default:
+			return errors.Errorf("unsupported file type %q of %q in the archive", header.Typeflag, header.Name)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package pg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsWALFileNeeded(t *testing.T) {
+	startWALFile := "000000010000000000000003"
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"000000010000000000000002", false},
+		{"000000010000000000000003", true},
+		{"000000010000000000000004", true},
+		// The position is compared regardless of the timeline.
+		{"000000020000000000000004", true},
+		{"000000020000000000000001", false},
+		{"00000002.history", true},
+		{"000000010000000000000004.partial", false},
+		{"base.tar.gz", false},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, isWALFileNeeded(test.name, startWALFile), test.name)
+	}
+}
+
+func TestGetLatestBaseBackupBeforeOrEqualTs(t *testing.T) {
+	baseBackupList := []*BaseBackupMeta{
+		{Name: "100", StopTs: 110},
+		{Name: "200", StopTs: 210},
+		{Name: "300", StopTs: 310},
+	}
+	tests := []struct {
+		targetTs int64
+		want     string
+		wantErr  bool
+	}{
+		{targetTs: 100, wantErr: true},
+		{targetTs: 110, want: "100"},
+		{targetTs: 209, want: "100"},
+		{targetTs: 210, want: "200"},
+		{targetTs: 1000, want: "300"},
+	}
+
+	for _, test := range tests {
+		got, err := getLatestBaseBackupBeforeOrEqualTs(baseBackupList, test.targetTs)
+		if test.wantErr {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.want, got.Name)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	major, err := parseServerVersionNum("150001")
+	require.NoError(t, err)
+	require.Equal(t, 15, major)
+	major, err = parseServerVersionNum("90624")
+	require.NoError(t, err)
+	require.Equal(t, 9, major)
+
+	major, err = parseBinaryMajorVersion("postgres (PostgreSQL) 15.1\n")
+	require.NoError(t, err)
+	require.Equal(t, 15, major)
+	_, err = parseBinaryMajorVersion("")
+	require.Error(t, err)
+}
+
+func TestUpdateWALSegmentState(t *testing.T) {
+	now := time.Unix(1669883400, 0)
+	state, stale := updateWALSegmentState(walSegmentState{}, "000000010000000000000001", now, time.Hour)
+	require.False(t, stale)
+	require.Equal(t, walSegmentState{Segment: "000000010000000000000001", StartTs: now.Unix()}, state)
+
+	state, stale = updateWALSegmentState(state, "000000010000000000000001", now.Add(30*time.Minute), time.Hour)
+	require.False(t, stale)
+	require.Equal(t, now.Unix(), state.StartTs)
+
+	state, stale = updateWALSegmentState(state, "000000010000000000000001", now.Add(time.Hour), time.Hour)
+	require.True(t, stale)
+	require.Equal(t, now.Add(time.Hour).Unix(), state.StartTs)
+
+	// The segment is completed by the server.
+	state, stale = updateWALSegmentState(state, "000000010000000000000002", now.Add(3*time.Hour), time.Hour)
+	require.False(t, stale)
+	require.Equal(t, walSegmentState{Segment: "000000010000000000000002", StartTs: now.Add(3 * time.Hour).Unix()}, state)
+}
+
+func TestFormatRecoveryTargetTime(t *testing.T) {
+	require.Equal(t, "2022-12-01 08:30:00+00", formatRecoveryTargetTime(1669883400))
+}
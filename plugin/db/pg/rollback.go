@@ -0,0 +1,586 @@
+package pg
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/db/util"
+)
+
+const (
+	rollbackSlotNamePrefix = "bytebase_rollback_"
+	// logicalDecodingPlugin is the output plugin shipped with PostgreSQL, so that users don't need to install any extension.
+	logicalDecodingPlugin = "test_decoding"
+	// unchangedToastDatum is the value test_decoding outputs for the TOASTed value which is not changed and not logged.
+	unchangedToastDatum = "unchanged-toast-datum"
+	noTupleData         = "(no-tuple-data)"
+	// maxRollbackSQLSize is the max size of the generated rollback SQL.
+	maxRollbackSQLSize = 8 * 1024 * 1024
+)
+
+// RowChangeType is the type of the row change decoded from the WAL.
+type RowChangeType string
+
+const (
+	// RowChangeInsert is the row change type for INSERT.
+	RowChangeInsert RowChangeType = "INSERT"
+	// RowChangeUpdate is the row change type for UPDATE.
+	RowChangeUpdate RowChangeType = "UPDATE"
+	// RowChangeDelete is the row change type for DELETE.
+	RowChangeDelete RowChangeType = "DELETE"
+	// RowChangeTruncate is the row change type for TRUNCATE.
+	RowChangeTruncate RowChangeType = "TRUNCATE"
+)
+
+// ColumnValue is a column value in the row change.
+type ColumnValue struct {
+	// Name is the quoted identifier of the column.
+	Name string
+	Type string
+	// Value is the SQL literal of the value, such as 1, 'foo' and null.
+	Value string
+}
+
+// RowChange is a row change decoded by test_decoding.
+type RowChange struct {
+	// Table is the qualified and quoted identifier of the table, such as public."Users".
+	Table string
+	Type  RowChangeType
+	// OldTuple is the row before the change for UPDATE and DELETE. It only contains the replica identity columns unless the table has REPLICA IDENTITY FULL.
+	OldTuple []ColumnValue
+	// NewTuple is the row after the change for INSERT and UPDATE.
+	NewTuple []ColumnValue
+}
+
+// GetRollbackSlotName returns the logical replication slot name capturing the changes of the task.
+func GetRollbackSlotName(taskID int) string {
+	return fmt.Sprintf("%s%d", rollbackSlotNamePrefix, taskID)
+}
+
+// ParseRollbackSlotName returns the task ID of the logical replication slot, or false if the slot isn't created for rollback SQL generation.
+func ParseRollbackSlotName(slotName string) (int, bool) {
+	if !strings.HasPrefix(slotName, rollbackSlotNamePrefix) {
+		return 0, false
+	}
+	taskID, err := strconv.Atoi(strings.TrimPrefix(slotName, rollbackSlotNamePrefix))
+	if err != nil {
+		return 0, false
+	}
+	return taskID, true
+}
+
+// IsLogicalDecodingEnabled returns true if the instance can decode the WAL for rollback SQL generation.
+func (driver *Driver) IsLogicalDecodingEnabled(ctx context.Context) (bool, error) {
+	walLevel, err := driver.getSetting(ctx, "wal_level")
+	if err != nil {
+		return false, err
+	}
+	return walLevel == "logical", nil
+}
+
+// CreateRollbackSlot creates the logical replication slot in the database to capture the changes for rollback SQL generation.
+// The slot retains the WAL files on the server until it's dropped by DropRollbackSlot.
+func (driver *Driver) CreateRollbackSlot(ctx context.Context, database, slotName string) error {
+	sqldb, err := driver.GetDBConnection(ctx, database)
+	if err != nil {
+		return err
+	}
+	// Drop the dirty slot left from a former task execution.
+	if err := driver.DropRollbackSlot(ctx, slotName); err != nil {
+		return err
+	}
+	query := "SELECT pg_create_logical_replication_slot($1, $2)"
+	if _, err := sqldb.ExecContext(ctx, query, slotName, logicalDecodingPlugin); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	return nil
+}
+
+// DropRollbackSlot drops the logical replication slot if it exists.
+func (driver *Driver) DropRollbackSlot(ctx context.Context, slotName string) error {
+	query := "SELECT pg_drop_replication_slot(slot_name) FROM pg_replication_slots WHERE slot_name = $1"
+	if _, err := driver.db.ExecContext(ctx, query, slotName); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	return nil
+}
+
+// ListRollbackSlots returns the logical replication slots created for rollback SQL generation in the instance.
+// The key is the slot name and the value is the database where the slot is created.
+func (driver *Driver) ListRollbackSlots(ctx context.Context) (map[string]string, error) {
+	query := "SELECT slot_name, database FROM pg_replication_slots WHERE slot_type = 'logical' AND slot_name LIKE $1"
+	rows, err := driver.db.QueryContext(ctx, query, rollbackSlotNamePrefix+"%")
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	slots := make(map[string]string)
+	for rows.Next() {
+		var slotName, database string
+		if err := rows.Scan(&slotName, &database); err != nil {
+			return nil, err
+		}
+		if _, ok := ParseRollbackSlotName(slotName); ok {
+			slots[slotName] = database
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+// EnableTransactionIDCapture makes Execute record the ID of the committed transaction for rollback SQL generation.
+func (driver *Driver) EnableTransactionIDCapture() {
+	driver.captureTransactionID = true
+}
+
+// GetCurrentWALLSN returns the current WAL write location, such as 0/16B3748.
+func (driver *Driver) GetCurrentWALLSN(ctx context.Context) (string, error) {
+	query := "SELECT pg_current_wal_lsn()"
+	var lsn string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&lsn); err != nil {
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	return lsn, nil
+}
+
+// GetLastTransactionID returns the ID of the last transaction committed by Execute, which is empty if there's no transaction
+// or the capture isn't enabled by EnableTransactionIDCapture.
+func (driver *Driver) GetLastTransactionID() string {
+	return driver.lastTransactionID
+}
+
+// GenerateRollbackSQL generates the rollback SQL statements from the changes captured by the logical replication slot.
+// The driver must connect to the database where the slot is created.
+// lsnEnd is the WAL location after the transaction commits, we decode the changes till it.
+// transactionID is used to filter the changes of the target transaction.
+// The changes are peeked, so that the generation can be retried before the slot is dropped.
+func (driver *Driver) GenerateRollbackSQL(ctx context.Context, slotName, lsnEnd, transactionID string) (string, error) {
+	query := "SELECT data FROM pg_logical_slot_peek_changes($1, $2::pg_lsn, NULL, 'include-xids', '1', 'skip-empty-xacts', '1')"
+	rows, err := driver.db.QueryContext(ctx, query, slotName, lsnEnd)
+	if err != nil {
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var changeList []*RowChange
+	inTransaction := false
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return "", err
+		}
+		switch {
+		case strings.HasPrefix(data, "BEGIN "):
+			inTransaction = strings.TrimPrefix(data, "BEGIN ") == transactionID
+		case strings.HasPrefix(data, "COMMIT "):
+			inTransaction = false
+		case inTransaction:
+			change, err := ParseRowChange(data)
+			if err != nil {
+				return "", errors.WithMessagef(err, "failed to parse change %q", data)
+			}
+			changeList = append(changeList, change)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	if err := driver.checkReplicaIdentityFull(ctx, changeList); err != nil {
+		return "", err
+	}
+	keyColumnsMap, err := driver.getKeyColumns(ctx, changeList)
+	if err != nil {
+		return "", err
+	}
+	return GetRollbackSQL(changeList, keyColumnsMap)
+}
+
+// getKeyColumns returns the quoted names of the columns identifying the rows, keyed by the table with INSERT or UPDATE changes.
+// The rollback SQL locates the rows by the primary key, or by the replica identity index if there is no primary key.
+// The tables without them are absent in the result.
+func (driver *Driver) getKeyColumns(ctx context.Context, changeList []*RowChange) (map[string][]string, error) {
+	keyColumnsMap := make(map[string][]string)
+	checked := make(map[string]bool)
+	for _, change := range changeList {
+		if change.Type != RowChangeInsert && change.Type != RowChangeUpdate {
+			continue
+		}
+		if checked[change.Table] {
+			continue
+		}
+		checked[change.Table] = true
+		// Both the primary key and the replica identity index consist of the NOT NULL columns without expressions.
+		query := `
+		SELECT i.indisprimary, quote_ident(a.attname)
+		FROM pg_index i
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::regclass AND (i.indisprimary OR i.indisreplident)
+		ORDER BY array_position(i.indkey::int2[], a.attnum)`
+		rows, err := driver.db.QueryContext(ctx, query, change.Table)
+		if err != nil {
+			return nil, util.FormatErrorWithQuery(err, query)
+		}
+		var primaryKey, replicaIdentity []string
+		for rows.Next() {
+			var isPrimary bool
+			var column string
+			if err := rows.Scan(&isPrimary, &column); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if isPrimary {
+				primaryKey = append(primaryKey, column)
+			} else {
+				replicaIdentity = append(replicaIdentity, column)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return nil, util.FormatErrorWithQuery(err, query)
+		}
+		rows.Close()
+		if len(primaryKey) > 0 {
+			keyColumnsMap[change.Table] = primaryKey
+		} else if len(replicaIdentity) > 0 {
+			keyColumnsMap[change.Table] = replicaIdentity
+		}
+	}
+	return keyColumnsMap, nil
+}
+
+// checkReplicaIdentityFull checks the tables with UPDATE or DELETE changes have REPLICA IDENTITY FULL,
+// otherwise the old rows only contain the replica identity columns and we cannot restore them.
+func (driver *Driver) checkReplicaIdentityFull(ctx context.Context, changeList []*RowChange) error {
+	checked := make(map[string]bool)
+	for _, change := range changeList {
+		if change.Type != RowChangeUpdate && change.Type != RowChangeDelete {
+			continue
+		}
+		if checked[change.Table] {
+			continue
+		}
+		checked[change.Table] = true
+		query := "SELECT relreplident FROM pg_class WHERE oid = $1::regclass"
+		var replicaIdentity string
+		if err := driver.db.QueryRowContext(ctx, query, change.Table).Scan(&replicaIdentity); err != nil {
+			return util.FormatErrorWithQuery(err, query)
+		}
+		if replicaIdentity != "f" {
+			return errors.Errorf("the old rows of %s on table %s are not logged, please set REPLICA IDENTITY FULL for the table to generate rollback SQL", change.Type, change.Table)
+		}
+	}
+	return nil
+}
+
+// GetRollbackSQL generates the rollback SQL for the list of row changes in the reversed order.
+// keyColumnsMap is the quoted names of the primary key or replica identity columns, keyed by the table.
+func GetRollbackSQL(changeList []*RowChange, keyColumnsMap map[string][]string) (string, error) {
+	var buf strings.Builder
+	for i := len(changeList) - 1; i >= 0; i-- {
+		sql, err := changeList[i].getRollbackSQL(keyColumnsMap[changeList[i].Table])
+		if err != nil {
+			return "", err
+		}
+		if _, err := buf.WriteString(sql + "\n"); err != nil {
+			return "", err
+		}
+		if buf.Len() > maxRollbackSQLSize {
+			return "", errors.Errorf("rollback SQL size exceeds the limit %d bytes", maxRollbackSQLSize)
+		}
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func (c *RowChange) getRollbackSQL(keyColumns []string) (string, error) {
+	switch c.Type {
+	case RowChangeInsert:
+		condition, err := c.formatCondition(c.NewTuple, keyColumns)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("DELETE FROM %s WHERE %s;", c.Table, condition), nil
+	case RowChangeUpdate:
+		if len(c.OldTuple) == 0 || len(c.OldTuple) < len(c.NewTuple) {
+			return "", errors.Errorf("the old row of UPDATE on table %s is not logged, please set REPLICA IDENTITY FULL for the table to generate rollback SQL", c.Table)
+		}
+		if err := checkTuple(c.OldTuple); err != nil {
+			return "", err
+		}
+		condition, err := c.formatCondition(c.NewTuple, keyColumns)
+		if err != nil {
+			return "", err
+		}
+		var assignments []string
+		for _, column := range c.OldTuple {
+			assignments = append(assignments, fmt.Sprintf("%s = %s", column.Name, column.Value))
+		}
+		return fmt.Sprintf("UPDATE %s SET %s WHERE %s;", c.Table, strings.Join(assignments, ", "), condition), nil
+	case RowChangeDelete:
+		if err := checkTuple(c.OldTuple); err != nil {
+			return "", err
+		}
+		var columns, values []string
+		for _, column := range c.OldTuple {
+			columns = append(columns, column.Name)
+			values = append(values, column.Value)
+		}
+		return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", c.Table, strings.Join(columns, ", "), strings.Join(values, ", ")), nil
+	case RowChangeTruncate:
+		return "", errors.Errorf("cannot generate rollback SQL for TRUNCATE on table %s", c.Table)
+	default:
+		return "", errors.Errorf("unsupported row change type %q", c.Type)
+	}
+}
+
+func checkTuple(tuple []ColumnValue) error {
+	if len(tuple) == 0 {
+		return errors.Errorf("the row is not logged, please set REPLICA IDENTITY FULL for the table to generate rollback SQL")
+	}
+	for _, column := range tuple {
+		if column.Value == unchangedToastDatum {
+			return errors.Errorf("the TOASTed value of column %s is not logged, please set REPLICA IDENTITY FULL for the table to generate rollback SQL", column.Name)
+		}
+	}
+	return nil
+}
+
+// formatCondition formats the condition locating the row by the key columns.
+// Comparing all columns doesn't work, because some types have no equality operator, such as json, point and xml,
+// and the rows without key may be duplicated.
+func (c *RowChange) formatCondition(tuple []ColumnValue, keyColumns []string) (string, error) {
+	if len(keyColumns) == 0 {
+		return "", errors.Errorf("table %s has no primary key or replica identity index to locate the rows, cannot generate rollback SQL", c.Table)
+	}
+	var conditions []string
+	for _, key := range keyColumns {
+		var value *ColumnValue
+		for i := range tuple {
+			if tuple[i].Name == key {
+				value = &tuple[i]
+				break
+			}
+		}
+		if value == nil {
+			return "", errors.Errorf("the key column %s of table %s is not logged", key, c.Table)
+		}
+		if value.Value == unchangedToastDatum {
+			return "", errors.Errorf("the TOASTed value of key column %s is not logged, please set REPLICA IDENTITY FULL for the table to generate rollback SQL", key)
+		}
+		conditions = append(conditions, fmt.Sprintf("%s IS NOT DISTINCT FROM %s::%s", key, formatLiteral(value.Value), value.Type))
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+// formatLiteral converts the value output by test_decoding to a quoted literal,
+// so that the type cast applies to the whole value, such as '-1'::integer and 'NaN'::double precision.
+func formatLiteral(value string) string {
+	if value == "null" {
+		return "NULL"
+	}
+	if strings.HasPrefix(value, "'") {
+		return value
+	}
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+}
+
+// ParseRowChange parses the row change in the test_decoding output format, such as
+// table public.t: UPDATE: old-key: id[integer]:1 name[text]:'foo' new-tuple: id[integer]:1 name[text]:'bar'.
+func ParseRowChange(data string) (*RowChange, error) {
+	if !strings.HasPrefix(data, "table ") {
+		return nil, errors.Errorf("invalid change format")
+	}
+	s := strings.TrimPrefix(data, "table ")
+	table, s, err := scanTableName(s)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(s, ": ") {
+		return nil, errors.Errorf("expecting \": \" after the table name")
+	}
+	s = strings.TrimPrefix(s, ": ")
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return nil, errors.Errorf("missing change type")
+	}
+	change := &RowChange{
+		Table: table,
+		Type:  RowChangeType(s[:i]),
+	}
+	s = strings.TrimPrefix(s[i+1:], " ")
+
+	switch change.Type {
+	case RowChangeInsert:
+		if change.NewTuple, err = parseTuple(s); err != nil {
+			return nil, err
+		}
+	case RowChangeUpdate:
+		if strings.HasPrefix(s, "old-key: ") {
+			i := strings.Index(s, " new-tuple: ")
+			if i < 0 {
+				return nil, errors.Errorf("missing new-tuple")
+			}
+			if change.OldTuple, err = parseTuple(strings.TrimPrefix(s[:i], "old-key: ")); err != nil {
+				return nil, err
+			}
+			s = s[i+len(" new-tuple: "):]
+		}
+		if change.NewTuple, err = parseTuple(s); err != nil {
+			return nil, err
+		}
+	case RowChangeDelete:
+		if change.OldTuple, err = parseTuple(s); err != nil {
+			return nil, err
+		}
+	case RowChangeTruncate:
+	default:
+		return nil, errors.Errorf("unsupported change type %q", change.Type)
+	}
+	return change, nil
+}
+
+// scanTableName scans the qualified table name quoted by quote_identifier(), and returns the table name and the rest.
+func scanTableName(s string) (string, string, error) {
+	var buf strings.Builder
+	inQuote := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '"' {
+			if inQuote && i+1 < len(s) && s[i+1] == '"' {
+				buf.WriteString(`""`)
+				i++
+				continue
+			}
+			inQuote = !inQuote
+		}
+		if !inQuote && c == ':' {
+			return buf.String(), s[i:], nil
+		}
+		buf.WriteByte(c)
+	}
+	return "", "", errors.Errorf("missing \":\" after the table name")
+}
+
+// parseTuple parses the tuple in the format of name[type]:value separated by spaces.
+func parseTuple(s string) ([]ColumnValue, error) {
+	if s == noTupleData {
+		return nil, nil
+	}
+	var tuple []ColumnValue
+	reader := bufio.NewReader(strings.NewReader(s))
+	for {
+		column, err := parseColumnValue(reader)
+		if err != nil {
+			return nil, err
+		}
+		tuple = append(tuple, column)
+		c, err := reader.ReadByte()
+		if err != nil {
+			// The end of the tuple.
+			return tuple, nil
+		}
+		if c != ' ' {
+			return nil, errors.Errorf("expecting space between columns, but got %q", c)
+		}
+	}
+}
+
+func parseColumnValue(reader *bufio.Reader) (ColumnValue, error) {
+	var column ColumnValue
+	// The column name.
+	var buf strings.Builder
+	inQuote := false
+	for {
+		c, err := reader.ReadByte()
+		if err != nil {
+			return column, errors.Errorf("unexpected end of column name")
+		}
+		if c == '"' {
+			inQuote = !inQuote
+		}
+		if !inQuote && c == '[' {
+			break
+		}
+		buf.WriteByte(c)
+	}
+	column.Name = buf.String()
+
+	// The type name may contain brackets such as integer[], so we look for "]:".
+	buf.Reset()
+	for {
+		c, err := reader.ReadByte()
+		if err != nil {
+			return column, errors.Errorf("unexpected end of the type of column %s", column.Name)
+		}
+		if c == ']' {
+			next, err := reader.Peek(1)
+			if err == nil && next[0] == ':' {
+				if _, err := reader.ReadByte(); err != nil {
+					return column, err
+				}
+				break
+			}
+		}
+		buf.WriteByte(c)
+	}
+	column.Type = buf.String()
+
+	// The value is either a quoted literal or a token without spaces.
+	buf.Reset()
+	next, err := reader.Peek(1)
+	if err != nil {
+		return column, errors.Errorf("unexpected end of the value of column %s", column.Name)
+	}
+	if next[0] == '\'' {
+		if _, err := reader.ReadByte(); err != nil {
+			return column, err
+		}
+		buf.WriteByte('\'')
+		for {
+			c, err := reader.ReadByte()
+			if err != nil {
+				return column, errors.Errorf("unterminated quoted value of column %s", column.Name)
+			}
+			buf.WriteByte(c)
+			if c != '\'' {
+				continue
+			}
+			next, err := reader.Peek(1)
+			if err == nil && next[0] == '\'' {
+				// Escaped single quote.
+				if _, err := reader.ReadByte(); err != nil {
+					return column, err
+				}
+				buf.WriteByte('\'')
+				continue
+			}
+			break
+		}
+	} else {
+		for {
+			next, err := reader.Peek(1)
+			if err != nil || next[0] == ' ' {
+				break
+			}
+			c, err := reader.ReadByte()
+			if err != nil {
+				return column, err
+			}
+			buf.WriteByte(c)
+		}
+	}
+	column.Value = buf.String()
+	return column, nil
+}
+
+// formatTransactionID converts the 64-bit transaction ID returned by txid_current() to the 32-bit one output by test_decoding.
+func formatTransactionID(txid int64) string {
+	return strconv.FormatInt(txid&0xFFFFFFFF, 10)
+}
@@ -0,0 +1,210 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRowChange(t *testing.T) {
+	tests := []struct {
+		data string
+		want *RowChange
+		err  bool
+	}{
+		{
+			data: "table public.t: INSERT: id[integer]:1 name[text]:'it''s me' tags[text[]]:'{a,b}' note[character varying]:null",
+			want: &RowChange{
+				Table: "public.t",
+				Type:  RowChangeInsert,
+				NewTuple: []ColumnValue{
+					{Name: "id", Type: "integer", Value: "1"},
+					{Name: "name", Type: "text", Value: "'it''s me'"},
+					{Name: "tags", Type: "text[]", Value: "'{a,b}'"},
+					{Name: "note", Type: "character varying", Value: "null"},
+				},
+			},
+		},
+		{
+			data: `table "my schema"."User: Table": UPDATE: old-key: id[integer]:1 "First Name"[text]:'a b' new-tuple: id[integer]:1 "First Name"[text]:'c'`,
+			want: &RowChange{
+				Table: `"my schema"."User: Table"`,
+				Type:  RowChangeUpdate,
+				OldTuple: []ColumnValue{
+					{Name: "id", Type: "integer", Value: "1"},
+					{Name: `"First Name"`, Type: "text", Value: "'a b'"},
+				},
+				NewTuple: []ColumnValue{
+					{Name: "id", Type: "integer", Value: "1"},
+					{Name: `"First Name"`, Type: "text", Value: "'c'"},
+				},
+			},
+		},
+		{
+			data: "table public.t: UPDATE: id[integer]:1 name[text]:'c'",
+			want: &RowChange{
+				Table: "public.t",
+				Type:  RowChangeUpdate,
+				NewTuple: []ColumnValue{
+					{Name: "id", Type: "integer", Value: "1"},
+					{Name: "name", Type: "text", Value: "'c'"},
+				},
+			},
+		},
+		{
+			data: "table public.t: DELETE: id[integer]:2 name[text]:'b'",
+			want: &RowChange{
+				Table: "public.t",
+				Type:  RowChangeDelete,
+				OldTuple: []ColumnValue{
+					{Name: "id", Type: "integer", Value: "2"},
+					{Name: "name", Type: "text", Value: "'b'"},
+				},
+			},
+		},
+		{
+			data: "table public.t: DELETE: (no-tuple-data)",
+			want: &RowChange{
+				Table: "public.t",
+				Type:  RowChangeDelete,
+			},
+		},
+		{
+			data: "table public.t: TRUNCATE: (no-flags)",
+			want: &RowChange{
+				Table: "public.t",
+				Type:  RowChangeTruncate,
+			},
+		},
+		{
+			data: "message: transactional: 1 prefix: foo, sz: 3 content:bar",
+			err:  true,
+		},
+		{
+			data: "table public.t: INSERT: name[text]:'unterminated",
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		change, err := ParseRowChange(test.data)
+		if test.err {
+			require.Error(t, err, test.data)
+			continue
+		}
+		require.NoError(t, err, test.data)
+		require.Equal(t, test.want, change, test.data)
+	}
+}
+
+func TestGetRollbackSQL(t *testing.T) {
+	keyColumnsMap := map[string][]string{
+		"public.t":        {"id"},
+		`public."Orders"`: {`"OrderID"`, "line"},
+	}
+	tests := []struct {
+		name        string
+		changeList  []string
+		rollbackSQL string
+		err         bool
+	}{
+		{
+			name:        "empty",
+			changeList:  nil,
+			rollbackSQL: "",
+		},
+		{
+			name: "INSERT",
+			changeList: []string{
+				"table public.t: INSERT: id[integer]:1 name[text]:'alice'",
+				"table public.t: INSERT: id[integer]:2 name[text]:null",
+			},
+			rollbackSQL: `DELETE FROM public.t WHERE id IS NOT DISTINCT FROM '2'::integer;
+DELETE FROM public.t WHERE id IS NOT DISTINCT FROM '1'::integer;`,
+		},
+		{
+			name: "INSERT with composite key and the types without equality operator",
+			changeList: []string{
+				`table public."Orders": INSERT: "OrderID"[bigint]:-1 line[text]:'a''b' doc[json]:'{"a": 1}' pos[point]:'(1,2)' price[double precision]:0.1`,
+			},
+			rollbackSQL: `DELETE FROM public."Orders" WHERE "OrderID" IS NOT DISTINCT FROM '-1'::bigint AND line IS NOT DISTINCT FROM 'a''b'::text;`,
+		},
+		{
+			name: "INSERT without key",
+			changeList: []string{
+				"table public.logs: INSERT: msg[text]:'hello'",
+			},
+			err: true,
+		},
+		{
+			name: "UPDATE and DELETE",
+			changeList: []string{
+				"table public.t: UPDATE: old-key: id[integer]:1 name[text]:'alice' new-tuple: id[integer]:1 name[text]:'bob'",
+				"table public.t: DELETE: id[integer]:1 name[text]:'bob'",
+			},
+			rollbackSQL: `INSERT INTO public.t (id, name) VALUES (1, 'bob');
+UPDATE public.t SET id = 1, name = 'alice' WHERE id IS NOT DISTINCT FROM '1'::integer;`,
+		},
+		{
+			name: "UPDATE with unchanged TOAST value in the new row",
+			changeList: []string{
+				"table public.t: UPDATE: old-key: id[integer]:1 doc[text]:'a' new-tuple: id[integer]:2 doc[text]:unchanged-toast-datum",
+			},
+			rollbackSQL: `UPDATE public.t SET id = 1, doc = 'a' WHERE id IS NOT DISTINCT FROM '2'::integer;`,
+		},
+		{
+			name: "UPDATE without old row",
+			changeList: []string{
+				"table public.t: UPDATE: id[integer]:1 name[text]:'bob'",
+			},
+			err: true,
+		},
+		{
+			name: "unchanged TOAST value in the old row",
+			changeList: []string{
+				"table public.t: UPDATE: old-key: id[integer]:1 doc[text]:unchanged-toast-datum new-tuple: id[integer]:1 doc[text]:'b'",
+			},
+			err: true,
+		},
+		{
+			name: "TRUNCATE",
+			changeList: []string{
+				"table public.t: TRUNCATE: (no-flags)",
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		var changeList []*RowChange
+		for _, data := range test.changeList {
+			change, err := ParseRowChange(data)
+			require.NoError(t, err, test.name)
+			changeList = append(changeList, change)
+		}
+		rollbackSQL, err := GetRollbackSQL(changeList, keyColumnsMap)
+		if test.err {
+			require.Error(t, err, test.name)
+			continue
+		}
+		require.NoError(t, err, test.name)
+		require.Equal(t, test.rollbackSQL, rollbackSQL, test.name)
+	}
+}
+
+func TestFormatTransactionID(t *testing.T) {
+	require.Equal(t, "750", formatTransactionID(750))
+	// The epoch is stripped.
+	require.Equal(t, "750", formatTransactionID(1<<32+750))
+}
+
+func TestParseRollbackSlotName(t *testing.T) {
+	taskID, ok := ParseRollbackSlotName(GetRollbackSlotName(101))
+	require.True(t, ok)
+	require.Equal(t, 101, taskID)
+
+	_, ok = ParseRollbackSlotName("bytebase_wal_archive")
+	require.False(t, ok)
+	_, ok = ParseRollbackSlotName("bytebase_rollback_x")
+	require.False(t, ok)
+}
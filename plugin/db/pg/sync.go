@@ -80,11 +80,15 @@ func (driver *Driver) SyncDBSchema(ctx context.Context, databaseName string) (*s
 	}
 	defer txn.Rollback()
 
+	versionNum, err := getServerVersionNum(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get server version of database %q", databaseName)
+	}
 	schemaList, err := getSchemas(txn)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get schemas from database %q", databaseName)
 	}
-	tableMap, err := getTables(txn)
+	tableMap, err := getTables(txn, versionNum)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get tables from database %q", databaseName)
 	}
@@ -96,6 +100,18 @@ func (driver *Driver) SyncDBSchema(ctx context.Context, databaseName string) (*s
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get extensions from database %q", databaseName)
 	}
+	functionMap, procedureMap, err := getRoutines(txn, versionNum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get functions and procedures from database %q", databaseName)
+	}
+	sequenceMap, err := getSequences(txn, versionNum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sequences from database %q", databaseName)
+	}
+	enumTypeMap, err := getEnumTypes(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get enum types from database %q", databaseName)
+	}
 
 	if err := txn.Commit(); err != nil {
 		return nil, err
@@ -111,6 +127,12 @@ func (driver *Driver) SyncDBSchema(ctx context.Context, databaseName string) (*s
 	for schemaName := range viewMap {
 		schemaNameMap[schemaName] = true
 	}
+	for schemaName := range functionMap {
+		schemaNameMap[schemaName] = true
+	}
+	for schemaName := range procedureMap {
+		schemaNameMap[schemaName] = true
+	}
 	var schemaNames []string
 	for schemaName := range schemaNameMap {
 		schemaNames = append(schemaNames, schemaName)
@@ -127,9 +149,13 @@ func (driver *Driver) SyncDBSchema(ctx context.Context, databaseName string) (*s
 			views = []*storepb.ViewMetadata{}
 		}
 		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
-			Name:   schemaName,
-			Tables: tables,
-			Views:  views,
+			Name:       schemaName,
+			Tables:     tables,
+			Views:      views,
+			Functions:  functionMap[schemaName],
+			Procedures: procedureMap[schemaName],
+			Sequences:  sequenceMap[schemaName],
+			EnumTypes:  enumTypeMap[schemaName],
 		})
 	}
 	databaseMetadata.Extensions = extensions
@@ -296,7 +322,7 @@ func getSchemas(txn *sql.Tx) ([]string, error) {
 }
 
 // getTables gets all tables of a database.
-func getTables(txn *sql.Tx) (map[string][]*storepb.TableMetadata, error) {
+func getTables(txn *sql.Tx, versionNum int) (map[string][]*storepb.TableMetadata, error) {
 	columnMap, err := getTableColumns(txn)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get table columns")
@@ -309,6 +335,14 @@ func getTables(txn *sql.Tx) (map[string][]*storepb.TableMetadata, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get foreign keys")
 	}
+	triggersMap, err := getTriggers(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get triggers")
+	}
+	partitionMap, err := getPartitions(txn, versionNum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get partitions")
+	}
 
 	tableMap := make(map[string][]*storepb.TableMetadata)
 	query := `
@@ -342,6 +376,12 @@ func getTables(txn *sql.Tx) (map[string][]*storepb.TableMetadata, error) {
 		table.Columns = columnMap[key]
 		table.Indexes = indexMap[key]
 		table.ForeignKeys = foreignKeysMap[key]
+		table.Triggers = triggersMap[key]
+		if partition, ok := partitionMap[key]; ok {
+			table.PartitionType = partition.partitionType
+			table.PartitionExpression = partition.expression
+			table.Partitions = partition.partitions
+		}
 
 		tableMap[schemaName] = append(tableMap[schemaName], table)
 	}
@@ -479,6 +519,327 @@ func getExtensions(txn *sql.Tx) ([]*storepb.ExtensionMetadata, error) {
 	return extensions, nil
 }
 
+// getServerVersionNum gets the server version number, such as 90624 for 9.6.24 and 150002 for 15.2.
+func getServerVersionNum(txn *sql.Tx) (int, error) {
+	var versionNum int
+	if err := txn.QueryRow("SELECT current_setting('server_version_num')::int").Scan(&versionNum); err != nil {
+		return 0, err
+	}
+	return versionNum, nil
+}
+
+// getRoutines gets all functions and procedures of a database.
+// The routines installed by extensions are excluded.
+func getRoutines(txn *sql.Tx, versionNum int) (map[string][]*storepb.FunctionMetadata, map[string][]*storepb.ProcedureMetadata, error) {
+	// The prokind column is introduced in PostgreSQL 11 along with procedures.
+	kind := "p.prokind"
+	if versionNum < 110000 {
+		kind = "CASE WHEN p.proisagg THEN 'a' WHEN p.proiswindow THEN 'w' ELSE 'f' END"
+	}
+
+	functionMap := make(map[string][]*storepb.FunctionMetadata)
+	procedureMap := make(map[string][]*storepb.ProcedureMetadata)
+	// pg_get_functiondef() raises an error for aggregate functions, so it's only evaluated for functions and procedures.
+	query := `
+		SELECT
+			n.nspname,
+			p.proname,
+			` + kind + `,
+			pg_get_function_arguments(p.oid),
+			COALESCE(pg_get_function_result(p.oid), ''),
+			CASE WHEN ` + kind + ` IN ('f', 'p') THEN pg_get_functiondef(p.oid) ELSE '' END,
+			COALESCE(obj_description(p.oid, 'pg_proc'), '')
+		FROM pg_catalog.pg_proc p
+		JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+		WHERE n.nspname NOT IN ('pg_catalog', 'information_schema')
+			AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend d WHERE d.objid = p.oid AND d.deptype = 'e')
+		ORDER BY n.nspname, p.proname, p.oid;`
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, name, routineKind, arguments, result, definition, comment string
+		if err := rows.Scan(&schemaName, &name, &routineKind, &arguments, &result, &definition, &comment); err != nil {
+			return nil, nil, err
+		}
+		switch routineKind {
+		case "f":
+			functionMap[schemaName] = append(functionMap[schemaName], &storepb.FunctionMetadata{
+				Name:       name,
+				Arguments:  arguments,
+				ReturnType: result,
+				Definition: definition,
+				Comment:    comment,
+			})
+		case "p":
+			procedureMap[schemaName] = append(procedureMap[schemaName], &storepb.ProcedureMetadata{
+				Name:       name,
+				Arguments:  arguments,
+				Definition: definition,
+				Comment:    comment,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return functionMap, procedureMap, nil
+}
+
+// getSequences gets all sequences of a database.
+func getSequences(txn *sql.Tx, versionNum int) (map[string][]*storepb.SequenceMetadata, error) {
+	sequenceMap := make(map[string][]*storepb.SequenceMetadata)
+	// The pg_sequences view is introduced in PostgreSQL 10, the sequences of the earlier versions are skipped.
+	if versionNum < 100000 {
+		return sequenceMap, nil
+	}
+	query := `
+		SELECT
+			s.schemaname,
+			s.sequencename,
+			s.data_type::text,
+			s.start_value,
+			s.increment_by,
+			s.min_value,
+			s.max_value,
+			s.cycle,
+			COALESCE(t.relname, ''),
+			COALESCE(a.attname, '')
+		FROM pg_catalog.pg_sequences s
+		JOIN pg_catalog.pg_namespace n ON n.nspname = s.schemaname
+		JOIN pg_catalog.pg_class c ON c.relnamespace = n.oid AND c.relname = s.sequencename AND c.relkind = 'S'
+		LEFT JOIN pg_catalog.pg_depend d ON d.objid = c.oid AND d.classid = 'pg_catalog.pg_class'::regclass AND d.refclassid = 'pg_catalog.pg_class'::regclass AND d.deptype IN ('a', 'i')
+		LEFT JOIN pg_catalog.pg_class t ON t.oid = d.refobjid
+		LEFT JOIN pg_catalog.pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+		WHERE s.schemaname NOT IN ('pg_catalog', 'information_schema')
+		ORDER BY s.schemaname, s.sequencename;`
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		sequence := &storepb.SequenceMetadata{}
+		var schemaName string
+		if err := rows.Scan(
+			&schemaName,
+			&sequence.Name,
+			&sequence.DataType,
+			&sequence.Start,
+			&sequence.Increment,
+			&sequence.MinValue,
+			&sequence.MaxValue,
+			&sequence.Cycle,
+			&sequence.OwnerTable,
+			&sequence.OwnerColumn,
+		); err != nil {
+			return nil, err
+		}
+		sequenceMap[schemaName] = append(sequenceMap[schemaName], sequence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sequenceMap, nil
+}
+
+// getEnumTypes gets all enum types of a database.
+func getEnumTypes(txn *sql.Tx) (map[string][]*storepb.EnumTypeMetadata, error) {
+	enumTypeMap := make(map[string][]*storepb.EnumTypeMetadata)
+	query := `
+		SELECT n.nspname, t.typname, e.enumlabel
+		FROM pg_catalog.pg_type t
+		JOIN pg_catalog.pg_enum e ON e.enumtypid = t.oid
+		JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+		WHERE n.nspname NOT IN ('pg_catalog', 'information_schema')
+		ORDER BY n.nspname, t.typname, e.enumsortorder;`
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, typeName, label string
+		if err := rows.Scan(&schemaName, &typeName, &label); err != nil {
+			return nil, err
+		}
+		// The labels of an enum type are adjacent.
+		enumTypes := enumTypeMap[schemaName]
+		if n := len(enumTypes); n > 0 && enumTypes[n-1].Name == typeName {
+			enumTypes[n-1].Values = append(enumTypes[n-1].Values, label)
+			continue
+		}
+		enumTypeMap[schemaName] = append(enumTypes, &storepb.EnumTypeMetadata{
+			Name:   typeName,
+			Values: []string{label},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return enumTypeMap, nil
+}
+
+// getTriggers gets all triggers of a database.
+func getTriggers(txn *sql.Tx) (map[db.TableKey][]*storepb.TriggerMetadata, error) {
+	triggersMap := make(map[db.TableKey][]*storepb.TriggerMetadata)
+	query := `
+		SELECT n.nspname, c.relname, t.tgname, t.tgtype, pg_get_triggerdef(t.oid)
+		FROM pg_catalog.pg_trigger t
+		JOIN pg_catalog.pg_class c ON c.oid = t.tgrelid
+		JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+		WHERE NOT t.tgisinternal AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+		ORDER BY n.nspname, c.relname, t.tgname;`
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		trigger := &storepb.TriggerMetadata{}
+		var schemaName, tableName string
+		var triggerType int
+		if err := rows.Scan(&schemaName, &tableName, &trigger.Name, &triggerType, &trigger.Definition); err != nil {
+			return nil, err
+		}
+		trigger.Timing, trigger.Event = getTriggerTimingAndEvent(triggerType)
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		triggersMap[key] = append(triggersMap[key], trigger)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return triggersMap, nil
+}
+
+// getTriggerTimingAndEvent decodes the tgtype bits of pg_trigger.
+// https://github.com/postgres/postgres/blob/REL_15_STABLE/src/include/catalog/pg_trigger.h
+func getTriggerTimingAndEvent(triggerType int) (string, string) {
+	const (
+		triggerTypeBefore   = 1 << 1
+		triggerTypeInsert   = 1 << 2
+		triggerTypeDelete   = 1 << 3
+		triggerTypeUpdate   = 1 << 4
+		triggerTypeTruncate = 1 << 5
+		triggerTypeInstead  = 1 << 6
+	)
+	timing := "AFTER"
+	if triggerType&triggerTypeBefore != 0 {
+		timing = "BEFORE"
+	} else if triggerType&triggerTypeInstead != 0 {
+		timing = "INSTEAD OF"
+	}
+	var events []string
+	if triggerType&triggerTypeInsert != 0 {
+		events = append(events, "INSERT")
+	}
+	if triggerType&triggerTypeUpdate != 0 {
+		events = append(events, "UPDATE")
+	}
+	if triggerType&triggerTypeDelete != 0 {
+		events = append(events, "DELETE")
+	}
+	if triggerType&triggerTypeTruncate != 0 {
+		events = append(events, "TRUNCATE")
+	}
+	return timing, strings.Join(events, " OR ")
+}
+
+type tablePartition struct {
+	partitionType string
+	expression    string
+	partitions    []*storepb.TablePartitionMetadata
+}
+
+// getPartitions gets the partitions of all partitioned tables of a database.
+func getPartitions(txn *sql.Tx, versionNum int) (map[db.TableKey]*tablePartition, error) {
+	partitionMap := make(map[db.TableKey]*tablePartition)
+	// The declarative partitioning is introduced in PostgreSQL 10, so there is no partitioned table in the earlier versions.
+	if versionNum < 100000 {
+		return partitionMap, nil
+	}
+	query := `
+		SELECT n.nspname, c.relname, pg_get_partkeydef(c.oid)
+		FROM pg_catalog.pg_partitioned_table pt
+		JOIN pg_catalog.pg_class c ON c.oid = pt.partrelid
+		JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname NOT IN ('pg_catalog', 'information_schema');`
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, tableName, partitionKey string
+		if err := rows.Scan(&schemaName, &tableName, &partitionKey); err != nil {
+			return nil, err
+		}
+		partitionType, expression := splitPartitionKey(partitionKey)
+		partitionMap[db.TableKey{Schema: schemaName, Table: tableName}] = &tablePartition{
+			partitionType: partitionType,
+			expression:    expression,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	childQuery := `
+		SELECT pn.nspname, p.relname, c.relname, pg_get_expr(c.relpartbound, c.oid)
+		FROM pg_catalog.pg_inherits i
+		JOIN pg_catalog.pg_class c ON c.oid = i.inhrelid
+		JOIN pg_catalog.pg_class p ON p.oid = i.inhparent
+		JOIN pg_catalog.pg_namespace pn ON pn.oid = p.relnamespace
+		WHERE c.relispartition AND pn.nspname NOT IN ('pg_catalog', 'information_schema')
+		ORDER BY pn.nspname, p.relname, c.relname;`
+	childRows, err := txn.Query(childQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer childRows.Close()
+	childMap := make(map[db.TableKey][]*storepb.TablePartitionMetadata)
+	for childRows.Next() {
+		var schemaName, parentName string
+		partition := &storepb.TablePartitionMetadata{}
+		if err := childRows.Scan(&schemaName, &parentName, &partition.Name, &partition.Value); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: schemaName, Table: parentName}
+		childMap[key] = append(childMap[key], partition)
+	}
+	if err := childRows.Err(); err != nil {
+		return nil, err
+	}
+
+	for key, partition := range partitionMap {
+		partition.partitions = childMap[key]
+		// The partitions that are partitioned tables themselves are subpartitioned.
+		for _, child := range partition.partitions {
+			child.Subpartitions = childMap[db.TableKey{Schema: key.Schema, Table: child.Name}]
+		}
+	}
+	return partitionMap, nil
+}
+
+// splitPartitionKey splits the partition key definition such as "RANGE (created_ts)" into the partition type and expression.
+func splitPartitionKey(partitionKey string) (string, string) {
+	partitionType, expression, found := strings.Cut(partitionKey, " ")
+	if !found {
+		return partitionKey, ""
+	}
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "(") && strings.HasSuffix(expression, ")") {
+		expression = expression[1 : len(expression)-1]
+	}
+	return partitionType, expression
+}
+
 // getIndexes gets all indices of a database.
 func getIndexes(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, error) {
 	indexMap := make(map[db.TableKey][]*storepb.IndexMetadata)
@@ -496,7 +496,7 @@ func readRows(rows *sql.Rows, dbType db.Type, columnTypes []*sql.ColumnType, col
 		rowData := []interface{}{}
 		for i := range columnTypes {
 			if len(fieldList) > 0 && fieldList[i].Sensitive {
-				rowData = append(rowData, "******")
+				rowData = append(rowData, maskSensitiveData(fieldList[i].Mask, scanArgs[i]))
 				continue
 			}
 			if v, ok := (scanArgs[i]).(*sql.NullBool); ok && v.Valid {
@@ -739,7 +739,7 @@ func readRowsForClickhouse(rows *sql.Rows, columnTypes []*sql.ColumnType, column
 		rowData := []interface{}{}
 		for i := range cols {
 			if len(fieldList) > 0 && fieldList[i].Sensitive {
-				rowData = append(rowData, "******")
+				rowData = append(rowData, maskSensitiveData(fieldList[i].Mask, cols[i]))
 				continue
 			}
 
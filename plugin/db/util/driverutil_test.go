@@ -1,6 +1,7 @@
 package util
 
 import (
+	"database/sql"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -713,3 +714,419 @@ func TestExtractSensitiveField(t *testing.T) {
 		require.Equal(t, test.fieldList, res, test.statement)
 	}
 }
+
+func TestExtractPostgreSQLSensitiveField(t *testing.T) {
+	const (
+		defaultDatabase = "db"
+	)
+	var (
+		defaultDatabaseSchema = &db.SensitiveSchemaInfo{
+			DatabaseList: []db.DatabaseSchema{
+				{
+					Name: defaultDatabase,
+					SchemaList: []db.SchemaSchema{
+						{
+							Name: "public",
+							TableList: []db.TableSchema{
+								{
+									Name: "t",
+									ColumnList: []db.ColumnInfo{
+										{
+											Name:      "a",
+											Sensitive: true,
+										},
+										{
+											Name:      "b",
+											Sensitive: false,
+										},
+										{
+											Name:      "c",
+											Sensitive: false,
+										},
+									},
+								},
+							},
+						},
+						{
+							Name: "s",
+							TableList: []db.TableSchema{
+								{
+									Name: "t",
+									ColumnList: []db.ColumnInfo{
+										{
+											Name:      "a",
+											Sensitive: false,
+										},
+										{
+											Name:      "b",
+											Sensitive: true,
+										},
+									},
+								},
+								{
+									Name: "u",
+									ColumnList: []db.ColumnInfo{
+										{
+											Name:      "x",
+											Sensitive: true,
+										},
+										{
+											Name:      "y",
+											Sensitive: false,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	)
+	tests := []struct {
+		statement  string
+		schemaInfo *db.SensitiveSchemaInfo
+		fieldList  []db.SensitiveField
+	}{
+		{
+			// Test for the result limit wrapper.
+			statement:  `WITH result AS (SELECT * FROM t) SELECT * FROM result LIMIT 10000;`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "a", Sensitive: true}, {Name: "b", Sensitive: false}, {Name: "c", Sensitive: false}},
+		},
+		{
+			// Test for explicit schema name.
+			statement:  `SELECT s.t.a, t.b FROM s.t`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "a", Sensitive: false}, {Name: "b", Sensitive: true}},
+		},
+		{
+			// Test for join with USING and qualified star.
+			statement:  `SELECT x.*, y.b AS yb FROM public.t AS x JOIN s.t AS y USING (a)`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "a", Sensitive: true}, {Name: "b", Sensitive: false}, {Name: "c", Sensitive: false}, {Name: "yb", Sensitive: true}},
+		},
+		{
+			// Test for natural join.
+			statement:  `SELECT * FROM s.t NATURAL JOIN (SELECT a, c FROM public.t) AS x`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "a", Sensitive: true}, {Name: "b", Sensitive: true}, {Name: "c", Sensitive: false}},
+		},
+		{
+			// Test for expressions and associated sub-query.
+			statement:  `SELECT b || 'x', CASE WHEN c > 0 THEN 1 ELSE 0 END, (SELECT max(x.a) FROM t AS x WHERE x.b = t.b) AS m, a::text FROM t`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "?column?", Sensitive: false}, {Name: "case", Sensitive: false}, {Name: "m", Sensitive: true}, {Name: "a", Sensitive: true}},
+		},
+		{
+			// Test for set operation.
+			statement:  `SELECT b, c FROM t UNION ALL SELECT a, a FROM t EXCEPT SELECT b, c FROM t`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "b", Sensitive: true}, {Name: "c", Sensitive: true}},
+		},
+		{
+			// Test for Recursive Common Table Expression dependent closures.
+			statement: `
+				WITH RECURSIVE t1(cc1, cc2, cc3, n) AS (
+					SELECT b, c, c, 1 FROM t
+					UNION
+					SELECT cc2, cc3, cc1 + n, n + 1 FROM t1 WHERE n < 5
+				), t2 AS (SELECT a FROM t)
+				SELECT t1.*, t2.a FROM t1, t2;
+			`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "cc1", Sensitive: false}, {Name: "cc2", Sensitive: false}, {Name: "cc3", Sensitive: false}, {Name: "n", Sensitive: false}, {Name: "a", Sensitive: true}},
+		},
+		{
+			// Test for Recursive Common Table Expression propagating the sensitive column.
+			statement: `
+				WITH RECURSIVE t1(cc1, cc2, cc3, n) AS (
+					SELECT a, b, c, 1 FROM t
+					UNION
+					SELECT cc3, cc1, cc2, n + 1 FROM t1 WHERE n < 5
+				)
+				SELECT * FROM t1;
+			`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "cc1", Sensitive: true}, {Name: "cc2", Sensitive: true}, {Name: "cc3", Sensitive: true}, {Name: "n", Sensitive: false}},
+		},
+		{
+			// Test for LATERAL sub-query and the alias column list.
+			statement:  `SELECT x.*, y.* FROM t AS x(p, q), LATERAL (SELECT p AS r) AS y`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "p", Sensitive: true}, {Name: "q", Sensitive: false}, {Name: "c", Sensitive: false}, {Name: "r", Sensitive: true}},
+		},
+		{
+			// Test for whole-row reference.
+			statement:  `SELECT t FROM t`,
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "t", Sensitive: true}},
+		},
+		{
+			// Test for no FROM clause.
+			statement:  "SELECT 1;",
+			schemaInfo: &db.SensitiveSchemaInfo{},
+			fieldList:  []db.SensitiveField{{Name: "?column?", Sensitive: false}},
+		},
+		{
+			// Test for SHOW statement.
+			statement:  "SHOW search_path",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  nil,
+		},
+		{
+			// Test for SET statement.
+			statement:  "SET search_path TO s, public",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  nil,
+		},
+		{
+			// Test for multi-statement with only one query.
+			statement:  "SET statement_timeout = 1000; SELECT a FROM t;",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "a", Sensitive: true}},
+		},
+		{
+			// Test for system catalogs.
+			statement:  "SELECT relname, relkind FROM pg_catalog.pg_class",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "relname", Sensitive: false}, {Name: "relkind", Sensitive: false}},
+		},
+		{
+			// Test for information schema.
+			statement:  "SELECT * FROM information_schema.tables WHERE table_schema = 'public'",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  nil,
+		},
+		{
+			// Test for unqualified system catalogs.
+			statement:  "SELECT c.* FROM pg_class c JOIN pg_namespace n ON c.relnamespace = n.oid",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  nil,
+		},
+		{
+			// Test for set-returning functions in FROM clause.
+			statement:  "SELECT g, g * 2 AS double FROM generate_series(1, 10) AS g",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "g", Sensitive: false}, {Name: "double", Sensitive: false}},
+		},
+		{
+			// Test for set-returning functions with sensitive arguments.
+			statement:  "SELECT e, t.b FROM t, unnest(ARRAY[t.a]) AS e",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "e", Sensitive: true}, {Name: "b", Sensitive: false}},
+		},
+		{
+			// Test for the table in non-public schema found through search_path.
+			statement:  "SELECT * FROM u",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "x", Sensitive: true}, {Name: "y", Sensitive: false}},
+		},
+		{
+			// Test for the explicit columns of the known tables joined with the system catalogs.
+			statement:  "SELECT t.a, c.relname FROM t JOIN pg_catalog.pg_class c ON t.b = c.relname",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "a", Sensitive: true}, {Name: "relname", Sensitive: false}},
+		},
+		{
+			// Test for the sub-query column hiding the outer one.
+			statement:  "SELECT (SELECT a FROM public.t LIMIT 1) AS x FROM s.t",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "x", Sensitive: true}},
+		},
+		{
+			// Test for the correlated sub-query referencing the outer column.
+			statement:  "SELECT (SELECT max(c) FROM public.t WHERE c = x.b) AS m, (SELECT x.b FROM public.t LIMIT 1) AS n FROM s.t AS x",
+			schemaInfo: defaultDatabaseSchema,
+			fieldList:  []db.SensitiveField{{Name: "m", Sensitive: false}, {Name: "n", Sensitive: true}},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := extractSensitiveField(db.Postgres, test.statement, defaultDatabase, test.schemaInfo)
+		require.NoError(t, err)
+		require.Equal(t, test.fieldList, res, test.statement)
+	}
+
+	failedTests := []string{
+		// The columns of the system catalogs cannot be told from the sensitive ones.
+		"SELECT * FROM pg_catalog.pg_class c JOIN t ON c.relname = t.b",
+		// The view or the table not synced yet may read the sensitive columns.
+		"SELECT * FROM v_sensitive",
+		"SELECT t.b FROM t JOIN s.new_table n USING (b)",
+		// The user-defined function may read the sensitive columns.
+		"SELECT * FROM get_users()",
+		"SELECT * FROM public.unnest(ARRAY[1])",
+		// The set-returning function reads the sensitive column.
+		"SELECT e.* FROM t, unnest(ARRAY[t.a]) AS e",
+		// Only one query can be checked in one statement.
+		"SELECT a FROM t; SELECT b FROM t;",
+	}
+	for _, statement := range failedTests {
+		_, err := extractSensitiveField(db.Postgres, statement, defaultDatabase, defaultDatabaseSchema)
+		require.Error(t, err, statement)
+	}
+}
+
+func TestExtractSensitiveFieldMask(t *testing.T) {
+	const (
+		defaultDatabase = "db"
+	)
+	var (
+		emailMask   = db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeEmail}
+		partialMask = db.SensitiveDataMask{Type: db.SensitiveDataMaskTypePartial, Option: db.SensitiveDataMaskOption{PrefixLength: 1, SuffixLength: 1}}
+		columnList  = []db.ColumnInfo{
+			{
+				Name:      "a",
+				Sensitive: true,
+				Mask:      emailMask,
+			},
+			{
+				Name:      "b",
+				Sensitive: true,
+				Mask:      partialMask,
+			},
+			{
+				Name:      "c",
+				Sensitive: false,
+			},
+		}
+		schemaInfo = &db.SensitiveSchemaInfo{
+			DatabaseList: []db.DatabaseSchema{
+				{
+					Name:      defaultDatabase,
+					TableList: []db.TableSchema{{Name: "t", ColumnList: columnList}},
+					SchemaList: []db.SchemaSchema{
+						{
+							Name:      "public",
+							TableList: []db.TableSchema{{Name: "t", ColumnList: columnList}},
+						},
+					},
+				},
+			},
+		}
+	)
+	tests := []struct {
+		dbType    db.Type
+		statement string
+		fieldList []db.SensitiveField
+	}{
+		{
+			// Test for the column reference keeping the mask of the column.
+			dbType:    db.MySQL,
+			statement: `SELECT x.a, b AS bb, concat(a, c) FROM t AS x`,
+			fieldList: []db.SensitiveField{{Name: "a", Sensitive: true, Mask: emailMask}, {Name: "bb", Sensitive: true, Mask: partialMask}, {Name: "concat(a, c)", Sensitive: true}},
+		},
+		{
+			// Test for merging the different masks in set operation.
+			dbType:    db.MySQL,
+			statement: `SELECT a, b, c FROM t UNION SELECT a, a, b FROM t`,
+			fieldList: []db.SensitiveField{{Name: "a", Sensitive: true, Mask: emailMask}, {Name: "b", Sensitive: true}, {Name: "c", Sensitive: true, Mask: partialMask}},
+		},
+		{
+			// Test for the column reference keeping the mask of the column.
+			dbType:    db.Postgres,
+			statement: `WITH result AS (SELECT x.a, b AS bb, a || c FROM t AS x) SELECT * FROM result LIMIT 10000;`,
+			fieldList: []db.SensitiveField{{Name: "a", Sensitive: true, Mask: emailMask}, {Name: "bb", Sensitive: true, Mask: partialMask}, {Name: "?column?", Sensitive: true}},
+		},
+		{
+			// Test for merging the different masks in set operation.
+			dbType:    db.Postgres,
+			statement: `SELECT a, b, c FROM t UNION SELECT a, a, b FROM t`,
+			fieldList: []db.SensitiveField{{Name: "a", Sensitive: true, Mask: emailMask}, {Name: "b", Sensitive: true}, {Name: "c", Sensitive: true, Mask: partialMask}},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := extractSensitiveField(test.dbType, test.statement, defaultDatabase, schemaInfo)
+		require.NoError(t, err)
+		require.Equal(t, test.fieldList, res, test.statement)
+	}
+}
+
+func TestMaskSensitiveData(t *testing.T) {
+	tests := []struct {
+		mask  db.SensitiveDataMask
+		value interface{}
+		want  interface{}
+	}{
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeDefault},
+			value: &sql.NullString{String: "secret", Valid: true},
+			want:  "******",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeHash},
+			value: &sql.NullString{},
+			want:  "******",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypePartial, Option: db.SensitiveDataMaskOption{PrefixLength: 2, SuffixLength: 1}},
+			value: &sql.NullString{String: "bytebase", Valid: true},
+			want:  "by******e",
+		},
+		{
+			// Keeping all characters is not masking at all.
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypePartial, Option: db.SensitiveDataMaskOption{PrefixLength: 2, SuffixLength: 2}},
+			value: &sql.NullString{String: "abc", Valid: true},
+			want:  "******",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeEmail},
+			value: &sql.NullString{String: "support@bytebase.com", Valid: true},
+			want:  "s******@bytebase.com",
+		},
+		{
+			// The one character local part isn't kept, otherwise nothing is masked.
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeEmail},
+			value: &sql.NullString{String: "a@example.com", Valid: true},
+			want:  "******@example.com",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeEmail},
+			value: &sql.NullString{String: "not an email", Valid: true},
+			want:  "******",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypePhone},
+			value: &sql.NullString{String: "+1 (555) 123-4567", Valid: true},
+			want:  "+* (***) ***-4567",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeHash, HashKey: "secret"},
+			value: &sql.NullInt64{Int64: 42, Valid: true},
+			want:  "93c121e7aa437a1e01e3c512c6f0ce3c821a839025dca4408f85616de4aaee70",
+		},
+		{
+			// The unkeyed digest can be reversed by the dictionary attack.
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeHash},
+			value: &sql.NullInt64{Int64: 42, Valid: true},
+			want:  "******",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeRange, Option: db.SensitiveDataMaskOption{RangeWidth: 10}},
+			value: &sql.NullInt64{Int64: 42, Valid: true},
+			want:  "[40, 50)",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeRange, Option: db.SensitiveDataMaskOption{RangeWidth: 1000}},
+			value: &sql.NullString{String: "-1234.5", Valid: true},
+			want:  "[-2000, -1000)",
+		},
+		{
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeRange, Option: db.SensitiveDataMaskOption{RangeWidth: 10}},
+			value: &sql.NullString{String: "abc", Valid: true},
+			want:  "******",
+		},
+		{
+			// The ClickHouse driver scans the nullable values into pointer to pointer.
+			mask:  db.SensitiveDataMask{Type: db.SensitiveDataMaskTypeRange, Option: db.SensitiveDataMaskOption{RangeWidth: 10}},
+			value: func() interface{} { v := int32(7); p := &v; return &p }(),
+			want:  "[0, 10)",
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, maskSensitiveData(test.mask, test.value), test.mask)
+	}
+}
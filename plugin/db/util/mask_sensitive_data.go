@@ -34,6 +34,12 @@ func extractSensitiveField(dbType db.Type, statement string, currentDatabase str
 			schemaInfo:      schemaInfo,
 		}
 		return extractor.extractMySQLSensitiveField(statement)
+	case db.Postgres:
+		extractor := &sensitiveFieldExtractor{
+			currentDatabase: currentDatabase,
+			schemaInfo:      schemaInfo,
+		}
+		return extractor.extractPostgreSQLSensitiveField(statement)
 	default:
 		return nil, nil
 	}
@@ -70,16 +76,37 @@ func (extractor *sensitiveFieldExtractor) extractMySQLSensitiveField(statement s
 		result = append(result, db.SensitiveField{
 			Name:      field.name,
 			Sensitive: field.sensitive,
+			Mask:      field.mask,
 		})
 	}
 	return result, nil
 }
 
 type fieldInfo struct {
-	name      string
-	table     string
+	name  string
+	table string
+	// schema is only used by the engines having the schema level, such as PostgreSQL.
+	schema    string
 	database  string
 	sensitive bool
+	// mask is the mask for the sensitive field, only meaningful if sensitive is true.
+	mask db.SensitiveDataMask
+}
+
+// mergeSensitive merges the sensitive attribute of the field into the target, such as for UNION and NATURAL JOIN.
+// The target falls back to the DEFAULT mask if the two fields are sensitive with different masks.
+func mergeSensitive(target *fieldInfo, field fieldInfo) {
+	if !field.sensitive {
+		return
+	}
+	if !target.sensitive {
+		target.sensitive = true
+		target.mask = field.mask
+		return
+	}
+	if target.mask != field.mask {
+		target.mask = db.SensitiveDataMask{}
+	}
 }
 
 func (extractor *sensitiveFieldExtractor) extractNode(in tidbast.Node) ([]fieldInfo, error) {
@@ -131,9 +158,7 @@ func (extractor *sensitiveFieldExtractor) extractSetOpr(node *tidbast.SetOprStmt
 				return nil, errors.Errorf("The used SELECT statements have a different number of columns")
 			}
 			for index := 0; index < len(result); index++ {
-				if fieldList[index].sensitive {
-					result[index].sensitive = true
-				}
+				mergeSensitive(&result[index], fieldList[index])
 			}
 		}
 	}
@@ -211,6 +236,7 @@ func (extractor *sensitiveFieldExtractor) extractRecursiveCTE(node *tidbast.Comm
 			cteInfo.ColumnList = append(cteInfo.ColumnList, db.ColumnInfo{
 				Name:      field.name,
 				Sensitive: field.sensitive,
+				Mask:      field.mask,
 			})
 		}
 
@@ -244,9 +270,12 @@ func (extractor *sensitiveFieldExtractor) extractRecursiveCTE(node *tidbast.Comm
 
 			changed := false
 			for i, field := range fieldList {
-				if field.sensitive && !cteInfo.ColumnList[i].Sensitive {
+				column := fieldInfo{sensitive: cteInfo.ColumnList[i].Sensitive, mask: cteInfo.ColumnList[i].Mask}
+				mergeSensitive(&column, field)
+				if column.sensitive != cteInfo.ColumnList[i].Sensitive || column.mask != cteInfo.ColumnList[i].Mask {
 					changed = true
-					cteInfo.ColumnList[i].Sensitive = true
+					cteInfo.ColumnList[i].Sensitive = column.sensitive
+					cteInfo.ColumnList[i].Mask = column.mask
 				}
 			}
 
@@ -283,6 +312,7 @@ func (extractor *sensitiveFieldExtractor) extractNonRecursiveCTE(node *tidbast.C
 		result.ColumnList = append(result.ColumnList, db.ColumnInfo{
 			Name:      field.name,
 			Sensitive: field.sensitive,
+			Mask:      field.mask,
 		})
 	}
 	return result, nil
@@ -345,11 +375,20 @@ func (extractor *sensitiveFieldExtractor) extractSelect(node *tidbast.SelectStmt
 					return nil, err
 				}
 				fieldName := extractFieldName(field)
+				// Only the field referencing the column directly keeps the mask of the column.
+				// Other sensitive expressions use the DEFAULT mask.
+				var mask db.SensitiveDataMask
+				if columnName, ok := field.Expr.(*tidbast.ColumnNameExpr); ok && sensitive {
+					if column, exists := extractor.findField(columnName.Name.Schema.O, columnName.Name.Table.O, columnName.Name.Name.O); exists {
+						mask = column.mask
+					}
+				}
 				result = append(result, fieldInfo{
 					database:  "",
 					table:     "",
 					name:      fieldName,
 					sensitive: sensitive,
+					mask:      mask,
 				})
 			}
 		}
@@ -373,6 +412,11 @@ func extractFieldName(in *tidbast.SelectField) string {
 }
 
 func (extractor *sensitiveFieldExtractor) checkFieldSensitive(databaseName string, tableName string, fieldName string) bool {
+	field, exists := extractor.findField(databaseName, tableName, fieldName)
+	return exists && field.sensitive
+}
+
+func (extractor *sensitiveFieldExtractor) findField(databaseName string, tableName string, fieldName string) (fieldInfo, bool) {
 	// One sub-query may have multi-outer schemas and the multi-outer schemas can use the same name, such as:
 	//
 	//  select (
@@ -392,7 +436,7 @@ func (extractor *sensitiveFieldExtractor) checkFieldSensitive(databaseName strin
 		sameTable := (tableName == field.table || tableName == "")
 		sameField := (fieldName == field.name)
 		if sameDatabase && sameTable && sameField {
-			return field.sensitive
+			return field, true
 		}
 	}
 
@@ -401,11 +445,11 @@ func (extractor *sensitiveFieldExtractor) checkFieldSensitive(databaseName strin
 		sameTable := (tableName == field.table || tableName == "")
 		sameField := (fieldName == field.name)
 		if sameDatabase && sameTable && sameField {
-			return field.sensitive
+			return field, true
 		}
 	}
 
-	return false
+	return fieldInfo{}, false
 }
 
 func (extractor *sensitiveFieldExtractor) extractColumnFromExprNode(in tidbast.ExprNode) (sensitive bool, err error) {
@@ -527,6 +571,7 @@ func (extractor *sensitiveFieldExtractor) extractTableSource(node *tidbast.Table
 				table:     node.AsName.O,
 				database:  field.database,
 				sensitive: field.sensitive,
+				mask:      field.mask,
 			})
 		}
 	} else {
@@ -581,6 +626,7 @@ func (extractor *sensitiveFieldExtractor) extractTableName(node *tidbast.TableNa
 			table:     tableSchema.Name,
 			database:  databaseName,
 			sensitive: column.Sensitive,
+			mask:      column.Mask,
 		})
 	}
 	return res, nil
@@ -618,8 +664,8 @@ func mergeJoinField(node *tidbast.Join, leftField []fieldInfo, rightField []fiel
 		// Natural Join will merge the same column name field.
 		for _, field := range leftField {
 			// Merge the sensitive attribute for the same column name field.
-			if rField, exists := rightFieldMap[strings.ToLower(field.name)]; exists && rField.sensitive {
-				field.sensitive = true
+			if rField, exists := rightFieldMap[strings.ToLower(field.name)]; exists {
+				mergeSensitive(&field, rField)
 			}
 			result = append(result, field)
 		}
@@ -642,8 +688,8 @@ func mergeJoinField(node *tidbast.Join, leftField []fieldInfo, rightField []fiel
 				_, existsInUsingMap := usingMap[strings.ToLower(field.name)]
 				rField, existsInRightField := rightFieldMap[strings.ToLower(field.name)]
 				// Merge the sensitive attribute for the column name field in USING.
-				if existsInUsingMap && existsInRightField && rField.sensitive {
-					field.sensitive = true
+				if existsInUsingMap && existsInRightField {
+					mergeSensitive(&field, rField)
 				}
 				result = append(result, field)
 			}
@@ -0,0 +1,1010 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	pgquery "github.com/pganalyze/pg_query_go/v2"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/db"
+)
+
+const (
+	// pgDefaultSchema is the schema preferred for the unqualified table names.
+	// We don't know the search_path of the session, so the other schemas are only searched if the table isn't in it.
+	pgDefaultSchema = "public"
+	// pgUnknownFieldName is the field name PostgreSQL uses if it cannot infer a name for the target.
+	pgUnknownFieldName = "?column?"
+	// pgUnknownColumnsName is the name of the field standing for all columns of a relation missing in the schema info,
+	// such as the system catalogs and the set-returning functions.
+	// The empty name never conflicts with a real column, and it's kept by the CTEs converted to db.TableSchema.
+	pgUnknownColumnsName = ""
+	// pgCatalogSchema is the schema of the system catalogs, which is always searched first for the unqualified names.
+	pgCatalogSchema = "pg_catalog"
+	// pgInformationSchema is the schema of the information schema views.
+	pgInformationSchema = "information_schema"
+)
+
+// pgBuiltinSetReturningFunctions is the set-returning functions in pg_catalog, whose result comes from their arguments only.
+var pgBuiltinSetReturningFunctions = map[string]bool{
+	"generate_series":           true,
+	"generate_subscripts":       true,
+	"unnest":                    true,
+	"regexp_matches":            true,
+	"regexp_split_to_table":     true,
+	"string_to_table":           true,
+	"json_array_elements":       true,
+	"json_array_elements_text":  true,
+	"json_each":                 true,
+	"json_each_text":            true,
+	"json_object_keys":          true,
+	"json_populate_recordset":   true,
+	"json_to_recordset":         true,
+	"jsonb_array_elements":      true,
+	"jsonb_array_elements_text": true,
+	"jsonb_each":                true,
+	"jsonb_each_text":           true,
+	"jsonb_object_keys":         true,
+	"jsonb_populate_recordset":  true,
+	"jsonb_to_recordset":        true,
+	"jsonb_path_query":          true,
+}
+
+func (extractor *sensitiveFieldExtractor) extractPostgreSQLSensitiveField(statement string) ([]db.SensitiveField, error) {
+	res, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, err
+	}
+	var queryList []*pgquery.Node
+	for _, stmt := range res.Stmts {
+		switch stmt.Stmt.Node.(type) {
+		case *pgquery.Node_SelectStmt:
+			queryList = append(queryList, stmt.Stmt)
+		case *pgquery.Node_ExecuteStmt, *pgquery.Node_FetchStmt:
+			// The result columns of the prepared statements and the cursors are unknown.
+			return nil, errors.Errorf("cannot check the sensitive data of %T", stmt.Stmt.Node)
+		}
+	}
+	// Other statements, such as SHOW, SET and EXPLAIN, don't return the table data.
+	if len(queryList) == 0 {
+		return nil, nil
+	}
+	if len(queryList) > 1 {
+		return nil, errors.Errorf("cannot check the sensitive data of %d queries in one statement, please run them one by one", len(queryList))
+	}
+
+	fieldList, err := extractor.pgExtractNode(queryList[0])
+	if err != nil {
+		return nil, err
+	}
+	if pgHasUnknownColumns(fieldList) {
+		for _, field := range fieldList {
+			if field.sensitive {
+				return nil, errors.Errorf("cannot mask the sensitive data along with the columns of the system catalogs or the set-returning functions, please select the columns explicitly")
+			}
+		}
+		// Nothing to mask, and the number of the result columns is unknown.
+		return nil, nil
+	}
+
+	result := []db.SensitiveField{}
+	for _, field := range fieldList {
+		result = append(result, db.SensitiveField{
+			Name:      field.name,
+			Sensitive: field.sensitive,
+			Mask:      field.mask,
+		})
+	}
+	return result, nil
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractNode(in *pgquery.Node) ([]fieldInfo, error) {
+	return extractor.pgExtractNodeWithPrevious(in, nil)
+}
+
+// pgExtractNodeWithPrevious extracts the fields of the node, where the fields of the previous FROM items are visible to
+// the function calls in FROM, which are implicitly LATERAL.
+func (extractor *sensitiveFieldExtractor) pgExtractNodeWithPrevious(in *pgquery.Node, previousFieldList []fieldInfo) ([]fieldInfo, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	switch node := in.Node.(type) {
+	case *pgquery.Node_SelectStmt:
+		return extractor.pgExtractSelect(node.SelectStmt)
+	case *pgquery.Node_RangeVar:
+		return extractor.pgExtractRangeVar(node.RangeVar)
+	case *pgquery.Node_RangeSubselect:
+		return extractor.pgExtractRangeSubselect(node.RangeSubselect)
+	case *pgquery.Node_JoinExpr:
+		return extractor.pgExtractJoin(node.JoinExpr)
+	case *pgquery.Node_RangeFunction:
+		return extractor.pgExtractRangeFunction(node.RangeFunction, previousFieldList)
+	}
+	return nil, nil
+}
+
+// pgExtractRangeFunction extracts the function calls in FROM, such as "generate_series(1, 3)".
+// We cannot infer the result columns without the function definition, so they are unknown columns,
+// which are sensitive if any argument is sensitive.
+// The user-defined functions may read the sensitive columns, so only the built-in functions are allowed.
+func (extractor *sensitiveFieldExtractor) pgExtractRangeFunction(node *pgquery.RangeFunction, previousFieldList []fieldInfo) ([]fieldInfo, error) {
+	for _, function := range node.Functions {
+		list, ok := function.Node.(*pgquery.Node_List)
+		if !ok || len(list.List.Items) == 0 {
+			return nil, errors.Errorf("expect List but found %T", function.Node)
+		}
+		funcCall, ok := list.List.Items[0].Node.(*pgquery.Node_FuncCall)
+		if !ok {
+			return nil, errors.Errorf("expect FuncCall but found %T", list.List.Items[0].Node)
+		}
+		nameList, err := pgColumnRefNameList(funcCall.FuncCall.Funcname)
+		if err != nil {
+			return nil, err
+		}
+		if !pgIsBuiltinSetReturningFunction(nameList) {
+			return nil, errors.Errorf("cannot check the sensitive data of the function %q, please query the tables directly", strings.Join(nameList, "."))
+		}
+	}
+	argExtractor := &sensitiveFieldExtractor{
+		currentDatabase:    extractor.currentDatabase,
+		schemaInfo:         extractor.schemaInfo,
+		outerSchemaInfo:    append(append([]fieldInfo{}, extractor.outerSchemaInfo...), previousFieldList...),
+		cteOuterSchemaInfo: extractor.cteOuterSchemaInfo,
+	}
+	sensitive, err := argExtractor.pgExtractColumnFromExprList(node.Functions)
+	if err != nil {
+		return nil, err
+	}
+	field := fieldInfo{
+		name:      pgUnknownColumnsName,
+		database:  extractor.currentDatabase,
+		sensitive: sensitive,
+	}
+	// The table name of the function call without alias is the function name.
+	if len(node.Functions) == 1 {
+		if list, ok := node.Functions[0].Node.(*pgquery.Node_List); ok && len(list.List.Items) > 0 {
+			field.table = pgExtractExprName(list.List.Items[0])
+		}
+	}
+	return pgApplyAlias([]fieldInfo{field}, node.Alias)
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractWith(node *pgquery.WithClause) error {
+	for _, cte := range node.Ctes {
+		in, ok := cte.Node.(*pgquery.Node_CommonTableExpr)
+		if !ok {
+			return errors.Errorf("expect CommonTableExpr but found %T", cte.Node)
+		}
+		var cteTable db.TableSchema
+		var err error
+		if node.Recursive {
+			cteTable, err = extractor.pgExtractRecursiveCTE(in.CommonTableExpr)
+		} else {
+			cteTable, err = extractor.pgExtractNonRecursiveCTE(in.CommonTableExpr)
+		}
+		if err != nil {
+			return err
+		}
+		extractor.cteOuterSchemaInfo = append(extractor.cteOuterSchemaInfo, cteTable)
+	}
+	return nil
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractNonRecursiveCTE(node *pgquery.CommonTableExpr) (db.TableSchema, error) {
+	fieldList, err := extractor.pgExtractNode(node.Ctequery)
+	if err != nil {
+		return db.TableSchema{}, err
+	}
+	if err := pgRenameFieldList(fieldList, node.Aliascolnames); err != nil {
+		return db.TableSchema{}, err
+	}
+	result := db.TableSchema{
+		Name:       node.Ctename,
+		ColumnList: []db.ColumnInfo{},
+	}
+	for _, field := range fieldList {
+		result.ColumnList = append(result.ColumnList, db.ColumnInfo{
+			Name:      field.name,
+			Sensitive: field.sensitive,
+			Mask:      field.mask,
+		})
+	}
+	return result, nil
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractRecursiveCTE(node *pgquery.CommonTableExpr) (db.TableSchema, error) {
+	query, ok := node.Ctequery.Node.(*pgquery.Node_SelectStmt)
+	if !ok {
+		return extractor.pgExtractNonRecursiveCTE(node)
+	}
+	// The recursive CTE in PostgreSQL must be in the form of "non_recursive_term UNION [ALL] recursive_term".
+	if query.SelectStmt.Op != pgquery.SetOperation_SETOP_UNION || query.SelectStmt.WithClause != nil {
+		return extractor.pgExtractNonRecursiveCTE(node)
+	}
+
+	initialField, err := extractor.pgExtractSelect(query.SelectStmt.Larg)
+	if err != nil {
+		return db.TableSchema{}, err
+	}
+	if err := pgRenameFieldList(initialField, node.Aliascolnames); err != nil {
+		return db.TableSchema{}, err
+	}
+	cteInfo := db.TableSchema{Name: node.Ctename}
+	for _, field := range initialField {
+		cteInfo.ColumnList = append(cteInfo.ColumnList, db.ColumnInfo{
+			Name:      field.name,
+			Sensitive: field.sensitive,
+			Mask:      field.mask,
+		})
+	}
+
+	// Compute dependent closures by simulating the recursive process, see the comment in extractRecursiveCTE.
+	extractor.cteOuterSchemaInfo = append(extractor.cteOuterSchemaInfo, cteInfo)
+	defer func() {
+		extractor.cteOuterSchemaInfo = extractor.cteOuterSchemaInfo[:len(extractor.cteOuterSchemaInfo)-1]
+	}()
+	for {
+		fieldList, err := extractor.pgExtractSelect(query.SelectStmt.Rarg)
+		if err != nil {
+			return db.TableSchema{}, err
+		}
+		if pgHasUnknownColumns(fieldList) || pgHasUnknownColumns(initialField) {
+			// The columns cannot be matched by position, so all of them are unknown columns.
+			cteInfo.ColumnList = []db.ColumnInfo{pgMergeUnknownColumns(initialField, fieldList).toColumnInfo()}
+			break
+		}
+		if len(fieldList) != len(cteInfo.ColumnList) {
+			// The error content comes from PostgreSQL.
+			return db.TableSchema{}, errors.Errorf("each UNION query must have the same number of columns")
+		}
+
+		changed := false
+		for i, field := range fieldList {
+			column := fieldInfo{sensitive: cteInfo.ColumnList[i].Sensitive, mask: cteInfo.ColumnList[i].Mask}
+			mergeSensitive(&column, field)
+			if column.sensitive != cteInfo.ColumnList[i].Sensitive || column.mask != cteInfo.ColumnList[i].Mask {
+				changed = true
+				cteInfo.ColumnList[i].Sensitive = column.sensitive
+				cteInfo.ColumnList[i].Mask = column.mask
+			}
+		}
+
+		if !changed {
+			break
+		}
+		extractor.cteOuterSchemaInfo[len(extractor.cteOuterSchemaInfo)-1] = cteInfo
+	}
+	return cteInfo, nil
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractSelect(node *pgquery.SelectStmt) ([]fieldInfo, error) {
+	if node == nil {
+		return nil, nil
+	}
+
+	if node.WithClause != nil {
+		cteOuterLength := len(extractor.cteOuterSchemaInfo)
+		defer func() {
+			extractor.cteOuterSchemaInfo = extractor.cteOuterSchemaInfo[:cteOuterLength]
+		}()
+		if err := extractor.pgExtractWith(node.WithClause); err != nil {
+			return nil, err
+		}
+	}
+
+	// The set operation, such as UNION, INTERSECT and EXCEPT.
+	if node.Op != pgquery.SetOperation_SETOP_NONE && node.Op != pgquery.SetOperation_SET_OPERATION_UNDEFINED {
+		leftField, err := extractor.pgExtractSelect(node.Larg)
+		if err != nil {
+			return nil, err
+		}
+		rightField, err := extractor.pgExtractSelect(node.Rarg)
+		if err != nil {
+			return nil, err
+		}
+		if pgHasUnknownColumns(leftField) || pgHasUnknownColumns(rightField) {
+			// The columns cannot be matched by position, so all of them are unknown columns.
+			return []fieldInfo{pgMergeUnknownColumns(leftField, rightField)}, nil
+		}
+		if len(leftField) != len(rightField) {
+			// The error content comes from PostgreSQL.
+			return nil, errors.Errorf("each UNION query must have the same number of columns")
+		}
+		for i := range leftField {
+			mergeSensitive(&leftField[i], rightField[i])
+		}
+		return leftField, nil
+	}
+
+	// The VALUES list.
+	if len(node.ValuesLists) > 0 {
+		var result []fieldInfo
+		for _, row := range node.ValuesLists {
+			list, ok := row.Node.(*pgquery.Node_List)
+			if !ok {
+				return nil, errors.Errorf("expect List but found %T", row.Node)
+			}
+			for i, item := range list.List.Items {
+				sensitive, err := extractor.pgExtractColumnFromExpr(item)
+				if err != nil {
+					return nil, err
+				}
+				if i >= len(result) {
+					result = append(result, fieldInfo{name: pgColumnName(i)})
+				}
+				if sensitive {
+					result[i].sensitive = true
+				}
+			}
+		}
+		return result, nil
+	}
+
+	var fromFieldList []fieldInfo
+	for _, item := range node.FromClause {
+		fieldList, err := extractor.pgExtractFromItem(item, fromFieldList)
+		if err != nil {
+			return nil, err
+		}
+		fromFieldList = append(fromFieldList, fieldList...)
+	}
+	extractor.fromFieldList = fromFieldList
+	defer func() {
+		extractor.fromFieldList = nil
+	}()
+
+	var result []fieldInfo
+	for _, target := range node.TargetList {
+		resTarget, ok := target.Node.(*pgquery.Node_ResTarget)
+		if !ok {
+			return nil, errors.Errorf("expect ResTarget but found %T", target.Node)
+		}
+		if resTarget.ResTarget.Val == nil {
+			continue
+		}
+		if columnRef, ok := resTarget.ResTarget.Val.Node.(*pgquery.Node_ColumnRef); ok && pgIsStar(columnRef.ColumnRef) {
+			fieldList, err := extractor.pgExtractStar(columnRef.ColumnRef, fromFieldList)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, fieldList...)
+			continue
+		}
+
+		sensitive, err := extractor.pgExtractColumnFromExpr(resTarget.ResTarget.Val)
+		if err != nil {
+			return nil, err
+		}
+		// Only the field referencing the column directly keeps the mask of the column.
+		// Other sensitive expressions use the DEFAULT mask.
+		var mask db.SensitiveDataMask
+		if columnRef, ok := resTarget.ResTarget.Val.Node.(*pgquery.Node_ColumnRef); ok && sensitive {
+			nameList, err := pgColumnRefNameList(columnRef.ColumnRef.Fields)
+			if err != nil {
+				return nil, err
+			}
+			if field, exists := extractor.pgFindColumnRef(nameList); exists {
+				mask = field.mask
+			}
+		}
+		result = append(result, fieldInfo{
+			name:      pgExtractFieldName(resTarget.ResTarget),
+			sensitive: sensitive,
+			mask:      mask,
+		})
+	}
+	return result, nil
+}
+
+// pgExtractFromItem extracts the fields of one item in the FROM clause.
+// The previous items are visible to the LATERAL sub-queries.
+func (extractor *sensitiveFieldExtractor) pgExtractFromItem(in *pgquery.Node, previousFieldList []fieldInfo) ([]fieldInfo, error) {
+	if subselect, ok := in.Node.(*pgquery.Node_RangeSubselect); ok && subselect.RangeSubselect.Lateral {
+		lateralExtractor := &sensitiveFieldExtractor{
+			currentDatabase:    extractor.currentDatabase,
+			schemaInfo:         extractor.schemaInfo,
+			outerSchemaInfo:    append(append([]fieldInfo{}, extractor.outerSchemaInfo...), previousFieldList...),
+			cteOuterSchemaInfo: extractor.cteOuterSchemaInfo,
+		}
+		return lateralExtractor.pgExtractRangeSubselect(subselect.RangeSubselect)
+	}
+	return extractor.pgExtractNodeWithPrevious(in, previousFieldList)
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractStar(node *pgquery.ColumnRef, fromFieldList []fieldInfo) ([]fieldInfo, error) {
+	// The unqualified "*".
+	if len(node.Fields) == 1 {
+		return fromFieldList, nil
+	}
+
+	nameList, err := pgColumnRefNameList(node.Fields[:len(node.Fields)-1])
+	if err != nil {
+		return nil, err
+	}
+	schemaName, tableName := "", nameList[len(nameList)-1]
+	if len(nameList) > 1 {
+		schemaName = nameList[len(nameList)-2]
+	}
+
+	var result []fieldInfo
+	for _, field := range fromFieldList {
+		sameSchema := schemaName == "" || schemaName == field.schema
+		sameTable := tableName == field.table
+		if sameSchema && sameTable {
+			result = append(result, field)
+		}
+	}
+	if len(result) == 0 {
+		// The error content comes from PostgreSQL.
+		return nil, errors.Errorf("missing FROM-clause entry for table %q", tableName)
+	}
+	return result, nil
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractRangeVar(node *pgquery.RangeVar) ([]fieldInfo, error) {
+	if node.Catalogname != "" && node.Catalogname != extractor.currentDatabase {
+		// The error content comes from PostgreSQL.
+		return nil, errors.Errorf("cross-database references are not implemented: %q.%q.%q", node.Catalogname, node.Schemaname, node.Relname)
+	}
+	schemaName, tableSchema, exists := extractor.pgFindTableSchema(node.Schemaname, node.Relname)
+	if !exists {
+		// The views and the tables not synced yet may read the sensitive columns, so we refuse them.
+		// Only the system catalogs are known to be non-sensitive.
+		if !pgIsSystemRelation(node.Schemaname, node.Relname) {
+			return nil, errors.Errorf("cannot find the relation %q in the synced schema, it may be a view or a table not synced yet, please query the tables directly or sync the database schema", pgQualifiedName(node.Schemaname, node.Relname))
+		}
+		return pgApplyAlias([]fieldInfo{{
+			name:     pgUnknownColumnsName,
+			table:    node.Relname,
+			schema:   node.Schemaname,
+			database: extractor.currentDatabase,
+		}}, node.Alias)
+	}
+
+	var result []fieldInfo
+	for _, column := range tableSchema.ColumnList {
+		result = append(result, fieldInfo{
+			name:      column.Name,
+			table:     tableSchema.Name,
+			schema:    schemaName,
+			database:  extractor.currentDatabase,
+			sensitive: column.Sensitive,
+			mask:      column.Mask,
+		})
+	}
+	return pgApplyAlias(result, node.Alias)
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractRangeSubselect(node *pgquery.RangeSubselect) ([]fieldInfo, error) {
+	fieldList, err := extractor.pgExtractSubquery(node.Subquery)
+	if err != nil {
+		return nil, err
+	}
+	return pgApplyAlias(fieldList, node.Alias)
+}
+
+// pgExtractSubquery extracts the fields of a sub-query with a new extractor,
+// so that the FROM clause of the current query is kept.
+func (extractor *sensitiveFieldExtractor) pgExtractSubquery(node *pgquery.Node) ([]fieldInfo, error) {
+	subqueryExtractor := &sensitiveFieldExtractor{
+		currentDatabase:    extractor.currentDatabase,
+		schemaInfo:         extractor.schemaInfo,
+		outerSchemaInfo:    append(append([]fieldInfo{}, extractor.outerSchemaInfo...), extractor.fromFieldList...),
+		cteOuterSchemaInfo: extractor.cteOuterSchemaInfo,
+	}
+	return subqueryExtractor.pgExtractNode(node)
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractJoin(node *pgquery.JoinExpr) ([]fieldInfo, error) {
+	leftField, err := extractor.pgExtractNode(node.Larg)
+	if err != nil {
+		return nil, err
+	}
+	rightField, err := extractor.pgExtractNode(node.Rarg)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []fieldInfo
+	rightFieldMap := make(map[string]fieldInfo)
+	for _, field := range rightField {
+		if field.name != pgUnknownColumnsName {
+			rightFieldMap[field.name] = field
+		}
+	}
+	leftFieldMap := make(map[string]fieldInfo)
+	for _, field := range leftField {
+		if field.name != pgUnknownColumnsName {
+			leftFieldMap[field.name] = field
+		}
+	}
+
+	var mergedMap map[string]bool
+	if node.IsNatural {
+		// Natural Join will merge the same column name field.
+		mergedMap = make(map[string]bool)
+		for _, field := range leftField {
+			if _, exists := rightFieldMap[field.name]; exists {
+				mergedMap[field.name] = true
+			}
+		}
+	} else if len(node.UsingClause) > 0 {
+		// ... JOIN ... USING (...) will merge the column in USING.
+		nameList, err := pgColumnRefNameList(node.UsingClause)
+		if err != nil {
+			return nil, err
+		}
+		mergedMap = make(map[string]bool)
+		for _, name := range nameList {
+			mergedMap[name] = true
+		}
+	}
+
+	for _, field := range leftField {
+		// Merge the sensitive attribute for the merged column.
+		if rField, exists := rightFieldMap[field.name]; exists && mergedMap[field.name] {
+			mergeSensitive(&field, rField)
+		}
+		result = append(result, field)
+	}
+	for _, field := range rightField {
+		if _, exists := leftFieldMap[field.name]; exists && mergedMap[field.name] {
+			continue
+		}
+		result = append(result, field)
+	}
+
+	if node.Alias != nil {
+		// The join alias hides the table names of both sides.
+		return pgApplyAlias(result, node.Alias)
+	}
+	return result, nil
+}
+
+// pgFindTableSchema finds the table in the schema info, and returns false if it doesn't exist.
+// The unqualified table name is looked up in the default schema first, then in the other schemas which may be in the
+// search_path. If several other schemas have the table, the columns with the same name are treated as sensitive if any
+// of them is, because we don't know which table the session actually reads.
+func (extractor *sensitiveFieldExtractor) pgFindTableSchema(schemaName string, tableName string) (string, db.TableSchema, bool) {
+	// The CTE with the same name hides the closer one, see the comment in findTableSchema.
+	if schemaName == "" {
+		for i := len(extractor.cteOuterSchemaInfo) - 1; i >= 0; i-- {
+			table := extractor.cteOuterSchemaInfo[i]
+			if table.Name == tableName {
+				return "", table, true
+			}
+		}
+	}
+
+	var candidateSchemaList []string
+	var candidateList []db.TableSchema
+	for _, database := range extractor.schemaInfo.DatabaseList {
+		if database.Name != extractor.currentDatabase {
+			continue
+		}
+		for _, schema := range database.SchemaList {
+			if schemaName != "" && schema.Name != schemaName {
+				continue
+			}
+			for _, table := range schema.TableList {
+				if table.Name != tableName {
+					continue
+				}
+				if schemaName == "" && schema.Name == pgDefaultSchema {
+					return schema.Name, table, true
+				}
+				candidateSchemaList = append(candidateSchemaList, schema.Name)
+				candidateList = append(candidateList, table)
+			}
+		}
+	}
+	if len(candidateList) == 0 {
+		return "", db.TableSchema{}, false
+	}
+
+	result := db.TableSchema{Name: tableName}
+	for _, column := range candidateList[0].ColumnList {
+		field := fieldInfo{sensitive: column.Sensitive, mask: column.Mask}
+		for _, other := range candidateList[1:] {
+			for _, otherColumn := range other.ColumnList {
+				if otherColumn.Name == column.Name {
+					mergeSensitive(&field, fieldInfo{sensitive: otherColumn.Sensitive, mask: otherColumn.Mask})
+				}
+			}
+		}
+		result.ColumnList = append(result.ColumnList, db.ColumnInfo{
+			Name:      column.Name,
+			Sensitive: field.sensitive,
+			Mask:      field.mask,
+		})
+	}
+	return candidateSchemaList[0], result, true
+}
+
+// pgCheckColumnRefSensitive checks the column reference in the form of [[schema.]table.]column.
+func (extractor *sensitiveFieldExtractor) pgCheckColumnRefSensitive(nameList []string) bool {
+	if field, exists := extractor.pgFindColumnRef(nameList); exists {
+		return field.sensitive
+	}
+	if len(nameList) == 1 {
+		// The whole-row reference, such as "SELECT t FROM t", contains all columns of the table.
+		return extractor.pgCheckTableSensitive(nameList[0])
+	}
+	return false
+}
+
+// pgFindColumnRef finds the field referenced by the column reference in the form of [[schema.]table.]column.
+func (extractor *sensitiveFieldExtractor) pgFindColumnRef(nameList []string) (fieldInfo, bool) {
+	switch len(nameList) {
+	case 1:
+		return extractor.pgFindField("", "", nameList[0])
+	case 2:
+		return extractor.pgFindField("", nameList[0], nameList[1])
+	default:
+		n := len(nameList)
+		return extractor.pgFindField(nameList[n-3], nameList[n-2], nameList[n-1])
+	}
+}
+
+func (extractor *sensitiveFieldExtractor) pgFindField(schemaName string, tableName string, fieldName string) (fieldInfo, bool) {
+	// The FROM clause of the sub-query itself hides the outer schemas, such as:
+	//
+	//  select (select a from public.t limit 1) from s.t
+	//
+	// The column a refers to public.t rather than s.t.
+	for _, field := range extractor.fromFieldList {
+		if pgFieldMatch(field, schemaName, tableName, fieldName) {
+			return field, true
+		}
+	}
+	// The field may be a column of the unknown relations in the FROM clause, prefer the sensitive one.
+	// If the outer schema has the field too, we don't know which one is referenced, so merge them.
+	if result, found := pgFindUnknownColumns(extractor.fromFieldList, schemaName, tableName); found {
+		if field, exists := extractor.pgFindOuterField(schemaName, tableName, fieldName); exists {
+			mergeSensitive(&result, field)
+		}
+		return result, true
+	}
+
+	if field, exists := extractor.pgFindOuterField(schemaName, tableName, fieldName); exists {
+		return field, true
+	}
+	return pgFindUnknownColumns(extractor.outerSchemaInfo, schemaName, tableName)
+}
+
+func (extractor *sensitiveFieldExtractor) pgFindOuterField(schemaName string, tableName string, fieldName string) (fieldInfo, bool) {
+	// The closer outer schema wins, see the comment in findField.
+	for i := len(extractor.outerSchemaInfo) - 1; i >= 0; i-- {
+		field := extractor.outerSchemaInfo[i]
+		if pgFieldMatch(field, schemaName, tableName, fieldName) {
+			return field, true
+		}
+	}
+	return fieldInfo{}, false
+}
+
+// pgFindUnknownColumns finds the unknown columns of the relations matching the table name, and prefers the sensitive one.
+func pgFindUnknownColumns(fieldList []fieldInfo, schemaName string, tableName string) (fieldInfo, bool) {
+	var result fieldInfo
+	found := false
+	for _, field := range fieldList {
+		if pgFieldMatch(field, schemaName, tableName, pgUnknownColumnsName) && (!found || field.sensitive) {
+			result, found = field, true
+		}
+	}
+	return result, found
+}
+
+func pgFieldMatch(field fieldInfo, schemaName string, tableName string, fieldName string) bool {
+	sameSchema := schemaName == "" || schemaName == field.schema
+	sameTable := tableName == "" || tableName == field.table
+	sameField := fieldName == field.name
+	return sameSchema && sameTable && sameField
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractColumnFromExpr(in *pgquery.Node) (bool, error) {
+	if in == nil {
+		return false, nil
+	}
+
+	switch node := in.Node.(type) {
+	case *pgquery.Node_ColumnRef:
+		nameList, err := pgColumnRefNameList(node.ColumnRef.Fields)
+		if err != nil {
+			return false, err
+		}
+		return extractor.pgCheckColumnRefSensitive(nameList), nil
+	case *pgquery.Node_SubLink:
+		// Sub-query in expression can be the non-associated or associated sub-query.
+		// The sub-query can access the outer schema.
+		fieldList, err := extractor.pgExtractSubquery(node.SubLink.Subselect)
+		if err != nil {
+			return false, err
+		}
+		for _, field := range fieldList {
+			if field.sensitive {
+				return true, nil
+			}
+		}
+		return extractor.pgExtractColumnFromExpr(node.SubLink.Testexpr)
+	case *pgquery.Node_AExpr:
+		return extractor.pgExtractColumnFromExprList([]*pgquery.Node{node.AExpr.Lexpr, node.AExpr.Rexpr})
+	case *pgquery.Node_BoolExpr:
+		return extractor.pgExtractColumnFromExprList(node.BoolExpr.Args)
+	case *pgquery.Node_FuncCall:
+		return extractor.pgExtractColumnFromExprList(node.FuncCall.Args)
+	case *pgquery.Node_TypeCast:
+		return extractor.pgExtractColumnFromExpr(node.TypeCast.Arg)
+	case *pgquery.Node_CaseExpr:
+		nodeList := []*pgquery.Node{node.CaseExpr.Arg, node.CaseExpr.Defresult}
+		nodeList = append(nodeList, node.CaseExpr.Args...)
+		return extractor.pgExtractColumnFromExprList(nodeList)
+	case *pgquery.Node_CaseWhen:
+		return extractor.pgExtractColumnFromExprList([]*pgquery.Node{node.CaseWhen.Expr, node.CaseWhen.Result})
+	case *pgquery.Node_CoalesceExpr:
+		return extractor.pgExtractColumnFromExprList(node.CoalesceExpr.Args)
+	case *pgquery.Node_MinMaxExpr:
+		return extractor.pgExtractColumnFromExprList(node.MinMaxExpr.Args)
+	case *pgquery.Node_NullTest:
+		return extractor.pgExtractColumnFromExpr(node.NullTest.Arg)
+	case *pgquery.Node_BooleanTest:
+		return extractor.pgExtractColumnFromExpr(node.BooleanTest.Arg)
+	case *pgquery.Node_AIndirection:
+		return extractor.pgExtractColumnFromExpr(node.AIndirection.Arg)
+	case *pgquery.Node_AArrayExpr:
+		return extractor.pgExtractColumnFromExprList(node.AArrayExpr.Elements)
+	case *pgquery.Node_RowExpr:
+		return extractor.pgExtractColumnFromExprList(node.RowExpr.Args)
+	case *pgquery.Node_CollateClause:
+		return extractor.pgExtractColumnFromExpr(node.CollateClause.Arg)
+	case *pgquery.Node_GroupingFunc:
+		return extractor.pgExtractColumnFromExprList(node.GroupingFunc.Args)
+	case *pgquery.Node_XmlExpr:
+		nodeList := []*pgquery.Node{}
+		nodeList = append(nodeList, node.XmlExpr.NamedArgs...)
+		nodeList = append(nodeList, node.XmlExpr.Args...)
+		return extractor.pgExtractColumnFromExprList(nodeList)
+	case *pgquery.Node_ResTarget:
+		return extractor.pgExtractColumnFromExpr(node.ResTarget.Val)
+	case *pgquery.Node_List:
+		return extractor.pgExtractColumnFromExprList(node.List.Items)
+	case *pgquery.Node_AConst,
+		*pgquery.Node_ParamRef,
+		*pgquery.Node_SqlvalueFunction:
+		// No expression need to extract.
+	}
+	return false, nil
+}
+
+func (extractor *sensitiveFieldExtractor) pgExtractColumnFromExprList(nodeList []*pgquery.Node) (bool, error) {
+	for _, node := range nodeList {
+		sensitive, err := extractor.pgExtractColumnFromExpr(node)
+		if err != nil {
+			return false, err
+		}
+		if sensitive {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// pgCheckTableSensitive returns true if any column of the table in the scope is sensitive.
+func (extractor *sensitiveFieldExtractor) pgCheckTableSensitive(tableName string) bool {
+	for _, field := range extractor.outerSchemaInfo {
+		if field.table == tableName && field.sensitive {
+			return true
+		}
+	}
+	for _, field := range extractor.fromFieldList {
+		if field.table == tableName && field.sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// pgHasUnknownColumns returns true if the field list contains the unknown columns of a relation.
+func pgHasUnknownColumns(fieldList []fieldInfo) bool {
+	for _, field := range fieldList {
+		if field.name == pgUnknownColumnsName {
+			return true
+		}
+	}
+	return false
+}
+
+// pgMergeUnknownColumns merges the field lists into the unknown columns, which are sensitive if any field is.
+func pgMergeUnknownColumns(fieldLists ...[]fieldInfo) fieldInfo {
+	result := fieldInfo{name: pgUnknownColumnsName}
+	for _, fieldList := range fieldLists {
+		for _, field := range fieldList {
+			mergeSensitive(&result, field)
+		}
+	}
+	return result
+}
+
+func (field fieldInfo) toColumnInfo() db.ColumnInfo {
+	return db.ColumnInfo{
+		Name:      field.name,
+		Sensitive: field.sensitive,
+		Mask:      field.mask,
+	}
+}
+
+// pgIsSystemRelation returns true if the relation is a system catalog or an information schema view.
+// The unqualified names with the "pg_" prefix are resolved in pg_catalog first, because it's implicitly searched first.
+func pgIsSystemRelation(schemaName string, relationName string) bool {
+	switch schemaName {
+	case pgCatalogSchema, pgInformationSchema:
+		return true
+	case "":
+		return strings.HasPrefix(relationName, "pg_")
+	}
+	return false
+}
+
+// pgIsBuiltinSetReturningFunction returns true if the function name refers to a built-in set-returning function.
+func pgIsBuiltinSetReturningFunction(nameList []string) bool {
+	switch len(nameList) {
+	case 1:
+		return pgBuiltinSetReturningFunctions[nameList[0]]
+	case 2:
+		return nameList[0] == pgCatalogSchema && pgBuiltinSetReturningFunctions[nameList[1]]
+	}
+	return false
+}
+
+func pgQualifiedName(schemaName string, name string) string {
+	if schemaName == "" {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", schemaName, name)
+}
+
+func pgIsStar(node *pgquery.ColumnRef) bool {
+	if len(node.Fields) == 0 {
+		return false
+	}
+	_, ok := node.Fields[len(node.Fields)-1].Node.(*pgquery.Node_AStar)
+	return ok
+}
+
+func pgColumnRefNameList(nodeList []*pgquery.Node) ([]string, error) {
+	var result []string
+	for _, node := range nodeList {
+		s, ok := node.Node.(*pgquery.Node_String_)
+		if !ok {
+			return nil, errors.Errorf("expect String but found %T", node.Node)
+		}
+		result = append(result, s.String_.Str)
+	}
+	if len(result) == 0 {
+		return nil, errors.Errorf("expect at least one name")
+	}
+	return result, nil
+}
+
+// pgApplyAlias applies the alias "AS alias(col1, col2, ...)" to the field list.
+func pgApplyAlias(fieldList []fieldInfo, alias *pgquery.Alias) ([]fieldInfo, error) {
+	if alias == nil {
+		return fieldList, nil
+	}
+	if pgHasUnknownColumns(fieldList) {
+		// The column names cannot be matched by position, only the table name is applied.
+		var result []fieldInfo
+		for _, field := range fieldList {
+			result = append(result, fieldInfo{
+				name:      field.name,
+				table:     alias.Aliasname,
+				sensitive: field.sensitive,
+				mask:      field.mask,
+			})
+		}
+		return result, nil
+	}
+	if len(alias.Colnames) > len(fieldList) {
+		// The error content comes from PostgreSQL.
+		return nil, errors.Errorf("table %q has %d columns available but %d columns specified", alias.Aliasname, len(fieldList), len(alias.Colnames))
+	}
+	nameList := []string{}
+	if len(alias.Colnames) > 0 {
+		var err error
+		if nameList, err = pgColumnRefNameList(alias.Colnames); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []fieldInfo
+	for i, field := range fieldList {
+		name := field.name
+		if i < len(nameList) {
+			name = nameList[i]
+		}
+		result = append(result, fieldInfo{
+			name:      name,
+			table:     alias.Aliasname,
+			sensitive: field.sensitive,
+			mask:      field.mask,
+		})
+	}
+	return result, nil
+}
+
+func pgRenameFieldList(fieldList []fieldInfo, colnames []*pgquery.Node) error {
+	if len(colnames) == 0 || pgHasUnknownColumns(fieldList) {
+		return nil
+	}
+	if len(colnames) > len(fieldList) {
+		// The error content comes from PostgreSQL.
+		return errors.Errorf("WITH query has %d columns available but %d columns specified", len(fieldList), len(colnames))
+	}
+	nameList, err := pgColumnRefNameList(colnames)
+	if err != nil {
+		return err
+	}
+	for i, name := range nameList {
+		fieldList[i].name = name
+	}
+	return nil
+}
+
+func pgExtractFieldName(in *pgquery.ResTarget) string {
+	if in.Name != "" {
+		return in.Name
+	}
+	return pgExtractExprName(in.Val)
+}
+
+func pgExtractExprName(in *pgquery.Node) string {
+	if in == nil {
+		return pgUnknownFieldName
+	}
+	switch node := in.Node.(type) {
+	case *pgquery.Node_ColumnRef:
+		if nameList, err := pgColumnRefNameList(node.ColumnRef.Fields); err == nil {
+			return nameList[len(nameList)-1]
+		}
+	case *pgquery.Node_FuncCall:
+		if nameList, err := pgColumnRefNameList(node.FuncCall.Funcname); err == nil {
+			return nameList[len(nameList)-1]
+		}
+	case *pgquery.Node_TypeCast:
+		if name := pgExtractExprName(node.TypeCast.Arg); name != pgUnknownFieldName {
+			return name
+		}
+		if node.TypeCast.TypeName != nil {
+			if nameList, err := pgColumnRefNameList(node.TypeCast.TypeName.Names); err == nil {
+				return nameList[len(nameList)-1]
+			}
+		}
+	case *pgquery.Node_AIndirection:
+		return pgExtractExprName(node.AIndirection.Arg)
+	case *pgquery.Node_CaseExpr:
+		return "case"
+	case *pgquery.Node_CoalesceExpr:
+		return "coalesce"
+	case *pgquery.Node_SubLink:
+		switch node.SubLink.SubLinkType {
+		case pgquery.SubLinkType_EXISTS_SUBLINK:
+			return "exists"
+		case pgquery.SubLinkType_ARRAY_SUBLINK:
+			return "array"
+		case pgquery.SubLinkType_EXPR_SUBLINK:
+			if subselect, ok := node.SubLink.Subselect.Node.(*pgquery.Node_SelectStmt); ok && len(subselect.SelectStmt.TargetList) == 1 {
+				if target, ok := subselect.SelectStmt.TargetList[0].Node.(*pgquery.Node_ResTarget); ok {
+					return pgExtractFieldName(target.ResTarget)
+				}
+			}
+		}
+	}
+	return pgUnknownFieldName
+}
+
+// pgColumnName returns the default column name for VALUES list, such as column1, column2.
+func pgColumnName(i int) string {
+	return fmt.Sprintf("column%d", i+1)
+}
@@ -0,0 +1,146 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bytebase/bytebase/plugin/db"
+)
+
+// defaultMask is the masked value for the DEFAULT mask type.
+// The other mask types fall back to it if they cannot deal with the value, so that we never leak the raw value.
+const defaultMask = "******"
+
+// maskSensitiveData masks the scanned value with the mask. The scanned value is the pointer passed to rows.Scan().
+func maskSensitiveData(mask db.SensitiveDataMask, scanned interface{}) interface{} {
+	value := getScannedValue(scanned)
+	if value == nil {
+		return defaultMask
+	}
+
+	switch mask.Type {
+	case db.SensitiveDataMaskTypePartial:
+		return maskPartial(stringifyValue(value), mask.Option.PrefixLength, mask.Option.SuffixLength)
+	case db.SensitiveDataMaskTypeEmail:
+		return maskEmail(stringifyValue(value))
+	case db.SensitiveDataMaskTypePhone:
+		return maskPhone(stringifyValue(value))
+	case db.SensitiveDataMaskTypeHash:
+		return maskHash(stringifyValue(value), mask.HashKey)
+	case db.SensitiveDataMaskTypeRange:
+		return maskRange(stringifyValue(value), mask.Option.RangeWidth)
+	default:
+		return defaultMask
+	}
+}
+
+// getScannedValue returns the value of the scanned pointer, nil for the NULL value.
+func getScannedValue(scanned interface{}) interface{} {
+	if v, ok := scanned.(driver.Valuer); ok {
+		value, err := v.Value()
+		if err != nil {
+			return nil
+		}
+		return value
+	}
+	rv := reflect.ValueOf(scanned)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+func stringifyValue(value interface{}) string {
+	if v, ok := value.([]byte); ok {
+		return string(v)
+	}
+	return fmt.Sprint(value)
+}
+
+// maskPartial keeps the leading prefixLength and trailing suffixLength characters, such as "ab******yz".
+func maskPartial(s string, prefixLength int, suffixLength int) string {
+	runes := []rune(s)
+	if prefixLength < 0 || suffixLength < 0 || prefixLength+suffixLength >= len(runes) {
+		return defaultMask
+	}
+	return string(runes[:prefixLength]) + defaultMask + string(runes[len(runes)-suffixLength:])
+}
+
+// maskEmail keeps the first character of the local part and the domain, such as "a******@example.com".
+// The local part is masked entirely if it has only one character, so that at least one character is masked.
+func maskEmail(s string) string {
+	at := strings.LastIndex(s, "@")
+	if at <= 0 {
+		return defaultMask
+	}
+	local := []rune(s[:at])
+	if len(local) == 1 {
+		return defaultMask + s[at:]
+	}
+	return string(local[0]) + defaultMask + s[at:]
+}
+
+// maskPhone keeps the last four digits and the separators, such as "+** (***) ***-4567".
+func maskPhone(s string) string {
+	const keepDigits = 4
+	runes := []rune(s)
+	digits := 0
+	for _, r := range runes {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	if digits <= keepDigits {
+		return defaultMask
+	}
+	masked := 0
+	for i, r := range runes {
+		if masked == digits-keepDigits {
+			break
+		}
+		if unicode.IsDigit(r) {
+			runes[i] = '*'
+			masked++
+		}
+	}
+	return string(runes)
+}
+
+// maskHash replaces the value with the hex encoded HMAC-SHA256 digest.
+// The digest is deterministic for the same key, but it cannot be reversed by the dictionary attack without the key.
+func maskHash(s string, key string) string {
+	if len(key) == 0 {
+		return defaultMask
+	}
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// maskRange replaces the number with the range [lower, upper) it falls into, the range is aligned to the width.
+func maskRange(s string, width float64) string {
+	if width <= 0 {
+		return defaultMask
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return defaultMask
+	}
+	lower := math.Floor(v/width) * width
+	upper := lower + width
+	return fmt.Sprintf("[%s, %s)", strconv.FormatFloat(lower, 'f', -1, 64), strconv.FormatFloat(upper, 'f', -1, 64))
+}
@@ -0,0 +1,22 @@
+// Package idp is the plugin for the identity providers used by single sign-on.
+package idp
+
+// UserInfo is the user information returned by the identity provider.
+type UserInfo struct {
+	// Issuer and Subject identify the user in the identity provider, and are stable across the email changes.
+	// Bytebase binds its users to them rather than the email.
+	Issuer      string
+	Subject     string
+	Email       string
+	DisplayName string
+	// Groups is the list of the groups the user belongs to in the identity provider.
+	Groups []string
+}
+
+// FieldMapping maps the user information to the claims or attributes returned by the identity provider.
+// An empty field means using the default claim or attribute of the identity provider.
+type FieldMapping struct {
+	Email       string `json:"email"`
+	DisplayName string `json:"displayName"`
+	Groups      string `json:"groups"`
+}
@@ -0,0 +1,219 @@
+// Package oidc is the plugin for the OpenID Connect identity providers, such as Okta and Keycloak.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/bytebase/bytebase/plugin/idp"
+)
+
+const (
+	defaultEmailClaim       = "email"
+	defaultDisplayNameClaim = "name"
+	defaultGroupsClaim      = "groups"
+)
+
+// IdentityProviderConfig is the configuration of an OIDC identity provider.
+type IdentityProviderConfig struct {
+	// Issuer is the issuer URL of the identity provider, which is used to discover the provider configuration.
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	// Scopes is the list of the extra scopes to request, such as "groups".
+	// The "openid", "profile" and "email" scopes are always requested.
+	Scopes       []string         `json:"scopes"`
+	FieldMapping idp.FieldMapping `json:"fieldMapping"`
+}
+
+// IdentityProvider is an OIDC identity provider.
+type IdentityProvider struct {
+	config   IdentityProviderConfig
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+	// userInfoSupported is whether the identity provider supports the userinfo endpoint.
+	userInfoSupported bool
+}
+
+// NewIdentityProvider discovers the OIDC identity provider by the issuer and returns the identity provider.
+func NewIdentityProvider(ctx context.Context, config IdentityProviderConfig) (*IdentityProvider, error) {
+	if config.Issuer == "" {
+		return nil, errors.New("issuer is required")
+	}
+	if config.ClientID == "" {
+		return nil, errors.New("client ID is required")
+	}
+	if config.ClientSecret == "" {
+		return nil, errors.New("client secret is required")
+	}
+	provider, err := oidc.NewProvider(ctx, strings.TrimSuffix(config.Issuer, "/"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to discover the OIDC provider %q", config.Issuer)
+	}
+	var claims struct {
+		UserInfoEndpoint string `json:"userinfo_endpoint"`
+	}
+	if err := provider.Claims(&claims); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the OIDC provider configuration")
+	}
+	return &IdentityProvider{
+		config:            config,
+		provider:          provider,
+		verifier:          provider.Verifier(&oidc.Config{ClientID: config.ClientID}),
+		userInfoSupported: claims.UserInfoEndpoint != "",
+	}, nil
+}
+
+func (p *IdentityProvider) getOAuth2Config(redirectURL string) *oauth2.Config {
+	scopes := []string{oidc.ScopeOpenID, "profile", "email"}
+	for _, scope := range p.config.Scopes {
+		if scope != oidc.ScopeOpenID && scope != "profile" && scope != "email" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint:     p.provider.Endpoint(),
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+	}
+}
+
+// AuthCodeURL returns the URL of the authorization endpoint to redirect the user to.
+// The nonce is bound to the ID token, and the code verifier is used by PKCE to bind the authorization code to the login request.
+func (p *IdentityProvider) AuthCodeURL(redirectURL, state, nonce, codeVerifier string) string {
+	return p.getOAuth2Config(redirectURL).AuthCodeURL(
+		state,
+		oidc.Nonce(nonce),
+		oauth2.SetAuthURLParam("code_challenge", GetCodeChallenge(codeVerifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
+}
+
+// GetUserInfo exchanges the authorization code for the tokens, verifies the ID token and returns the user information.
+func (p *IdentityProvider) GetUserInfo(ctx context.Context, redirectURL, code, codeVerifier, nonce string) (*idp.UserInfo, error) {
+	token, err := p.getOAuth2Config(redirectURL).Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange the authorization code")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("the token response doesn't contain the ID token")
+	}
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to verify the ID token")
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("the nonce of the ID token mismatches the login request")
+	}
+
+	claims := make(map[string]interface{})
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the ID token claims")
+	}
+	// Some identity providers, such as Okta, only return the profile and groups claims by the userinfo endpoint.
+	if p.userInfoSupported {
+		userInfo, err := p.provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the user info")
+		}
+		if userInfo.Subject != idToken.Subject {
+			return nil, errors.Errorf("the subject %q of the user info mismatches the subject %q of the ID token", userInfo.Subject, idToken.Subject)
+		}
+		userInfoClaims := make(map[string]interface{})
+		if err := userInfo.Claims(&userInfoClaims); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the user info claims")
+		}
+		for key, value := range userInfoClaims {
+			if _, ok := claims[key]; !ok {
+				claims[key] = value
+			}
+		}
+	}
+	return getUserInfo(claims, p.config.FieldMapping)
+}
+
+func getUserInfo(claims map[string]interface{}, fieldMapping idp.FieldMapping) (*idp.UserInfo, error) {
+	emailClaim := fieldMapping.Email
+	if emailClaim == "" {
+		emailClaim = defaultEmailClaim
+	}
+	displayNameClaim := fieldMapping.DisplayName
+	if displayNameClaim == "" {
+		displayNameClaim = defaultDisplayNameClaim
+	}
+	groupsClaim := fieldMapping.Groups
+	if groupsClaim == "" {
+		groupsClaim = defaultGroupsClaim
+	}
+
+	userInfo := &idp.UserInfo{}
+	if v, ok := claims["iss"].(string); ok {
+		userInfo.Issuer = v
+	}
+	if v, ok := claims["sub"].(string); ok {
+		userInfo.Subject = v
+	}
+	if userInfo.Issuer == "" || userInfo.Subject == "" {
+		return nil, errors.New("the \"iss\" or \"sub\" claim is missing")
+	}
+	if v, ok := claims[emailClaim].(string); ok {
+		userInfo.Email = v
+	}
+	if userInfo.Email == "" {
+		return nil, errors.Errorf("the %q claim is missing", emailClaim)
+	}
+	// The email may be self-asserted by the user of the identity provider, so only the verified one is trusted.
+	if !isEmailVerified(claims["email_verified"]) {
+		return nil, errors.Errorf("the email %q is not verified by the identity provider", userInfo.Email)
+	}
+	if v, ok := claims[displayNameClaim].(string); ok {
+		userInfo.DisplayName = v
+	}
+	switch v := claims[groupsClaim].(type) {
+	case string:
+		userInfo.Groups = []string{v}
+	case []interface{}:
+		for _, group := range v {
+			userInfo.Groups = append(userInfo.Groups, fmt.Sprintf("%v", group))
+		}
+	}
+	return userInfo, nil
+}
+
+// isEmailVerified returns whether the email_verified claim is true.
+// Some identity providers, such as AWS Cognito, return it as a string.
+func isEmailVerified(claim interface{}) bool {
+	switch v := claim.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// GenerateCodeVerifier generates a random PKCE code verifier.
+func GenerateCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate code verifier")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// GetCodeChallenge returns the S256 PKCE code challenge of the code verifier.
+func GetCodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
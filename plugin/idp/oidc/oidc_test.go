@@ -0,0 +1,241 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/plugin/idp"
+)
+
+const (
+	testClientID     = "bytebase"
+	testClientSecret = "secret"
+	testCode         = "code"
+	testRedirectURL  = "https://bytebase.example.com/oidc/callback"
+)
+
+// fakeServer is a fake OIDC identity provider.
+type fakeServer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+	// codeChallenge and nonce are recorded from the authorization request.
+	codeChallenge string
+	nonce         string
+	groups        []string
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	s := &fakeServer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"issuer":                 s.URL,
+			"authorization_endpoint": s.URL + "/authorize",
+			"token_endpoint":         s.URL + "/token",
+			"userinfo_endpoint":      s.URL + "/userinfo",
+			"jwks_uri":               s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "test",
+					"alg": "RS256",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.Form.Get("code") != testCode || GetCodeChallenge(r.Form.Get("code_verifier")) != s.codeChallenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   s.URL,
+			"sub":   "1",
+			"aud":   testClientID,
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"iat":   time.Now().Unix(),
+			"nonce": s.nonce,
+			"email": "alice@example.com",
+		})
+		token.Header["kid"] = "test"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"sub":            "1",
+			"name":           "Alice",
+			"email":          "ignored@example.com",
+			"email_verified": true,
+			"groups":         s.groups,
+		})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestGetUserInfo(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeServer(t)
+	s.groups = []string{"dba", "dev"}
+
+	p, err := NewIdentityProvider(ctx, IdentityProviderConfig{
+		Issuer:       s.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		Scopes:       []string{"groups"},
+	})
+	require.NoError(t, err)
+
+	codeVerifier, err := GenerateCodeVerifier()
+	require.NoError(t, err)
+	authURL, err := url.Parse(p.AuthCodeURL(testRedirectURL, "state", "nonce", codeVerifier))
+	require.NoError(t, err)
+	query := authURL.Query()
+	require.Equal(t, s.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	require.Equal(t, "state", query.Get("state"))
+	require.Equal(t, "openid profile email groups", query.Get("scope"))
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+	s.codeChallenge = query.Get("code_challenge")
+	s.nonce = query.Get("nonce")
+
+	userInfo, err := p.GetUserInfo(ctx, testRedirectURL, testCode, codeVerifier, "nonce")
+	require.NoError(t, err)
+	require.Equal(t, &idp.UserInfo{
+		Issuer:      s.URL,
+		Subject:     "1",
+		Email:       "alice@example.com",
+		DisplayName: "Alice",
+		Groups:      []string{"dba", "dev"},
+	}, userInfo)
+
+	// Mismatched nonce.
+	_, err = p.GetUserInfo(ctx, testRedirectURL, testCode, codeVerifier, "another-nonce")
+	require.Error(t, err)
+
+	// Mismatched code verifier.
+	_, err = p.GetUserInfo(ctx, testRedirectURL, testCode, "another-verifier", "nonce")
+	require.Error(t, err)
+}
+
+func TestGetUserInfoFromClaims(t *testing.T) {
+	tests := []struct {
+		claims       map[string]interface{}
+		fieldMapping idp.FieldMapping
+		want         *idp.UserInfo
+		err          bool
+	}{
+		{
+			claims: map[string]interface{}{
+				"iss":            "https://idp.example.com",
+				"sub":            "1",
+				"email":          "alice@example.com",
+				"email_verified": true,
+				"name":           "Alice",
+				"groups":         "dba",
+			},
+			want: &idp.UserInfo{Issuer: "https://idp.example.com", Subject: "1", Email: "alice@example.com", DisplayName: "Alice", Groups: []string{"dba"}},
+		},
+		{
+			claims: map[string]interface{}{
+				"iss":                "https://idp.example.com",
+				"sub":                "1",
+				"preferred_username": "alice@example.com",
+				"email_verified":     "true",
+				"roles":              []interface{}{"dba", "dev"},
+			},
+			fieldMapping: idp.FieldMapping{Email: "preferred_username", Groups: "roles"},
+			want:         &idp.UserInfo{Issuer: "https://idp.example.com", Subject: "1", Email: "alice@example.com", Groups: []string{"dba", "dev"}},
+		},
+		{
+			claims: map[string]interface{}{
+				"iss":            "https://idp.example.com",
+				"sub":            "1",
+				"name":           "Alice",
+				"email_verified": true,
+			},
+			err: true,
+		},
+		// Unverified email.
+		{
+			claims: map[string]interface{}{
+				"iss":   "https://idp.example.com",
+				"sub":   "1",
+				"email": "alice@example.com",
+			},
+			err: true,
+		},
+		{
+			claims: map[string]interface{}{
+				"iss":            "https://idp.example.com",
+				"sub":            "1",
+				"email":          "alice@example.com",
+				"email_verified": false,
+			},
+			err: true,
+		},
+		// Missing subject.
+		{
+			claims: map[string]interface{}{
+				"iss":            "https://idp.example.com",
+				"email":          "alice@example.com",
+				"email_verified": true,
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		userInfo, err := getUserInfo(test.claims, test.fieldMapping)
+		if test.err {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.want, userInfo)
+	}
+}
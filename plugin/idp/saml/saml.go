@@ -0,0 +1,418 @@
+// Package saml is the plugin for the SAML 2.0 identity providers.
+//
+// Bytebase is the service provider sending the authentication requests with the HTTP-Redirect binding
+// and receiving the responses with the HTTP-POST binding. The assertions must be signed by the identity provider.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"github.com/bytebase/bytebase/plugin/idp"
+)
+
+const (
+	protocolNamespace  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	assertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	metadataNamespace  = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	httpPostBinding     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	statusSuccess       = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	bearerMethod        = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	nameIDFormatEmail   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	nameIDFormatDefault = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+
+	timeFormat = "2006-01-02T15:04:05Z"
+	// maxClockSkew is the max clock skew allowed between Bytebase and the identity provider.
+	maxClockSkew = 3 * time.Minute
+
+	defaultDisplayNameAttribute = "displayName"
+	defaultGroupsAttribute      = "groups"
+)
+
+// IdentityProviderConfig is the configuration of a SAML identity provider.
+type IdentityProviderConfig struct {
+	// EntityID is the entity ID of the identity provider, which is the issuer of the assertions.
+	EntityID string `json:"entityId"`
+	// SSOURL is the single sign-on URL of the identity provider supporting the HTTP-Redirect binding.
+	SSOURL string `json:"ssoUrl"`
+	// Certificate is the PEM encoded X.509 certificate used to verify the signature of the assertions.
+	Certificate string `json:"certificate"`
+	// FieldMapping maps the user information to the attributes of the assertion.
+	// The email is the NameID of the subject if the email attribute is not specified.
+	FieldMapping idp.FieldMapping `json:"fieldMapping"`
+}
+
+// ServiceProvider is the service provider, which is Bytebase itself.
+type ServiceProvider struct {
+	// EntityID is the entity ID of Bytebase, which is the audience of the assertions.
+	EntityID string
+	// ACSURL is the assertion consumer service URL of Bytebase.
+	ACSURL string
+}
+
+// IdentityProvider is a SAML identity provider.
+type IdentityProvider struct {
+	config      IdentityProviderConfig
+	certificate *x509.Certificate
+}
+
+// NewIdentityProvider returns a SAML identity provider.
+func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, error) {
+	if config.EntityID == "" {
+		return nil, errors.New("entity ID is required")
+	}
+	if _, err := url.ParseRequestURI(config.SSOURL); err != nil {
+		return nil, errors.Wrapf(err, "invalid single sign-on URL %q", config.SSOURL)
+	}
+	block, _ := pem.Decode([]byte(config.Certificate))
+	if block == nil {
+		return nil, errors.New("the certificate is not PEM encoded")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the certificate")
+	}
+	return &IdentityProvider{
+		config:      config,
+		certificate: certificate,
+	}, nil
+}
+
+// GenerateRequestID generates a random ID for the authentication request.
+func GenerateRequestID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate request ID")
+	}
+	// The ID must not start with a number as it's an xsd:ID.
+	return "id-" + hex.EncodeToString(b), nil
+}
+
+// AuthnRequestURL returns the URL redirecting the user to the identity provider with the authentication request.
+func (p *IdentityProvider) AuthnRequestURL(sp ServiceProvider, requestID, relayState string, now time.Time) (string, error) {
+	doc := etree.NewDocument()
+	request := doc.CreateElement("samlp:AuthnRequest")
+	request.CreateAttr("xmlns:samlp", protocolNamespace)
+	request.CreateAttr("xmlns:saml", assertionNamespace)
+	request.CreateAttr("ID", requestID)
+	request.CreateAttr("Version", "2.0")
+	request.CreateAttr("IssueInstant", now.UTC().Format(timeFormat))
+	request.CreateAttr("Destination", p.config.SSOURL)
+	request.CreateAttr("AssertionConsumerServiceURL", sp.ACSURL)
+	request.CreateAttr("ProtocolBinding", httpPostBinding)
+	request.CreateElement("saml:Issuer").SetText(sp.EntityID)
+	nameIDPolicy := request.CreateElement("samlp:NameIDPolicy")
+	nameIDPolicy.CreateAttr("Format", nameIDFormatDefault)
+	nameIDPolicy.CreateAttr("AllowCreate", "true")
+
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create deflate writer")
+	}
+	if _, err := doc.WriteTo(w); err != nil {
+		return "", errors.Wrap(err, "failed to write authentication request")
+	}
+	if err := w.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to write authentication request")
+	}
+
+	u, err := url.Parse(p.config.SSOURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid single sign-on URL %q", p.config.SSOURL)
+	}
+	query := u.Query()
+	query.Set("SAMLRequest", base64.StdEncoding.EncodeToString(buf.Bytes()))
+	if relayState != "" {
+		query.Set("RelayState", relayState)
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// GetUserInfo verifies the base64 encoded SAML response posted to the assertion consumer service and returns the user information.
+// The requestID is the ID of the authentication request the response is in response to.
+func (p *IdentityProvider) GetUserInfo(sp ServiceProvider, samlResponse, requestID string, now time.Time) (*idp.UserInfo, error) {
+	raw, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode SAML response")
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to parse SAML response")
+	}
+	response := doc.Root()
+	if response == nil || !isElement(response, protocolNamespace, "Response") {
+		return nil, errors.New("the SAML response is not a Response element")
+	}
+
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: []*x509.Certificate{p.certificate},
+	})
+	validationContext.Clock = dsig.NewFakeClockAt(now)
+
+	// The signature could either be on the response or on the assertion.
+	// We only read the elements covered by the signature to prevent the signature wrapping attacks.
+	var assertion *etree.Element
+	if findChild(response, dsig.Namespace, "Signature") != nil {
+		validated, err := validationContext.Validate(detach(response))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to verify the signature of the SAML response")
+		}
+		response = validated
+		assertion = findChild(response, assertionNamespace, "Assertion")
+	} else {
+		unverified := findChild(response, assertionNamespace, "Assertion")
+		if unverified != nil {
+			validated, err := validationContext.Validate(detach(unverified))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to verify the signature of the SAML assertion")
+			}
+			assertion = validated
+		}
+	}
+
+	if err := p.validateResponse(response, sp, requestID); err != nil {
+		return nil, err
+	}
+	if assertion == nil {
+		if findChild(response, assertionNamespace, "EncryptedAssertion") != nil {
+			return nil, errors.New("the encrypted assertion is not supported")
+		}
+		return nil, errors.New("the SAML response doesn't contain any assertion")
+	}
+	if err := p.validateAssertion(assertion, sp, requestID, now); err != nil {
+		return nil, err
+	}
+	return p.getUserInfo(assertion)
+}
+
+func (p *IdentityProvider) validateResponse(response *etree.Element, sp ServiceProvider, requestID string) error {
+	if destination := response.SelectAttrValue("Destination", ""); destination != "" && destination != sp.ACSURL {
+		return errors.Errorf("the destination %q of the SAML response mismatches %q", destination, sp.ACSURL)
+	}
+	if inResponseTo := response.SelectAttrValue("InResponseTo", ""); inResponseTo != requestID {
+		return errors.Errorf("the SAML response is in response to %q, expecting %q", inResponseTo, requestID)
+	}
+	if issuer := findChild(response, assertionNamespace, "Issuer"); issuer != nil && strings.TrimSpace(issuer.Text()) != p.config.EntityID {
+		return errors.Errorf("the issuer %q of the SAML response mismatches %q", strings.TrimSpace(issuer.Text()), p.config.EntityID)
+	}
+	status := findChild(response, protocolNamespace, "Status")
+	if status == nil {
+		return errors.New("the SAML response doesn't contain the status")
+	}
+	statusCode := findChild(status, protocolNamespace, "StatusCode")
+	if statusCode == nil || statusCode.SelectAttrValue("Value", "") != statusSuccess {
+		message := ""
+		if statusMessage := findChild(status, protocolNamespace, "StatusMessage"); statusMessage != nil {
+			message = statusMessage.Text()
+		}
+		return errors.Errorf("the SAML authentication failed: %s", message)
+	}
+	return nil
+}
+
+func (p *IdentityProvider) validateAssertion(assertion *etree.Element, sp ServiceProvider, requestID string, now time.Time) error {
+	issuer := findChild(assertion, assertionNamespace, "Issuer")
+	if issuer == nil || strings.TrimSpace(issuer.Text()) != p.config.EntityID {
+		return errors.Errorf("the issuer of the assertion mismatches %q", p.config.EntityID)
+	}
+
+	subject := findChild(assertion, assertionNamespace, "Subject")
+	if subject == nil {
+		return errors.New("the assertion doesn't contain the subject")
+	}
+	confirmed := false
+	for _, confirmation := range findChildren(subject, assertionNamespace, "SubjectConfirmation") {
+		if confirmation.SelectAttrValue("Method", "") != bearerMethod {
+			continue
+		}
+		data := findChild(confirmation, assertionNamespace, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if recipient := data.SelectAttrValue("Recipient", ""); recipient != sp.ACSURL {
+			continue
+		}
+		if inResponseTo := data.SelectAttrValue("InResponseTo", ""); inResponseTo != "" && inResponseTo != requestID {
+			continue
+		}
+		notOnOrAfter, err := parseTime(data.SelectAttrValue("NotOnOrAfter", ""))
+		if err != nil || !now.Before(notOnOrAfter.Add(maxClockSkew)) {
+			continue
+		}
+		confirmed = true
+		break
+	}
+	if !confirmed {
+		return errors.New("the subject of the assertion cannot be confirmed, the assertion may be expired or issued to another service provider")
+	}
+
+	conditions := findChild(assertion, assertionNamespace, "Conditions")
+	if conditions == nil {
+		return errors.New("the assertion doesn't contain the conditions")
+	}
+	if v := conditions.SelectAttrValue("NotBefore", ""); v != "" {
+		notBefore, err := parseTime(v)
+		if err != nil {
+			return err
+		}
+		if now.Add(maxClockSkew).Before(notBefore) {
+			return errors.New("the assertion is not valid yet")
+		}
+	}
+	if v := conditions.SelectAttrValue("NotOnOrAfter", ""); v != "" {
+		notOnOrAfter, err := parseTime(v)
+		if err != nil {
+			return err
+		}
+		if !now.Before(notOnOrAfter.Add(maxClockSkew)) {
+			return errors.New("the assertion is expired")
+		}
+	}
+	for _, restriction := range findChildren(conditions, assertionNamespace, "AudienceRestriction") {
+		matched := false
+		for _, audience := range findChildren(restriction, assertionNamespace, "Audience") {
+			if strings.TrimSpace(audience.Text()) == sp.EntityID {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return errors.Errorf("the assertion is not issued to the audience %q", sp.EntityID)
+		}
+	}
+	return nil
+}
+
+func (p *IdentityProvider) getUserInfo(assertion *etree.Element) (*idp.UserInfo, error) {
+	attributes := make(map[string][]string)
+	for _, statement := range findChildren(assertion, assertionNamespace, "AttributeStatement") {
+		for _, attribute := range findChildren(statement, assertionNamespace, "Attribute") {
+			name := attribute.SelectAttrValue("Name", "")
+			for _, value := range findChildren(attribute, assertionNamespace, "AttributeValue") {
+				attributes[name] = append(attributes[name], strings.TrimSpace(value.Text()))
+			}
+		}
+	}
+
+	userInfo := &idp.UserInfo{Issuer: p.config.EntityID}
+	if subject := findChild(assertion, assertionNamespace, "Subject"); subject != nil {
+		if nameID := findChild(subject, assertionNamespace, "NameID"); nameID != nil {
+			userInfo.Subject = strings.TrimSpace(nameID.Text())
+		}
+	}
+	if userInfo.Subject == "" {
+		return nil, errors.New("the NameID of the subject is missing in the assertion")
+	}
+	if p.config.FieldMapping.Email == "" {
+		userInfo.Email = userInfo.Subject
+	} else if values := attributes[p.config.FieldMapping.Email]; len(values) > 0 {
+		userInfo.Email = values[0]
+	}
+	if userInfo.Email == "" {
+		return nil, errors.New("the email of the user is missing in the assertion")
+	}
+	displayNameAttribute := p.config.FieldMapping.DisplayName
+	if displayNameAttribute == "" {
+		displayNameAttribute = defaultDisplayNameAttribute
+	}
+	if values := attributes[displayNameAttribute]; len(values) > 0 {
+		userInfo.DisplayName = values[0]
+	}
+	groupsAttribute := p.config.FieldMapping.Groups
+	if groupsAttribute == "" {
+		groupsAttribute = defaultGroupsAttribute
+	}
+	userInfo.Groups = attributes[groupsAttribute]
+	return userInfo, nil
+}
+
+// Metadata returns the metadata of the service provider, which could be imported by the identity provider.
+func Metadata(sp ServiceProvider) ([]byte, error) {
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
+	entity := doc.CreateElement("md:EntityDescriptor")
+	entity.CreateAttr("xmlns:md", metadataNamespace)
+	entity.CreateAttr("entityID", sp.EntityID)
+	descriptor := entity.CreateElement("md:SPSSODescriptor")
+	descriptor.CreateAttr("AuthnRequestsSigned", "false")
+	descriptor.CreateAttr("WantAssertionsSigned", "true")
+	descriptor.CreateAttr("protocolSupportEnumeration", protocolNamespace)
+	descriptor.CreateElement("md:NameIDFormat").SetText(nameIDFormatEmail)
+	acs := descriptor.CreateElement("md:AssertionConsumerService")
+	acs.CreateAttr("Binding", httpPostBinding)
+	acs.CreateAttr("Location", sp.ACSURL)
+	acs.CreateAttr("index", "0")
+	doc.Indent(2)
+	return doc.WriteToBytes()
+}
+
+// detach returns a copy of the element with the namespace declarations of its ancestors,
+// so that the element could be canonicalized and verified on its own.
+func detach(el *etree.Element) *etree.Element {
+	detached := el.Copy()
+	declared := make(map[string]bool)
+	for _, attr := range detached.Attr {
+		if isNamespaceDeclaration(attr) {
+			declared[attr.Key] = true
+		}
+	}
+	for parent := el.Parent(); parent != nil; parent = parent.Parent() {
+		for _, attr := range parent.Attr {
+			if isNamespaceDeclaration(attr) && !declared[attr.Key] {
+				declared[attr.Key] = true
+				detached.Attr = append(detached.Attr, attr)
+			}
+		}
+	}
+	return detached
+}
+
+func isNamespaceDeclaration(attr etree.Attr) bool {
+	return attr.Space == "xmlns" || (attr.Space == "" && attr.Key == "xmlns")
+}
+
+func isElement(el *etree.Element, namespace, tag string) bool {
+	return el.Tag == tag && el.NamespaceURI() == namespace
+}
+
+func findChild(el *etree.Element, namespace, tag string) *etree.Element {
+	for _, child := range el.ChildElements() {
+		if isElement(child, namespace, tag) {
+			return child
+		}
+	}
+	return nil
+}
+
+func findChildren(el *etree.Element, namespace, tag string) []*etree.Element {
+	var children []*etree.Element
+	for _, child := range el.ChildElements() {
+		if isElement(child, namespace, tag) {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func parseTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid time %q", s)
+	}
+	return t, nil
+}
@@ -0,0 +1,267 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/plugin/idp"
+)
+
+const (
+	testEntityID  = "https://idp.example.com/metadata"
+	testSSOURL    = "https://idp.example.com/sso"
+	testRequestID = "id-request"
+)
+
+var testServiceProvider = ServiceProvider{
+	EntityID: "https://bytebase.example.com/api/auth/idp/101/metadata",
+	ACSURL:   "https://bytebase.example.com/api/auth/idp/101/acs",
+}
+
+func generateCertificate(t *testing.T) (*rsa.PrivateKey, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return key, der
+}
+
+type testResponse struct {
+	issuer       string
+	audience     string
+	inResponseTo string
+	notOnOrAfter time.Time
+	statusCode   string
+}
+
+func newTestResponse() testResponse {
+	return testResponse{
+		issuer:       testEntityID,
+		audience:     testServiceProvider.EntityID,
+		inResponseTo: testRequestID,
+		notOnOrAfter: time.Now().Add(5 * time.Minute),
+		statusCode:   statusSuccess,
+	}
+}
+
+// build builds the response document and the assertion to sign.
+func (r testResponse) build() (*etree.Document, *etree.Element) {
+	now := time.Now().UTC().Format(timeFormat)
+	notOnOrAfter := r.notOnOrAfter.UTC().Format(timeFormat)
+
+	doc := etree.NewDocument()
+	response := doc.CreateElement("samlp:Response")
+	response.CreateAttr("xmlns:samlp", protocolNamespace)
+	response.CreateAttr("xmlns:saml", assertionNamespace)
+	response.CreateAttr("ID", "id-response")
+	response.CreateAttr("Version", "2.0")
+	response.CreateAttr("IssueInstant", now)
+	response.CreateAttr("Destination", testServiceProvider.ACSURL)
+	response.CreateAttr("InResponseTo", r.inResponseTo)
+	response.CreateElement("saml:Issuer").SetText(r.issuer)
+	response.CreateElement("samlp:Status").CreateElement("samlp:StatusCode").CreateAttr("Value", r.statusCode)
+
+	assertion := response.CreateElement("saml:Assertion")
+	assertion.CreateAttr("ID", "id-assertion")
+	assertion.CreateAttr("Version", "2.0")
+	assertion.CreateAttr("IssueInstant", now)
+	assertion.CreateElement("saml:Issuer").SetText(r.issuer)
+	subject := assertion.CreateElement("saml:Subject")
+	nameID := subject.CreateElement("saml:NameID")
+	nameID.CreateAttr("Format", nameIDFormatEmail)
+	nameID.SetText("alice@example.com")
+	confirmation := subject.CreateElement("saml:SubjectConfirmation")
+	confirmation.CreateAttr("Method", bearerMethod)
+	data := confirmation.CreateElement("saml:SubjectConfirmationData")
+	data.CreateAttr("InResponseTo", r.inResponseTo)
+	data.CreateAttr("NotOnOrAfter", notOnOrAfter)
+	data.CreateAttr("Recipient", testServiceProvider.ACSURL)
+	conditions := assertion.CreateElement("saml:Conditions")
+	conditions.CreateAttr("NotBefore", now)
+	conditions.CreateAttr("NotOnOrAfter", notOnOrAfter)
+	conditions.CreateElement("saml:AudienceRestriction").CreateElement("saml:Audience").SetText(r.audience)
+	statement := assertion.CreateElement("saml:AttributeStatement")
+	displayName := statement.CreateElement("saml:Attribute")
+	displayName.CreateAttr("Name", "displayName")
+	displayName.CreateElement("saml:AttributeValue").SetText("Alice")
+	groups := statement.CreateElement("saml:Attribute")
+	groups.CreateAttr("Name", "groups")
+	groups.CreateElement("saml:AttributeValue").SetText("dba")
+	groups.CreateElement("saml:AttributeValue").SetText("dev")
+	return doc, assertion
+}
+
+// sign signs the assertion in place.
+func sign(t *testing.T, key *rsa.PrivateKey, der []byte, assertion *etree.Element) {
+	signingContext := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}))
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signed, err := signingContext.SignEnveloped(detach(assertion))
+	require.NoError(t, err)
+	// Keep the signature only, the namespace declarations are inherited from the response.
+	signature := signed.ChildElements()[len(signed.ChildElements())-1]
+	assertion.InsertChildAt(1, signature)
+}
+
+func encode(t *testing.T, doc *etree.Document) string {
+	b, err := doc.WriteToBytes()
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestGetUserInfo(t *testing.T) {
+	key, der := generateCertificate(t)
+	p, err := NewIdentityProvider(IdentityProviderConfig{
+		EntityID:    testEntityID,
+		SSOURL:      testSSOURL,
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	})
+	require.NoError(t, err)
+
+	doc, assertion := newTestResponse().build()
+	sign(t, key, der, assertion)
+	userInfo, err := p.GetUserInfo(testServiceProvider, encode(t, doc), testRequestID, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, &idp.UserInfo{
+		Issuer:      testEntityID,
+		Subject:     "alice@example.com",
+		Email:       "alice@example.com",
+		DisplayName: "Alice",
+		Groups:      []string{"dba", "dev"},
+	}, userInfo)
+
+	// Replayed response for another request.
+	_, err = p.GetUserInfo(testServiceProvider, encode(t, doc), "id-another-request", time.Now())
+	require.Error(t, err)
+
+	// Expired response.
+	_, err = p.GetUserInfo(testServiceProvider, encode(t, doc), testRequestID, time.Now().Add(time.Hour))
+	require.Error(t, err)
+
+	// Tampered assertion.
+	for _, value := range assertion.FindElements("//saml:AttributeValue") {
+		if value.Text() == "dev" {
+			value.SetText("owner")
+		}
+	}
+	_, err = p.GetUserInfo(testServiceProvider, encode(t, doc), testRequestID, time.Now())
+	require.Error(t, err)
+}
+
+func TestGetUserInfoInvalid(t *testing.T) {
+	key, der := generateCertificate(t)
+	p, err := NewIdentityProvider(IdentityProviderConfig{
+		EntityID:    testEntityID,
+		SSOURL:      testSSOURL,
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	})
+	require.NoError(t, err)
+	anotherKey, anotherDER := generateCertificate(t)
+
+	tests := []struct {
+		name     string
+		response testResponse
+		unsigned bool
+		// signedByAnother is whether the assertion is signed by an untrusted certificate.
+		signedByAnother bool
+	}{
+		{
+			name:     "unsigned",
+			response: newTestResponse(),
+			unsigned: true,
+		},
+		{
+			name:            "untrusted certificate",
+			response:        newTestResponse(),
+			signedByAnother: true,
+		},
+		{
+			name: "another audience",
+			response: func() testResponse {
+				r := newTestResponse()
+				r.audience = "https://another.example.com"
+				return r
+			}(),
+		},
+		{
+			name: "another issuer",
+			response: func() testResponse {
+				r := newTestResponse()
+				r.issuer = "https://another.example.com"
+				return r
+			}(),
+		},
+		{
+			name: "failed status",
+			response: func() testResponse {
+				r := newTestResponse()
+				r.statusCode = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+				return r
+			}(),
+		},
+	}
+
+	for _, test := range tests {
+		doc, assertion := test.response.build()
+		if test.signedByAnother {
+			sign(t, anotherKey, anotherDER, assertion)
+		} else if !test.unsigned {
+			sign(t, key, der, assertion)
+		}
+		_, err := p.GetUserInfo(testServiceProvider, encode(t, doc), testRequestID, time.Now())
+		require.Error(t, err, test.name)
+	}
+}
+
+func TestAuthnRequestURL(t *testing.T) {
+	_, der := generateCertificate(t)
+	p, err := NewIdentityProvider(IdentityProviderConfig{
+		EntityID:    testEntityID,
+		SSOURL:      testSSOURL + "?tenant=bytebase",
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	})
+	require.NoError(t, err)
+
+	requestURL, err := p.AuthnRequestURL(testServiceProvider, testRequestID, "relay", time.Now())
+	require.NoError(t, err)
+	u, err := url.Parse(requestURL)
+	require.NoError(t, err)
+	require.Equal(t, "bytebase", u.Query().Get("tenant"))
+	require.Equal(t, "relay", u.Query().Get("RelayState"))
+
+	compressed, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	require.NoError(t, err)
+	raw, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	require.NoError(t, err)
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(raw))
+	request := doc.Root()
+	require.True(t, isElement(request, protocolNamespace, "AuthnRequest"))
+	require.Equal(t, testRequestID, request.SelectAttrValue("ID", ""))
+	require.Equal(t, testServiceProvider.ACSURL, request.SelectAttrValue("AssertionConsumerServiceURL", ""))
+	require.Equal(t, testServiceProvider.EntityID, findChild(request, assertionNamespace, "Issuer").Text())
+}
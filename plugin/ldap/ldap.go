@@ -0,0 +1,281 @@
+// Package ldap is the plugin for syncing the users and groups from the LDAP directories, such as OpenLDAP and Active Directory.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultUserFilter           = "(objectClass=person)"
+	defaultEmailAttribute       = "mail"
+	defaultNameAttribute        = "cn"
+	defaultGroupFilter          = "(|(objectClass=groupOfNames)(objectClass=groupOfUniqueNames)(objectClass=group))"
+	defaultGroupNameAttribute   = "cn"
+	defaultGroupMemberAttribute = "member"
+	memberOfAttribute           = "memberOf"
+
+	pagingSize = 500
+	timeout    = 30 * time.Second
+)
+
+// Config is the configuration of an LDAP directory.
+type Config struct {
+	// URL is the LDAP server URL, such as ldap://ldap.example.com:389 or ldaps://ldap.example.com:636.
+	URL string `json:"url"`
+	// StartTLS upgrades the ldap:// connection with StartTLS.
+	StartTLS           bool `json:"startTls"`
+	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+	// BindDN and BindPassword are the credentials of the service account to search the directory.
+	BindDN       string `json:"bindDn"`
+	BindPassword string `json:"bindPassword"`
+
+	// UserBaseDN is the base DN to search the users, such as ou=people,dc=example,dc=com.
+	UserBaseDN string `json:"userBaseDn"`
+	// UserFilter defaults to (objectClass=person).
+	UserFilter string `json:"userFilter"`
+	// EmailAttribute defaults to mail.
+	EmailAttribute string `json:"emailAttribute"`
+	// NameAttribute defaults to cn.
+	NameAttribute string `json:"nameAttribute"`
+
+	// GroupBaseDN is the base DN to search the groups, such as ou=groups,dc=example,dc=com.
+	// If empty, the groups are read from the memberOf attribute of the users, which is the case of Active Directory.
+	GroupBaseDN string `json:"groupBaseDn"`
+	// GroupFilter defaults to the groupOfNames, groupOfUniqueNames and group object classes.
+	GroupFilter string `json:"groupFilter"`
+	// GroupNameAttribute defaults to cn.
+	GroupNameAttribute string `json:"groupNameAttribute"`
+	// GroupMemberAttribute is the attribute listing the member DNs, defaults to member.
+	GroupMemberAttribute string `json:"groupMemberAttribute"`
+}
+
+// User is a user in the LDAP directory.
+type User struct {
+	DN    string
+	Email string
+	Name  string
+	// Groups is the sorted list of the group names the user belongs to.
+	Groups []string
+}
+
+// group is a group in the LDAP directory.
+type group struct {
+	name      string
+	memberDNs []string
+}
+
+// Validate validates the config and fills the defaults.
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("URL is required")
+	}
+	if !strings.HasPrefix(c.URL, "ldap://") && !strings.HasPrefix(c.URL, "ldaps://") {
+		return errors.Errorf("URL %q must start with ldap:// or ldaps://", c.URL)
+	}
+	if c.UserBaseDN == "" {
+		return errors.New("user base DN is required")
+	}
+	if c.UserFilter == "" {
+		c.UserFilter = defaultUserFilter
+	}
+	if c.EmailAttribute == "" {
+		c.EmailAttribute = defaultEmailAttribute
+	}
+	if c.NameAttribute == "" {
+		c.NameAttribute = defaultNameAttribute
+	}
+	if c.GroupFilter == "" {
+		c.GroupFilter = defaultGroupFilter
+	}
+	if c.GroupNameAttribute == "" {
+		c.GroupNameAttribute = defaultGroupNameAttribute
+	}
+	if c.GroupMemberAttribute == "" {
+		c.GroupMemberAttribute = defaultGroupMemberAttribute
+	}
+	return nil
+}
+
+// Fetch fetches the users and their groups from the LDAP directory.
+// The users without the email attribute are skipped since the email identifies a Bytebase principal.
+func Fetch(ctx context.Context, config Config) ([]*User, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	conn, err := connect(config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	// Close the connection on cancellation so that the pending operations return.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	userAttributes := []string{config.EmailAttribute, config.NameAttribute}
+	if config.GroupBaseDN == "" {
+		userAttributes = append(userAttributes, memberOfAttribute)
+	}
+	userEntries, err := search(conn, config.UserBaseDN, config.UserFilter, userAttributes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search users")
+	}
+
+	var groups []*group
+	if config.GroupBaseDN != "" {
+		groupEntries, err := search(conn, config.GroupBaseDN, config.GroupFilter, []string{config.GroupNameAttribute, config.GroupMemberAttribute})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to search groups")
+		}
+		for _, entry := range groupEntries {
+			groups = append(groups, &group{
+				name:      entry.GetAttributeValue(config.GroupNameAttribute),
+				memberDNs: entry.GetAttributeValues(config.GroupMemberAttribute),
+			})
+		}
+	}
+	return convertUsers(config, userEntries, groups), nil
+}
+
+// Authenticate verifies the password of the user by binding as the user.
+func Authenticate(config Config, dn, password string) error {
+	// An empty password results in an unauthenticated bind which always succeeds.
+	if password == "" {
+		return errors.New("password is required")
+	}
+	config.BindDN = dn
+	config.BindPassword = password
+	conn, err := connect(config)
+	if err != nil {
+		return err
+	}
+	conn.Close()
+	return nil
+}
+
+func connect(config Config) (*ldap.Conn, error) {
+	tlsConfig := &tls.Config{
+		// #nosec G402
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	conn, err := ldap.DialURL(config.URL, ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial %q", config.URL)
+	}
+	conn.SetTimeout(timeout)
+
+	if config.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if config.BindDN != "" {
+		if err := conn.Bind(config.BindDN, config.BindPassword); err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "failed to bind as %q", config.BindDN)
+		}
+	}
+	return conn, nil
+}
+
+func search(conn *ldap.Conn, baseDN, filter string, attributes []string) ([]*ldap.Entry, error) {
+	request := ldap.NewSearchRequest(
+		baseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		0,     /* sizeLimit */
+		0,     /* timeLimit */
+		false, /* typesOnly */
+		filter,
+		attributes,
+		nil, /* controls */
+	)
+	result, err := conn.SearchWithPaging(request, pagingSize)
+	if err != nil {
+		return nil, err
+	}
+	return result.Entries, nil
+}
+
+// convertUsers converts the LDAP entries to the users and resolves their groups.
+// The groups are read from the memberOf attribute if the group base DN is not configured.
+func convertUsers(config Config, userEntries []*ldap.Entry, groups []*group) []*User {
+	// groupNames maps the normalized member DN to the names of the groups it belongs to.
+	groupNames := make(map[string][]string)
+	for _, g := range groups {
+		if g.name == "" {
+			continue
+		}
+		for _, memberDN := range g.memberDNs {
+			dn := normalizeDN(memberDN)
+			groupNames[dn] = append(groupNames[dn], g.name)
+		}
+	}
+
+	var users []*User
+	for _, entry := range userEntries {
+		email := strings.ToLower(strings.TrimSpace(entry.GetAttributeValue(config.EmailAttribute)))
+		if email == "" {
+			continue
+		}
+		user := &User{
+			DN:    entry.DN,
+			Email: email,
+			Name:  entry.GetAttributeValue(config.NameAttribute),
+		}
+		if user.Name == "" {
+			user.Name = strings.Split(email, "@")[0]
+		}
+
+		nameSet := make(map[string]bool)
+		if config.GroupBaseDN != "" {
+			for _, name := range groupNames[normalizeDN(entry.DN)] {
+				nameSet[name] = true
+			}
+		} else {
+			for _, groupDN := range entry.GetAttributeValues(memberOfAttribute) {
+				if name := getFirstRDNValue(groupDN); name != "" {
+					nameSet[name] = true
+				}
+			}
+		}
+		for name := range nameSet {
+			user.Groups = append(user.Groups, name)
+		}
+		sort.Strings(user.Groups)
+		users = append(users, user)
+	}
+	return users
+}
+
+// normalizeDN normalizes the DN for comparison, falling back to the lowercased DN if it fails to parse.
+func normalizeDN(s string) string {
+	dn, err := ldap.ParseDN(s)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(s))
+	}
+	return strings.ToLower(dn.String())
+}
+
+// getFirstRDNValue returns the value of the first relative DN, such as "dba" for "cn=dba,ou=groups,dc=example,dc=com".
+func getFirstRDNValue(s string) string {
+	dn, err := ldap.ParseDN(s)
+	if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+		return ""
+	}
+	return dn.RDNs[0].Attributes[0].Value
+}
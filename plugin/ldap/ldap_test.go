@@ -0,0 +1,145 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertUsers(t *testing.T) {
+	userEntries := []*ldap.Entry{
+		ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+			"mail": {"Alice@Example.com"},
+			"cn":   {"Alice"},
+			"memberOf": {
+				"cn=dba,ou=groups,dc=example,dc=com",
+				"CN=dev,OU=groups,DC=example,DC=com",
+			},
+		}),
+		ldap.NewEntry("uid=bob,ou=people,dc=example,dc=com", map[string][]string{
+			"mail": {"bob@example.com"},
+		}),
+		// No email.
+		ldap.NewEntry("uid=carol,ou=people,dc=example,dc=com", map[string][]string{
+			"cn": {"Carol"},
+		}),
+	}
+
+	tests := []struct {
+		name   string
+		config Config
+		groups []*group
+		want   []*User
+	}{
+		{
+			name:   "memberOf",
+			config: Config{},
+			want: []*User{
+				{DN: "uid=alice,ou=people,dc=example,dc=com", Email: "alice@example.com", Name: "Alice", Groups: []string{"dba", "dev"}},
+				{DN: "uid=bob,ou=people,dc=example,dc=com", Email: "bob@example.com", Name: "bob"},
+			},
+		},
+		{
+			name:   "group search",
+			config: Config{GroupBaseDN: "ou=groups,dc=example,dc=com"},
+			groups: []*group{
+				{name: "owner", memberDNs: []string{"UID=Bob, OU=people, DC=example, DC=com"}},
+				{name: "dev", memberDNs: []string{"uid=alice,ou=people,dc=example,dc=com", "uid=bob,ou=people,dc=example,dc=com"}},
+				{name: "", memberDNs: []string{"uid=alice,ou=people,dc=example,dc=com"}},
+			},
+			want: []*User{
+				{DN: "uid=alice,ou=people,dc=example,dc=com", Email: "alice@example.com", Name: "Alice", Groups: []string{"dev"}},
+				{DN: "uid=bob,ou=people,dc=example,dc=com", Email: "bob@example.com", Name: "bob", Groups: []string{"dev", "owner"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		config := test.config
+		config.URL = "ldap://localhost:389"
+		config.UserBaseDN = "ou=people,dc=example,dc=com"
+		require.NoError(t, config.Validate())
+		require.Equal(t, test.want, convertUsers(config, userEntries, test.groups), test.name)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		config  Config
+		wantErr bool
+	}{
+		{
+			config:  Config{URL: "ldap://localhost:389", UserBaseDN: "dc=example,dc=com"},
+			wantErr: false,
+		},
+		{
+			config:  Config{URL: "ldaps://localhost:636", UserBaseDN: "dc=example,dc=com"},
+			wantErr: false,
+		},
+		{
+			config:  Config{URL: "localhost:389", UserBaseDN: "dc=example,dc=com"},
+			wantErr: true,
+		},
+		{
+			config:  Config{URL: "ldap://localhost:389"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		err := test.config.Validate()
+		if test.wantErr {
+			require.Error(t, err, test.config.URL)
+		} else {
+			require.NoError(t, err, test.config.URL)
+			require.Equal(t, defaultUserFilter, test.config.UserFilter)
+		}
+	}
+}
+
+// Only for manual test against a local OpenLDAP container.
+// Should be skipped in CI.
+//
+//	docker run --rm -p 389:389 -e LDAP_ORGANISATION=example -e LDAP_DOMAIN=example.com -e LDAP_ADMIN_PASSWORD=admin osixia/openldap:1.5.0
+func TestFetchOpenLDAP(t *testing.T) {
+	t.Skip()
+	a := require.New(t)
+	config := Config{
+		URL:          "ldap://localhost:389",
+		BindDN:       "cn=admin,dc=example,dc=com",
+		BindPassword: "admin",
+		UserBaseDN:   "ou=people,dc=example,dc=com",
+		GroupBaseDN:  "ou=groups,dc=example,dc=com",
+	}
+	a.NoError(config.Validate())
+
+	conn, err := connect(config)
+	a.NoError(err)
+	defer conn.Close()
+	entries := []struct {
+		dn         string
+		attributes map[string][]string
+	}{
+		{dn: "ou=people,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"organizationalUnit"}, "ou": {"people"}}},
+		{dn: "ou=groups,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"organizationalUnit"}, "ou": {"groups"}}},
+		{dn: "uid=alice,ou=people,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"inetOrgPerson"}, "uid": {"alice"}, "cn": {"Alice"}, "sn": {"Alice"}, "mail": {"alice@example.com"}}},
+		{dn: "uid=bob,ou=people,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"inetOrgPerson"}, "uid": {"bob"}, "cn": {"Bob"}, "sn": {"Bob"}, "mail": {"bob@example.com"}}},
+		{dn: "cn=dba,ou=groups,dc=example,dc=com", attributes: map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"dba"}, "member": {"uid=alice,ou=people,dc=example,dc=com"}}},
+	}
+	for _, entry := range entries {
+		request := ldap.NewAddRequest(entry.dn, nil)
+		for name, values := range entry.attributes {
+			request.Attribute(name, values)
+		}
+		a.NoError(conn.Add(request))
+	}
+
+	users, err := Fetch(context.Background(), config)
+	a.NoError(err)
+	a.Equal([]*User{
+		{DN: "uid=alice,ou=people,dc=example,dc=com", Email: "alice@example.com", Name: "Alice", Groups: []string{"dba"}},
+		{DN: "uid=bob,ou=people,dc=example,dc=com", Email: "bob@example.com", Name: "Bob"},
+	}, users)
+}
@@ -28,6 +28,10 @@ type tokenizer struct {
 	len    uint
 	line   int
 
+	// collectComment is true to collect the comments into commentList while scanning.
+	collectComment bool
+	commentList    []Comment
+
 	// steaming API specific field
 	reader  *bufio.Reader
 	f       func(string) error
@@ -625,6 +629,21 @@ func (t *tokenizer) scanDoubleDollarQuotedString() error {
 }
 
 func (t *tokenizer) scanComment() error {
+	if !t.collectComment {
+		return t.skipComment()
+	}
+	startPos, line := t.pos(), t.line
+	if err := t.skipComment(); err != nil {
+		return err
+	}
+	t.commentList = append(t.commentList, Comment{
+		Text: strings.TrimRight(t.getString(startPos, t.pos()-startPos), "\r\n"),
+		Line: line,
+	})
+	return nil
+}
+
+func (t *tokenizer) skipComment() error {
 	switch {
 	case t.char(0) == '/' && t.char(1) == '*':
 		t.skip(2)
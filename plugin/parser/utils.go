@@ -36,6 +36,33 @@ func SplitMultiSQL(engineType EngineType, statement string) ([]SingleSQL, error)
 	}
 }
 
+// Comment is a comment in the SQL, including the comment markers such as "--" and "/* */".
+type Comment struct {
+	Text string
+	// Line is the line where the comment starts.
+	Line int
+}
+
+// ExtractComments extracts the comments from the statement with the comment syntax of the engine,
+// so the comment markers in the string literals and quoted identifiers are not comments.
+func ExtractComments(engineType EngineType, statement string) ([]Comment, error) {
+	t := newTokenizer(statement)
+	t.collectComment = true
+	switch engineType {
+	case Postgres:
+		if _, err := t.splitPostgreSQLMultiSQL(); err != nil {
+			return nil, err
+		}
+	case MySQL, TiDB:
+		if _, err := t.splitMySQLMultiSQL(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("engine type is not supported: %s", engineType)
+	}
+	return t.commentList, nil
+}
+
 // SplitMultiSQLStream splits statement stream into a slice of the single SQL.
 func SplitMultiSQLStream(engineType EngineType, src io.Reader, f func(string) error) ([]SingleSQL, error) {
 	switch engineType {
@@ -209,3 +209,39 @@ func TestExtractDatabaseList(t *testing.T) {
 		require.Equal(t, test.want, res)
 	}
 }
+
+func TestExtractComments(t *testing.T) {
+	tests := []struct {
+		engineType EngineType
+		statement  string
+		want       []Comment
+	}{
+		{
+			engineType: MySQL,
+			statement: `-- c1
+INSERT INTO t VALUES ('-- not a comment', "/* nor this */"); # c2
+/* c3
+*/ SELECT ` + "`#col`" + ` FROM t;`,
+			want: []Comment{
+				{Text: "-- c1", Line: 1},
+				{Text: "# c2", Line: 2},
+				{Text: "/* c3\n*/", Line: 3},
+			},
+		},
+		{
+			engineType: Postgres,
+			statement: `SELECT '-- not a comment', $$ /* nor this */ $$ # 1; -- c1
+/* c2 */ SELECT "--col" FROM t;`,
+			want: []Comment{
+				{Text: "-- c1", Line: 1},
+				{Text: "/* c2 */", Line: 2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		comments, err := ExtractComments(test.engineType, test.statement)
+		require.NoError(t, err)
+		require.Equal(t, test.want, comments, test.statement)
+	}
+}
@@ -0,0 +1,356 @@
+// Package backupfile encodes and decodes the backup files with optional compression and encryption.
+//
+// A plain backup file is the SQL dump itself, which is compatible with the backup files taken before.
+// An encoded backup file starts with a header describing how the dump is encoded:
+//
+//	magic "BBBACKUP" | version (1 byte) | compression (1 byte) | encryption (1 byte) | nonce prefix (7 bytes, encryption only)
+//
+// The dump is compressed first and then encrypted. The encryption uses AES-256-GCM over fixed size chunks,
+// so that the backup file can be streamed without holding the whole dump in memory.
+// The nonce of each chunk is the nonce prefix, the big endian chunk counter and a flag for the last chunk,
+// which prevents the chunks from being reordered or truncated. The header is authenticated as the additional data of each chunk.
+package backupfile
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/api"
+)
+
+const (
+	magic   = "BBBACKUP"
+	version = 1
+
+	compressionNone = 0
+	compressionGzip = 1
+	compressionZstd = 2
+
+	encryptionNone      = 0
+	encryptionAES256GCM = 1
+
+	// KeySize is the size of the AES-256 key.
+	KeySize = 32
+	// chunkSize is the plaintext size of an encrypted chunk.
+	chunkSize       = 64 * 1024
+	noncePrefixSize = 7
+	headerSize      = len(magic) + 3
+)
+
+// Options is the options to encode a backup file.
+type Options struct {
+	// Compression is the compression algorithm. Empty means not compressed.
+	Compression api.BackupCompression
+	// Key is the AES-256 key to encrypt the backup file. Nil means not encrypted.
+	Key []byte
+}
+
+// GenerateKey generates a random base64 encoded AES-256 key.
+func GenerateKey() (string, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", errors.Wrap(err, "failed to generate backup encryption key")
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// DecodeKey decodes the base64 encoded AES-256 key.
+func DecodeKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode backup encryption key")
+	}
+	if len(key) != KeySize {
+		return nil, errors.Errorf("invalid backup encryption key size %d, expecting %d", len(key), KeySize)
+	}
+	return key, nil
+}
+
+// NewWriter returns a writer encoding the backup file to w.
+// The caller must close the writer to flush the encoded backup file, which doesn't close w.
+// The backup file is written as is if neither compression nor encryption is required.
+func NewWriter(w io.Writer, opts Options) (io.WriteCloser, error) {
+	if opts.Compression == "" && opts.Key == nil {
+		return nopWriteCloser{w}, nil
+	}
+
+	header := []byte(magic)
+	header = append(header, version)
+	switch opts.Compression {
+	case "":
+		header = append(header, compressionNone)
+	case api.BackupCompressionGzip:
+		header = append(header, compressionGzip)
+	case api.BackupCompressionZstd:
+		header = append(header, compressionZstd)
+	default:
+		return nil, errors.Errorf("unsupported backup compression %q", opts.Compression)
+	}
+	if opts.Key == nil {
+		header = append(header, encryptionNone)
+	} else {
+		header = append(header, encryptionAES256GCM)
+	}
+
+	var closers []io.Closer
+	out := w
+	if opts.Key != nil {
+		aead, err := newAEAD(opts.Key)
+		if err != nil {
+			return nil, err
+		}
+		noncePrefix := make([]byte, noncePrefixSize)
+		if _, err := rand.Read(noncePrefix); err != nil {
+			return nil, errors.Wrap(err, "failed to generate nonce")
+		}
+		if _, err := w.Write(append(header, noncePrefix...)); err != nil {
+			return nil, errors.Wrap(err, "failed to write backup file header")
+		}
+		ew := &encryptWriter{
+			w:           w,
+			aead:        aead,
+			header:      header,
+			noncePrefix: noncePrefix,
+			buf:         make([]byte, 0, chunkSize),
+		}
+		out = ew
+		closers = append(closers, ew)
+	} else if _, err := w.Write(header); err != nil {
+		return nil, errors.Wrap(err, "failed to write backup file header")
+	}
+
+	switch opts.Compression {
+	case api.BackupCompressionGzip:
+		gw := gzip.NewWriter(out)
+		out = gw
+		closers = append(closers, gw)
+	case api.BackupCompressionZstd:
+		zw, err := zstd.NewWriter(out)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create zstd writer")
+		}
+		out = zw
+		closers = append(closers, zw)
+	}
+
+	return &encodeWriter{w: out, closers: closers}, nil
+}
+
+// NewReader returns a reader decoding the backup file from r.
+// The plain backup file is read as is. The key is required if the backup file is encrypted.
+// The caller must read to EOF to verify the integrity of the encrypted backup file.
+func NewReader(r io.Reader, key []byte) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(headerSize)
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "failed to read backup file header")
+	}
+	if !bytes.HasPrefix(header, []byte(magic)) || len(header) < headerSize {
+		return io.NopCloser(br), nil
+	}
+	header = append([]byte{}, header...)
+	if _, err := br.Discard(headerSize); err != nil {
+		return nil, errors.Wrap(err, "failed to read backup file header")
+	}
+	if header[len(magic)] != version {
+		return nil, errors.Errorf("unsupported backup file version %d", header[len(magic)])
+	}
+
+	var in io.Reader = br
+	switch header[len(magic)+2] {
+	case encryptionNone:
+	case encryptionAES256GCM:
+		if key == nil {
+			return nil, errors.New("the backup file is encrypted, but the encryption key is not provided")
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		noncePrefix := make([]byte, noncePrefixSize)
+		if _, err := io.ReadFull(br, noncePrefix); err != nil {
+			return nil, errors.Wrap(err, "failed to read nonce")
+		}
+		in = &decryptReader{
+			r:           br,
+			aead:        aead,
+			header:      header,
+			noncePrefix: noncePrefix,
+			buf:         make([]byte, chunkSize+aead.Overhead()),
+		}
+	default:
+		return nil, errors.Errorf("unsupported backup file encryption %d", header[len(magic)+2])
+	}
+
+	switch header[len(magic)+1] {
+	case compressionNone:
+		return io.NopCloser(in), nil
+	case compressionGzip:
+		gr, err := gzip.NewReader(in)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create gzip reader")
+		}
+		return gr, nil
+	case compressionZstd:
+		zr, err := zstd.NewReader(in)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create zstd reader")
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return nil, errors.Errorf("unsupported backup file compression %d", header[len(magic)+1])
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AES cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GCM")
+	}
+	return aead, nil
+}
+
+func getNonce(noncePrefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, noncePrefixSize+5)
+	nonce = append(nonce, noncePrefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// encodeWriter closes the compression and encryption writers in order.
+type encodeWriter struct {
+	w       io.Writer
+	closers []io.Closer
+}
+
+func (w *encodeWriter) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+func (w *encodeWriter) Close() error {
+	// The closers are appended from the innermost writer, so we close them in reverse order.
+	for i := len(w.closers) - 1; i >= 0; i-- {
+		if err := w.closers[i].Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type encryptWriter struct {
+	w           io.Writer
+	aead        cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	counter     uint32
+	buf         []byte
+}
+
+func (w *encryptWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		// We only seal a full chunk when there is more data, because the last chunk is sealed on Close.
+		if len(w.buf) == chunkSize {
+			if err := w.seal(false); err != nil {
+				return n, err
+			}
+		}
+		m := copy(w.buf[len(w.buf):chunkSize], p)
+		w.buf = w.buf[:len(w.buf)+m]
+		p = p[m:]
+		n += m
+	}
+	return n, nil
+}
+
+func (w *encryptWriter) Close() error {
+	return w.seal(true)
+}
+
+func (w *encryptWriter) seal(last bool) error {
+	if w.counter == ^uint32(0) {
+		return errors.New("backup file is too large to encrypt")
+	}
+	ciphertext := w.aead.Seal(nil, getNonce(w.noncePrefix, w.counter, last), w.buf, w.header)
+	if _, err := w.w.Write(ciphertext); err != nil {
+		return errors.Wrap(err, "failed to write encrypted chunk")
+	}
+	w.counter++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+type decryptReader struct {
+	r           *bufio.Reader
+	aead        cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	counter     uint32
+	buf         []byte
+	plaintext   []byte
+	done        bool
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+	for len(r.plaintext) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.plaintext)
+	r.plaintext = r.plaintext[n:]
+	return n, nil
+}
+
+func (r *decryptReader) open() error {
+	n, err := io.ReadFull(r.r, r.buf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		if err == io.EOF {
+			return errors.New("backup file is truncated")
+		}
+		return errors.Wrap(err, "failed to read encrypted chunk")
+	}
+	// The chunk is the last one if there is nothing after it.
+	last := err == io.ErrUnexpectedEOF
+	if !last {
+		if _, err := r.r.Peek(1); err == io.EOF {
+			last = true
+		}
+	}
+	plaintext, err := r.aead.Open(r.buf[:0], getNonce(r.noncePrefix, r.counter, last), r.buf[:n], r.header)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt backup file, the backup file is corrupted or the encryption key is wrong")
+	}
+	r.counter++
+	r.plaintext = plaintext
+	r.done = last
+	return nil
+}
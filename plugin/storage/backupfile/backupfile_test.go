@@ -0,0 +1,107 @@
+package backupfile
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/api"
+)
+
+func encode(t *testing.T, content []byte, opts Options) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, opts)
+	require.NoError(t, err)
+	_, err = w.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decode(encoded []byte, key []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(encoded), key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func TestRoundTrip(t *testing.T) {
+	keyString, err := GenerateKey()
+	require.NoError(t, err)
+	key, err := DecodeKey(keyString)
+	require.NoError(t, err)
+
+	contents := [][]byte{
+		nil,
+		[]byte("CREATE TABLE t(id INT);\n"),
+		// Exactly one chunk.
+		bytes.Repeat([]byte("a"), chunkSize),
+		// Several chunks with a partial last chunk.
+		[]byte(strings.Repeat("INSERT INTO t VALUES (1);\n", 10000)),
+	}
+	optionsList := []Options{
+		{},
+		{Compression: api.BackupCompressionGzip},
+		{Compression: api.BackupCompressionZstd},
+		{Key: key},
+		{Compression: api.BackupCompressionGzip, Key: key},
+		{Compression: api.BackupCompressionZstd, Key: key},
+	}
+	for _, opts := range optionsList {
+		for _, content := range contents {
+			encoded := encode(t, content, opts)
+			if opts.Compression == "" && opts.Key == nil {
+				require.Equal(t, len(content), len(encoded))
+			}
+			got, err := decode(encoded, key)
+			require.NoError(t, err, opts)
+			require.Equal(t, len(content), len(got), opts)
+			require.True(t, bytes.Equal(content, got), opts)
+		}
+	}
+}
+
+func TestDecodeCorrupted(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, KeySize)
+	content := []byte(strings.Repeat("INSERT INTO t VALUES (1);\n", 10000))
+	encoded := encode(t, content, Options{Compression: api.BackupCompressionGzip, Key: key})
+
+	// Missing key.
+	_, err := decode(encoded, nil)
+	require.Error(t, err)
+
+	// Wrong key.
+	_, err = decode(encoded, bytes.Repeat([]byte{2}, KeySize))
+	require.Error(t, err)
+
+	// Flipped bit.
+	flipped := append([]byte{}, encoded...)
+	flipped[len(flipped)/2] ^= 1
+	_, err = decode(flipped, key)
+	require.Error(t, err)
+
+	// Tampered header to drop the compression.
+	tampered := append([]byte{}, encoded...)
+	tampered[len(magic)+1] = compressionNone
+	_, err = decode(tampered, key)
+	require.Error(t, err)
+
+	// Truncated at the chunk boundary.
+	large := bytes.Repeat([]byte("a"), 3*chunkSize)
+	encoded = encode(t, large, Options{Key: key})
+	truncated := encoded[:headerSize+noncePrefixSize+2*(chunkSize+16)]
+	_, err = decode(truncated, key)
+	require.Error(t, err)
+}
+
+func TestDecodeKey(t *testing.T) {
+	_, err := DecodeKey("not base64")
+	require.Error(t, err)
+	_, err = DecodeKey("c2hvcnQ=")
+	require.Error(t, err)
+}
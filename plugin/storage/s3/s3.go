@@ -19,6 +19,9 @@ import (
 type Client struct {
 	c      *s3.Client
 	bucket string
+	// s3Compatible is true for the S3 compatible storage services, such as GCS, OSS and MinIO.
+	// They may not support the additional checksum algorithms of AWS S3.
+	s3Compatible bool
 }
 
 // GetCredentialsFromFile load AWS credentials from file.
@@ -51,6 +54,26 @@ func NewClient(ctx context.Context, region, bucket string, credentials aws.Crede
 	}, nil
 }
 
+// NewS3CompatibleClient returns a new client for the S3 compatible storage service at the endpoint, such as Google Cloud Storage, AliCloud OSS and MinIO.
+// usePathStyle should be true for the services not supporting the virtual hosted-style bucket addressing, such as MinIO.
+func NewS3CompatibleClient(ctx context.Context, region, bucket, endpoint string, usePathStyle bool, credentials aws.Credentials) (*Client, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx,
+		awsconfig.WithRegion(region),
+		awsconfig.WithCredentialsProvider(awscredentials.NewStaticCredentialsProvider(credentials.AccessKeyID, credentials.SecretAccessKey, "")),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load S3 compatible storage config")
+	}
+	return &Client{
+		c: s3.NewFromConfig(cfg, func(o *s3.Options) {
+			o.EndpointResolver = s3.EndpointResolverFromURL(endpoint)
+			o.UsePathStyle = usePathStyle
+		}),
+		bucket:       bucket,
+		s3Compatible: true,
+	}, nil
+}
+
 // ListObjects lists objects with prefix in their names.
 func (c *Client) ListObjects(ctx context.Context, prefix string) ([]types.Object, error) {
 	var ret []types.Object
@@ -78,29 +101,73 @@ func (c *Client) DownloadObject(ctx context.Context, path string, w io.WriterAt)
 	})
 }
 
+// ReadObject returns the reader of the object with path, which streams the object content.
+// The caller should close the reader.
+func (c *Client) ReadObject(ctx context.Context, path string) (io.ReadCloser, error) {
+	output, err := c.c.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &c.bucket,
+		Key:    &path,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Body, nil
+}
+
 // UploadObject uploads an object with the path.
 // Defaults to multipart upload with chunk size 5MB.
+// The body can be a stream without knowing the size in advance, such as a pipe.
 func (c *Client) UploadObject(ctx context.Context, path string, body io.Reader) (*manager.UploadOutput, error) {
 	uploader := manager.NewUploader(c.c)
-	return uploader.Upload(ctx, &s3.PutObjectInput{
-		Bucket:            &c.bucket,
-		Key:               &path,
-		Body:              body,
-		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
-	})
+	input := &s3.PutObjectInput{
+		Bucket: &c.bucket,
+		Key:    &path,
+		Body:   body,
+	}
+	if !c.s3Compatible {
+		input.ChecksumAlgorithm = types.ChecksumAlgorithmSha256
+	}
+	return uploader.Upload(ctx, input)
 }
 
 // DeleteObjects deletes the objects with path.
-func (c *Client) DeleteObjects(ctx context.Context, pathList ...string) (*s3.DeleteObjectsOutput, error) {
+// The S3 compatible storage services may not support the multi-object delete, such as the XML API of GCS,
+// so the objects are deleted one by one for them.
+func (c *Client) DeleteObjects(ctx context.Context, pathList ...string) error {
+	if len(pathList) == 0 {
+		return nil
+	}
+	if c.s3Compatible {
+		for _, path := range pathList {
+			path := path // create a new 'path'.
+			if _, err := c.c.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: &c.bucket,
+				Key:    &path,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to delete object %q", path)
+			}
+		}
+		return nil
+	}
+
 	var oidList []types.ObjectIdentifier
 	for _, path := range pathList {
 		path := path // create a new 'path'.
 		oidList = append(oidList, types.ObjectIdentifier{Key: &path})
 	}
-	return c.c.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+	output, err := c.c.DeleteObjects(ctx, &s3.DeleteObjectsInput{
 		Bucket: &c.bucket,
 		Delete: &types.Delete{Objects: oidList},
 	})
+	if err != nil {
+		return err
+	}
+	// The multi-object delete succeeds even if some objects fail to be deleted.
+	if len(output.Errors) > 0 {
+		e := output.Errors[0]
+		return errors.Errorf("failed to delete %d objects, the first one is %q: %s", len(output.Errors), aws.ToString(e.Key), aws.ToString(e.Message))
+	}
+	return nil
 }
 
 // GetBucket returns the bucket.
@@ -3,7 +3,14 @@ package s3
 import (
 	"bytes"
 	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -59,8 +66,122 @@ func TestS3Operations(t *testing.T) {
 	})
 
 	t.Run("DeleteObjects", func(t *testing.T) {
-		resp, err := client.DeleteObjects(ctx, "backup/test/blob")
+		err := client.DeleteObjects(ctx, "backup/test/blob")
 		a.NoError(err)
-		log.Info("Deleted", zap.Any("meta", resp.ResultMetadata))
 	})
 }
+
+// fakeS3CompatibleServer is an in-process S3 compatible storage service with the path-style bucket addressing.
+// Like the XML API of GCS, it doesn't support the multi-object delete.
+type fakeS3CompatibleServer struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (s *fakeS3CompatibleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucketPrefix := "/" + s.bucket
+	if !strings.HasPrefix(r.URL.Path, bucketPrefix) {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, bucketPrefix), "/")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Query().Has("delete"):
+		http.Error(w, "NotImplemented", http.StatusNotImplemented)
+	case r.Method == http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		s.objects[key] = body
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodGet && key == "":
+		type content struct {
+			Key  string
+			Size int
+		}
+		result := struct {
+			XMLName  xml.Name `xml:"ListBucketResult"`
+			Name     string
+			Prefix   string
+			KeyCount int
+			Contents []content
+		}{Name: s.bucket, Prefix: r.URL.Query().Get("prefix")}
+		var keyList []string
+		for k := range s.objects {
+			if strings.HasPrefix(k, result.Prefix) {
+				keyList = append(keyList, k)
+			}
+		}
+		sort.Strings(keyList)
+		for _, k := range keyList {
+			result.Contents = append(result.Contents, content{Key: k, Size: len(s.objects[k])})
+		}
+		result.KeyCount = len(result.Contents)
+		w.Header().Set("Content-Type", "application/xml")
+		if err := xml.NewEncoder(w).Encode(result); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	case r.Method == http.MethodGet:
+		body, ok := s.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		if _, err := w.Write(body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "NotImplemented", http.StatusNotImplemented)
+	}
+}
+
+func TestS3CompatibleOperations(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	fake := &fakeS3CompatibleServer{bucket: "bytebase", objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client, err := NewS3CompatibleClient(ctx, region, fake.bucket, server.URL, true /* usePathStyle */, aws.Credentials{
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+	})
+	a.NoError(err)
+
+	content := []byte("select 1;")
+	for _, path := range []string{"backup/test/stream", "backup/test/wal"} {
+		// The pipe has no size, which is the same as streaming a dump.
+		pr, pw := io.Pipe()
+		go func() {
+			_, err := pw.Write(content)
+			pw.CloseWithError(err)
+		}()
+		_, err = client.UploadObject(ctx, path, pr)
+		a.NoError(err)
+	}
+
+	reader, err := client.ReadObject(ctx, "backup/test/stream")
+	a.NoError(err)
+	got, err := io.ReadAll(reader)
+	a.NoError(err)
+	a.NoError(reader.Close())
+	a.Equal(content, got)
+
+	list, err := client.ListObjects(ctx, "backup/test/")
+	a.NoError(err)
+	a.Len(list, 2)
+
+	err = client.DeleteObjects(ctx, "backup/test/stream", "backup/test/wal")
+	a.NoError(err)
+	list, err = client.ListObjects(ctx, "backup/")
+	a.NoError(err)
+	a.Empty(list)
+}
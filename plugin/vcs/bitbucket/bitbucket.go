@@ -0,0 +1,473 @@
+// Package bitbucket is the plugin for Bitbucket Cloud and Bitbucket Data Center.
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/vcs"
+	"github.com/bytebase/bytebase/plugin/vcs/internal/oauth"
+)
+
+const (
+	// CloudInstanceURL is the instance URL of Bitbucket Cloud. Any other
+	// instance URL is treated as a self-hosted Bitbucket Data Center.
+	CloudInstanceURL = "https://bitbucket.org"
+
+	// apiPageSize is the default page size when making API requests, which is
+	// the maximum allowed by Bitbucket Cloud.
+	apiPageSize = 100
+)
+
+func init() {
+	vcs.Register(vcs.Bitbucket, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Bitbucket VCS provider. Bitbucket Cloud and Bitbucket Data
+// Center share nothing but the concepts, thus the provider dispatches every
+// call to the implementation of the instance.
+type Provider struct {
+	cloud      *cloudProvider
+	dataCenter *dataCenterProvider
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	if config.Client == nil {
+		config.Client = &http.Client{}
+	}
+	return &Provider{
+		cloud:      &cloudProvider{client: config.Client},
+		dataCenter: &dataCenterProvider{client: config.Client},
+	}
+}
+
+// IsCloud returns true if the instance URL is Bitbucket Cloud.
+func IsCloud(instanceURL string) bool {
+	return strings.TrimSuffix(instanceURL, "/") == CloudInstanceURL
+}
+
+// provider returns the implementation of the given instance.
+func (p *Provider) provider(instanceURL string) vcs.Provider {
+	if IsCloud(instanceURL) {
+		return p.cloud
+	}
+	return p.dataCenter
+}
+
+// APIURL returns the API URL path of a Bitbucket instance.
+func (p *Provider) APIURL(instanceURL string) string {
+	return p.provider(instanceURL).APIURL(instanceURL)
+}
+
+// ExchangeOAuthToken exchanges OAuth content with the provided authorization code.
+//
+// Docs:
+// - https://developer.atlassian.com/cloud/bitbucket/oauth-2/
+// - https://confluence.atlassian.com/bitbucketserver/bitbucket-oauth-2-0-provider-api-1108483661.html
+func (p *Provider) ExchangeOAuthToken(ctx context.Context, instanceURL string, oauthExchange *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	return p.provider(instanceURL).ExchangeOAuthToken(ctx, instanceURL, oauthExchange)
+}
+
+// TryLogin tries to fetch the user info from the current OAuth context.
+func (p *Provider) TryLogin(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) (*vcs.UserInfo, error) {
+	return p.provider(instanceURL).TryLogin(ctx, oauthCtx, instanceURL)
+}
+
+// FetchCommitByID fetches the commit data by its ID from the repository.
+func (p *Provider) FetchCommitByID(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) (*vcs.Commit, error) {
+	return p.provider(instanceURL).FetchCommitByID(ctx, oauthCtx, instanceURL, repositoryID, commitID)
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+func (p *Provider) GetDiffFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	return p.provider(instanceURL).GetDiffFileList(ctx, oauthCtx, instanceURL, repositoryID, beforeCommit, afterCommit)
+}
+
+// FetchUserInfo fetches user info of given user ID.
+func (p *Provider) FetchUserInfo(ctx context.Context, oauthCtx common.OauthContext, instanceURL, user string) (*vcs.UserInfo, error) {
+	return p.provider(instanceURL).FetchUserInfo(ctx, oauthCtx, instanceURL, user)
+}
+
+// FetchRepositoryActiveMemberList fetches all active members of a repository.
+func (p *Provider) FetchRepositoryActiveMemberList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string) ([]*vcs.RepositoryMember, error) {
+	return p.provider(instanceURL).FetchRepositoryActiveMemberList(ctx, oauthCtx, instanceURL, repositoryID)
+}
+
+// FetchAllRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+func (p *Provider) FetchAllRepositoryList(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) ([]*vcs.Repository, error) {
+	return p.provider(instanceURL).FetchAllRepositoryList(ctx, oauthCtx, instanceURL)
+}
+
+// FetchRepositoryFileList fetches the all files from the given repository tree recursively.
+func (p *Provider) FetchRepositoryFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, ref, filePath string) ([]*vcs.RepositoryTreeNode, error) {
+	return p.provider(instanceURL).FetchRepositoryFileList(ctx, oauthCtx, instanceURL, repositoryID, ref, filePath)
+}
+
+// CreateFile creates a file at given path in the repository.
+func (p *Provider) CreateFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.provider(instanceURL).CreateFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+// OverwriteFile overwrites an existing file at given path in the repository.
+func (p *Provider) OverwriteFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.provider(instanceURL).OverwriteFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+// ReadFileMeta reads the metadata of the given file in the repository.
+func (p *Provider) ReadFileMeta(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (*vcs.FileMeta, error) {
+	return p.provider(instanceURL).ReadFileMeta(ctx, oauthCtx, instanceURL, repositoryID, filePath, ref)
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+func (p *Provider) ReadFileContent(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (string, error) {
+	return p.provider(instanceURL).ReadFileContent(ctx, oauthCtx, instanceURL, repositoryID, filePath, ref)
+}
+
+// GetBranch gets the given branch in the repository.
+func (p *Provider) GetBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	return p.provider(instanceURL).GetBranch(ctx, oauthCtx, instanceURL, repositoryID, branchName)
+}
+
+// CreateBranch creates the branch in the repository.
+func (p *Provider) CreateBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, branch *vcs.BranchInfo) error {
+	return p.provider(instanceURL).CreateBranch(ctx, oauthCtx, instanceURL, repositoryID, branch)
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+func (p *Provider) ListPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	return p.provider(instanceURL).ListPullRequestFile(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID)
+}
+
+// CreatePullRequest creates the pull request in the repository.
+func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	return p.provider(instanceURL).CreatePullRequest(ctx, oauthCtx, instanceURL, repositoryID, pullRequestCreate)
+}
+
+// UpsertEnvironmentVariable creates or updates the environment variable in the repository.
+func (p *Provider) UpsertEnvironmentVariable(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, key, value string) error {
+	return p.provider(instanceURL).UpsertEnvironmentVariable(ctx, oauthCtx, instanceURL, repositoryID, key, value)
+}
+
+// CreateWebhook creates a webhook in the repository with given payload, which
+// should be a marshaled WebhookCreateOrUpdate.
+func (p *Provider) CreateWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, payload []byte) (string, error) {
+	return p.provider(instanceURL).CreateWebhook(ctx, oauthCtx, instanceURL, repositoryID, payload)
+}
+
+// PatchWebhook patches the webhook in the repository with given payload, which
+// should be a marshaled WebhookCreateOrUpdate.
+func (p *Provider) PatchWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string, payload []byte) error {
+	return p.provider(instanceURL).PatchWebhook(ctx, oauthCtx, instanceURL, repositoryID, webhookID, payload)
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+func (p *Provider) DeleteWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string) error {
+	return p.provider(instanceURL).DeleteWebhook(ctx, oauthCtx, instanceURL, repositoryID, webhookID)
+}
+
+// CreatePullRequestComment creates a comment with the markdown content in the
+// pull request.
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, content string) error {
+	if IsCloud(instanceURL) {
+		return p.cloud.createPullRequestComment(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID, content)
+	}
+	return p.dataCenter.createPullRequestComment(ctx, oauthCtx, instanceURL, repositoryID, pullRequestID, content)
+}
+
+// WebhookCreateOrUpdate is the API message for creating or updating a webhook,
+// which is translated into the webhook API message of Bitbucket Cloud or
+// Bitbucket Data Center.
+type WebhookCreateOrUpdate struct {
+	// Name is the description of the webhook.
+	Name string `json:"name"`
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// Secret is the key to sign the payloads.
+	Secret string `json:"secret"`
+	Active bool   `json:"active"`
+}
+
+// splitRepositoryID splits the repository ID into the workspace (or the
+// project key in Bitbucket Data Center) and the repository slug.
+func splitRepositoryID(repositoryID string) (owner, slug string, err error) {
+	parts := strings.Split(repositoryID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid Bitbucket repository ID %q, want the format of {workspace}/{repo_slug}", repositoryID)
+	}
+	return parts[0], parts[1], nil
+}
+
+// escapeFilePath escapes each segment of the file path, since Bitbucket
+// expects the slashes of the file path unescaped.
+func escapeFilePath(filePath string) string {
+	segments := strings.Split(filePath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// getCommitTitle returns the title of the commit message. Per Git convention,
+// the message title and body are separated by two new line characters.
+func getCommitTitle(message string) string {
+	messages := strings.SplitN(message, "\n\n", 2)
+	return strings.TrimSpace(messages[0])
+}
+
+// get makes a GET request to the URL and unmarshals the JSON response into v.
+// The action is used in the error messages, e.g. "fetch commit".
+func get(ctx context.Context, client *http.Client, oauthCtx common.OauthContext, instanceURL, url, action string, v interface{}) error {
+	body, err := getRaw(ctx, client, oauthCtx, instanceURL, url, action)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(body), v); err != nil {
+		return errors.Wrap(err, "unmarshal body")
+	}
+	return nil
+}
+
+// getRaw makes a GET request to the URL and returns the raw response body.
+func getRaw(ctx context.Context, client *http.Client, oauthCtx common.OauthContext, instanceURL, url, action string) (string, error) {
+	code, _, body, err := oauth.Get(
+		ctx,
+		client,
+		url,
+		&oauthCtx.AccessToken,
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to %s from URL %s", action, url)
+	} else if code >= 300 {
+		return "", errors.Errorf("failed to %s from URL %s, status code: %d, body: %s", action, url, code, body)
+	}
+	return body, nil
+}
+
+// send makes a request with the JSON body to the URL, and unmarshals the JSON
+// response into v if v is not nil.
+func send(ctx context.Context, client *http.Client, oauthCtx common.OauthContext, instanceURL, method, url, action string, in, v interface{}) error {
+	var payload []byte
+	if in != nil {
+		var err error
+		if payload, err = json.Marshal(in); err != nil {
+			return errors.Wrapf(err, "marshal request body to %s", action)
+		}
+	}
+
+	refresher := tokenRefresher(
+		instanceURL,
+		oauthContext{
+			ClientID:     oauthCtx.ClientID,
+			ClientSecret: oauthCtx.ClientSecret,
+			RefreshToken: oauthCtx.RefreshToken,
+		},
+		oauthCtx.Refresher,
+	)
+	var code int
+	var body string
+	var err error
+	switch method {
+	case http.MethodPost:
+		code, _, body, err = oauth.Post(ctx, client, url, &oauthCtx.AccessToken, bytes.NewReader(payload), refresher)
+	case http.MethodPut:
+		code, _, body, err = oauth.Put(ctx, client, url, &oauthCtx.AccessToken, bytes.NewReader(payload), refresher)
+	case http.MethodDelete:
+		code, _, body, err = oauth.Delete(ctx, client, url, &oauthCtx.AccessToken, refresher)
+	default:
+		return errors.Errorf("unsupported method %s", method)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", method, url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to %s through URL %s", action, url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to %s through URL %s, status code: %d, body: %s", action, url, code, body)
+	}
+
+	if v == nil || body == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(body), v); err != nil {
+		return errors.Wrap(err, "unmarshal body")
+	}
+	return nil
+}
+
+// sendMultipart makes a request with the multipart form body to the URL.
+func sendMultipart(ctx context.Context, client *http.Client, oauthCtx common.OauthContext, instanceURL, method, url, action string, body *bytes.Buffer, contentType string) error {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	// Bitbucket Data Center rejects multipart requests without this header to
+	// prevent XSRF attacks.
+	header.Set("X-Atlassian-Token", "no-check")
+
+	refresher := tokenRefresher(
+		instanceURL,
+		oauthContext{
+			ClientID:     oauthCtx.ClientID,
+			ClientSecret: oauthCtx.ClientSecret,
+			RefreshToken: oauthCtx.RefreshToken,
+		},
+		oauthCtx.Refresher,
+	)
+	var code int
+	var resp string
+	var err error
+	if method == http.MethodPost {
+		code, _, resp, err = oauth.PostWithHeader(ctx, client, url, &oauthCtx.AccessToken, body, header, refresher)
+	} else {
+		code, _, resp, err = oauth.PutWithHeader(ctx, client, url, &oauthCtx.AccessToken, body, header, refresher)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", method, url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to %s through URL %s", action, url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to %s through URL %s, status code: %d, body: %s", action, url, code, resp)
+	}
+	return nil
+}
+
+// tokenURL returns the URL to exchange and refresh the OAuth token.
+func tokenURL(instanceURL string) string {
+	if IsCloud(instanceURL) {
+		return fmt.Sprintf("%s/site/oauth2/access_token", CloudInstanceURL)
+	}
+	return fmt.Sprintf("%s/rest/oauth2/latest/token", instanceURL)
+}
+
+// oauthResponse is a Bitbucket OAuth response.
+type oauthResponse struct {
+	AccessToken      string `json:"access_token" `
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// requestToken requests an OAuth token with the given parameters. Bitbucket
+// Cloud authenticates the client with the basic auth, while Bitbucket Data
+// Center expects the client credentials in the form.
+func requestToken(ctx context.Context, client *http.Client, instanceURL, clientID, clientSecret string, params url.Values) (*oauthResponse, error) {
+	if !IsCloud(instanceURL) {
+		params.Set("client_id", clientID)
+		params.Set("client_secret", clientSecret)
+	}
+	url := tokenURL(instanceURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "construct POST %s", url)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if IsCloud(instanceURL) {
+		req.SetBasicAuth(clientID, clientSecret)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read OAuth response body, code %v", resp.StatusCode)
+	}
+
+	oauthResp := new(oauthResponse)
+	if err := json.Unmarshal(body, oauthResp); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal OAuth response body, code %v", resp.StatusCode)
+	}
+	if oauthResp.Error != "" {
+		return nil, errors.Errorf("failed to request OAuth token, error: %v, error_description: %v", oauthResp.Error, oauthResp.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("non-200 POST %s status code %d with body %q", url, resp.StatusCode, body)
+	}
+	return oauthResp, nil
+}
+
+// exchangeOAuthToken exchanges OAuth content with the provided authorization
+// code, which is the same for Bitbucket Cloud and Bitbucket Data Center except
+// for the token URL and the client authentication.
+func exchangeOAuthToken(ctx context.Context, client *http.Client, instanceURL string, oauthExchange *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	params := url.Values{}
+	params.Set("code", oauthExchange.Code)
+	params.Set("redirect_uri", oauthExchange.RedirectURL)
+	params.Set("grant_type", "authorization_code")
+	oauthResp, err := requestToken(ctx, client, instanceURL, oauthExchange.ClientID, oauthExchange.ClientSecret, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange OAuth token")
+	}
+
+	oauthToken := &vcs.OAuthToken{
+		AccessToken:  oauthResp.AccessToken,
+		RefreshToken: oauthResp.RefreshToken,
+		ExpiresIn:    oauthResp.ExpiresIn,
+		// Bitbucket does not return the creation time of the token.
+		CreatedAt: time.Now().Unix(),
+	}
+	if oauthToken.ExpiresIn != 0 {
+		oauthToken.ExpiresTs = oauthToken.CreatedAt + oauthToken.ExpiresIn
+	}
+	return oauthToken, nil
+}
+
+// oauthContext is the request context for refreshing oauth token.
+type oauthContext struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+}
+
+func tokenRefresher(instanceURL string, oauthCtx oauthContext, refresher common.TokenRefresher) oauth.TokenRefresher {
+	return func(ctx context.Context, client *http.Client, oldToken *string) error {
+		params := url.Values{}
+		params.Set("refresh_token", oauthCtx.RefreshToken)
+		params.Set("grant_type", "refresh_token")
+		r, err := requestToken(ctx, client, instanceURL, oauthCtx.ClientID, oauthCtx.ClientSecret, params)
+		if err != nil {
+			return errors.Wrap(err, "failed to refresh OAuth token")
+		}
+
+		// Update the old token to new value for retries.
+		*oldToken = r.AccessToken
+
+		var expireAt int64
+		if r.ExpiresIn != 0 {
+			expireAt = time.Now().Unix() + r.ExpiresIn
+		}
+		return refresher(r.AccessToken, r.RefreshToken, expireAt)
+	}
+}
@@ -0,0 +1,779 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/vcs"
+	"github.com/bytebase/bytebase/plugin/vcs/internal/oauth"
+)
+
+const testDataCenterURL = "https://bitbucket.example.com"
+
+func TestIsCloud(t *testing.T) {
+	assert.True(t, IsCloud("https://bitbucket.org"))
+	assert.True(t, IsCloud("https://bitbucket.org/"))
+	assert.False(t, IsCloud(testDataCenterURL))
+}
+
+func TestProvider_APIURL(t *testing.T) {
+	p := newMockProvider(nil)
+	assert.Equal(t, "https://api.bitbucket.org/2.0", p.APIURL(CloudInstanceURL))
+	assert.Equal(t, "https://bitbucket.example.com/rest/api/1.0", p.APIURL(testDataCenterURL))
+}
+
+func TestProvider_ExchangeOAuthToken(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "https://bitbucket.org/site/oauth2/access_token", r.URL.String())
+		clientID, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "test_client_id", clientID)
+		assert.Equal(t, "test_client_secret", clientSecret)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "test_code", r.PostForm.Get("code"))
+		assert.Empty(t, r.PostForm.Get("client_secret"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "access_token": "test_access_token",
+  "scopes": "repository:admin webhook pullrequest:write",
+  "expires_in": 7200,
+  "refresh_token": "test_refresh_token",
+  "token_type": "bearer"
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ExchangeOAuthToken(ctx, CloudInstanceURL,
+		&common.OAuthExchange{
+			ClientID:     "test_client_id",
+			ClientSecret: "test_client_secret",
+			Code:         "test_code",
+			RedirectURL:  "https://bytebase.example.com/oauth/callback",
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "test_access_token", got.AccessToken)
+	assert.Equal(t, "test_refresh_token", got.RefreshToken)
+	assert.Equal(t, got.CreatedAt+7200, got.ExpiresTs)
+}
+
+func TestOAuth_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	client := &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				if r.URL.Path == "/rest/oauth2/latest/token" {
+					require.NoError(t, r.ParseForm())
+					assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+					assert.Equal(t, "test_client_id", r.PostForm.Get("client_id"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: io.NopCloser(strings.NewReader(`
+{
+  "access_token": "new_access_token",
+  "expires_in": 7200,
+  "refresh_token": "new_refresh_token",
+  "token_type": "bearer"
+}
+`)),
+					}, nil
+				}
+
+				token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+				if token == "expired" {
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body: io.NopCloser(strings.NewReader(`
+{"error":"invalid_token","error_description":"The access token expired"}
+`)),
+					}, nil
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{}`)),
+				}, nil
+			},
+		},
+	}
+	token := "expired"
+
+	var gotRefreshToken string
+	var gotExpiresTs int64
+	refresher := func(_, refreshToken string, expiresTs int64) error {
+		gotRefreshToken = refreshToken
+		gotExpiresTs = expiresTs
+		return nil
+	}
+
+	_, _, _, err := oauth.Get(
+		ctx,
+		client,
+		"https://bitbucket.example.com/rest/api/1.0/users/octocat",
+		&token,
+		tokenRefresher(
+			testDataCenterURL,
+			oauthContext{ClientID: "test_client_id"},
+			refresher,
+		),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "new_access_token", token)
+	assert.Equal(t, "new_refresh_token", gotRefreshToken)
+	assert.NotZero(t, gotExpiresTs)
+}
+
+func TestProvider_Cloud_FetchCommitByID(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/2.0/repositories/octocat/hello-world/commit/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "type": "commit",
+  "hash": "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+  "date": "2023-01-12T08:27:41+00:00",
+  "author": {
+    "type": "author",
+    "raw": "Monalisa Octocat <octocat@example.com>",
+    "user": {
+      "display_name": "Monalisa Octocat",
+      "uuid": "{3d4e1f9a-8e8a-4bb8-9c8a-1f0e4e5a0c4b}"
+    }
+  },
+  "message": "Add migration\n\nCreate the book table.\n",
+  "links": {
+    "html": {
+      "href": "https://bitbucket.org/octocat/hello-world/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"
+    }
+  }
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchCommitByID(ctx, common.OauthContext{}, CloudInstanceURL, "octocat/hello-world", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	require.NoError(t, err)
+
+	want := &vcs.Commit{
+		ID:          "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+		Title:       "Add migration",
+		Message:     "Add migration\n\nCreate the book table.\n",
+		CreatedTs:   1673512061,
+		URL:         "https://bitbucket.org/octocat/hello-world/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+		AuthorName:  "Monalisa Octocat",
+		AuthorEmail: "octocat@example.com",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_Cloud_GetDiffFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/2.0/repositories/octocat/hello-world/diffstat/after..before", r.URL.Path)
+		if r.URL.Query().Get("page") == "" {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"status": "added", "old": null, "new": {"path": "prod/db##0001##migrate##create_book.sql"}},
+    {"status": "modified", "old": {"path": "README.md"}, "new": {"path": "README.md"}}
+  ],
+  "next": "https://api.bitbucket.org/2.0/repositories/octocat/hello-world/diffstat/after..before?page=2"
+}
+`)),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"status": "removed", "old": {"path": "prod/db##0000##baseline.sql"}, "new": null},
+    {"status": "renamed", "old": {"path": "old.sql"}, "new": {"path": "new.sql"}}
+  ]
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.GetDiffFileList(ctx, common.OauthContext{}, CloudInstanceURL, "octocat/hello-world", "before", "after")
+	require.NoError(t, err)
+
+	want := []vcs.FileDiff{
+		{Path: "prod/db##0001##migrate##create_book.sql", Type: vcs.FileDiffTypeAdded},
+		{Path: "README.md", Type: vcs.FileDiffTypeModified},
+		{Path: "prod/db##0000##baseline.sql", Type: vcs.FileDiffTypeRemoved},
+		{Path: "old.sql", Type: vcs.FileDiffTypeRemoved},
+		{Path: "new.sql", Type: vcs.FileDiffTypeAdded},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_DataCenter_GetDiffFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/hello-world/compare/changes", r.URL.Path)
+		assert.Equal(t, "after", r.URL.Query().Get("from"))
+		assert.Equal(t, "before", r.URL.Query().Get("to"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"path": {"toString": "prod/db##0001##migrate##create_book.sql"}, "type": "ADD"},
+    {"path": {"toString": "README.md"}, "type": "MODIFY"},
+    {"path": {"toString": "new.sql"}, "srcPath": {"toString": "old.sql"}, "type": "MOVE"}
+  ],
+  "isLastPage": true
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.GetDiffFileList(ctx, common.OauthContext{}, testDataCenterURL, "PRJ/hello-world", "before", "after")
+	require.NoError(t, err)
+
+	want := []vcs.FileDiff{
+		{Path: "prod/db##0001##migrate##create_book.sql", Type: vcs.FileDiffTypeAdded},
+		{Path: "README.md", Type: vcs.FileDiffTypeModified},
+		{Path: "old.sql", Type: vcs.FileDiffTypeRemoved},
+		{Path: "new.sql", Type: vcs.FileDiffTypeAdded},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_DataCenter_FetchRepositoryActiveMemberList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/hello-world/permissions/users", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"user": {"name": "octocat", "displayName": "Monalisa Octocat", "emailAddress": "octocat@example.com", "active": true}, "permission": "REPO_ADMIN"},
+    {"user": {"name": "hubot", "emailAddress": "hubot@example.com", "active": true}, "permission": "REPO_READ"},
+    {"user": {"name": "suspended", "emailAddress": "suspended@example.com", "active": false}, "permission": "REPO_WRITE"}
+  ],
+  "isLastPage": true
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchRepositoryActiveMemberList(ctx, common.OauthContext{}, testDataCenterURL, "PRJ/hello-world")
+	require.NoError(t, err)
+
+	want := []*vcs.RepositoryMember{
+		{
+			Name:         "Monalisa Octocat",
+			Email:        "octocat@example.com",
+			Role:         common.ProjectOwner,
+			VCSRole:      "REPO_ADMIN",
+			State:        vcs.StateActive,
+			RoleProvider: vcs.Bitbucket,
+		},
+		{
+			Name:         "hubot",
+			Email:        "hubot@example.com",
+			Role:         common.ProjectDeveloper,
+			VCSRole:      "REPO_READ",
+			State:        vcs.StateActive,
+			RoleProvider: vcs.Bitbucket,
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_DataCenter_FetchAllRepositoryList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/rest/api/1.0/repos", r.URL.Path)
+		assert.Equal(t, "REPO_ADMIN", r.URL.Query().Get("permission"))
+		if r.URL.Query().Get("start") == "0" {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"slug": "hello-world", "name": "Hello World", "project": {"key": "PRJ"}, "links": {"self": [{"href": "https://bitbucket.example.com/projects/PRJ/repos/hello-world/browse"}]}}
+  ],
+  "isLastPage": false,
+  "nextPageStart": 1
+}
+`)),
+			}, nil
+		}
+		assert.Equal(t, "1", r.URL.Query().Get("start"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"slug": "bytebase", "name": "Bytebase", "project": {"key": "DBA"}, "links": {"self": [{"href": "https://bitbucket.example.com/projects/DBA/repos/bytebase/browse"}]}}
+  ],
+  "isLastPage": true
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchAllRepositoryList(ctx, common.OauthContext{}, testDataCenterURL)
+	require.NoError(t, err)
+
+	want := []*vcs.Repository{
+		{
+			Name:     "Hello World",
+			FullPath: "PRJ/hello-world",
+			WebURL:   "https://bitbucket.example.com/projects/PRJ/repos/hello-world",
+		},
+		{
+			Name:     "Bytebase",
+			FullPath: "DBA/bytebase",
+			WebURL:   "https://bitbucket.example.com/projects/DBA/repos/bytebase",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_Cloud_FetchRepositoryFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/2.0/repositories/octocat/hello-world/src/main/prod/":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"type": "commit_file", "path": "prod/db##0001##migrate##create_book.sql"},
+    {"type": "commit_directory", "path": "prod/archive"}
+  ]
+}
+`)),
+			}, nil
+		case "/2.0/repositories/octocat/hello-world/src/main/prod/archive/":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"type": "commit_file", "path": "prod/archive/db##0000##baseline.sql"}
+  ]
+}
+`)),
+			}, nil
+		}
+		return nil, errors.Errorf("unexpected request path %q", r.URL.Path)
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchRepositoryFileList(ctx, common.OauthContext{}, CloudInstanceURL, "octocat/hello-world", "main", "prod")
+	require.NoError(t, err)
+
+	want := []*vcs.RepositoryTreeNode{
+		{Path: "prod/db##0001##migrate##create_book.sql", Type: "blob"},
+		{Path: "prod/archive/db##0000##baseline.sql", Type: "blob"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_DataCenter_FetchRepositoryFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/hello-world/files/prod", r.URL.Path)
+		assert.Equal(t, "main", r.URL.Query().Get("at"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "values": ["db##0001##migrate##create_book.sql", "archive/db##0000##baseline.sql"],
+  "isLastPage": true
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchRepositoryFileList(ctx, common.OauthContext{}, testDataCenterURL, "PRJ/hello-world", "main", "prod/")
+	require.NoError(t, err)
+
+	want := []*vcs.RepositoryTreeNode{
+		{Path: "prod/db##0001##migrate##create_book.sql", Type: "blob"},
+		{Path: "prod/archive/db##0000##baseline.sql", Type: "blob"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_Cloud_CreateFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/2.0/repositories/octocat/hello-world/src", r.URL.Path)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "CREATE TABLE book;", r.FormValue("prod/db##0001##migrate##create_book.sql"))
+		assert.Equal(t, "Add migration", r.FormValue("message"))
+		assert.Equal(t, "main", r.FormValue("branch"))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.CreateFile(ctx, common.OauthContext{}, CloudInstanceURL, "octocat/hello-world", "prod/db##0001##migrate##create_book.sql",
+		vcs.FileCommitCreate{
+			Branch:        "main",
+			Content:       "CREATE TABLE book;",
+			CommitMessage: "Add migration",
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestProvider_DataCenter_OverwriteFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/hello-world/browse/prod/db##0001##migrate##create_book.sql", r.URL.Path)
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "CREATE TABLE book;", r.FormValue("content"))
+		assert.Equal(t, "main", r.FormValue("branch"))
+		assert.Equal(t, "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d", r.FormValue("sourceCommitId"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.OverwriteFile(ctx, common.OauthContext{}, testDataCenterURL, "PRJ/hello-world", "prod/db##0001##migrate##create_book.sql",
+		vcs.FileCommitCreate{
+			Branch:        "main",
+			Content:       "CREATE TABLE book;",
+			CommitMessage: "Update migration",
+			LastCommitID:  "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestProvider_DataCenter_GetBranch(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/hello-world/branches", r.URL.Path)
+		assert.Equal(t, "main", r.URL.Query().Get("filterText"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"id": "refs/heads/main-backup", "displayId": "main-backup", "latestCommit": "0000000000000000000000000000000000000001"},
+    {"id": "refs/heads/main", "displayId": "main", "latestCommit": "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"}
+  ],
+  "isLastPage": true
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.GetBranch(ctx, common.OauthContext{}, testDataCenterURL, "PRJ/hello-world", "main")
+	require.NoError(t, err)
+	want := &vcs.BranchInfo{
+		Name:         "main",
+		LastCommitID: "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_Cloud_ListPullRequestFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/2.0/repositories/octocat/hello-world/pullrequests/1":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{"id": 1, "source": {"branch": {"name": "feature"}, "commit": {"hash": "7fd1a60b01f9"}}}
+`)),
+			}, nil
+		case "/2.0/repositories/octocat/hello-world/pullrequests/1/diffstat":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "values": [
+    {"status": "added", "old": null, "new": {"path": "prod/db##0001##migrate##create_book.sql"}},
+    {"status": "removed", "old": {"path": "prod/db##0000##baseline.sql"}, "new": null}
+  ]
+}
+`)),
+			}, nil
+		}
+		return nil, errors.Errorf("unexpected request path %q", r.URL.Path)
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ListPullRequestFile(ctx, common.OauthContext{}, CloudInstanceURL, "octocat/hello-world", "1")
+	require.NoError(t, err)
+
+	want := []*vcs.PullRequestFile{
+		{Path: "prod/db##0001##migrate##create_book.sql", LastCommitID: "7fd1a60b01f9"},
+		{Path: "prod/db##0000##baseline.sql", LastCommitID: "7fd1a60b01f9", IsDeleted: true},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_DataCenter_CreatePullRequest(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/hello-world/pull-requests", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var pullRequest dataCenterPullRequest
+		require.NoError(t, json.Unmarshal(body, &pullRequest))
+		assert.Equal(t, "refs/heads/feature", pullRequest.FromRef.ID)
+		assert.Equal(t, "refs/heads/main", pullRequest.ToRef.ID)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body: io.NopCloser(strings.NewReader(`
+{"id": 1, "links": {"self": [{"href": "https://bitbucket.example.com/projects/PRJ/repos/hello-world/pull-requests/1"}]}}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.CreatePullRequest(ctx, common.OauthContext{}, testDataCenterURL, "PRJ/hello-world",
+		&vcs.PullRequestCreate{
+			Title: "Set up SQL review",
+			Head:  "feature",
+			Base:  "main",
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "https://bitbucket.example.com/projects/PRJ/repos/hello-world/pull-requests/1", got.URL)
+}
+
+func TestProvider_Cloud_UpsertEnvironmentVariable(t *testing.T) {
+	var gotMethod, gotPath string
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodGet {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{"values": [{"uuid": "{7a9b5b3e}", "key": "SQL_REVIEW_API_SECRET", "secured": true}]}
+`)),
+			}, nil
+		}
+		gotMethod, gotPath = r.Method, r.URL.Path
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.UpsertEnvironmentVariable(ctx, common.OauthContext{}, CloudInstanceURL, "octocat/hello-world", "SQL_REVIEW_API_SECRET", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, gotMethod)
+	assert.Equal(t, "/2.0/repositories/octocat/hello-world/pipelines_config/variables/{7a9b5b3e}", gotPath)
+}
+
+func TestProvider_CreateWebhook(t *testing.T) {
+	payload, err := json.Marshal(
+		WebhookCreateOrUpdate{
+			Name:   "Bytebase GitOps",
+			URL:    "https://bytebase.example.com/hook/bitbucket/1",
+			Secret: "secret",
+			Active: true,
+		},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		instanceURL string
+		path        string
+		response    string
+		want        string
+	}{
+		{
+			instanceURL: CloudInstanceURL,
+			path:        "/2.0/repositories/octocat/hello-world/hooks",
+			response:    `{"uuid": "{8b9b5b3e-0e8a-4bb8-9c8a-1f0e4e5a0c4b}"}`,
+			want:        "{8b9b5b3e-0e8a-4bb8-9c8a-1f0e4e5a0c4b}",
+		},
+		{
+			instanceURL: testDataCenterURL,
+			path:        "/rest/api/1.0/projects/octocat/repos/hello-world/webhooks",
+			response:    `{"id": 10}`,
+			want:        "10",
+		},
+	}
+	for _, test := range tests {
+		p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, test.path, r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `"secret":"secret"`)
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(test.response)),
+			}, nil
+		},
+		)
+
+		ctx := context.Background()
+		got, err := p.CreateWebhook(ctx, common.OauthContext{}, test.instanceURL, "octocat/hello-world", payload)
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func TestParsePushEvent(t *testing.T) {
+	cloudEvent, err := ParsePushEvent(WebhookEventCloudPush, []byte(`
+{
+  "actor": {"display_name": "Monalisa Octocat"},
+  "repository": {"full_name": "octocat/hello-world", "links": {"html": {"href": "https://bitbucket.org/octocat/hello-world"}}},
+  "push": {
+    "changes": [
+      {
+        "old": {"type": "branch", "name": "main", "target": {"hash": "before"}},
+        "new": {"type": "branch", "name": "main", "target": {"hash": "after"}}
+      },
+      {
+        "old": null,
+        "new": {"type": "branch", "name": "feature", "target": {"hash": "after"}}
+      },
+      {
+        "old": {"type": "tag", "name": "v1.0.0", "target": {"hash": "before"}},
+        "new": {"type": "tag", "name": "v1.0.0", "target": {"hash": "after"}}
+      }
+    ]
+  }
+}
+`))
+	require.NoError(t, err)
+	assert.Equal(t,
+		&WebhookPushEvent{
+			RepositoryID:  "octocat/hello-world",
+			RepositoryURL: "https://bitbucket.org/octocat/hello-world",
+			ActorName:     "Monalisa Octocat",
+			RefChanges: []WebhookRefChange{
+				{Ref: "refs/heads/main", Before: "before", After: "after"},
+			},
+		},
+		cloudEvent,
+	)
+
+	dataCenterEvent, err := ParsePushEvent(WebhookEventDataCenterPush, []byte(`
+{
+  "eventKey": "repo:refs_changed",
+  "actor": {"name": "octocat", "displayName": "Monalisa Octocat", "active": true},
+  "repository": {"slug": "hello-world", "project": {"key": "PRJ"}},
+  "changes": [
+    {"refId": "refs/heads/main", "fromHash": "before", "toHash": "after", "type": "UPDATE"},
+    {"refId": "refs/heads/feature", "fromHash": "0000000000000000000000000000000000000000", "toHash": "after", "type": "ADD"}
+  ]
+}
+`))
+	require.NoError(t, err)
+	assert.Equal(t,
+		&WebhookPushEvent{
+			RepositoryID: "PRJ/hello-world",
+			ActorName:    "Monalisa Octocat",
+			RefChanges: []WebhookRefChange{
+				{Ref: "refs/heads/main", Before: "before", After: "after"},
+			},
+		},
+		dataCenterEvent,
+	)
+}
+
+func TestParsePullRequestEvent(t *testing.T) {
+	got, err := ParsePullRequestEvent(WebhookEventDataCenterPullRequestOpened, []byte(`
+{"pullRequest": {"id": 5, "toRef": {"repository": {"slug": "hello-world", "project": {"key": "PRJ"}}}}}
+`))
+	require.NoError(t, err)
+	assert.Equal(t, &WebhookPullRequestEvent{RepositoryID: "PRJ/hello-world", PullRequestID: "5"}, got)
+
+	_, err = ParsePullRequestEvent(WebhookEventCloudPush, nil)
+	assert.Error(t, err)
+}
+
+func TestWebhookPushEvent_ToVCS(t *testing.T) {
+	event := &WebhookPushEvent{
+		RepositoryID:  "octocat/hello-world",
+		RepositoryURL: "https://bitbucket.org/octocat/hello-world",
+		ActorName:     "Monalisa Octocat",
+	}
+	refChange := WebhookRefChange{Ref: "refs/heads/main", Before: "before", After: "after"}
+	headCommit := &vcs.Commit{
+		ID:         "after",
+		Title:      "Add migration",
+		AuthorName: "Monalisa Octocat",
+	}
+	fileDiffs := []vcs.FileDiff{
+		{Path: "prod/db##0001##migrate##create_book.sql", Type: vcs.FileDiffTypeAdded},
+		{Path: "prod/db##0000##baseline.sql", Type: vcs.FileDiffTypeModified},
+		{Path: "README.md", Type: vcs.FileDiffTypeRemoved},
+	}
+
+	want := vcs.PushEvent{
+		VCSType:            vcs.Bitbucket,
+		Ref:                "refs/heads/main",
+		Before:             "before",
+		After:              "after",
+		RepositoryID:       "octocat/hello-world",
+		RepositoryURL:      "https://bitbucket.org/octocat/hello-world",
+		RepositoryFullPath: "octocat/hello-world",
+		AuthorName:         "Monalisa Octocat",
+		CommitList: []vcs.Commit{
+			{
+				ID:           "after",
+				Title:        "Add migration",
+				AuthorName:   "Monalisa Octocat",
+				AddedList:    []string{"prod/db##0001##migrate##create_book.sql"},
+				ModifiedList: []string{"prod/db##0000##baseline.sql"},
+			},
+		},
+	}
+	assert.Equal(t, want, event.ToVCS(refChange, headCommit, fileDiffs))
+}
+
+func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
+	return newProvider(
+		vcs.ProviderConfig{
+			Client: &http.Client{
+				Transport: &common.MockRoundTripper{
+					MockRoundTrip: mockRoundTrip,
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,675 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/vcs"
+)
+
+// cloudAPIURL is the API URL of Bitbucket Cloud.
+const cloudAPIURL = "https://api.bitbucket.org/2.0"
+
+var _ vcs.Provider = (*cloudProvider)(nil)
+
+// cloudProvider is the Bitbucket Cloud implementation, where the repository ID
+// is in the format of "{workspace}/{repo_slug}".
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/intro/
+type cloudProvider struct {
+	client *http.Client
+}
+
+// cloudPage is a page of the paginated Bitbucket Cloud API response.
+type cloudPage struct {
+	Values json.RawMessage `json:"values"`
+	// Next is the URL of the next page, which is empty on the last page.
+	Next string `json:"next"`
+}
+
+// cloudLink is the link object of the Bitbucket Cloud API response.
+type cloudLink struct {
+	Href string `json:"href"`
+}
+
+type cloudUser struct {
+	UUID        string `json:"uuid"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+}
+
+type cloudUserEmail struct {
+	Email       string `json:"email"`
+	IsPrimary   bool   `json:"is_primary"`
+	IsConfirmed bool   `json:"is_confirmed"`
+}
+
+type cloudCommit struct {
+	Hash    string `json:"hash"`
+	Message string `json:"message"`
+	// Date expects corresponding JSON value is a string in RFC 3339 format,
+	// see https://pkg.go.dev/time#Time.MarshalJSON.
+	Date   time.Time `json:"date"`
+	Author struct {
+		// Raw is the author in the format of "Name <email>".
+		Raw  string    `json:"raw"`
+		User cloudUser `json:"user"`
+	} `json:"author"`
+	Links struct {
+		HTML cloudLink `json:"html"`
+	} `json:"links"`
+}
+
+type cloudDiffStatFile struct {
+	Path string `json:"path"`
+}
+
+type cloudDiffStat struct {
+	// Available values: "added", "removed", "modified", "renamed",
+	// "merge conflict", "remote deleted".
+	Status string             `json:"status"`
+	Old    *cloudDiffStatFile `json:"old"`
+	New    *cloudDiffStatFile `json:"new"`
+}
+
+type cloudRepository struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Links    struct {
+		HTML cloudLink `json:"html"`
+	} `json:"links"`
+}
+
+type cloudTreeEntry struct {
+	// Available values: "commit_file", "commit_directory".
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+type cloudFileMeta struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Commit struct {
+		Hash string `json:"hash"`
+	} `json:"commit"`
+}
+
+type cloudBranch struct {
+	Name   string `json:"name"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+type cloudBranchRef struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	Commit *struct {
+		Hash string `json:"hash"`
+	} `json:"commit,omitempty"`
+}
+
+type cloudPullRequest struct {
+	ID                int            `json:"id,omitempty"`
+	Title             string         `json:"title"`
+	Description       string         `json:"description"`
+	Source            cloudBranchRef `json:"source"`
+	Destination       cloudBranchRef `json:"destination"`
+	CloseSourceBranch bool           `json:"close_source_branch"`
+	Links             *struct {
+		HTML cloudLink `json:"html"`
+	} `json:"links,omitempty"`
+}
+
+type cloudPipelineVariable struct {
+	UUID    string `json:"uuid,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+type cloudWebhook struct {
+	UUID        string   `json:"uuid,omitempty"`
+	Description string   `json:"description"`
+	URL         string   `json:"url"`
+	Active      bool     `json:"active"`
+	Secret      string   `json:"secret,omitempty"`
+	Events      []string `json:"events"`
+}
+
+// APIURL returns the API URL of Bitbucket Cloud.
+func (*cloudProvider) APIURL(string) string {
+	return cloudAPIURL
+}
+
+// repositoryURL returns the API URL of the repository.
+func (p *cloudProvider) repositoryURL(repositoryID string) (string, error) {
+	workspace, slug, err := splitRepositoryID(repositoryID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/repositories/%s/%s", cloudAPIURL, url.PathEscape(workspace), url.PathEscape(slug)), nil
+}
+
+// listAll fetches all pages starting from the URL, and calls the appendValues
+// on the values of each page.
+func (p *cloudProvider) listAll(ctx context.Context, oauthCtx common.OauthContext, instanceURL, url, action string, appendValues func(values json.RawMessage) error) error {
+	for url != "" {
+		page := &cloudPage{}
+		if err := get(ctx, p.client, oauthCtx, instanceURL, url, action, page); err != nil {
+			return err
+		}
+		if err := appendValues(page.Values); err != nil {
+			return errors.Wrapf(err, "unmarshal values to %s", action)
+		}
+		url = page.Next
+	}
+	return nil
+}
+
+// ExchangeOAuthToken exchanges OAuth content with the provided authorization code.
+func (p *cloudProvider) ExchangeOAuthToken(ctx context.Context, instanceURL string, oauthExchange *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	return exchangeOAuthToken(ctx, p.client, instanceURL, oauthExchange)
+}
+
+// TryLogin tries to fetch the user info from the current OAuth context. The
+// email is the primary email of the user, which requires the "email" scope.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-get
+func (p *cloudProvider) TryLogin(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) (*vcs.UserInfo, error) {
+	user := &cloudUser{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/user", cloudAPIURL), "fetch user info", user); err != nil {
+		return nil, err
+	}
+
+	var primaryEmail string
+	if err := p.listAll(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/user/emails", cloudAPIURL), "fetch user emails", func(values json.RawMessage) error {
+		var emails []cloudUserEmail
+		if err := json.Unmarshal(values, &emails); err != nil {
+			return err
+		}
+		for _, email := range emails {
+			if email.IsPrimary && email.IsConfirmed {
+				primaryEmail = email.Email
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &vcs.UserInfo{
+		PublicEmail: primaryEmail,
+		Name:        user.DisplayName,
+		State:       vcs.StateActive,
+	}, nil
+}
+
+// FetchCommitByID fetches the commit data by its ID from the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-commit-commit-get
+func (p *cloudProvider) FetchCommitByID(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) (*vcs.Commit, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	commit := &cloudCommit{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/commit/%s", repoURL, url.PathEscape(commitID)), "fetch commit data", commit); err != nil {
+		return nil, err
+	}
+
+	authorName := commit.Author.User.DisplayName
+	var authorEmail string
+	if address, err := mail.ParseAddress(commit.Author.Raw); err == nil {
+		if authorName == "" {
+			authorName = address.Name
+		}
+		authorEmail = address.Address
+	}
+	return &vcs.Commit{
+		ID:          commit.Hash,
+		Title:       getCommitTitle(commit.Message),
+		Message:     commit.Message,
+		CreatedTs:   commit.Date.Unix(),
+		URL:         commit.Links.HTML.Href,
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+	}, nil
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-diffstat-spec-get
+func (p *cloudProvider) GetDiffFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: The spec "a..b" of Bitbucket Cloud is the reverse of Git, which
+	// means the changes of a compared to b.
+	diffStats, err := p.listDiffStats(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/diffstat/%s..%s?pagelen=%d", repoURL, afterCommit, beforeCommit, apiPageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []vcs.FileDiff
+	for _, diffStat := range diffStats {
+		switch diffStat.Status {
+		case "added":
+			ret = append(ret, vcs.FileDiff{Path: diffStat.New.Path, Type: vcs.FileDiffTypeAdded})
+		case "modified":
+			ret = append(ret, vcs.FileDiff{Path: diffStat.New.Path, Type: vcs.FileDiffTypeModified})
+		case "removed":
+			ret = append(ret, vcs.FileDiff{Path: diffStat.Old.Path, Type: vcs.FileDiffTypeRemoved})
+		case "renamed":
+			ret = append(ret,
+				vcs.FileDiff{Path: diffStat.Old.Path, Type: vcs.FileDiffTypeRemoved},
+				vcs.FileDiff{Path: diffStat.New.Path, Type: vcs.FileDiffTypeAdded},
+			)
+		}
+	}
+	return ret, nil
+}
+
+// listDiffStats lists all diff stats starting from the URL.
+func (p *cloudProvider) listDiffStats(ctx context.Context, oauthCtx common.OauthContext, instanceURL, url string) ([]cloudDiffStat, error) {
+	var diffStats []cloudDiffStat
+	if err := p.listAll(ctx, oauthCtx, instanceURL, url, "get file diff list", func(values json.RawMessage) error {
+		var page []cloudDiffStat
+		if err := json.Unmarshal(values, &page); err != nil {
+			return err
+		}
+		diffStats = append(diffStats, page...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return diffStats, nil
+}
+
+// FetchUserInfo fetches user info of given user ID. Bitbucket Cloud never
+// exposes the email of other users.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-users-selected-user-get
+func (p *cloudProvider) FetchUserInfo(ctx context.Context, oauthCtx common.OauthContext, instanceURL, userID string) (*vcs.UserInfo, error) {
+	user := &cloudUser{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/users/%s", cloudAPIURL, url.PathEscape(userID)), "fetch user info", user); err != nil {
+		return nil, err
+	}
+	return &vcs.UserInfo{
+		Name:  user.DisplayName,
+		State: vcs.StateActive,
+	}, nil
+}
+
+// FetchRepositoryActiveMemberList fetches all active members of a repository.
+func (*cloudProvider) FetchRepositoryActiveMemberList(context.Context, common.OauthContext, string, string) ([]*vcs.RepositoryMember, error) {
+	return nil, errors.New("Bitbucket Cloud does not expose the email of repository members, please sync members from other providers")
+}
+
+// FetchAllRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-get
+func (p *cloudProvider) FetchAllRepositoryList(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) ([]*vcs.Repository, error) {
+	var allRepos []*vcs.Repository
+	if err := p.listAll(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/repositories?role=admin&pagelen=%d", cloudAPIURL, apiPageSize), "fetch repository list", func(values json.RawMessage) error {
+		var repos []cloudRepository
+		if err := json.Unmarshal(values, &repos); err != nil {
+			return err
+		}
+		for _, r := range repos {
+			allRepos = append(allRepos,
+				&vcs.Repository{
+					Name:     r.Name,
+					FullPath: r.FullName,
+					WebURL:   r.Links.HTML.Href,
+				},
+			)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return allRepos, nil
+}
+
+// FetchRepositoryFileList fetches the all files from the given repository tree recursively.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (p *cloudProvider) FetchRepositoryFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, ref, filePath string) ([]*vcs.RepositoryTreeNode, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var allTreeNodes []*vcs.RepositoryTreeNode
+	directories := []string{strings.TrimSuffix(filePath, "/")}
+	for len(directories) > 0 {
+		directory := directories[0]
+		directories = directories[1:]
+
+		// The path of a directory must end with a slash.
+		directoryPath := escapeFilePath(directory)
+		if directoryPath != "" {
+			directoryPath += "/"
+		}
+		directoryURL := fmt.Sprintf("%s/src/%s/%s?pagelen=%d", repoURL, url.PathEscape(ref), directoryPath, apiPageSize)
+		if err := p.listAll(ctx, oauthCtx, instanceURL, directoryURL, "fetch repository file list", func(values json.RawMessage) error {
+			var entries []cloudTreeEntry
+			if err := json.Unmarshal(values, &entries); err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				switch entry.Type {
+				case "commit_directory":
+					directories = append(directories, entry.Path)
+				case "commit_file":
+					allTreeNodes = append(allTreeNodes,
+						&vcs.RepositoryTreeNode{
+							Path: entry.Path,
+							Type: "blob",
+						},
+					)
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return allTreeNodes, nil
+}
+
+// CreateFile creates a file at given path in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-post
+func (p *cloudProvider) CreateFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.commitFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+// OverwriteFile overwrites an existing file at given path in the repository.
+func (p *cloudProvider) OverwriteFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.commitFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+// commitFile commits the file to the branch, which creates the file if it does
+// not exist.
+func (p *cloudProvider) commitFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, field := range [][2]string{
+		{filePath, fileCommitCreate.Content},
+		{"message", fileCommitCreate.CommitMessage},
+		{"branch", fileCommitCreate.Branch},
+	} {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return errors.Wrapf(err, "write field %q", field[0])
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "close multipart writer")
+	}
+	return sendMultipart(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/src", repoURL), "create file", body, writer.FormDataContentType())
+}
+
+// ReadFileMeta reads the metadata of the given file in the repository.
+func (p *cloudProvider) ReadFileMeta(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (*vcs.FileMeta, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	meta := &cloudFileMeta{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/src/%s/%s?format=meta", repoURL, url.PathEscape(ref), escapeFilePath(filePath)), "read file meta", meta); err != nil {
+		return nil, err
+	}
+	return &vcs.FileMeta{
+		Name:         meta.Path[strings.LastIndex(meta.Path, "/")+1:],
+		Path:         meta.Path,
+		Size:         meta.Size,
+		LastCommitID: meta.Commit.Hash,
+	}, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+func (p *cloudProvider) ReadFileContent(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (string, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return "", err
+	}
+	return getRaw(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/src/%s/%s", repoURL, url.PathEscape(ref), escapeFilePath(filePath)), "read file content")
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-name-get
+func (p *cloudProvider) GetBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	branch := &cloudBranch{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/refs/branches/%s", repoURL, url.PathEscape(branchName)), "get branch", branch); err != nil {
+		return nil, err
+	}
+	return &vcs.BranchInfo{
+		Name:         branch.Name,
+		LastCommitID: branch.Target.Hash,
+	}, nil
+}
+
+// CreateBranch creates the branch in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-post
+func (p *cloudProvider) CreateBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, branch *vcs.BranchInfo) error {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	newBranch := &cloudBranch{Name: branch.Name}
+	newBranch.Target.Hash = branch.LastCommitID
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/refs/branches", repoURL), "create branch", newBranch, nil)
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-diffstat-get
+func (p *cloudProvider) ListPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	pullRequestURL := fmt.Sprintf("%s/pullrequests/%s", repoURL, url.PathEscape(pullRequestID))
+	pullRequest := &cloudPullRequest{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, pullRequestURL, "get pull request", pullRequest); err != nil {
+		return nil, err
+	}
+	if pullRequest.Source.Commit == nil {
+		return nil, errors.Errorf("pull request %s has no source commit", pullRequestID)
+	}
+
+	diffStats, err := p.listDiffStats(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/diffstat?pagelen=%d", pullRequestURL, apiPageSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list pull request file")
+	}
+
+	var res []*vcs.PullRequestFile
+	for _, diffStat := range diffStats {
+		if diffStat.Status == "removed" {
+			res = append(res, &vcs.PullRequestFile{
+				Path:         diffStat.Old.Path,
+				LastCommitID: pullRequest.Source.Commit.Hash,
+				IsDeleted:    true,
+			})
+			continue
+		}
+		if diffStat.New == nil {
+			continue
+		}
+		res = append(res, &vcs.PullRequestFile{
+			Path:         diffStat.New.Path,
+			LastCommitID: pullRequest.Source.Commit.Hash,
+		})
+	}
+	return res, nil
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-post
+func (p *cloudProvider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	pullRequest := &cloudPullRequest{
+		Title:             pullRequestCreate.Title,
+		Description:       pullRequestCreate.Body,
+		CloseSourceBranch: pullRequestCreate.RemoveHeadAfterMerged,
+	}
+	pullRequest.Source.Branch.Name = pullRequestCreate.Head
+	pullRequest.Destination.Branch.Name = pullRequestCreate.Base
+
+	res := &cloudPullRequest{}
+	if err := send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/pullrequests", repoURL), "create pull request", pullRequest, res); err != nil {
+		return nil, err
+	}
+	if res.Links == nil {
+		return nil, errors.New("missing the link of the created pull request")
+	}
+	return &vcs.PullRequest{
+		URL: res.Links.HTML.Href,
+	}, nil
+}
+
+// UpsertEnvironmentVariable creates or updates the secured repository variable
+// of Bitbucket Pipelines.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-get
+func (p *cloudProvider) UpsertEnvironmentVariable(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, key, value string) error {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	variablesURL := fmt.Sprintf("%s/pipelines_config/variables/", repoURL)
+
+	var existing *cloudPipelineVariable
+	if err := p.listAll(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s?pagelen=%d", variablesURL, apiPageSize), "list repository variables", func(values json.RawMessage) error {
+		var variables []cloudPipelineVariable
+		if err := json.Unmarshal(values, &variables); err != nil {
+			return err
+		}
+		for i := range variables {
+			if variables[i].Key == key {
+				existing = &variables[i]
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	variable := &cloudPipelineVariable{
+		Key:     key,
+		Value:   value,
+		Secured: true,
+	}
+	if existing != nil {
+		return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPut, fmt.Sprintf("%s%s", variablesURL, url.PathEscape(existing.UUID)), "update repository variable", variable, nil)
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, variablesURL, "create repository variable", variable, nil)
+}
+
+// toCloudWebhook converts the payload to the Bitbucket Cloud webhook.
+func toCloudWebhook(payload []byte) (*cloudWebhook, error) {
+	webhook := &WebhookCreateOrUpdate{}
+	if err := json.Unmarshal(payload, webhook); err != nil {
+		return nil, errors.Wrap(err, "unmarshal webhook payload")
+	}
+	return &cloudWebhook{
+		Description: webhook.Name,
+		URL:         webhook.URL,
+		Active:      webhook.Active,
+		Secret:      webhook.Secret,
+		Events:      []string{string(WebhookEventCloudPush)},
+	}, nil
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-post
+func (p *cloudProvider) CreateWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, payload []byte) (string, error) {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return "", err
+	}
+	webhook, err := toCloudWebhook(payload)
+	if err != nil {
+		return "", err
+	}
+	res := &cloudWebhook{}
+	if err := send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/hooks", repoURL), "create webhook", webhook, res); err != nil {
+		return "", err
+	}
+	return res.UUID, nil
+}
+
+// PatchWebhook patches the webhook in the repository with given payload.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-uid-put
+func (p *cloudProvider) PatchWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string, payload []byte) error {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	webhook, err := toCloudWebhook(payload)
+	if err != nil {
+		return err
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPut, fmt.Sprintf("%s/hooks/%s", repoURL, url.PathEscape(webhookID)), "patch webhook", webhook, nil)
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-uid-delete
+func (p *cloudProvider) DeleteWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string) error {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodDelete, fmt.Sprintf("%s/hooks/%s", repoURL, url.PathEscape(webhookID)), "delete webhook", nil, nil)
+}
+
+// createPullRequestComment creates a comment with the markdown content in the
+// pull request.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-post
+func (p *cloudProvider) createPullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, content string) error {
+	repoURL, err := p.repositoryURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	comment := map[string]interface{}{
+		"content": map[string]string{
+			"raw": content,
+		},
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/pullrequests/%s/comments", repoURL, url.PathEscape(pullRequestID)), "create pull request comment", comment, nil)
+}
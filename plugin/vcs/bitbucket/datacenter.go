@@ -0,0 +1,689 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/vcs"
+)
+
+// dataCenterAPIPath is the API path of a Bitbucket Data Center instance.
+const dataCenterAPIPath = "rest/api/1.0"
+
+var _ vcs.Provider = (*dataCenterProvider)(nil)
+
+// dataCenterProvider is the Bitbucket Data Center implementation, where the
+// repository ID is in the format of "{projectKey}/{repositorySlug}".
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/
+type dataCenterProvider struct {
+	client *http.Client
+}
+
+// dataCenterPage is a page of the paginated Bitbucket Data Center API response.
+type dataCenterPage struct {
+	Values        json.RawMessage `json:"values"`
+	IsLastPage    bool            `json:"isLastPage"`
+	NextPageStart int             `json:"nextPageStart"`
+}
+
+// dataCenterLinks is the links object of the Bitbucket Data Center API response.
+type dataCenterLinks struct {
+	Self []struct {
+		Href string `json:"href"`
+	} `json:"self"`
+}
+
+// href returns the first self link.
+func (l *dataCenterLinks) href() string {
+	if l == nil || len(l.Self) == 0 {
+		return ""
+	}
+	return l.Self[0].Href
+}
+
+type dataCenterUser struct {
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+	Active       bool   `json:"active"`
+}
+
+// toVCSUserInfo converts the user to *vcs.UserInfo.
+func (u dataCenterUser) toVCSUserInfo() *vcs.UserInfo {
+	name := u.DisplayName
+	if name == "" {
+		name = u.Name
+	}
+	state := vcs.StateActive
+	if !u.Active {
+		state = vcs.StateArchived
+	}
+	return &vcs.UserInfo{
+		PublicEmail: u.EmailAddress,
+		Name:        name,
+		State:       state,
+	}
+}
+
+type dataCenterUserPermission struct {
+	User dataCenterUser `json:"user"`
+	// Available values: "REPO_READ", "REPO_WRITE", "REPO_ADMIN".
+	Permission string `json:"permission"`
+}
+
+type dataCenterCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	// AuthorTimestamp is the Unix timestamp in milliseconds.
+	AuthorTimestamp int64          `json:"authorTimestamp"`
+	Author          dataCenterUser `json:"author"`
+}
+
+type dataCenterPath struct {
+	ToString string `json:"toString"`
+}
+
+type dataCenterChange struct {
+	Path    dataCenterPath  `json:"path"`
+	SrcPath *dataCenterPath `json:"srcPath"`
+	// Available values: "ADD", "COPY", "DELETE", "MODIFY", "MOVE", "UNKNOWN".
+	Type string `json:"type"`
+}
+
+type dataCenterRepository struct {
+	Slug    string `json:"slug"`
+	Name    string `json:"name"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+	Links *dataCenterLinks `json:"links"`
+}
+
+type dataCenterBranch struct {
+	ID           string `json:"id"`
+	DisplayID    string `json:"displayId"`
+	LatestCommit string `json:"latestCommit"`
+}
+
+type dataCenterRef struct {
+	ID           string `json:"id"`
+	LatestCommit string `json:"latestCommit,omitempty"`
+}
+
+type dataCenterPullRequest struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	FromRef     dataCenterRef    `json:"fromRef"`
+	ToRef       dataCenterRef    `json:"toRef"`
+	Links       *dataCenterLinks `json:"links,omitempty"`
+}
+
+type dataCenterWebhook struct {
+	ID            int      `json:"id,omitempty"`
+	Name          string   `json:"name"`
+	URL           string   `json:"url"`
+	Active        bool     `json:"active"`
+	Events        []string `json:"events"`
+	Configuration struct {
+		Secret string `json:"secret,omitempty"`
+	} `json:"configuration"`
+}
+
+// APIURL returns the API URL path of a Bitbucket Data Center instance.
+func (*dataCenterProvider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/%s", instanceURL, dataCenterAPIPath)
+}
+
+// repositoryURL returns the API URL of the repository.
+func (p *dataCenterProvider) repositoryURL(instanceURL, repositoryID string) (string, error) {
+	projectKey, slug, err := splitRepositoryID(repositoryID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/projects/%s/repos/%s", p.APIURL(instanceURL), url.PathEscape(projectKey), url.PathEscape(slug)), nil
+}
+
+// listAll fetches all pages of the URL, and calls the appendValues on the
+// values of each page.
+func (p *dataCenterProvider) listAll(ctx context.Context, oauthCtx common.OauthContext, instanceURL, url, action string, appendValues func(values json.RawMessage) error) error {
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+	start := 0
+	for {
+		page := &dataCenterPage{}
+		if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s%sstart=%d&limit=%d", url, separator, start, apiPageSize), action, page); err != nil {
+			return err
+		}
+		if err := appendValues(page.Values); err != nil {
+			return errors.Wrapf(err, "unmarshal values to %s", action)
+		}
+		if page.IsLastPage {
+			return nil
+		}
+		start = page.NextPageStart
+	}
+}
+
+// ExchangeOAuthToken exchanges OAuth content with the provided authorization code.
+func (p *dataCenterProvider) ExchangeOAuthToken(ctx context.Context, instanceURL string, oauthExchange *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	return exchangeOAuthToken(ctx, p.client, instanceURL, oauthExchange)
+}
+
+// TryLogin tries to fetch the user info from the current OAuth context.
+//
+// Bitbucket Data Center has no API for the authenticated user, thus we get the
+// username from the "whoami" servlet of the application links plugin.
+func (p *dataCenterProvider) TryLogin(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) (*vcs.UserInfo, error) {
+	username, err := getRaw(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/plugins/servlet/applinks/whoami", instanceURL), "fetch the authenticated user")
+	if err != nil {
+		return nil, err
+	}
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, errors.New("the access token is not associated with any user")
+	}
+	return p.FetchUserInfo(ctx, oauthCtx, instanceURL, username)
+}
+
+// FetchCommitByID fetches the commit data by its ID from the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-commits-commitid-get
+func (p *dataCenterProvider) FetchCommitByID(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, commitID string) (*vcs.Commit, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	commit := &dataCenterCommit{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/commits/%s", repoURL, url.PathEscape(commitID)), "fetch commit data", commit); err != nil {
+		return nil, err
+	}
+
+	projectKey, slug, _ := splitRepositoryID(repositoryID)
+	return &vcs.Commit{
+		ID:          commit.ID,
+		Title:       getCommitTitle(commit.Message),
+		Message:     commit.Message,
+		CreatedTs:   commit.AuthorTimestamp / 1000,
+		URL:         fmt.Sprintf("%s/projects/%s/repos/%s/commits/%s", instanceURL, projectKey, slug, commit.ID),
+		AuthorName:  commit.Author.toVCSUserInfo().Name,
+		AuthorEmail: commit.Author.EmailAddress,
+	}, nil
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-compare-changes-get
+func (p *dataCenterProvider) GetDiffFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	// The "from" is the source of the changes and the "to" is the target,
+	// which is the same as a pull request.
+	changes, err := p.listChanges(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/compare/changes?from=%s&to=%s", repoURL, url.QueryEscape(afterCommit), url.QueryEscape(beforeCommit)))
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []vcs.FileDiff
+	for _, change := range changes {
+		switch change.Type {
+		case "ADD", "COPY":
+			ret = append(ret, vcs.FileDiff{Path: change.Path.ToString, Type: vcs.FileDiffTypeAdded})
+		case "MODIFY":
+			ret = append(ret, vcs.FileDiff{Path: change.Path.ToString, Type: vcs.FileDiffTypeModified})
+		case "DELETE":
+			ret = append(ret, vcs.FileDiff{Path: change.Path.ToString, Type: vcs.FileDiffTypeRemoved})
+		case "MOVE":
+			if change.SrcPath != nil {
+				ret = append(ret, vcs.FileDiff{Path: change.SrcPath.ToString, Type: vcs.FileDiffTypeRemoved})
+			}
+			ret = append(ret, vcs.FileDiff{Path: change.Path.ToString, Type: vcs.FileDiffTypeAdded})
+		}
+	}
+	return ret, nil
+}
+
+// listChanges lists all changes of the URL.
+func (p *dataCenterProvider) listChanges(ctx context.Context, oauthCtx common.OauthContext, instanceURL, url string) ([]dataCenterChange, error) {
+	var changes []dataCenterChange
+	if err := p.listAll(ctx, oauthCtx, instanceURL, url, "get file diff list", func(values json.RawMessage) error {
+		var page []dataCenterChange
+		if err := json.Unmarshal(values, &page); err != nil {
+			return err
+		}
+		changes = append(changes, page...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// FetchUserInfo fetches user info of given user slug.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-system-maintenance/#api-api-latest-users-userslug-get
+func (p *dataCenterProvider) FetchUserInfo(ctx context.Context, oauthCtx common.OauthContext, instanceURL, userSlug string) (*vcs.UserInfo, error) {
+	user := &dataCenterUser{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/users/%s", p.APIURL(instanceURL), url.PathEscape(userSlug)), "fetch user info", user); err != nil {
+		return nil, err
+	}
+	return user.toVCSUserInfo(), nil
+}
+
+// getRoleAndMappedRole maps the repository permission to the Bytebase role.
+func getRoleAndMappedRole(permission string) common.ProjectRole {
+	switch permission {
+	case "REPO_ADMIN", "REPO_WRITE":
+		return common.ProjectOwner
+	case "REPO_READ":
+		return common.ProjectDeveloper
+	}
+	return ""
+}
+
+// FetchRepositoryActiveMemberList fetches all active members of a repository,
+// which only includes the users granted with the repository permissions
+// directly.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-permission-management/#api-api-latest-projects-projectkey-repos-repositoryslug-permissions-users-get
+func (p *dataCenterProvider) FetchRepositoryActiveMemberList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string) ([]*vcs.RepositoryMember, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var emptyEmailUserList []string
+	var allMembers []*vcs.RepositoryMember
+	if err := p.listAll(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/permissions/users", repoURL), "fetch repository members", func(values json.RawMessage) error {
+		var permissions []dataCenterUserPermission
+		if err := json.Unmarshal(values, &permissions); err != nil {
+			return err
+		}
+		for _, permission := range permissions {
+			userInfo := permission.User.toVCSUserInfo()
+			if userInfo.State != vcs.StateActive {
+				continue
+			}
+			if userInfo.PublicEmail == "" {
+				emptyEmailUserList = append(emptyEmailUserList, userInfo.Name)
+				continue
+			}
+			bytebaseRole := getRoleAndMappedRole(permission.Permission)
+			if bytebaseRole == "" {
+				continue
+			}
+			allMembers = append(allMembers,
+				&vcs.RepositoryMember{
+					Name:         userInfo.Name,
+					Email:        userInfo.PublicEmail,
+					Role:         bytebaseRole,
+					VCSRole:      permission.Permission,
+					State:        vcs.StateActive,
+					RoleProvider: vcs.Bitbucket,
+				},
+			)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(emptyEmailUserList) != 0 {
+		return nil, errors.Errorf("[ %v ] did not configure their email in Bitbucket, please make sure every members' email is configured before syncing", strings.Join(emptyEmailUserList, ", "))
+	}
+	return allMembers, nil
+}
+
+// FetchAllRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-repos-get
+func (p *dataCenterProvider) FetchAllRepositoryList(ctx context.Context, oauthCtx common.OauthContext, instanceURL string) ([]*vcs.Repository, error) {
+	var allRepos []*vcs.Repository
+	if err := p.listAll(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/repos?permission=REPO_ADMIN", p.APIURL(instanceURL)), "fetch repository list", func(values json.RawMessage) error {
+		var repos []dataCenterRepository
+		if err := json.Unmarshal(values, &repos); err != nil {
+			return err
+		}
+		for _, r := range repos {
+			allRepos = append(allRepos,
+				&vcs.Repository{
+					Name:     r.Name,
+					FullPath: fmt.Sprintf("%s/%s", r.Project.Key, r.Slug),
+					// The self link points to the file browser of the repository.
+					WebURL: strings.TrimSuffix(r.Links.href(), "/browse"),
+				},
+			)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return allRepos, nil
+}
+
+// FetchRepositoryFileList fetches the all files from the given repository tree recursively.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-files-path-get
+func (p *dataCenterProvider) FetchRepositoryFileList(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, ref, filePath string) ([]*vcs.RepositoryTreeNode, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	directory := strings.TrimSuffix(filePath, "/")
+
+	var allTreeNodes []*vcs.RepositoryTreeNode
+	if err := p.listAll(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/files/%s?at=%s", repoURL, escapeFilePath(directory), url.QueryEscape(ref)), "fetch repository file list", func(values json.RawMessage) error {
+		// The paths are relative to the directory.
+		var paths []string
+		if err := json.Unmarshal(values, &paths); err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if directory != "" {
+				path = fmt.Sprintf("%s/%s", directory, path)
+			}
+			allTreeNodes = append(allTreeNodes,
+				&vcs.RepositoryTreeNode{
+					Path: path,
+					Type: "blob",
+				},
+			)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return allTreeNodes, nil
+}
+
+// CreateFile creates a file at given path in the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-browse-path-put
+func (p *dataCenterProvider) CreateFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.commitFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+// OverwriteFile overwrites an existing file at given path in the repository.
+func (p *dataCenterProvider) OverwriteFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	return p.commitFile(ctx, oauthCtx, instanceURL, repositoryID, filePath, fileCommitCreate)
+}
+
+// commitFile commits the file to the branch. The "LastCommitID" is required
+// to overwrite an existing file, which is used to detect conflicting writes.
+func (p *dataCenterProvider) commitFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath string, fileCommitCreate vcs.FileCommitCreate) error {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fields := [][2]string{
+		{"branch", fileCommitCreate.Branch},
+		{"content", fileCommitCreate.Content},
+		{"message", fileCommitCreate.CommitMessage},
+	}
+	if fileCommitCreate.LastCommitID != "" {
+		fields = append(fields, [2]string{"sourceCommitId", fileCommitCreate.LastCommitID})
+	}
+	for _, field := range fields {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return errors.Wrapf(err, "write field %q", field[0])
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "close multipart writer")
+	}
+	return sendMultipart(ctx, p.client, oauthCtx, instanceURL, http.MethodPut, fmt.Sprintf("%s/browse/%s", repoURL, escapeFilePath(filePath)), "commit file", body, writer.FormDataContentType())
+}
+
+// ReadFileMeta reads the metadata of the given file in the repository.
+//
+// Bitbucket Data Center has no API for the file metadata, thus we read the
+// size from the file content, and the last commit from the commit history of
+// the file.
+func (p *dataCenterProvider) ReadFileMeta(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (*vcs.FileMeta, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := p.ReadFileContent(ctx, oauthCtx, instanceURL, repositoryID, filePath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &dataCenterPage{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/commits?path=%s&until=%s&limit=1", repoURL, url.QueryEscape(filePath), url.QueryEscape(ref)), "read file meta", page); err != nil {
+		return nil, err
+	}
+	var commits []dataCenterCommit
+	if err := json.Unmarshal(page.Values, &commits); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	if len(commits) == 0 {
+		return nil, common.Errorf(common.NotFound, "no commit found for file %s at %s", filePath, ref)
+	}
+
+	return &vcs.FileMeta{
+		Name:         filePath[strings.LastIndex(filePath, "/")+1:],
+		Path:         filePath,
+		Size:         int64(len(content)),
+		LastCommitID: commits[0].ID,
+	}, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-raw-path-get
+func (p *dataCenterProvider) ReadFileContent(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, filePath, ref string) (string, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return "", err
+	}
+	return getRaw(ctx, p.client, oauthCtx, instanceURL, fmt.Sprintf("%s/raw/%s?at=%s", repoURL, escapeFilePath(filePath), url.QueryEscape(ref)), "read file content")
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-branches-get
+func (p *dataCenterProvider) GetBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *dataCenterBranch
+	branchesURL := fmt.Sprintf("%s/branches?filterText=%s", repoURL, url.QueryEscape(branchName))
+	if err := p.listAll(ctx, oauthCtx, instanceURL, branchesURL, "get branch", func(values json.RawMessage) error {
+		var branches []dataCenterBranch
+		if err := json.Unmarshal(values, &branches); err != nil {
+			return err
+		}
+		// The filter matches the branch names containing the text, thus we
+		// look for the exact match.
+		for i := range branches {
+			if branches[i].DisplayID == branchName {
+				found = &branches[i]
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", branchesURL)
+	}
+	return &vcs.BranchInfo{
+		Name:         found.DisplayID,
+		LastCommitID: found.LatestCommit,
+	}, nil
+}
+
+// CreateBranch creates the branch in the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-branches-post
+func (p *dataCenterProvider) CreateBranch(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, branch *vcs.BranchInfo) error {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return err
+	}
+	newBranch := map[string]string{
+		"name":       branch.Name,
+		"startPoint": branch.LastCommitID,
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/branches", repoURL), "create branch", newBranch, nil)
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-pull-requests/#api-api-latest-projects-projectkey-repos-repositoryslug-pull-requests-pullrequestid-changes-get
+func (p *dataCenterProvider) ListPullRequestFile(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	pullRequestURL := fmt.Sprintf("%s/pull-requests/%s", repoURL, url.PathEscape(pullRequestID))
+	pullRequest := &dataCenterPullRequest{}
+	if err := get(ctx, p.client, oauthCtx, instanceURL, pullRequestURL, "get pull request", pullRequest); err != nil {
+		return nil, err
+	}
+
+	changes, err := p.listChanges(ctx, oauthCtx, instanceURL, fmt.Sprintf("%s/changes", pullRequestURL))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list pull request file")
+	}
+
+	var res []*vcs.PullRequestFile
+	for _, change := range changes {
+		res = append(res, &vcs.PullRequestFile{
+			Path:         change.Path.ToString,
+			LastCommitID: pullRequest.FromRef.LatestCommit,
+			IsDeleted:    change.Type == "DELETE",
+		})
+	}
+	return res, nil
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-pull-requests/#api-api-latest-projects-projectkey-repos-repositoryslug-pull-requests-post
+func (p *dataCenterProvider) CreatePullRequest(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	pullRequest := &dataCenterPullRequest{
+		Title:       pullRequestCreate.Title,
+		Description: pullRequestCreate.Body,
+		FromRef:     dataCenterRef{ID: fmt.Sprintf("refs/heads/%s", pullRequestCreate.Head)},
+		ToRef:       dataCenterRef{ID: fmt.Sprintf("refs/heads/%s", pullRequestCreate.Base)},
+	}
+
+	res := &dataCenterPullRequest{}
+	if err := send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/pull-requests", repoURL), "create pull request", pullRequest, res); err != nil {
+		return nil, err
+	}
+	return &vcs.PullRequest{
+		URL: res.Links.href(),
+	}, nil
+}
+
+// UpsertEnvironmentVariable creates or updates the environment variable in the repository.
+func (*dataCenterProvider) UpsertEnvironmentVariable(context.Context, common.OauthContext, string, string, string, string) error {
+	return errors.New("Bitbucket Data Center does not support repository variables")
+}
+
+// toDataCenterWebhook converts the payload to the Bitbucket Data Center webhook.
+func toDataCenterWebhook(payload []byte) (*dataCenterWebhook, error) {
+	webhook := &WebhookCreateOrUpdate{}
+	if err := json.Unmarshal(payload, webhook); err != nil {
+		return nil, errors.Wrap(err, "unmarshal webhook payload")
+	}
+	ret := &dataCenterWebhook{
+		Name:   webhook.Name,
+		URL:    webhook.URL,
+		Active: webhook.Active,
+		Events: []string{
+			string(WebhookEventDataCenterPush),
+			string(WebhookEventDataCenterPullRequestOpened),
+			string(WebhookEventDataCenterPullRequestUpdated),
+		},
+	}
+	ret.Configuration.Secret = webhook.Secret
+	return ret, nil
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-webhooks-post
+func (p *dataCenterProvider) CreateWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID string, payload []byte) (string, error) {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return "", err
+	}
+	webhook, err := toDataCenterWebhook(payload)
+	if err != nil {
+		return "", err
+	}
+	res := &dataCenterWebhook{}
+	if err := send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/webhooks", repoURL), "create webhook", webhook, res); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d", res.ID), nil
+}
+
+// PatchWebhook patches the webhook in the repository with given payload.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-webhooks-webhookid-put
+func (p *dataCenterProvider) PatchWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string, payload []byte) error {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return err
+	}
+	webhook, err := toDataCenterWebhook(payload)
+	if err != nil {
+		return err
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPut, fmt.Sprintf("%s/webhooks/%s", repoURL, url.PathEscape(webhookID)), "patch webhook", webhook, nil)
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-webhooks-webhookid-delete
+func (p *dataCenterProvider) DeleteWebhook(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, webhookID string) error {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return err
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodDelete, fmt.Sprintf("%s/webhooks/%s", repoURL, url.PathEscape(webhookID)), "delete webhook", nil, nil)
+}
+
+// createPullRequestComment creates a comment with the markdown content in the
+// pull request.
+//
+// Docs: https://developer.atlassian.com/server/bitbucket/rest/v811/api-group-pull-requests/#api-api-latest-projects-projectkey-repos-repositoryslug-pull-requests-pullrequestid-comments-post
+func (p *dataCenterProvider) createPullRequestComment(ctx context.Context, oauthCtx common.OauthContext, instanceURL, repositoryID, pullRequestID, content string) error {
+	repoURL, err := p.repositoryURL(instanceURL, repositoryID)
+	if err != nil {
+		return err
+	}
+	comment := map[string]string{
+		"text": content,
+	}
+	return send(ctx, p.client, oauthCtx, instanceURL, http.MethodPost, fmt.Sprintf("%s/pull-requests/%s/comments", repoURL, url.PathEscape(pullRequestID)), "create pull request comment", comment, nil)
+}
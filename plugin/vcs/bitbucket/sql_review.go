@@ -0,0 +1,80 @@
+package bitbucket
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/plugin/vcs"
+)
+
+const (
+	// PipelinesFilePath is the local path for the Bitbucket Pipelines file.
+	PipelinesFilePath = "bitbucket-pipelines.yml"
+	// sqlReviewStepName is the name of the SQL review step, which is used to
+	// find the existing step in the Bitbucket Pipelines file.
+	sqlReviewStepName = "Bytebase SQL Review"
+	// pullRequestsKeyword is the keyword for the pipelines triggered by pull
+	// requests, and pullRequestsAnyBranch is the glob pattern of the pipeline
+	// running on the pull requests from any branch.
+	// Docs: https://support.atlassian.com/bitbucket-cloud/docs/pipeline-start-conditions/#Pull-Requests
+	pullRequestsKeyword   = "pull-requests"
+	pullRequestsAnyBranch = "**"
+)
+
+// sqlReviewStep is the Bitbucket Pipelines step for SQL review in VCS workflow.
+//
+//go:embed bytebase-sql-review.yml
+var sqlReviewStep string
+
+// SetupBitbucketPipelines will update the Bitbucket Pipelines content to add
+// or update the SQL review step with SQL review endpoint. Bitbucket Pipelines
+// does not support including other files, thus the step is added to the
+// pipeline of pull requests from any branch directly.
+func SetupBitbucketPipelines(pipelinesConfig map[string]interface{}, endpoint string) (string, error) {
+	step := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(fmt.Sprintf(sqlReviewStep, endpoint, vcs.SQLReviewAPISecretName)), &step); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal SQL review step")
+	}
+
+	pipelines, ok := pipelinesConfig["pipelines"].(map[string]interface{})
+	if !ok {
+		pipelines = make(map[string]interface{})
+		pipelinesConfig["pipelines"] = pipelines
+	}
+	pullRequests, ok := pipelines[pullRequestsKeyword].(map[string]interface{})
+	if !ok {
+		pullRequests = make(map[string]interface{})
+		pipelines[pullRequestsKeyword] = pullRequests
+	}
+	stepList, _ := pullRequests[pullRequestsAnyBranch].([]interface{})
+
+	if i, ok := findSQLReviewStep(stepList); ok {
+		stepList[i] = map[string]interface{}{"step": step}
+	} else {
+		stepList = append(stepList, map[string]interface{}{"step": step})
+	}
+	pullRequests[pullRequestsAnyBranch] = stepList
+
+	newContent, err := yaml.Marshal(pipelinesConfig)
+	if err != nil {
+		return "", err
+	}
+	return string(newContent), nil
+}
+
+// findSQLReviewStep returns the index of the SQL review step in the step list.
+func findSQLReviewStep(stepList []interface{}) (int, bool) {
+	for i, data := range stepList {
+		val, ok := data.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if step, ok := val["step"].(map[string]interface{}); ok && step["name"] == sqlReviewStepName {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,64 @@
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const mockPipelinesContentYAMLStr = `
+image: node:16
+
+pipelines:
+  default:
+    - step:
+        name: Build
+        script:
+          - npm ci
+  pull-requests:
+    '**':
+      - step:
+          name: Test
+          script:
+            - npm test
+`
+
+func TestSetupBitbucketPipelines(t *testing.T) {
+	content := make(map[string]interface{})
+	err := yaml.Unmarshal([]byte(mockPipelinesContentYAMLStr), &content)
+	require.NoError(t, err)
+
+	newContent, err := SetupBitbucketPipelines(content, "https://bytebase.example.com/hook/sql-review/1")
+	require.NoError(t, err)
+
+	// Setting up again should update the existing step in place.
+	content = make(map[string]interface{})
+	err = yaml.Unmarshal([]byte(newContent), &content)
+	require.NoError(t, err)
+	newContent, err = SetupBitbucketPipelines(content, "https://bytebase.example.com/hook/sql-review/2")
+	require.NoError(t, err)
+
+	content = make(map[string]interface{})
+	err = yaml.Unmarshal([]byte(newContent), &content)
+	require.NoError(t, err)
+
+	assert.NotNil(t, content["image"])
+	pipelines, ok := content["pipelines"].(map[string]interface{})
+	require.True(t, ok)
+	assert.NotNil(t, pipelines["default"])
+
+	pullRequests, ok := pipelines["pull-requests"].(map[string]interface{})
+	require.True(t, ok)
+	stepList, ok := pullRequests["**"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, stepList, 2)
+
+	i, ok := findSQLReviewStep(stepList)
+	require.True(t, ok)
+	assert.Equal(t, 1, i)
+	step := stepList[i].(map[string]interface{})["step"].(map[string]interface{})
+	assert.Equal(t, "alpine:3", step["image"])
+	assert.Contains(t, step["script"], `export API="https://bytebase.example.com/hook/sql-review/2"`)
+}
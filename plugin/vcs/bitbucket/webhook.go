@@ -0,0 +1,226 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/vcs"
+)
+
+// WebhookEventKey is the Bitbucket webhook event key sent in the X-Event-Key
+// header.
+type WebhookEventKey string
+
+// The list of Bitbucket webhook events subscribed by Bytebase.
+const (
+	// WebhookEventCloudPush is the Bitbucket Cloud event for pushes. The SQL
+	// review of Bitbucket Cloud runs in Bitbucket Pipelines, thus no pull
+	// request event is subscribed.
+	WebhookEventCloudPush WebhookEventKey = "repo:push"
+
+	// WebhookEventDataCenterPush is the Bitbucket Data Center event for pushes.
+	WebhookEventDataCenterPush WebhookEventKey = "repo:refs_changed"
+	// WebhookEventDataCenterPullRequestOpened is the Bitbucket Data Center
+	// event for opened pull requests.
+	WebhookEventDataCenterPullRequestOpened WebhookEventKey = "pr:opened"
+	// WebhookEventDataCenterPullRequestUpdated is the Bitbucket Data Center
+	// event for new commits pushed to the source branch of pull requests.
+	WebhookEventDataCenterPullRequestUpdated WebhookEventKey = "pr:from_ref_updated"
+	// WebhookEventDataCenterPing is the Bitbucket Data Center event sent when
+	// testing the connection of the webhook.
+	WebhookEventDataCenterPing WebhookEventKey = "diagnostics:ping"
+)
+
+// IsPushEvent returns true if the event key is a push event.
+func (k WebhookEventKey) IsPushEvent() bool {
+	return k == WebhookEventCloudPush || k == WebhookEventDataCenterPush
+}
+
+// IsPullRequestEvent returns true if the event key is a pull request event.
+func (k WebhookEventKey) IsPullRequestEvent() bool {
+	return k == WebhookEventDataCenterPullRequestOpened || k == WebhookEventDataCenterPullRequestUpdated
+}
+
+// WebhookRefChange is an updated branch of the webhook push event.
+type WebhookRefChange struct {
+	// Ref is the full name of the branch, e.g. "refs/heads/main".
+	Ref    string
+	Before string
+	After  string
+}
+
+// WebhookPushEvent is the push event of Bitbucket Cloud and Bitbucket Data
+// Center in common. Unlike other VCS providers, the payloads do not contain
+// the changed files.
+type WebhookPushEvent struct {
+	RepositoryID string
+	// RepositoryURL is empty for Bitbucket Data Center, whose payloads do not
+	// contain any link of the repository.
+	RepositoryURL string
+	ActorName     string
+	// RefChanges only contains the updated branches, the created or deleted
+	// branches are ignored.
+	RefChanges []WebhookRefChange
+}
+
+// WebhookPullRequestEvent is the pull request event of Bitbucket Data Center.
+type WebhookPullRequestEvent struct {
+	// RepositoryID is the ID of the destination repository.
+	RepositoryID  string
+	PullRequestID string
+}
+
+type cloudWebhookRepository struct {
+	FullName string `json:"full_name"`
+	Links    struct {
+		HTML cloudLink `json:"html"`
+	} `json:"links"`
+}
+
+type cloudWebhookRef struct {
+	// Available values: "branch", "tag", "named_branch", "bookmark".
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+type cloudWebhookPushEvent struct {
+	Actor      cloudUser              `json:"actor"`
+	Repository cloudWebhookRepository `json:"repository"`
+	Push       struct {
+		Changes []struct {
+			// New is null if the ref is deleted, and Old is null if the ref is
+			// created.
+			New *cloudWebhookRef `json:"new"`
+			Old *cloudWebhookRef `json:"old"`
+		} `json:"changes"`
+	} `json:"push"`
+}
+
+type dataCenterWebhookRepository struct {
+	Slug    string `json:"slug"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+}
+
+type dataCenterWebhookPushEvent struct {
+	Actor      dataCenterUser              `json:"actor"`
+	Repository dataCenterWebhookRepository `json:"repository"`
+	Changes    []struct {
+		RefID    string `json:"refId"`
+		FromHash string `json:"fromHash"`
+		ToHash   string `json:"toHash"`
+		// Available values: "ADD", "DELETE", "UPDATE".
+		Type string `json:"type"`
+	} `json:"changes"`
+}
+
+type dataCenterWebhookPullRequestEvent struct {
+	PullRequest struct {
+		ID    int `json:"id"`
+		ToRef struct {
+			Repository dataCenterWebhookRepository `json:"repository"`
+		} `json:"toRef"`
+	} `json:"pullRequest"`
+}
+
+// ParsePushEvent parses the push event payload with given event key.
+func ParsePushEvent(eventKey WebhookEventKey, body []byte) (*WebhookPushEvent, error) {
+	switch eventKey {
+	case WebhookEventCloudPush:
+		var payload cloudWebhookPushEvent
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, errors.Wrap(err, "unmarshal push event")
+		}
+		event := &WebhookPushEvent{
+			RepositoryID:  payload.Repository.FullName,
+			RepositoryURL: payload.Repository.Links.HTML.Href,
+			ActorName:     payload.Actor.DisplayName,
+		}
+		for _, change := range payload.Push.Changes {
+			if change.New == nil || change.Old == nil || change.New.Type != "branch" {
+				continue
+			}
+			event.RefChanges = append(event.RefChanges, WebhookRefChange{
+				Ref:    fmt.Sprintf("refs/heads/%s", change.New.Name),
+				Before: change.Old.Target.Hash,
+				After:  change.New.Target.Hash,
+			})
+		}
+		return event, nil
+	case WebhookEventDataCenterPush:
+		var payload dataCenterWebhookPushEvent
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, errors.Wrap(err, "unmarshal push event")
+		}
+		event := &WebhookPushEvent{
+			RepositoryID: fmt.Sprintf("%s/%s", payload.Repository.Project.Key, payload.Repository.Slug),
+			ActorName:    payload.Actor.toVCSUserInfo().Name,
+		}
+		for _, change := range payload.Changes {
+			if change.Type != "UPDATE" || !strings.HasPrefix(change.RefID, "refs/heads/") {
+				continue
+			}
+			event.RefChanges = append(event.RefChanges, WebhookRefChange{
+				Ref:    change.RefID,
+				Before: change.FromHash,
+				After:  change.ToHash,
+			})
+		}
+		return event, nil
+	}
+	return nil, errors.Errorf("unexpected push event key %q", eventKey)
+}
+
+// ParsePullRequestEvent parses the pull request event payload with given event
+// key.
+func ParsePullRequestEvent(eventKey WebhookEventKey, body []byte) (*WebhookPullRequestEvent, error) {
+	switch eventKey {
+	case WebhookEventDataCenterPullRequestOpened, WebhookEventDataCenterPullRequestUpdated:
+		var payload dataCenterWebhookPullRequestEvent
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, errors.Wrap(err, "unmarshal pull request event")
+		}
+		repository := payload.PullRequest.ToRef.Repository
+		return &WebhookPullRequestEvent{
+			RepositoryID:  fmt.Sprintf("%s/%s", repository.Project.Key, repository.Slug),
+			PullRequestID: fmt.Sprintf("%d", payload.PullRequest.ID),
+		}, nil
+	}
+	return nil, errors.Errorf("unexpected pull request event key %q", eventKey)
+}
+
+// ToVCS converts the updated branch of the push event to vcs.PushEvent. As the
+// payloads do not contain the changed files, the caller should provide the
+// head commit and the diff between the commits before and after the push,
+// which are folded into a single commit.
+func (p *WebhookPushEvent) ToVCS(refChange WebhookRefChange, headCommit *vcs.Commit, fileDiffs []vcs.FileDiff) vcs.PushEvent {
+	commit := *headCommit
+	commit.AddedList = nil
+	commit.ModifiedList = nil
+	for _, fileDiff := range fileDiffs {
+		switch fileDiff.Type {
+		case vcs.FileDiffTypeAdded:
+			commit.AddedList = append(commit.AddedList, fileDiff.Path)
+		case vcs.FileDiffTypeModified:
+			commit.ModifiedList = append(commit.ModifiedList, fileDiff.Path)
+		}
+	}
+	return vcs.PushEvent{
+		VCSType:            vcs.Bitbucket,
+		Ref:                refChange.Ref,
+		Before:             refChange.Before,
+		After:              refChange.After,
+		RepositoryID:       p.RepositoryID,
+		RepositoryURL:      p.RepositoryURL,
+		RepositoryFullPath: p.RepositoryID,
+		AuthorName:         p.ActorName,
+		CommitList:         []vcs.Commit{commit},
+	}
+}
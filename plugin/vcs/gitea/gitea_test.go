@@ -0,0 +1,827 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/vcs"
+	"github.com/bytebase/bytebase/plugin/vcs/internal/oauth"
+)
+
+const testInstanceURL = "https://gitea.example.com"
+
+func TestProvider_FetchUserInfo(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/users/octocat", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "id": 1,
+  "login": "octocat",
+  "full_name": "Monalisa Octocat",
+  "email": "octocat@gitea.example.com",
+  "avatar_url": "https://gitea.example.com/avatars/1",
+  "language": "en-US",
+  "is_admin": false,
+  "active": true,
+  "prohibit_login": false,
+  "restricted": false,
+  "visibility": "public"
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchUserInfo(ctx, common.OauthContext{}, testInstanceURL, "octocat")
+	require.NoError(t, err)
+
+	want := &vcs.UserInfo{
+		PublicEmail: "octocat@gitea.example.com",
+		Name:        "Monalisa Octocat",
+		State:       vcs.StateActive,
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_FetchRepositoryActiveMemberList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/api/v1/repos/octocat/Hello-World/collaborators":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+[
+  {
+    "id": 1,
+    "login": "octocat",
+    "full_name": "Monalisa Octocat",
+    "email": "octocat@gitea.example.com"
+  },
+  {
+    "id": 2,
+    "login": "hubot",
+    "full_name": "",
+    "email": "hubot@gitea.example.com"
+  },
+  {
+    "id": 3,
+    "login": "suspended",
+    "full_name": "Suspended User",
+    "email": "suspended@gitea.example.com",
+    "prohibit_login": true
+  }
+]
+`)),
+			}, nil
+		case "/api/v1/repos/octocat/Hello-World/collaborators/octocat/permission":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"permission": "admin", "role_name": "admin"}`)),
+			}, nil
+		case "/api/v1/repos/octocat/Hello-World/collaborators/hubot/permission":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"permission": "read", "role_name": "read"}`)),
+			}, nil
+		}
+		return nil, errors.Errorf("unexpected request path %q", r.URL.Path)
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchRepositoryActiveMemberList(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World")
+	require.NoError(t, err)
+
+	want := []*vcs.RepositoryMember{
+		{
+			Name:         "Monalisa Octocat",
+			Email:        "octocat@gitea.example.com",
+			Role:         common.ProjectOwner,
+			VCSRole:      string(RepositoryRoleAdmin),
+			State:        vcs.StateActive,
+			RoleProvider: vcs.Gitea,
+		},
+		{
+			Name:         "hubot",
+			Email:        "hubot@gitea.example.com",
+			Role:         common.ProjectDeveloper,
+			VCSRole:      string(RepositoryRoleRead),
+			State:        vcs.StateActive,
+			RoleProvider: vcs.Gitea,
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_FetchCommitByID(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd",
+  "sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+  "created": "2014-11-07T22:01:45Z",
+  "html_url": "https://gitea.example.com/octocat/Hello-World/commit/7638417db6d59f3c431d3e1f261cc637155684cd",
+  "commit": {
+    "url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd",
+    "author": {
+      "name": "Monalisa Octocat",
+      "email": "octocat@gitea.example.com",
+      "date": "2014-11-07T22:01:45Z"
+    },
+    "committer": {
+      "name": "Monalisa Octocat",
+      "email": "octocat@gitea.example.com",
+      "date": "2014-11-07T22:01:45Z"
+    },
+    "message": "added readme\n"
+  },
+  "parents": [
+    {
+      "url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/git/commits/1acc419d4d6a9ce985db7be48c6349a0475975b5",
+      "sha": "1acc419d4d6a9ce985db7be48c6349a0475975b5"
+    }
+  ]
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchCommitByID(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "7638417db6d59f3c431d3e1f261cc637155684cd")
+	require.NoError(t, err)
+
+	want := &vcs.Commit{
+		ID:         "7638417db6d59f3c431d3e1f261cc637155684cd",
+		AuthorName: "Monalisa Octocat",
+		CreatedTs:  1415397705,
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_ExchangeOAuthToken(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/login/oauth/access_token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "test_client_id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "test_client_secret", r.PostForm.Get("client_secret"))
+		assert.Equal(t, "test_code", r.PostForm.Get("code"))
+		assert.Equal(t, "http://localhost:3000", r.PostForm.Get("redirect_uri"))
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "access_token": "gta_16C7e42F292c6912E7710c838347Ae178B4a",
+  "token_type": "bearer",
+  "expires_in": 3600,
+  "refresh_token": "gtr_1B4a2e77838347a7E420ce178F2E7c6912E169246c34E1ccbF66C46812d16D5B1A9Dc86A1498"
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ExchangeOAuthToken(
+		ctx,
+		testInstanceURL,
+		&common.OAuthExchange{
+			ClientID:     "test_client_id",
+			ClientSecret: "test_client_secret",
+			Code:         "test_code",
+			RedirectURL:  "http://localhost:3000",
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "gta_16C7e42F292c6912E7710c838347Ae178B4a", got.AccessToken)
+	assert.Equal(t, "gtr_1B4a2e77838347a7E420ce178F2E7c6912E169246c34E1ccbF66C46812d16D5B1A9Dc86A1498", got.RefreshToken)
+	assert.Equal(t, int64(3600), got.ExpiresIn)
+	assert.Equal(t, got.CreatedAt+3600, got.ExpiresTs)
+}
+
+func TestProvider_FetchAllRepositoryList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/user/repos", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+[
+  {
+    "id": 1296269,
+    "name": "Hello-World",
+    "full_name": "octocat/Hello-World",
+    "html_url": "https://gitea.example.com/octocat/Hello-World",
+    "permissions": {
+      "admin": true,
+      "push": true,
+      "pull": true
+    }
+  },
+  {
+    "id": 1296270,
+    "name": "Hello-Gitea",
+    "full_name": "octocat/Hello-Gitea",
+    "html_url": "https://gitea.example.com/octocat/Hello-Gitea",
+    "permissions": {
+      "admin": false,
+      "push": true,
+      "pull": true
+    }
+  }
+]
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchAllRepositoryList(ctx, common.OauthContext{}, testInstanceURL)
+	require.NoError(t, err)
+
+	want := []*vcs.Repository{
+		{
+			ID:       1296269,
+			Name:     "Hello-World",
+			FullPath: "octocat/Hello-World",
+			WebURL:   "https://gitea.example.com/octocat/Hello-World",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_FetchRepositoryFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/git/trees/main", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("recursive"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "sha": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+  "url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/git/trees/9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+  "tree": [
+    {
+      "path": "file.rb",
+      "mode": "100644",
+      "type": "blob",
+      "size": 30,
+      "sha": "44b4fc6d56897b048c772eb4087f854f46256132"
+    },
+    {
+      "path": "subdir",
+      "mode": "040000",
+      "type": "tree",
+      "sha": "f484d249c660418515fb01c2b9662073663c242e"
+    },
+    {
+      "path": "subdir/exec_file",
+      "mode": "100755",
+      "type": "blob",
+      "size": 75,
+      "sha": "45b983be36b73c0788dc9cbcb76cbb80fc7bb057"
+    }
+  ],
+  "truncated": false,
+  "page": 1,
+  "total_count": 3
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchRepositoryFileList(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "main", "subdir")
+	require.NoError(t, err)
+
+	want := []*vcs.RepositoryTreeNode{
+		{
+			Path: "subdir/exec_file",
+			Type: "blob",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_CreateFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/contents/notes/hello world.txt", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"message":"my commit message","content":"bXkgbmV3IGZpbGUgY29udGVudHM=","branch":"master"}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.CreateFile(
+		ctx,
+		common.OauthContext{},
+		testInstanceURL,
+		"octocat/Hello-World",
+		"notes/hello world.txt",
+		vcs.FileCommitCreate{
+			Branch:        "master",
+			Content:       "my new file contents",
+			CommitMessage: "my commit message",
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestProvider_OverwriteFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/contents/notes/hello.txt", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"message":"update file","content":"bXkgdXBkYXRlZCBmaWxlIGNvbnRlbnRz","sha":"95b966ae1c166bd92f8ae7d1c313e738c731dfc3","branch":"master"}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.OverwriteFile(
+		ctx,
+		common.OauthContext{},
+		testInstanceURL,
+		"octocat/Hello-World",
+		"notes/hello.txt",
+		vcs.FileCommitCreate{
+			Branch:        "master",
+			Content:       "my updated file contents",
+			CommitMessage: "update file",
+			LastCommitID:  "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestProvider_ReadFileContent(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/contents/notes/hello.txt", r.URL.Path)
+		assert.Equal(t, "master", r.URL.Query().Get("ref"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "name": "hello.txt",
+  "path": "notes/hello.txt",
+  "sha": "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+  "last_commit_sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+  "type": "file",
+  "size": 9,
+  "encoding": "base64",
+  "content": "aGVsbG8gZ2l0ZWE="
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ReadFileContent(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "notes/hello.txt", "master")
+	require.NoError(t, err)
+	assert.Equal(t, "hello gitea", got)
+
+	meta, err := p.ReadFileMeta(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "notes/hello.txt", "master")
+	require.NoError(t, err)
+	want := &vcs.FileMeta{
+		Name:         "hello.txt",
+		Path:         "notes/hello.txt",
+		Size:         9,
+		LastCommitID: "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+	}
+	assert.Equal(t, want, meta)
+}
+
+func TestProvider_CreateWebhook(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/hooks", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "id": 12345678,
+  "type": "gitea",
+  "config": {
+    "content_type": "json",
+    "url": "https://example.com/hook/gitea/webhook"
+  },
+  "events": ["push"],
+  "active": true
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.CreateWebhook(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "12345678", got)
+}
+
+func TestOAuth_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	client := &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+				if token == "expired" {
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body: io.NopCloser(strings.NewReader(`
+					{"message":"token is required","url":"https://gitea.example.com/api/swagger"}
+					`)),
+					}, nil
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+{
+  "access_token": "gta_16C7e42F292c6912E7710c838347Ae178B4a",
+  "token_type": "bearer",
+  "expires_in": 3600,
+  "refresh_token": "gtr_1B4a2e77838347a7E420ce178F2E7c6912E169246c34E1ccbF66C46812d16D5B1A9Dc86A1498"
+}
+`)),
+				}, nil
+			},
+		},
+	}
+	token := "expired"
+
+	var gotRefreshToken string
+	var gotExpiresTs int64
+	refresher := func(_, refreshToken string, expiresTs int64) error {
+		gotRefreshToken = refreshToken
+		gotExpiresTs = expiresTs
+		return nil
+	}
+
+	_, _, _, err := oauth.Get(
+		ctx,
+		client,
+		"https://gitea.example.com/api/v1/users/octocat",
+		&token,
+		tokenRefresher(
+			testInstanceURL,
+			oauthContext{},
+			refresher,
+		),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "gta_16C7e42F292c6912E7710c838347Ae178B4a", token)
+	assert.Equal(t, "gtr_1B4a2e77838347a7E420ce178F2E7c6912E169246c34E1ccbF66C46812d16D5B1A9Dc86A1498", gotRefreshToken)
+	assert.NotZero(t, gotExpiresTs)
+}
+
+func TestProvider_GetBranch(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/branches/feature/foo", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "name": "feature/foo",
+  "commit": {
+    "id": "aa218f56b14c9653891f9e74264a383fa43fefbd",
+    "message": "Update README.md\n",
+    "url": "https://gitea.example.com/octocat/Hello-World/commit/aa218f56b14c9653891f9e74264a383fa43fefbd"
+  },
+  "protected": false
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.GetBranch(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "feature/foo")
+	require.NoError(t, err)
+
+	want := &vcs.BranchInfo{
+		Name:         "feature/foo",
+		LastCommitID: "aa218f56b14c9653891f9e74264a383fa43fefbd",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_CreateBranch(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/branches", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"new_branch_name":"feature/foo","old_ref_name":"aa218f56b14c9653891f9e74264a383fa43fefbd"}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"name": "feature/foo"}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.CreateBranch(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", &vcs.BranchInfo{
+		Name:         "feature/foo",
+		LastCommitID: "aa218f56b14c9653891f9e74264a383fa43fefbd",
+	})
+	require.NoError(t, err)
+}
+
+func TestProvider_CreatePullRequest(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/pulls", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"title":"Amazing new feature","body":"Please pull these awesome changes in!","head":"feature/foo","base":"master"}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "id": 1,
+  "number": 1347,
+  "html_url": "https://gitea.example.com/octocat/Hello-World/pulls/1347",
+  "state": "open",
+  "title": "Amazing new feature"
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.CreatePullRequest(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", &vcs.PullRequestCreate{
+		Title: "Amazing new feature",
+		Body:  "Please pull these awesome changes in!",
+		Head:  "feature/foo",
+		Base:  "master",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &vcs.PullRequest{URL: "https://gitea.example.com/octocat/Hello-World/pulls/1347"}, got)
+}
+
+func TestProvider_UpsertEnvironmentVariable(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/actions/secrets/SQL_REVIEW_API_SECRET", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"data":"secret_value"}`, string(body))
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.UpsertEnvironmentVariable(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", vcs.SQLReviewAPISecretName, "secret_value")
+	require.NoError(t, err)
+}
+
+func TestProvider_ListPullRequestFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/pulls/1347/files", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+[
+  {
+    "filename": "file1.txt",
+    "status": "added",
+    "additions": 103,
+    "deletions": 21,
+    "changes": 124,
+    "html_url": "https://gitea.example.com/octocat/Hello-World/src/commit/6dcb09b5b57875f334f61aebed695e2e4193db5e/file1.txt",
+    "contents_url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/contents/file1.txt?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e"
+  },
+  {
+    "filename": "file2.txt",
+    "status": "deleted",
+    "contents_url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/contents/file2.txt?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e"
+  }
+]
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ListPullRequestFile(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "1347")
+	require.NoError(t, err)
+
+	want := []*vcs.PullRequestFile{
+		{
+			Path:         "file1.txt",
+			LastCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			IsDeleted:    false,
+		},
+		{
+			Path:         "file2.txt",
+			LastCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			IsDeleted:    true,
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_GetDiffFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/compare/before_sha...after_sha", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "total_commits": 2,
+  "commits": [
+    {
+      "sha": "1acc419d4d6a9ce985db7be48c6349a0475975b5",
+      "files": [
+        {"filename": "file1.txt", "status": "added"},
+        {"filename": "file2.txt", "status": "modified"}
+      ]
+    },
+    {
+      "sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+      "files": [
+        {"filename": "file1.txt", "status": "modified"},
+        {"filename": "file3.txt", "status": "removed"}
+      ]
+    }
+  ]
+}
+`)),
+		}, nil
+	},
+	)
+	ctx := context.Background()
+	got, err := p.GetDiffFileList(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "before_sha", "after_sha")
+	require.NoError(t, err)
+
+	want := []vcs.FileDiff{
+		{
+			Path: "file1.txt",
+			Type: vcs.FileDiffTypeAdded,
+		},
+		{
+			Path: "file2.txt",
+			Type: vcs.FileDiffTypeModified,
+		},
+		{
+			Path: "file3.txt",
+			Type: vcs.FileDiffTypeRemoved,
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestFoldCommitFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		commits []Commit
+		want    []vcs.FileDiff
+	}{
+		{
+			name: "added then removed",
+			commits: []Commit{
+				{Files: []CommitAffectedFile{{FileName: "a.sql", Status: "added"}}},
+				{Files: []CommitAffectedFile{{FileName: "a.sql", Status: "removed"}}},
+			},
+			want: nil,
+		},
+		{
+			name: "removed then added",
+			commits: []Commit{
+				{Files: []CommitAffectedFile{{FileName: "a.sql", Status: "removed"}}},
+				{Files: []CommitAffectedFile{{FileName: "a.sql", Status: "added"}}},
+			},
+			want: []vcs.FileDiff{{Path: "a.sql", Type: vcs.FileDiffTypeModified}},
+		},
+		{
+			name: "modified then removed",
+			commits: []Commit{
+				{Files: []CommitAffectedFile{{FileName: "a.sql", Status: "modified"}}},
+				{Files: []CommitAffectedFile{{FileName: "a.sql", Status: "removed"}}},
+			},
+			want: []vcs.FileDiff{{Path: "a.sql", Type: vcs.FileDiffTypeRemoved}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, foldCommitFiles(test.commits))
+		})
+	}
+}
+
+func TestWebhookPushEvent_ToVCS(t *testing.T) {
+	a := require.New(t)
+	var event WebhookPushEvent
+	err := json.Unmarshal([]byte(`
+{
+  "ref": "refs/heads/main",
+  "before": "28e1879d029cb852e4844d9c718537df08844e03",
+  "after": "bffeb74224043ba2feb48d137756c8a9331c449a",
+  "compare_url": "https://gitea.example.com/octocat/Hello-World/compare/28e1879d029cb852e4844d9c718537df08844e03...bffeb74224043ba2feb48d137756c8a9331c449a",
+  "commits": [
+    {
+      "id": "bffeb74224043ba2feb48d137756c8a9331c449a",
+      "message": "Add migration\n\nCreate the book table.\n",
+      "url": "https://gitea.example.com/octocat/Hello-World/commit/bffeb74224043ba2feb48d137756c8a9331c449a",
+      "author": {
+        "name": "Monalisa Octocat",
+        "email": "octocat@gitea.example.com",
+        "username": "octocat"
+      },
+      "timestamp": "2017-03-13T13:52:11Z",
+      "added": ["prod/db##0001##migrate##create_book.sql"],
+      "removed": [],
+      "modified": []
+    }
+  ],
+  "repository": {
+    "id": 140,
+    "full_name": "octocat/Hello-World",
+    "html_url": "https://gitea.example.com/octocat/Hello-World"
+  },
+  "pusher": {
+    "login": "octocat"
+  },
+  "sender": {
+    "login": "octocat"
+  }
+}
+`), &event)
+	a.NoError(err)
+
+	want := vcs.PushEvent{
+		VCSType:            vcs.Gitea,
+		Ref:                "refs/heads/main",
+		Before:             "28e1879d029cb852e4844d9c718537df08844e03",
+		After:              "bffeb74224043ba2feb48d137756c8a9331c449a",
+		RepositoryID:       "octocat/Hello-World",
+		RepositoryURL:      "https://gitea.example.com/octocat/Hello-World",
+		RepositoryFullPath: "octocat/Hello-World",
+		AuthorName:         "octocat",
+		CommitList: []vcs.Commit{
+			{
+				ID:           "bffeb74224043ba2feb48d137756c8a9331c449a",
+				Title:        "Add migration",
+				Message:      "Add migration\n\nCreate the book table.\n",
+				CreatedTs:    1489413131,
+				URL:          "https://gitea.example.com/octocat/Hello-World/commit/bffeb74224043ba2feb48d137756c8a9331c449a",
+				AuthorName:   "Monalisa Octocat",
+				AuthorEmail:  "octocat@gitea.example.com",
+				AddedList:    []string{"prod/db##0001##migrate##create_book.sql"},
+				ModifiedList: []string{},
+			},
+		},
+	}
+	a.Equal(want, event.ToVCS())
+}
+
+func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
+	return newProvider(
+		vcs.ProviderConfig{
+			Client: &http.Client{
+				Transport: &common.MockRoundTripper{
+					MockRoundTrip: mockRoundTrip,
+				},
+			},
+		},
+	)
+}
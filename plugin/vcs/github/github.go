@@ -37,6 +37,7 @@ const (
 
 func init() {
 	vcs.Register(vcs.GitHubCom, newProvider)
+	vcs.Register(vcs.GitHubEnterprise, newProvider)
 }
 
 var _ vcs.Provider = (*Provider)(nil)
@@ -55,6 +56,15 @@ func newProvider(config vcs.ProviderConfig) vcs.Provider {
 	}
 }
 
+// vcsType returns the VCS type of the GitHub instance, the instance is the
+// GitHub Enterprise Server if it's not the GitHub.com.
+func vcsType(instanceURL string) vcs.Type {
+	if instanceURL == githubComURL {
+		return vcs.GitHubCom
+	}
+	return vcs.GitHubEnterprise
+}
+
 // APIURL returns the API URL path of GitHub.
 func (*Provider) APIURL(instanceURL string) string {
 	if instanceURL == githubComURL {
@@ -423,7 +433,7 @@ func (p *Provider) FetchRepositoryActiveMemberList(ctx context.Context, oauthCtx
 	var emptyEmailUserList []string
 	var allMembers []*vcs.RepositoryMember
 	for _, c := range allCollaborators {
-		userInfo, err := p.FetchUserInfo(ctx, oauthCtx, githubComURL, c.Login)
+		userInfo, err := p.FetchUserInfo(ctx, oauthCtx, instanceURL, c.Login)
 		if err != nil {
 			return nil, errors.Wrapf(err, "fetch user info, login: %s", c.Login)
 		}
@@ -441,7 +451,7 @@ func (p *Provider) FetchRepositoryActiveMemberList(ctx context.Context, oauthCtx
 				Role:         bytebaseRole,
 				VCSRole:      string(githubRole),
 				State:        vcs.StateActive,
-				RoleProvider: vcs.GitHubCom,
+				RoleProvider: vcsType(instanceURL),
 			},
 		)
 	}
@@ -1491,8 +1501,9 @@ func (p WebhookPushEvent) ToVCS() vcs.PushEvent {
 			ModifiedList: commit.Modified,
 		})
 	}
+	instanceURL := strings.TrimSuffix(p.Repository.HTMLURL, "/"+p.Repository.FullName)
 	return vcs.PushEvent{
-		VCSType:            vcs.GitHubCom,
+		VCSType:            vcsType(instanceURL),
 		Ref:                p.Ref,
 		Before:             p.Before,
 		After:              p.After,
@@ -1348,6 +1348,45 @@ func TestProvider_GetDiffFileList(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestProvider_APIURL(t *testing.T) {
+	p := newProvider(vcs.ProviderConfig{})
+	assert.Equal(t, "https://api.github.com", p.APIURL(githubComURL))
+	assert.Equal(t, "https://github.example.com/api/v3", p.APIURL("https://github.example.com"))
+}
+
+func TestWebhookPushEvent_ToVCS(t *testing.T) {
+	tests := []struct {
+		name    string
+		htmlURL string
+		want    vcs.Type
+	}{
+		{
+			name:    "GitHub.com",
+			htmlURL: "https://github.com/octocat/Hello-World",
+			want:    vcs.GitHubCom,
+		},
+		{
+			name:    "GitHub Enterprise Server",
+			htmlURL: "https://github.example.com/octocat/Hello-World",
+			want:    vcs.GitHubEnterprise,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := WebhookPushEvent{
+				Ref: "refs/heads/main",
+				Repository: WebhookRepository{
+					FullName: "octocat/Hello-World",
+					HTMLURL:  test.htmlURL,
+				},
+			}
+			got := event.ToVCS()
+			assert.Equal(t, test.want, got.VCSType)
+			assert.Equal(t, test.htmlURL, got.RepositoryURL)
+		})
+	}
+}
+
 func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
 	return newProvider(
 		vcs.ProviderConfig{
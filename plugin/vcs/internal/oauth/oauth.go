@@ -18,6 +18,12 @@ import (
 type TokenRefresher func(ctx context.Context, client *http.Client, oldToken *string) error
 
 func requester(ctx context.Context, client *http.Client, method, url string, token *string, body io.Reader) func() (*http.Response, error) {
+	return requesterWithHeader(ctx, client, method, url, token, body, nil)
+}
+
+// requesterWithHeader is similar to requester but sets the given header to the
+// request, which overrides the default JSON content type if present.
+func requesterWithHeader(ctx context.Context, client *http.Client, method, url string, token *string, body io.Reader, header http.Header) func() (*http.Response, error) {
 	// The body may be read multiple times but io.Reader is meant to be read once,
 	// so we read the body first and build the reader every time.
 	var bodyBytes []byte
@@ -37,6 +43,9 @@ func requester(ctx context.Context, client *http.Client, method, url string, tok
 		}
 
 		req.Header.Set("Content-Type", "application/json")
+		for key, values := range header {
+			req.Header[key] = values
+		}
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", *token))
 		resp, err := client.Do(req)
 		if err != nil {
@@ -52,6 +61,12 @@ func Post(ctx context.Context, client *http.Client, url string, token *string, b
 	return retry(ctx, client, token, tokenRefresher, requester(ctx, client, http.MethodPost, url, token, body))
 }
 
+// PostWithHeader is similar to Post but sets the given request header, e.g. to
+// send the body as "multipart/form-data".
+func PostWithHeader(ctx context.Context, client *http.Client, url string, token *string, body io.Reader, reqHeader http.Header, tokenRefresher TokenRefresher) (code int, header http.Header, respBody string, err error) {
+	return retry(ctx, client, token, tokenRefresher, requesterWithHeader(ctx, client, http.MethodPost, url, token, body, reqHeader))
+}
+
 // Get makes a HTTP GET request to the given URL using the token. It refreshes
 // token and retries the request in the case of the token has expired.
 func Get(ctx context.Context, client *http.Client, url string, token *string, tokenRefresher TokenRefresher) (code int, header http.Header, respBody string, err error) {
@@ -64,6 +79,12 @@ func Put(ctx context.Context, client *http.Client, url string, token *string, bo
 	return retry(ctx, client, token, tokenRefresher, requester(ctx, client, http.MethodPut, url, token, body))
 }
 
+// PutWithHeader is similar to Put but sets the given request header, e.g. to
+// send the body as "multipart/form-data".
+func PutWithHeader(ctx context.Context, client *http.Client, url string, token *string, body io.Reader, reqHeader http.Header, tokenRefresher TokenRefresher) (code int, header http.Header, respBody string, err error) {
+	return retry(ctx, client, token, tokenRefresher, requesterWithHeader(ctx, client, http.MethodPut, url, token, body, reqHeader))
+}
+
 // Patch makes a HTTP PATCH request to the given URL using the token. It
 // refreshes token and retries the request in the case of the token has expired.
 func Patch(ctx context.Context, client *http.Client, url string, token *string, body io.Reader, tokenRefresher TokenRefresher) (code int, header http.Header, respBody string, err error) {
@@ -116,6 +137,17 @@ func retry(ctx context.Context, client *http.Client, token *string, tokenRefresh
 type oauthError struct {
 	Err              string `json:"error"`
 	ErrorDescription string `json:"error_description"`
+	// Message is the error message of the Gitea API.
+	Message string `json:"message"`
+}
+
+const giteaTokenRequiredMessage = "token is required"
+
+// bitbucketError is the error response of the Bitbucket Cloud API.
+type bitbucketError struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
 }
 
 func (e oauthError) Error() string {
@@ -133,12 +165,25 @@ func getOAuthErrorDetails(code int, body []byte) error {
 		return nil
 	}
 
+	// Bitbucket Cloud returns
+	// {"type":"error","error":{"message":"Access token expired. Use your refresh token to obtain a new access token."}}
+	var be bitbucketError
+	if code == http.StatusUnauthorized && json.Unmarshal(body, &be) == nil && strings.Contains(be.Error.Message, "expired") {
+		return &oauthError{Err: "invalid_token", ErrorDescription: be.Error.Message}
+	}
+
 	var oe oauthError
 	if err := json.Unmarshal(body, &oe); err != nil {
 		// If we failed to unmarshal body with oauth error, it's not oauthError and we should return nil.
 		//nolint:nilerr
 		return nil
 	}
+	// Gitea treats the request with an expired access token as an anonymous
+	// request, and the API requiring a token returns
+	// {"message":"token is required","url":"https://gitea.example.com/api/swagger"}
+	if code == http.StatusUnauthorized && oe.Message == giteaTokenRequiredMessage {
+		return &oe
+	}
 	// https://www.oauth.com/oauth2-servers/access-tokens/access-token-response/
 	// {"error":"invalid_token","error_description":"Token is expired. You can either do re-authorization or token refresh."}
 	// {"error":"invalid_grant","error_description":"The provided authorization grant is invalid, expired, revoked, does not match the redirection URI used in the authorization request, or was issued to another client."}
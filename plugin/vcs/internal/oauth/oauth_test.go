@@ -35,6 +35,27 @@ func TestPost(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPostWithHeader(t *testing.T) {
+	ctx := context.Background()
+	client := &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "multipart/form-data; boundary=xxx", r.Header.Get("Content-Type"))
+				assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				return &http.Response{}, nil
+			},
+		},
+	}
+	token := "token"
+	header := http.Header{}
+	header.Set("Content-Type", "multipart/form-data; boundary=xxx")
+	header.Set("X-Atlassian-Token", "no-check")
+	_, _, _, err := PostWithHeader(ctx, client, "", &token, strings.NewReader("POST body"), header, nil)
+	require.NoError(t, err)
+}
+
 func TestGet(t *testing.T) {
 	ctx := context.Background()
 	client := &http.Client{
@@ -132,3 +153,35 @@ func TestRetry_Exceeded(t *testing.T) {
 	gotErr := fmt.Sprintf("%v", err)
 	assert.Equal(t, wantErr, gotErr)
 }
+
+func TestRetry_BitbucketTokenExpired(t *testing.T) {
+	ctx := context.Background()
+	token := "expired"
+
+	calls := 0
+	code, _, body, err := retry(ctx, nil, &token,
+		func(_ context.Context, _ *http.Client, token *string) error {
+			*token = "refreshed"
+			return nil
+		},
+		func() (*http.Response, error) {
+			calls++
+			if token == "expired" {
+				return &http.Response{
+					StatusCode: http.StatusUnauthorized,
+					Body: io.NopCloser(strings.NewReader(`
+{"type":"error","error":{"message":"Access token expired. Use your refresh token to obtain a new access token."}}
+`)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("OK")),
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "OK", body)
+	assert.Equal(t, 2, calls)
+}
@@ -2,6 +2,8 @@ package vcs
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -22,6 +24,12 @@ const (
 	GitLabSelfHost Type = "GITLAB_SELF_HOST"
 	// GitHubCom is the VCS type for GitHub.com.
 	GitHubCom Type = "GITHUB_COM"
+	// GitHubEnterprise is the VCS type for the self-hosted GitHub Enterprise Server.
+	GitHubEnterprise Type = "GITHUB_ENTERPRISE"
+	// Gitea is the VCS type for self-hosted Gitea and its fork Forgejo.
+	Gitea Type = "GITEA"
+	// Bitbucket is the VCS type for both Bitbucket Cloud and the self-hosted Bitbucket Data Center.
+	Bitbucket Type = "BITBUCKET"
 
 	// SQLReviewAPISecretName is the api secret name used in GitHub action or GitLab CI workflow.
 	SQLReviewAPISecretName = "SQL_REVIEW_API_SECRET"
@@ -332,6 +340,29 @@ var (
 // ProviderConfig is the provider configuration.
 type ProviderConfig struct {
 	Client *http.Client
+	// CACert is the PEM encoded CA bundle trusted in addition to the system
+	// CA pool, e.g. the self-signed CA of the GitHub Enterprise Server. It's
+	// ignored if the Client is set.
+	CACert string
+}
+
+// NewHTTPClient returns an HTTP client trusting the PEM encoded CA bundle in
+// addition to the system CA pool.
+func NewHTTPClient(caCert string) (*http.Client, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM([]byte(caCert)) {
+		return nil, errors.New("no valid PEM encoded certificate found in the CA bundle")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	return &http.Client{Transport: transport}, nil
 }
 
 type providerFunc func(ProviderConfig) Provider
@@ -360,6 +391,13 @@ func Get(vcsType Type, providerConfig ProviderConfig) Provider {
 		panic("vcs: unknown provider " + vcsType)
 	}
 
+	if providerConfig.Client == nil && providerConfig.CACert != "" {
+		// The CA bundle is validated before saving the VCS, fall back to the
+		// system CA pool just in case.
+		if client, err := NewHTTPClient(providerConfig.CACert); err == nil {
+			providerConfig.Client = client
+		}
+	}
 	return f(providerConfig)
 }
 
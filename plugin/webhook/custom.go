@@ -1,9 +1,7 @@
 package webhook
 
 import (
-	"bytes"
 	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/pkg/errors"
@@ -15,8 +13,14 @@ type CustomWebhookResponse struct {
 	Message string `json:"message"`
 }
 
+// CustomWebhookRequestVersion is the version of the custom webhook request schema.
+// The minor version is bumped when fields are added, and the major version is bumped on incompatible changes.
+const CustomWebhookRequestVersion = "1.1"
+
 // CustomWebhookRequest is the API message for Custom webhook request.
 type CustomWebhookRequest struct {
+	// Version is the version of the request schema.
+	Version      string   `json:"version"`
 	Level        Level    `json:"level"`
 	ActivityType string   `json:"activity_type"`
 	Title        string   `json:"title"`
@@ -24,9 +28,16 @@ type CustomWebhookRequest struct {
 	Link         string   `json:"link"`
 	CreatorID    int      `json:"creator_id"`
 	CreatorName  string   `json:"creator_name"`
+	CreatorEmail string   `json:"creator_email"`
 	CreatedTS    int64    `json:"created_ts"`
 	Issue        *Issue   `json:"issue"`
 	Project      *Project `json:"project"`
+	// Stage is only present for the stage and task activities.
+	Stage *Stage `json:"stage,omitempty"`
+	// Task is only present for the task activities.
+	Task *Task `json:"task,omitempty"`
+	// TaskResult is only present for the task status update activities.
+	TaskResult *TaskResult `json:"task_result,omitempty"`
 }
 
 func init() {
@@ -37,8 +48,8 @@ func init() {
 type CustomReceiver struct{}
 
 func (*CustomReceiver) post(context Context) error {
-	// TODO(p0ny): handle context.Task
 	payload := CustomWebhookRequest{
+		Version:      CustomWebhookRequestVersion,
 		Level:        context.Level,
 		ActivityType: context.ActivityType,
 		Title:        context.Title,
@@ -46,38 +57,26 @@ func (*CustomReceiver) post(context Context) error {
 		Link:         context.Link,
 		CreatorID:    context.CreatorID,
 		CreatorName:  context.CreatorName,
+		CreatorEmail: context.CreatorEmail,
 		CreatedTS:    context.CreatedTs,
 		Issue:        context.Issue,
 		Project:      context.Project,
+		Stage:        context.Stage,
+		Task:         context.Task,
+		TaskResult:   context.TaskResult,
 	}
 
 	body, err := json.Marshal(&payload)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
-	req, err := http.NewRequest("POST",
-		context.URL, bytes.NewBuffer(body))
-	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	header := http.Header{}
+	if context.Secret != "" {
+		header.Set(SignatureHeader, Sign(context.Secret, body))
 	}
-
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: timeout,
-	}
-	resp, err := client.Do(req)
+	b, err := postMessage(context, body, header)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
-	}
-
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		return err
 	}
 
 	webhookResponse := &CustomWebhookResponse{}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCustomReceiver(t *testing.T) {
+	a := require.New(t)
+
+	var request CustomWebhookRequest
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		a.NoError(json.Unmarshal(body, &request))
+		signature = r.Header.Get(SignatureHeader)
+		a.Equal(Sign("secret", body), signature)
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	result, err := Deliver("bb.plugin.webhook.custom", Context{
+		URL:          server.URL,
+		Secret:       "secret",
+		Level:        WebhookSuccess,
+		ActivityType: "bb.pipeline.task.status.update",
+		Title:        "Task completed - t1",
+		Stage:        &Stage{ID: 3, PipelineID: 2, Name: "Prod Stage", Environment: "Prod"},
+		Task: &Task{
+			ID:            4,
+			PipelineID:    2,
+			StageID:       3,
+			Name:          "t1",
+			Status:        "DONE",
+			Environment:   "Prod",
+			Database:      "db",
+			Statement:     "CREATE TABLE t(id INT);",
+			SchemaVersion: "20230101",
+			CheckResultList: []*TaskCheckResult{
+				{Type: "bb.task-check.database.statement.advise", Status: "WARN", Code: 401, Title: "column.required"},
+			},
+		},
+		TaskResult: &TaskResult{Name: "t1", Status: "DONE"},
+	})
+	a.NoError(err)
+	a.Equal(http.StatusOK, result.ResponseCode)
+	a.NotEmpty(signature)
+
+	a.Equal(CustomWebhookRequestVersion, request.Version)
+	a.Equal(3, request.Stage.ID)
+	a.Equal("Prod", request.Stage.Environment)
+	a.Equal(2, request.Task.PipelineID)
+	a.Equal("db", request.Task.Database)
+	a.Equal("CREATE TABLE t(id INT);", request.Task.Statement)
+	a.Equal("20230101", request.Task.SchemaVersion)
+	a.Len(request.Task.CheckResultList, 1)
+	a.Equal("DONE", request.TaskResult.Status)
+}
+
+func TestCustomWebhookSchema(t *testing.T) {
+	a := require.New(t)
+
+	content, err := os.ReadFile("../../docs/openapi/custom-webhook.yaml")
+	a.NoError(err)
+	var doc struct {
+		Info struct {
+			Version string `yaml:"version"`
+		} `yaml:"info"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]interface{} `yaml:"properties"`
+			} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	a.NoError(yaml.Unmarshal(content, &doc))
+	a.Equal(CustomWebhookRequestVersion, doc.Info.Version)
+
+	types := map[string]reflect.Type{
+		"CustomWebhookRequest":  reflect.TypeOf(CustomWebhookRequest{}),
+		"CustomWebhookResponse": reflect.TypeOf(CustomWebhookResponse{}),
+		"Issue":                 reflect.TypeOf(Issue{}),
+		"Project":               reflect.TypeOf(Project{}),
+		"Stage":                 reflect.TypeOf(Stage{}),
+		"Task":                  reflect.TypeOf(Task{}),
+		"TaskCheckResult":       reflect.TypeOf(TaskCheckResult{}),
+		"TaskResult":            reflect.TypeOf(TaskResult{}),
+	}
+	a.Len(doc.Components.Schemas, len(types))
+	for name, typ := range types {
+		schema, ok := doc.Components.Schemas[name]
+		a.True(ok, name)
+		var fieldList, propertyList []string
+		for i := 0; i < typ.NumField(); i++ {
+			fieldList = append(fieldList, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+		}
+		for property := range schema.Properties {
+			propertyList = append(propertyList, property)
+		}
+		a.ElementsMatch(fieldList, propertyList, name)
+	}
+}
@@ -2,6 +2,12 @@
 package webhook
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
 	"sync"
 	"time"
 
@@ -56,15 +62,56 @@ type TaskResult struct {
 	SkippedReason string `json:"skippedReason"`
 }
 
+// Stage object of stage.
+type Stage struct {
+	ID          int    `json:"id"`
+	PipelineID  int    `json:"pipelineId"`
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+}
+
+// Task object of task.
+// The `statement` field is truncated if it's too long, and the `sheetId` field is only present if the statement is stored in a sheet.
+type Task struct {
+	ID                 int                `json:"id"`
+	PipelineID         int                `json:"pipelineId"`
+	StageID            int                `json:"stageId"`
+	Name               string             `json:"name"`
+	Status             string             `json:"status"`
+	Type               string             `json:"type"`
+	Environment        string             `json:"environment"`
+	Instance           string             `json:"instance"`
+	Database           string             `json:"database"`
+	Statement          string             `json:"statement"`
+	StatementTruncated bool               `json:"statementTruncated"`
+	SheetID            int                `json:"sheetId,omitempty"`
+	SchemaVersion      string             `json:"schemaVersion"`
+	CheckResultList    []*TaskCheckResult `json:"checkResultList"`
+}
+
+// TaskCheckResult is a result of the latest task check run of each type.
+type TaskCheckResult struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Code    int    `json:"code"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
 // Project object of project.
 type Project struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
 
+// SignatureHeader is the header carrying the hex encoded HMAC-SHA256 signature of the custom webhook request body.
+const SignatureHeader = "X-Bytebase-Signature"
+
 // Context is the context of webhook.
 type Context struct {
-	URL          string
+	URL string
+	// Secret signs the custom webhook requests. It's not persisted with the context.
+	Secret       string `json:"-"`
 	Level        Level
 	ActivityType string
 	Title        string
@@ -77,6 +124,22 @@ type Context struct {
 	Issue        *Issue
 	Project      *Project
 	TaskResult   *TaskResult
+	Stage        *Stage
+	Task         *Task
+
+	// result records the delivery if it's not nil.
+	result *Result
+}
+
+// Result is the result of a webhook delivery.
+type Result struct {
+	// Request is the request body.
+	Request string
+	// ResponseCode is the HTTP status code of the response, or 0 if no response is received.
+	ResponseCode int
+	// ResponseBody is the response body.
+	ResponseBody string
+	Latency      time.Duration
 }
 
 // Receiver is the webhook receiver.
@@ -152,11 +215,68 @@ func register(host string, r Receiver) {
 
 // Post posts the message to webhook.
 func Post(webhookType string, context Context) error {
+	_, err := Deliver(webhookType, context)
+	return err
+}
+
+// Deliver posts the message to webhook and returns the result of the delivery.
+// The result is returned even if the delivery fails.
+func Deliver(webhookType string, context Context) (*Result, error) {
 	receiverMu.RLock()
 	r, ok := receivers[webhookType]
 	receiverMu.RUnlock()
 	if !ok {
-		return errors.Errorf("webhook: no applicable receiver for webhook type: %v", webhookType)
+		return nil, errors.Errorf("webhook: no applicable receiver for webhook type: %v", webhookType)
+	}
+	result := &Result{}
+	context.result = result
+	return result, r.post(context)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// postMessage posts the JSON body to the webhook URL and returns the response body if the status code is 200.
+func postMessage(context Context, body []byte, header http.Header) ([]byte, error) {
+	req, err := http.NewRequest("POST",
+		context.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	if context.result != nil {
+		context.result.Request = string(body)
+		context.result.Latency = time.Since(start)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+	}
+	if context.result != nil {
+		context.result.ResponseCode = resp.StatusCode
+		context.result.ResponseBody = string(b)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to POST webhook to %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
 	}
-	return r.post(context)
+	return b, nil
 }
@@ -150,6 +150,45 @@ func Stop(pgBinDir, pgDataDir string) error {
 	return p.Run()
 }
 
+// StartInBackground starts a postgres instance listening on the unix socket in the data directory only, and returns without waiting.
+// It's used to start a temporary instance which may take a long time to become available, such as replaying WAL files.
+// The server log is written to logFile.
+func StartInBackground(port int, binDir, dataDir, logFile string) error {
+	pgbin := filepath.Join(binDir, "pg_ctl")
+
+	p := exec.Command(pgbin, "start", "-W",
+		"-D", dataDir,
+		"-l", logFile,
+		"-o", fmt.Sprintf(`-p %d -k %s -h ""`, port, dataDir))
+
+	uid, gid, sameUser, err := shouldSwitchUser()
+	if err != nil {
+		return err
+	}
+	if !sameUser {
+		p.SysProcAttr = &syscall.SysProcAttr{
+			Setpgid:    true,
+			Credential: &syscall.Credential{Uid: uint32(uid)},
+		}
+		if err := filepath.Walk(dataDir, func(name string, _ os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Lchown(name, uid, gid)
+		}); err != nil {
+			return errors.Wrapf(err, "failed to change owner of data directory %q to bytebase", dataDir)
+		}
+	}
+
+	p.Stdout = nil
+	p.Stderr = os.Stderr
+	if err := p.Run(); err != nil {
+		return errors.Wrapf(err, "failed to start postgres %q", p.String())
+	}
+
+	return nil
+}
+
 // InitDB inits a postgres database if not yet.
 func InitDB(pgBinDir, pgDataDir, pgUser string) error {
 	versionPath := filepath.Join(pgDataDir, "PG_VERSION")
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -9,6 +12,10 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/plugin/db"
+	"github.com/bytebase/bytebase/plugin/parser"
+	"github.com/bytebase/bytebase/plugin/parser/differ"
+	"github.com/bytebase/bytebase/store"
 )
 
 func (s *Server) registerAnomalyRoutes(g *echo.Group) {
@@ -51,4 +58,199 @@ func (s *Server) registerAnomalyRoutes(g *echo.Group) {
 		}
 		return nil
 	})
+	g.POST("/anomaly/:anomalyID/reconcile", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		id, err := strconv.Atoi(c.Param("anomalyID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Anomaly ID is not a number: %s", c.Param("anomalyID"))).SetInternal(err)
+		}
+
+		reconcile := &api.AnomalyReconcile{
+			ID:        id,
+			CreatorID: c.Get(getPrincipalIDContextKey()).(int),
+		}
+		if err := jsonapi.UnmarshalPayload(c.Request().Body, reconcile); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed reconcile anomaly request").SetInternal(err)
+		}
+		if reconcile.Direction != api.AnomalyReconcileRevert && reconcile.Direction != api.AnomalyReconcileAccept {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid reconcile direction %q", reconcile.Direction))
+		}
+
+		issue, err := s.reconcileSchemaDrift(ctx, reconcile)
+		if err != nil {
+			return err
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, issue); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to marshal reconcile anomaly response: %v", id)).SetInternal(err)
+		}
+		return nil
+	})
+}
+
+// reconcileSchemaDrift creates a schema update issue reconciling the schema drift anomaly, and links the issue to the anomaly.
+// The anomaly is archived once the migration in the issue is done.
+func (s *Server) reconcileSchemaDrift(ctx context.Context, reconcile *api.AnomalyReconcile) (*api.Issue, error) {
+	normalRowStatus := api.Normal
+	anomalyList, err := s.store.FindAnomaly(ctx, &api.AnomalyFind{
+		ID:        &reconcile.ID,
+		RowStatus: &normalRowStatus,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch anomaly ID: %v", reconcile.ID)).SetInternal(err)
+	}
+	if len(anomalyList) == 0 {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Anomaly ID not found: %d", reconcile.ID))
+	}
+	anomaly := anomalyList[0]
+	if anomaly.Type != api.AnomalyDatabaseSchemaDrift || anomaly.Database == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Anomaly %d is not a schema drift", reconcile.ID))
+	}
+	payload := &api.AnomalyDatabaseSchemaDriftPayload{}
+	if err := json.Unmarshal([]byte(anomaly.Payload), payload); err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to unmarshal payload of anomaly ID: %v", reconcile.ID)).SetInternal(err)
+	}
+	if payload.IssueID != 0 {
+		issue, err := s.store.GetIssueByID(ctx, payload.IssueID)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch issue ID: %v", payload.IssueID)).SetInternal(err)
+		}
+		if issue != nil && issue.Status == api.IssueOpen {
+			return nil, echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("Schema drift is being reconciled by issue %d", issue.ID))
+		}
+	}
+
+	database := anomaly.Database
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{UID: &database.ProjectID})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch project ID: %v", database.ProjectID)).SetInternal(err)
+	}
+	if project == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Project ID not found: %d", database.ProjectID))
+	}
+	// The migration detail of the tenant mode project applies to all databases with the same name.
+	if project.TenantMode == api.TenantModeTenant {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Cannot reconcile schema drift for databases in tenant mode projects")
+	}
+
+	var engine parser.EngineType
+	switch anomaly.Instance.Engine {
+	case db.Postgres:
+		engine = parser.Postgres
+	case db.MySQL:
+		engine = parser.MySQL
+	default:
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Reconciling schema drift is not supported for engine %q", anomaly.Instance.Engine))
+	}
+
+	expectSchema, actualSchema, err := s.getSchemaDriftDumps(ctx, anomaly.InstanceID, database.Name, payload.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var detail *api.MigrationDetail
+	var issueName, issueDescription string
+	switch reconcile.Direction {
+	case api.AnomalyReconcileRevert:
+		diff, err := differ.SchemaDiff(engine, actualSchema, expectSchema)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to compute the diff from the actual schema to the expected schema").SetInternal(err)
+		}
+		if diff == "" {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "No applicable DDL to revert the schema drift")
+		}
+		detail = &api.MigrationDetail{
+			MigrationType: db.Migrate,
+			DatabaseID:    database.ID,
+			Statement:     diff,
+		}
+		issueName = fmt.Sprintf("[%s] Revert schema drift", database.Name)
+		issueDescription = fmt.Sprintf("Revert the schema drift of database %q to the expected schema of version %s.", database.Name, payload.Version)
+	case api.AnomalyReconcileAccept:
+		diff, err := differ.SchemaDiff(engine, expectSchema, actualSchema)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to compute the diff from the expected schema to the actual schema").SetInternal(err)
+		}
+		detail = &api.MigrationDetail{
+			MigrationType: db.Baseline,
+			DatabaseID:    database.ID,
+		}
+		issueName = fmt.Sprintf("[%s] Accept schema drift", database.Name)
+		issueDescription = fmt.Sprintf("Establish a new baseline accepting the schema drift of database %q from version %s.", database.Name, payload.Version)
+		if diff != "" {
+			issueDescription += fmt.Sprintf("\n\nThe drift:\n\n%s", diff)
+		}
+	}
+
+	createContext, err := json.Marshal(&api.MigrationContext{
+		DetailList: []*api.MigrationDetail{detail},
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal update schema context").SetInternal(err)
+	}
+	issue, err := s.createIssue(ctx, &api.IssueCreate{
+		CreatorID:     reconcile.CreatorID,
+		ProjectID:     project.UID,
+		Name:          issueName,
+		Type:          api.IssueDatabaseSchemaUpdate,
+		Description:   issueDescription,
+		AssigneeID:    api.SystemBotID,
+		CreateContext: string(createContext),
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to create schema update issue").SetInternal(err)
+	}
+
+	// Link the issue to the anomaly.
+	payload.IssueID = issue.ID
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal anomaly payload").SetInternal(err)
+	}
+	if _, err := s.store.UpsertActiveAnomaly(ctx, &api.AnomalyUpsert{
+		CreatorID:  reconcile.CreatorID,
+		InstanceID: anomaly.InstanceID,
+		DatabaseID: anomaly.DatabaseID,
+		Type:       anomaly.Type,
+		Payload:    string(bytes),
+	}); err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to link issue %d to anomaly %d", issue.ID, anomaly.ID)).SetInternal(err)
+	}
+	return issue, nil
+}
+
+// getSchemaDriftDumps gets the expected schema recorded in the migration history of the version and the current schema dump of the database.
+// They're fetched on reconciling instead of being kept in the anomaly payload, so the diff DDL is computed against the latest schema.
+func (s *Server) getSchemaDriftDumps(ctx context.Context, instanceID int, databaseName, version string) (string, string, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceID})
+	if err != nil {
+		return "", "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch instance ID: %v", instanceID)).SetInternal(err)
+	}
+	if instance == nil {
+		return "", "", echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Instance ID not found: %d", instanceID))
+	}
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, databaseName)
+	if err != nil {
+		return "", "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to connect to database %q", databaseName)).SetInternal(err)
+	}
+	defer driver.Close(ctx)
+
+	limit := 1
+	list, err := driver.FindMigrationHistoryList(ctx, &db.MigrationHistoryFind{
+		Database: &databaseName,
+		Version:  &version,
+		Limit:    &limit,
+	})
+	if err != nil {
+		return "", "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch migration history of version %s", version)).SetInternal(err)
+	}
+	if len(list) == 0 {
+		return "", "", echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Migration history not found for version %s", version))
+	}
+	var schemaBuf bytes.Buffer
+	if _, err := driver.Dump(ctx, databaseName, &schemaBuf, true /* schemaOnly */); err != nil {
+		return "", "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to dump schema of database %q", databaseName)).SetInternal(err)
+	}
+	return list[0].Schema, schemaBuf.String(), nil
 }
@@ -185,15 +185,18 @@ func convertDatabaseMetadata(metadata *storepb.DatabaseMetadata) *v1pb.DatabaseM
 		}
 		for _, table := range schema.Tables {
 			t := &v1pb.TableMetadata{
-				Name:          table.Name,
-				Engine:        table.Engine,
-				Collation:     table.Collation,
-				RowCount:      table.RowCount,
-				DataSize:      table.DataSize,
-				IndexSize:     table.IndexSize,
-				DataFree:      table.DataFree,
-				CreateOptions: table.CreateOptions,
-				Comment:       table.Comment,
+				Name:                table.Name,
+				Engine:              table.Engine,
+				Collation:           table.Collation,
+				RowCount:            table.RowCount,
+				DataSize:            table.DataSize,
+				IndexSize:           table.IndexSize,
+				DataFree:            table.DataFree,
+				CreateOptions:       table.CreateOptions,
+				Comment:             table.Comment,
+				PartitionType:       table.PartitionType,
+				PartitionExpression: table.PartitionExpression,
+				Partitions:          convertTablePartitionMetadata(table.Partitions),
 			}
 			for _, column := range table.Columns {
 				t.Columns = append(t.Columns, &v1pb.ColumnMetadata{
@@ -230,6 +233,14 @@ func convertDatabaseMetadata(metadata *storepb.DatabaseMetadata) *v1pb.DatabaseM
 					MatchType:         foreignKey.MatchType,
 				})
 			}
+			for _, trigger := range table.Triggers {
+				t.Triggers = append(t.Triggers, &v1pb.TriggerMetadata{
+					Name:       trigger.Name,
+					Timing:     trigger.Timing,
+					Event:      trigger.Event,
+					Definition: trigger.Definition,
+				})
+			}
 			s.Tables = append(s.Tables, t)
 		}
 		for _, view := range schema.Views {
@@ -239,6 +250,42 @@ func convertDatabaseMetadata(metadata *storepb.DatabaseMetadata) *v1pb.DatabaseM
 				Comment:    view.Comment,
 			})
 		}
+		for _, function := range schema.Functions {
+			s.Functions = append(s.Functions, &v1pb.FunctionMetadata{
+				Name:       function.Name,
+				Arguments:  function.Arguments,
+				ReturnType: function.ReturnType,
+				Definition: function.Definition,
+				Comment:    function.Comment,
+			})
+		}
+		for _, procedure := range schema.Procedures {
+			s.Procedures = append(s.Procedures, &v1pb.ProcedureMetadata{
+				Name:       procedure.Name,
+				Arguments:  procedure.Arguments,
+				Definition: procedure.Definition,
+				Comment:    procedure.Comment,
+			})
+		}
+		for _, sequence := range schema.Sequences {
+			s.Sequences = append(s.Sequences, &v1pb.SequenceMetadata{
+				Name:        sequence.Name,
+				DataType:    sequence.DataType,
+				Start:       sequence.Start,
+				Increment:   sequence.Increment,
+				MinValue:    sequence.MinValue,
+				MaxValue:    sequence.MaxValue,
+				Cycle:       sequence.Cycle,
+				OwnerTable:  sequence.OwnerTable,
+				OwnerColumn: sequence.OwnerColumn,
+			})
+		}
+		for _, enumType := range schema.EnumTypes {
+			s.EnumTypes = append(s.EnumTypes, &v1pb.EnumTypeMetadata{
+				Name:   enumType.Name,
+				Values: enumType.Values,
+			})
+		}
 		m.Schemas = append(m.Schemas, s)
 	}
 	for _, extension := range metadata.Extensions {
@@ -251,3 +298,15 @@ func convertDatabaseMetadata(metadata *storepb.DatabaseMetadata) *v1pb.DatabaseM
 	}
 	return m
 }
+
+func convertTablePartitionMetadata(partitions []*storepb.TablePartitionMetadata) []*v1pb.TablePartitionMetadata {
+	var result []*v1pb.TablePartitionMetadata
+	for _, partition := range partitions {
+		result = append(result, &v1pb.TablePartitionMetadata{
+			Name:          partition.Name,
+			Value:         partition.Value,
+			Subpartitions: convertTablePartitionMetadata(partition.Subpartitions),
+		})
+	}
+	return result
+}
@@ -566,15 +566,20 @@ func convertToV1PBSensitiveDataPolicy(payloadStr string) (*v1pb.Policy_Sensitive
 
 	var sensitiveDataList []*v1pb.SensitiveData
 	for _, data := range payload.SensitiveDataList {
-		maskType := v1pb.SensitiveDataMaskType_MASK_TYPE_UNSPECIFIED
-		if data.Type == api.SensitiveDataMaskTypeDefault {
-			maskType = v1pb.SensitiveDataMaskType_DEFAULT
-		}
-		sensitiveDataList = append(sensitiveDataList, &v1pb.SensitiveData{
+		v1Data := &v1pb.SensitiveData{
+			Schema:   data.Schema,
 			Table:    data.Table,
 			Column:   data.Column,
-			MaskType: maskType,
-		})
+			MaskType: convertToV1PBSensitiveDataMaskType(data.Type),
+		}
+		if data.MaskOption != nil {
+			v1Data.MaskOption = &v1pb.SensitiveDataMaskOption{
+				PrefixLength: int32(data.MaskOption.PrefixLength),
+				SuffixLength: int32(data.MaskOption.SuffixLength),
+				RangeWidth:   data.MaskOption.RangeWidth,
+			}
+		}
+		sensitiveDataList = append(sensitiveDataList, v1Data)
 	}
 
 	return &v1pb.Policy_SensitiveDataPolicy{
@@ -587,20 +592,66 @@ func convertToV1PBSensitiveDataPolicy(payloadStr string) (*v1pb.Policy_Sensitive
 func convertToSensitiveDataPolicyPayload(policy *v1pb.SensitiveDataPolicy) (*api.SensitiveDataPolicy, error) {
 	var sensitiveDataList []api.SensitiveData
 	for _, data := range policy.SensitiveData {
-		if data.MaskType != v1pb.SensitiveDataMaskType_DEFAULT {
-			return nil, errors.Errorf("invalid sensitive data mask type %v", data.MaskType)
+		maskType, err := convertToSensitiveDataMaskType(data.MaskType)
+		if err != nil {
+			return nil, err
 		}
-		sensitiveDataList = append(sensitiveDataList, api.SensitiveData{
+		sensitiveData := api.SensitiveData{
+			Schema: data.Schema,
 			Table:  data.Table,
 			Column: data.Column,
-			Type:   api.SensitiveDataMaskTypeDefault,
-		})
+			Type:   maskType,
+		}
+		if data.MaskOption != nil {
+			sensitiveData.MaskOption = &api.SensitiveDataMaskOption{
+				PrefixLength: int(data.MaskOption.PrefixLength),
+				SuffixLength: int(data.MaskOption.SuffixLength),
+				RangeWidth:   data.MaskOption.RangeWidth,
+			}
+		}
+		sensitiveDataList = append(sensitiveDataList, sensitiveData)
 	}
 	return &api.SensitiveDataPolicy{
 		SensitiveDataList: sensitiveDataList,
 	}, nil
 }
 
+func convertToV1PBSensitiveDataMaskType(maskType api.SensitiveDataMaskType) v1pb.SensitiveDataMaskType {
+	switch maskType {
+	case api.SensitiveDataMaskTypeDefault:
+		return v1pb.SensitiveDataMaskType_DEFAULT
+	case api.SensitiveDataMaskTypePartial:
+		return v1pb.SensitiveDataMaskType_PARTIAL
+	case api.SensitiveDataMaskTypeEmail:
+		return v1pb.SensitiveDataMaskType_EMAIL
+	case api.SensitiveDataMaskTypePhone:
+		return v1pb.SensitiveDataMaskType_PHONE
+	case api.SensitiveDataMaskTypeHash:
+		return v1pb.SensitiveDataMaskType_HASH
+	case api.SensitiveDataMaskTypeRange:
+		return v1pb.SensitiveDataMaskType_RANGE
+	}
+	return v1pb.SensitiveDataMaskType_MASK_TYPE_UNSPECIFIED
+}
+
+func convertToSensitiveDataMaskType(maskType v1pb.SensitiveDataMaskType) (api.SensitiveDataMaskType, error) {
+	switch maskType {
+	case v1pb.SensitiveDataMaskType_DEFAULT:
+		return api.SensitiveDataMaskTypeDefault, nil
+	case v1pb.SensitiveDataMaskType_PARTIAL:
+		return api.SensitiveDataMaskTypePartial, nil
+	case v1pb.SensitiveDataMaskType_EMAIL:
+		return api.SensitiveDataMaskTypeEmail, nil
+	case v1pb.SensitiveDataMaskType_PHONE:
+		return api.SensitiveDataMaskTypePhone, nil
+	case v1pb.SensitiveDataMaskType_HASH:
+		return api.SensitiveDataMaskTypeHash, nil
+	case v1pb.SensitiveDataMaskType_RANGE:
+		return api.SensitiveDataMaskTypeRange, nil
+	}
+	return "", errors.Errorf("invalid sensitive data mask type %v", maskType)
+}
+
 func convertToV1PBBackupPlanPolicy(payloadStr string) (*v1pb.Policy_BackupPlanPolicy, error) {
 	payload, err := api.UnmarshalBackupPlanPolicy(payloadStr)
 	if err != nil {
@@ -617,10 +668,33 @@ func convertToV1PBBackupPlanPolicy(payloadStr string) (*v1pb.Policy_BackupPlanPo
 		schedule = v1pb.BackupPlanSchedule_WEEKLY
 	}
 
+	storageBackend := v1pb.BackupStorageBackend_BACKUP_STORAGE_BACKEND_UNSPECIFIED
+	switch payload.StorageBackend {
+	case api.BackupStorageBackendLocal:
+		storageBackend = v1pb.BackupStorageBackend_LOCAL
+	case api.BackupStorageBackendS3:
+		storageBackend = v1pb.BackupStorageBackend_S3
+	case api.BackupStorageBackendGCS:
+		storageBackend = v1pb.BackupStorageBackend_GCS
+	case api.BackupStorageBackendOSS:
+		storageBackend = v1pb.BackupStorageBackend_OSS
+	}
+
+	compression := v1pb.BackupCompression_BACKUP_COMPRESSION_UNSPECIFIED
+	switch payload.Compression {
+	case api.BackupCompressionGzip:
+		compression = v1pb.BackupCompression_GZIP
+	case api.BackupCompressionZstd:
+		compression = v1pb.BackupCompression_ZSTD
+	}
+
 	return &v1pb.Policy_BackupPlanPolicy{
 		BackupPlanPolicy: &v1pb.BackupPlanPolicy{
 			Schedule:          schedule,
 			RetentionDuration: &durationpb.Duration{Seconds: int64(payload.RetentionPeriodTs)},
+			StorageBackend:    storageBackend,
+			Compression:       compression,
+			Encryption:        payload.Encryption,
 		},
 	}, nil
 }
@@ -638,9 +712,38 @@ func convertToBackupPlanPolicyPayload(policy *v1pb.BackupPlanPolicy) (*api.Backu
 		return nil, errors.Errorf("invalid backup plan schedule %v", policy.Schedule)
 	}
 
+	var storageBackend api.BackupStorageBackend
+	switch policy.StorageBackend {
+	case v1pb.BackupStorageBackend_BACKUP_STORAGE_BACKEND_UNSPECIFIED:
+	case v1pb.BackupStorageBackend_LOCAL:
+		storageBackend = api.BackupStorageBackendLocal
+	case v1pb.BackupStorageBackend_S3:
+		storageBackend = api.BackupStorageBackendS3
+	case v1pb.BackupStorageBackend_GCS:
+		storageBackend = api.BackupStorageBackendGCS
+	case v1pb.BackupStorageBackend_OSS:
+		storageBackend = api.BackupStorageBackendOSS
+	default:
+		return nil, errors.Errorf("invalid backup storage backend %v", policy.StorageBackend)
+	}
+
+	var compression api.BackupCompression
+	switch policy.Compression {
+	case v1pb.BackupCompression_BACKUP_COMPRESSION_UNSPECIFIED:
+	case v1pb.BackupCompression_GZIP:
+		compression = api.BackupCompressionGzip
+	case v1pb.BackupCompression_ZSTD:
+		compression = api.BackupCompressionZstd
+	default:
+		return nil, errors.Errorf("invalid backup compression %v", policy.Compression)
+	}
+
 	return &api.BackupPlanPolicy{
 		Schedule:          schedule,
 		RetentionPeriodTs: int(policy.RetentionDuration.Seconds),
+		StorageBackend:    storageBackend,
+		Compression:       compression,
+		Encryption:        policy.Encryption,
 	}, nil
 }
 
@@ -328,8 +328,14 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 		roleProvider = v1pb.RoleProvider_BYTEBASE
 	case api.ProjectRoleProviderGitHubCom:
 		roleProvider = v1pb.RoleProvider_GITHUB_COM
+	case api.ProjectRoleProviderGitHubEnterprise:
+		roleProvider = v1pb.RoleProvider_GITHUB_ENTERPRISE
 	case api.ProjectRoleProviderGitLabSelfHost:
 		roleProvider = v1pb.RoleProvider_GITLAB_SELF_HOST
+	case api.ProjectRoleProviderGitea:
+		roleProvider = v1pb.RoleProvider_GITEA
+	case api.ProjectRoleProviderBitbucket:
+		roleProvider = v1pb.RoleProvider_BITBUCKET
 	}
 
 	schemaChange := v1pb.SchemaChange_SCHEMA_CHANGE_UNSPECIFIED
@@ -413,8 +419,14 @@ func convertToProjectRoleProvider(roleProvider v1pb.RoleProvider) (api.ProjectRo
 		r = api.ProjectRoleProviderBytebase
 	case v1pb.RoleProvider_GITHUB_COM:
 		r = api.ProjectRoleProviderGitHubCom
+	case v1pb.RoleProvider_GITHUB_ENTERPRISE:
+		r = api.ProjectRoleProviderGitHubEnterprise
 	case v1pb.RoleProvider_GITLAB_SELF_HOST:
 		r = api.ProjectRoleProviderGitLabSelfHost
+	case v1pb.RoleProvider_GITEA:
+		r = api.ProjectRoleProviderGitea
+	case v1pb.RoleProvider_BITBUCKET:
+		r = api.ProjectRoleProviderBitbucket
 	default:
 		return r, errors.Errorf("invalid role provider %v", roleProvider)
 	}
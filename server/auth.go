@@ -18,7 +18,7 @@ import (
 )
 
 func (s *Server) registerAuthRoutes(g *echo.Group) {
-	// for now, we only support Gitlab
+	// For now, only GitLab and GitHub support the OAuth login.
 	g.GET("/auth/provider", func(c echo.Context) error {
 		ctx := c.Request().Context()
 		vcsFind := &api.VCSFind{}
@@ -29,6 +29,11 @@ func (s *Server) registerAuthRoutes(g *echo.Group) {
 
 		var authProviderList []*api.AuthProvider
 		for _, vcs := range vcsList {
+			switch api.PrincipalAuthProvider(vcs.Type) {
+			case api.PrincipalAuthProviderGitlabSelfHost, api.PrincipalAuthProviderGitHubCom, api.PrincipalAuthProviderGitHubEnterprise:
+			default:
+				continue
+			}
 			newProvider := &api.AuthProvider{
 				ID:            vcs.ID,
 				Type:          vcs.Type,
@@ -69,13 +74,20 @@ func (s *Server) registerAuthRoutes(g *echo.Group) {
 					return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("User not found: %s", login.Email))
 				}
 
-				// Compare the stored hashed password, with the hashed version of the password that was received.
-				if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(login.Password)); err != nil {
-					// If the two passwords don't match, return a 401 status.
-					return echo.NewHTTPError(http.StatusUnauthorized, "Incorrect password").SetInternal(err)
+				// The users synced from the LDAP directory sign in with the LDAP password only.
+				isLDAPUser, httpErr := s.authenticateLDAPUser(ctx, user, login.Password)
+				if httpErr != nil {
+					return httpErr
+				}
+				if !isLDAPUser {
+					// Compare the stored hashed password, with the hashed version of the password that was received.
+					if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(login.Password)); err != nil {
+						// If the two passwords don't match, return a 401 status.
+						return echo.NewHTTPError(http.StatusUnauthorized, "Incorrect password").SetInternal(err)
+					}
 				}
 			}
-		case api.PrincipalAuthProviderGitlabSelfHost, api.PrincipalAuthProviderGitHubCom:
+		case api.PrincipalAuthProviderGitlabSelfHost, api.PrincipalAuthProviderGitHubCom, api.PrincipalAuthProviderGitHubEnterprise:
 			{
 				login := &api.VCSLogin{}
 				if err := jsonapi.UnmarshalPayload(c.Request().Body, login); err != nil {
@@ -90,7 +102,7 @@ func (s *Server) registerAuthRoutes(g *echo.Group) {
 				}
 
 				// Exchange OAuth Token
-				oauthToken, err := vcs.Get(vcsFound.Type, vcs.ProviderConfig{}).ExchangeOAuthToken(
+				oauthToken, err := vcs.Get(vcsFound.Type, vcs.ProviderConfig{CACert: vcsFound.CACert}).ExchangeOAuthToken(
 					ctx,
 					vcsFound.InstanceURL,
 					&common.OAuthExchange{
@@ -104,7 +116,7 @@ func (s *Server) registerAuthRoutes(g *echo.Group) {
 					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to exchange OAuth token").SetInternal(err)
 				}
 
-				userInfo, err := vcs.Get(vcsFound.Type, vcs.ProviderConfig{}).TryLogin(ctx,
+				userInfo, err := vcs.Get(vcsFound.Type, vcs.ProviderConfig{CACert: vcsFound.CACert}).TryLogin(ctx,
 					common.OauthContext{
 						ClientID:     vcsFound.ApplicationID,
 						ClientSecret: vcsFound.Secret,
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
 	"github.com/bytebase/bytebase/common/log"
 	"github.com/bytebase/bytebase/plugin/webhook"
 	"github.com/bytebase/bytebase/server/component/config"
@@ -20,6 +21,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// maxWebhookStatementSize is the maximum size of the task statement sent in the webhook.
+const maxWebhookStatementSize = 64 * 1024
+
 // Manager is the activity manager.
 type Manager struct {
 	store   *store.Store
@@ -100,14 +104,15 @@ func (m *Manager) BatchCreateTaskStatusUpdateApprovalActivity(ctx context.Contex
 			ID:   issue.ProjectID,
 			Name: issue.Project.Name,
 		},
+		Stage:        convertWebhookStage(stage),
 		Description:  anyActivity.Comment,
 		Link:         fmt.Sprintf("%s/issue/%s", m.profile.ExternalURL, api.IssueSlug(issue)),
 		CreatorID:    anyActivity.CreatorID,
 		CreatorName:  anyActivity.Creator.Name,
 		CreatorEmail: anyActivity.Creator.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(webhookCtx, webhookList)
+	// The webhook delivery runner calls the external webhook endpoints to avoid blocking web serving thread.
+	m.enqueueWebhookList(ctx, webhookCtx, webhookList)
 
 	return nil
 }
@@ -159,29 +164,30 @@ func (m *Manager) CreateActivity(ctx context.Context, create *api.ActivityCreate
 			zap.Error(err))
 		return activity, nil
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(webhookCtx, webhookList)
+	// The webhook delivery runner calls the external webhook endpoints to avoid blocking web serving thread.
+	m.enqueueWebhookList(ctx, webhookCtx, webhookList)
 
 	return activity, nil
 }
 
-func postWebhookList(webhookCtx webhook.Context, webhookList []*api.ProjectWebhook) {
+// enqueueWebhookList enqueues the webhook deliveries, which are delivered with retries by the webhook delivery runner.
+func (m *Manager) enqueueWebhookList(ctx context.Context, webhookCtx webhook.Context, webhookList []*api.ProjectWebhook) {
+	webhookCtx.CreatedTs = time.Now().Unix()
+	payload, err := json.Marshal(webhookCtx)
+	if err != nil {
+		log.Error("Failed to marshal webhook context", zap.String("title", webhookCtx.Title), zap.Error(err))
+		return
+	}
 	for _, hook := range webhookList {
-		webhookCtx.URL = hook.URL
-		webhookCtx.CreatedTs = time.Now().Unix()
-		const maxRetries = 3
-		for retries := 0; retries < maxRetries; retries++ {
-			if err := webhook.Post(hook.Type, webhookCtx); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				log.Warn("Failed to post webhook event on activity",
-					zap.String("webhook type", hook.Type),
-					zap.String("webhook name", hook.Name),
-					zap.String("activity type", webhookCtx.ActivityType),
-					zap.String("title", webhookCtx.Title),
-					zap.Error(err))
-			} else {
-				break
-			}
+		if _, err := m.store.CreateProjectWebhookDelivery(ctx, &store.ProjectWebhookDeliveryMessage{
+			ProjectWebhookID: hook.ID,
+			Status:           api.ProjectWebhookDeliveryPending,
+			Payload:          string(payload),
+		}); err != nil {
+			log.Error("Failed to enqueue webhook delivery",
+				zap.String("webhook name", hook.Name),
+				zap.String("activity type", webhookCtx.ActivityType),
+				zap.Error(err))
 		}
 	}
 }
@@ -189,6 +195,8 @@ func postWebhookList(webhookCtx webhook.Context, webhookList []*api.ProjectWebho
 func (m *Manager) getWebhookContext(ctx context.Context, activity *api.Activity, meta *Metadata, updater *store.UserMessage) (webhook.Context, error) {
 	var webhookCtx webhook.Context
 	var webhookTaskResult *webhook.TaskResult
+	var webhookStage *webhook.Stage
+	var webhookTask *webhook.Task
 	level := webhook.WebhookInfo
 	title := ""
 	link := fmt.Sprintf("%s/issue/%s", m.profile.ExternalURL, api.IssueSlug(meta.Issue))
@@ -299,6 +307,15 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *api.Activity,
 			return webhookCtx, err
 		}
 		link += fmt.Sprintf("?stage=%d", payload.StageID)
+		// The stage is additional info, so the webhook is still posted without it.
+		stage, err := m.getWebhookStage(ctx, payload.StageID)
+		if err != nil {
+			log.Warn("Failed to find stage for webhook event after stage status updating, post it without the stage",
+				zap.String("issue_name", meta.Issue.Name),
+				zap.Int("stage_id", payload.StageID),
+				zap.Error(err))
+		}
+		webhookStage = stage
 		switch payload.StageStatusUpdateType {
 		case api.StageStatusUpdateTypeBegin:
 			title = fmt.Sprintf("Stage begins - %s", payload.StageName)
@@ -336,6 +353,19 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *api.Activity,
 			Name:   task.Name,
 			Status: string(task.Status),
 		}
+		// The task and the stage are additional info, so the webhook is still posted without them.
+		if webhookTask, err = convertWebhookTask(task); err != nil {
+			log.Warn("Failed to convert task for webhook event after changing the issue task status, post it without the task",
+				zap.String("issue_name", meta.Issue.Name),
+				zap.Int("task_id", update.TaskID),
+				zap.Error(err))
+		}
+		if webhookStage, err = m.getWebhookStage(ctx, task.StageID); err != nil {
+			log.Warn("Failed to find stage for webhook event after changing the issue task status, post it without the stage",
+				zap.String("issue_name", meta.Issue.Name),
+				zap.Int("stage_id", task.StageID),
+				zap.Error(err))
+		}
 
 		title = "Task changed - " + task.Name
 		switch update.NewStatus {
@@ -408,6 +438,8 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *api.Activity,
 			Name: meta.Issue.Project.Name,
 		},
 		TaskResult:   webhookTaskResult,
+		Stage:        webhookStage,
+		Task:         webhookTask,
 		Description:  activity.Comment,
 		Link:         link,
 		CreatorID:    updater.ID,
@@ -417,6 +449,100 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *api.Activity,
 	return webhookCtx, nil
 }
 
+func (m *Manager) getWebhookStage(ctx context.Context, stageID int) (*webhook.Stage, error) {
+	stageList, err := m.store.FindStage(ctx, &api.StageFind{ID: &stageID})
+	if err != nil {
+		return nil, err
+	}
+	if len(stageList) == 0 {
+		return nil, errors.Errorf("stage not found for ID %v", stageID)
+	}
+	return convertWebhookStage(stageList[0]), nil
+}
+
+func convertWebhookStage(stage *api.Stage) *webhook.Stage {
+	webhookStage := &webhook.Stage{
+		ID:         stage.ID,
+		PipelineID: stage.PipelineID,
+		Name:       stage.Name,
+	}
+	if stage.Environment != nil {
+		webhookStage.Environment = stage.Environment.Name
+	}
+	return webhookStage
+}
+
+func convertWebhookTask(task *api.Task) (*webhook.Task, error) {
+	var payload struct {
+		DatabaseName  string `json:"databaseName,omitempty"`
+		Statement     string `json:"statement,omitempty"`
+		SheetID       int    `json:"sheetId,omitempty"`
+		SchemaVersion string `json:"schemaVersion,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(task.Payload), &payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal task payload")
+	}
+	statement, truncated := common.TruncateString(payload.Statement, maxWebhookStatementSize)
+	webhookTask := &webhook.Task{
+		ID:                 task.ID,
+		PipelineID:         task.PipelineID,
+		StageID:            task.StageID,
+		Name:               task.Name,
+		Status:             string(task.Status),
+		Type:               string(task.Type),
+		Database:           payload.DatabaseName,
+		Statement:          statement,
+		StatementTruncated: truncated,
+		SheetID:            payload.SheetID,
+		SchemaVersion:      payload.SchemaVersion,
+		CheckResultList:    []*webhook.TaskCheckResult{},
+	}
+	if task.Instance != nil {
+		webhookTask.Instance = task.Instance.Name
+		if task.Instance.Environment != nil {
+			webhookTask.Environment = task.Instance.Environment.Name
+		}
+	}
+	// The database of the creating database task is only set after the task is done.
+	if task.Database != nil {
+		webhookTask.Database = task.Database.Name
+	}
+
+	// Only the latest finished run of each check type counts.
+	latestCheckRunMap := make(map[api.TaskCheckType]*api.TaskCheckRun)
+	for _, checkRun := range task.TaskCheckRunList {
+		if checkRun.Status != api.TaskCheckRunDone {
+			continue
+		}
+		if latest, ok := latestCheckRunMap[checkRun.Type]; !ok || checkRun.ID > latest.ID {
+			latestCheckRunMap[checkRun.Type] = checkRun
+		}
+	}
+	var checkRunList []*api.TaskCheckRun
+	for _, checkRun := range latestCheckRunMap {
+		checkRunList = append(checkRunList, checkRun)
+	}
+	sort.Slice(checkRunList, func(i, j int) bool {
+		return checkRunList[i].ID < checkRunList[j].ID
+	})
+	for _, checkRun := range checkRunList {
+		var result api.TaskCheckRunResultPayload
+		if err := json.Unmarshal([]byte(checkRun.Result), &result); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal result of task check run %d", checkRun.ID)
+		}
+		for _, checkResult := range result.ResultList {
+			webhookTask.CheckResultList = append(webhookTask.CheckResultList, &webhook.TaskCheckResult{
+				Type:    string(checkRun.Type),
+				Status:  string(checkResult.Status),
+				Code:    checkResult.Code,
+				Title:   checkResult.Title,
+				Content: checkResult.Content,
+			})
+		}
+	}
+	return webhookTask, nil
+}
+
 func (m *Manager) postInboxIssueActivity(ctx context.Context, issue *api.Issue, activityID int) error {
 	if issue.CreatorID != api.SystemBotID {
 		inboxCreate := &api.InboxCreate{
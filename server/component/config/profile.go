@@ -48,6 +48,9 @@ type Profile struct {
 	BackupRegion         string
 	BackupBucket         string
 	BackupCredentialFile string
+	// BackupEndpoint is the endpoint of the S3 compatible storage service, such as MinIO.
+	// The default endpoint of the backup storage backend is used if it's empty.
+	BackupEndpoint string
 
 	// IM integration related fields
 	// FeishuAPIURL is the URL of Feishu API server.
@@ -63,6 +66,8 @@ type Profile struct {
 	MetricConnectionKey string
 	// DisableMetric will disable the metric collector.
 	DisableMetric bool
+	// EnablePrometheus will expose the server and runner health metrics at the Prometheus /metrics endpoint.
+	EnablePrometheus bool
 }
 
 // UseEmbedDB returns whether to use embedDB.
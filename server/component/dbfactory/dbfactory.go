@@ -54,8 +54,9 @@ func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.
 		ctx,
 		instance.Engine,
 		db.DriverConfig{
-			DbBinDir:  dbBinDir,
-			BinlogDir: common.GetBinlogAbsDir(d.dataDir, instance.UID),
+			DbBinDir:      dbBinDir,
+			BinlogDir:     common.GetBinlogAbsDir(d.dataDir, instance.UID),
+			WALArchiveDir: common.GetWALArchiveAbsDir(d.dataDir, instance.UID),
 		},
 		db.ConnectionConfig{
 			Username: adminDataSource.Username,
@@ -113,8 +114,9 @@ func (d *DBFactory) GetReadOnlyDatabaseDriver(ctx context.Context, instance *sto
 		ctx,
 		instance.Engine,
 		db.DriverConfig{
-			DbBinDir:  dbBinDir,
-			BinlogDir: common.GetBinlogAbsDir(d.dataDir, instance.UID),
+			DbBinDir:      dbBinDir,
+			BinlogDir:     common.GetBinlogAbsDir(d.dataDir, instance.UID),
+			WALArchiveDir: common.GetWALArchiveAbsDir(d.dataDir, instance.UID),
 		},
 		db.ConnectionConfig{
 			Username: dataSource.Username,
@@ -0,0 +1,165 @@
+// Package monitor exposes the server and runner health metrics in the Prometheus format.
+package monitor
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common/log"
+	"github.com/bytebase/bytebase/server/component/state"
+	"github.com/bytebase/bytebase/store"
+)
+
+const (
+	namespace = "bytebase"
+	// collectTimeout is the timeout for querying the store on each scrape.
+	collectTimeout = 10 * time.Second
+)
+
+var (
+	// TaskSchedulerQueueDepth is the number of running tasks waiting for the task scheduler to pick them up.
+	TaskSchedulerQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "task_scheduler",
+		Name:      "queue_depth",
+		Help:      "Number of running tasks waiting to be executed by the task scheduler.",
+	})
+	// TaskRunDuration is the duration of task runs by task type and result status.
+	TaskRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "task",
+		Name:      "run_duration_seconds",
+		Help:      "Duration of task runs by task type and result status.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 15, 60, 300, 900, 3600},
+	}, []string{"type", "status"})
+	// TaskCheckResultCount is the number of task check results by check type and result status.
+	TaskCheckResultCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "task_check",
+		Name:      "result_total",
+		Help:      "Number of task check results by check type and result status.",
+	}, []string{"type", "status"})
+	// BackupDuration is the duration of database backups by storage backend and result status.
+	BackupDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "backup",
+		Name:      "duration_seconds",
+		Help:      "Duration of database backups by storage backend and result status.",
+		Buckets:   []float64{1, 5, 15, 60, 300, 900, 3600, 10800},
+	}, []string{"storage_backend", "status"})
+	// BackupFailureCount is the number of failed database backups by storage backend.
+	BackupFailureCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "backup",
+		Name:      "failure_total",
+		Help:      "Number of failed database backups by storage backend.",
+	}, []string{"storage_backend"})
+	// SchemaSyncDuration is the latency of syncing an instance by instance and sync scope.
+	// The scope is "instance" for syncing the database list and "database" for syncing all database schemas of the instance.
+	SchemaSyncDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "schema_sync",
+		Name:      "duration_seconds",
+		Help:      "Latency of the schema sync by instance and sync scope.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 15, 60, 300},
+	}, []string{"instance", "scope"})
+
+	anomalyCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "anomaly", "count"),
+		"Number of active anomalies by anomaly type.",
+		[]string{"type"}, nil,
+	)
+	instanceOutstandingConnectionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "instance", "outstanding_connections"),
+		"Number of outstanding connections held by tasks and task checks per instance.",
+		[]string{"instance_id"}, nil,
+	)
+)
+
+// Register registers the server and runner health metrics to the registerer.
+func Register(registerer prometheus.Registerer, store *store.Store, stateCfg *state.State) error {
+	collectors := []prometheus.Collector{
+		TaskSchedulerQueueDepth,
+		TaskRunDuration,
+		TaskCheckResultCount,
+		BackupDuration,
+		BackupFailureCount,
+		SchemaSyncDuration,
+		&anomalyCollector{store: store},
+		&connectionCollector{stateCfg: stateCfg},
+	}
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ObserveSince observes the elapsed time since start on the observer.
+func ObserveSince(observer prometheus.Observer, start time.Time) {
+	observer.Observe(time.Since(start).Seconds())
+}
+
+var _ prometheus.Collector = (*anomalyCollector)(nil)
+
+// anomalyCollector collects the active anomaly counts from the store on each scrape.
+type anomalyCollector struct {
+	store *store.Store
+}
+
+// Describe implements prometheus.Collector.
+func (*anomalyCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- anomalyCountDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *anomalyCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	anomalyCountMetricList, err := c.store.CountAnomalyGroupByType(ctx)
+	if err != nil {
+		log.Warn("Failed to count anomalies for the Prometheus exporter", zap.Error(err))
+		ch <- prometheus.NewInvalidMetric(anomalyCountDesc, err)
+		return
+	}
+	counts := make(map[api.AnomalyType]int)
+	for _, anomalyCountMetric := range anomalyCountMetricList {
+		counts[anomalyCountMetric.Type] += anomalyCountMetric.Count
+	}
+	for anomalyType, count := range counts {
+		ch <- prometheus.MustNewConstMetric(anomalyCountDesc, prometheus.GaugeValue, float64(count), string(anomalyType))
+	}
+}
+
+var _ prometheus.Collector = (*connectionCollector)(nil)
+
+// connectionCollector collects the outstanding connections per instance from the in-memory state.
+type connectionCollector struct {
+	stateCfg *state.State
+}
+
+// Describe implements prometheus.Collector.
+func (*connectionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- instanceOutstandingConnectionsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *connectionCollector) Collect(ch chan<- prometheus.Metric) {
+	c.stateCfg.Lock()
+	connections := make(map[int]int, len(c.stateCfg.InstanceOutstandingConnections))
+	for instanceID, count := range c.stateCfg.InstanceOutstandingConnections {
+		connections[instanceID] = count
+	}
+	c.stateCfg.Unlock()
+
+	for instanceID, count := range connections {
+		ch <- prometheus.MustNewConstMetric(instanceOutstandingConnectionsDesc, prometheus.GaugeValue, float64(count), strconv.Itoa(instanceID))
+	}
+}
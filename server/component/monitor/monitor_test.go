@@ -0,0 +1,31 @@
+package monitor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/server/component/state"
+)
+
+func TestConnectionCollector(t *testing.T) {
+	a := require.New(t)
+	stateCfg := &state.State{
+		InstanceOutstandingConnections: map[int]int{
+			101: 3,
+			102: 0,
+		},
+	}
+	collector := &connectionCollector{stateCfg: stateCfg}
+
+	want := `
+# HELP bytebase_instance_outstanding_connections Number of outstanding connections held by tasks and task checks per instance.
+# TYPE bytebase_instance_outstanding_connections gauge
+bytebase_instance_outstanding_connections{instance_id="101"} 3
+bytebase_instance_outstanding_connections{instance_id="102"} 0
+`
+	err := testutil.CollectAndCompare(collector, strings.NewReader(want))
+	a.NoError(err)
+}
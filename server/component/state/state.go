@@ -31,6 +31,8 @@ type State struct {
 	RunningTasks sync.Map // map[taskID]bool
 	// RunningTasksCancel is the cancel's of running tasks.
 	RunningTasksCancel sync.Map // map[taskID]context.CancelFunc
+	// IdentityProviderLogins is the map from the login state to the pending single sign-on login.
+	IdentityProviderLogins sync.Map // map[state]*identityProviderLogin
 	// InstanceOutstandingConnections is the maximum number of connections per instance.
 	InstanceOutstandingConnections map[int]int
 
@@ -0,0 +1,599 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/jsonapi"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/idp"
+	"github.com/bytebase/bytebase/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/plugin/idp/saml"
+	"github.com/bytebase/bytebase/store"
+)
+
+// identityProviderLoginTimeout is the timeout for the user to sign in the identity provider.
+const identityProviderLoginTimeout = 10 * time.Minute
+
+// identityProviderLogin is a pending single sign-on login started by Bytebase.
+// It's keyed by the login state, which is the OIDC state or the SAML relay state.
+type identityProviderLogin struct {
+	identityProviderID int
+	// nonce and codeVerifier are used by OIDC.
+	nonce        string
+	codeVerifier string
+	// requestID is used by SAML.
+	requestID  string
+	expireTime time.Time
+}
+
+func (s *Server) registerIdentityProviderRoutes(g *echo.Group) {
+	g.POST("/idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if !s.licenseService.IsFeatureEnabled(api.FeatureSSO) {
+			return echo.NewHTTPError(http.StatusForbidden, api.FeatureSSO.AccessErrorMessage())
+		}
+		identityProviderCreate := &api.IdentityProviderCreate{
+			CreatorID: c.Get(getPrincipalIDContextKey()).(int),
+		}
+		if err := jsonapi.UnmarshalPayload(c.Request().Body, identityProviderCreate); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed create identity provider request").SetInternal(err)
+		}
+		if identityProviderCreate.Name == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "Identity provider name is required")
+		}
+		config, err := validateIdentityProviderConfig(identityProviderCreate.Type, identityProviderCreate.Config)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid identity provider config: %v", err)).SetInternal(err)
+		}
+		configString, err := config.String()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal identity provider config").SetInternal(err)
+		}
+
+		identityProvider, err := s.store.CreateIdentityProvider(ctx, &store.IdentityProviderMessage{
+			Name:   identityProviderCreate.Name,
+			Type:   identityProviderCreate.Type,
+			Config: configString,
+		}, identityProviderCreate.CreatorID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create identity provider").SetInternal(err)
+		}
+		composedIdentityProvider, err := composeIdentityProvider(identityProvider)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to compose identity provider").SetInternal(err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, composedIdentityProvider); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal create identity provider response").SetInternal(err)
+		}
+		return nil
+	})
+
+	g.GET("/idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProviders, err := s.store.ListIdentityProviders(ctx, &store.FindIdentityProviderMessage{ShowDeleted: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to fetch identity provider list").SetInternal(err)
+		}
+		var composedIdentityProviderList []*api.IdentityProvider
+		for _, identityProvider := range identityProviders {
+			composedIdentityProvider, err := composeIdentityProvider(identityProvider)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to compose identity provider %d", identityProvider.UID)).SetInternal(err)
+			}
+			composedIdentityProviderList = append(composedIdentityProviderList, composedIdentityProvider)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, composedIdentityProviderList); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal identity provider list response").SetInternal(err)
+		}
+		return nil
+	})
+
+	g.GET("/idp/:idpID", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		id, err := strconv.Atoi(c.Param("idpID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", c.Param("idpID"))).SetInternal(err)
+		}
+		identityProvider, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{UID: &id, ShowDeleted: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch identity provider ID: %v", id)).SetInternal(err)
+		}
+		if identityProvider == nil {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Identity provider not found: %d", id))
+		}
+		composedIdentityProvider, err := composeIdentityProvider(identityProvider)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to compose identity provider %d", id)).SetInternal(err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, composedIdentityProvider); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to marshal identity provider ID response: %v", id)).SetInternal(err)
+		}
+		return nil
+	})
+
+	g.PATCH("/idp/:idpID", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if !s.licenseService.IsFeatureEnabled(api.FeatureSSO) {
+			return echo.NewHTTPError(http.StatusForbidden, api.FeatureSSO.AccessErrorMessage())
+		}
+		id, err := strconv.Atoi(c.Param("idpID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", c.Param("idpID"))).SetInternal(err)
+		}
+		identityProviderPatch := &api.IdentityProviderPatch{
+			ID:        id,
+			UpdaterID: c.Get(getPrincipalIDContextKey()).(int),
+		}
+		if err := jsonapi.UnmarshalPayload(c.Request().Body, identityProviderPatch); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed patch identity provider request").SetInternal(err)
+		}
+
+		identityProvider, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{UID: &id, ShowDeleted: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch identity provider ID: %v", id)).SetInternal(err)
+		}
+		if identityProvider == nil {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Identity provider not found: %d", id))
+		}
+
+		update := &store.UpdateIdentityProviderMessage{
+			Name: identityProviderPatch.Name,
+		}
+		if v := identityProviderPatch.Config; v != nil {
+			config, err := validateIdentityProviderConfig(identityProvider.Type, *v)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid identity provider config: %v", err)).SetInternal(err)
+			}
+			// The client secret is redacted in the response, so an empty one means keeping the existing secret.
+			if config.OIDC != nil && config.OIDC.ClientSecret == "" {
+				oldConfig, err := api.UnmarshalIdentityProviderConfig(identityProvider.Type, identityProvider.Config)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to unmarshal identity provider %d config", id)).SetInternal(err)
+				}
+				config.OIDC.ClientSecret = oldConfig.OIDC.ClientSecret
+			}
+			configString, err := config.String()
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal identity provider config").SetInternal(err)
+			}
+			update.Config = &configString
+		}
+		if v := identityProviderPatch.RowStatus; v != nil {
+			deleted := *v == string(api.Archived)
+			update.Delete = &deleted
+		}
+
+		identityProvider, err = s.store.UpdateIdentityProvider(ctx, id, update, identityProviderPatch.UpdaterID)
+		if err != nil {
+			if common.ErrorCode(err) == common.NotFound {
+				return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Identity provider not found: %d", id))
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to patch identity provider ID: %v", id)).SetInternal(err)
+		}
+		composedIdentityProvider, err := composeIdentityProvider(identityProvider)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to compose identity provider %d", id)).SetInternal(err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, composedIdentityProvider); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to marshal identity provider patch response: %v", id)).SetInternal(err)
+		}
+		return nil
+	})
+}
+
+// registerIdentityProviderAuthRoutes registers the routes for signing in with the identity providers.
+// They are under "/auth" so that they skip the authentication.
+func (s *Server) registerIdentityProviderAuthRoutes(g *echo.Group) {
+	// List the identity providers on the sign in page, the config is not returned.
+	g.GET("/auth/idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		var identityProviderList []*api.IdentityProvider
+		if s.licenseService.IsFeatureEnabled(api.FeatureSSO) {
+			identityProviders, err := s.store.ListIdentityProviders(ctx, &store.FindIdentityProviderMessage{})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to fetch identity provider list").SetInternal(err)
+			}
+			for _, identityProvider := range identityProviders {
+				identityProviderList = append(identityProviderList, &api.IdentityProvider{
+					ID:        identityProvider.UID,
+					RowStatus: api.Normal,
+					Name:      identityProvider.Name,
+					Type:      identityProvider.Type,
+				})
+			}
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, identityProviderList); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal identity provider list response").SetInternal(err)
+		}
+		return nil
+	})
+
+	// Redirect the user to the identity provider to sign in.
+	g.GET("/auth/idp/:idpID/login", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, config, httpErr := s.getActiveIdentityProvider(ctx, c.Param("idpID"))
+		if httpErr != nil {
+			return httpErr
+		}
+		state, err := common.RandomString(32)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate login state").SetInternal(err)
+		}
+		login := &identityProviderLogin{
+			identityProviderID: identityProvider.UID,
+			expireTime:         time.Now().Add(identityProviderLoginTimeout),
+		}
+
+		var redirectURL string
+		switch identityProvider.Type {
+		case api.IdentityProviderTypeOIDC:
+			oidcIdentityProvider, err := oidc.NewIdentityProvider(ctx, *config.OIDC)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to connect to identity provider %q", identityProvider.Name)).SetInternal(err)
+			}
+			if login.nonce, err = common.RandomString(32); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate nonce").SetInternal(err)
+			}
+			if login.codeVerifier, err = oidc.GenerateCodeVerifier(); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate code verifier").SetInternal(err)
+			}
+			redirectURL = oidcIdentityProvider.AuthCodeURL(s.getIdentityProviderURL(identityProvider.UID, "callback"), state, login.nonce, login.codeVerifier)
+		case api.IdentityProviderTypeSAML:
+			samlIdentityProvider, err := saml.NewIdentityProvider(*config.SAML)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Invalid identity provider %q", identityProvider.Name)).SetInternal(err)
+			}
+			if login.requestID, err = saml.GenerateRequestID(); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate SAML request ID").SetInternal(err)
+			}
+			if redirectURL, err = samlIdentityProvider.AuthnRequestURL(s.getSAMLServiceProvider(identityProvider.UID), login.requestID, state, time.Now()); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate SAML authentication request").SetInternal(err)
+			}
+		}
+
+		s.stateCfg.IdentityProviderLogins.Range(func(key, value interface{}) bool {
+			if time.Now().After(value.(*identityProviderLogin).expireTime) {
+				s.stateCfg.IdentityProviderLogins.Delete(key)
+			}
+			return true
+		})
+		s.stateCfg.IdentityProviderLogins.Store(state, login)
+		return c.Redirect(http.StatusFound, redirectURL)
+	})
+
+	// The OIDC redirect URL.
+	g.GET("/auth/idp/:idpID/callback", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, config, httpErr := s.getActiveIdentityProvider(ctx, c.Param("idpID"))
+		if httpErr != nil {
+			return httpErr
+		}
+		if identityProvider.Type != api.IdentityProviderTypeOIDC {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Identity provider %q is not an OIDC identity provider", identityProvider.Name))
+		}
+		if errorCode := c.QueryParam("error"); errorCode != "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("Failed to sign in with %q: %s %s", identityProvider.Name, errorCode, c.QueryParam("error_description")))
+		}
+		login, httpErr := s.popIdentityProviderLogin(c.QueryParam("state"), identityProvider.UID)
+		if httpErr != nil {
+			return httpErr
+		}
+
+		oidcIdentityProvider, err := oidc.NewIdentityProvider(ctx, *config.OIDC)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to connect to identity provider %q", identityProvider.Name)).SetInternal(err)
+		}
+		userInfo, err := oidcIdentityProvider.GetUserInfo(ctx, s.getIdentityProviderURL(identityProvider.UID, "callback"), c.QueryParam("code"), login.codeVerifier, login.nonce)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("Failed to sign in with %q", identityProvider.Name)).SetInternal(err)
+		}
+		return s.signInWithIdentityProvider(c, config, userInfo)
+	})
+
+	// The SAML assertion consumer service.
+	g.POST("/auth/idp/:idpID/acs", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		identityProvider, config, httpErr := s.getActiveIdentityProvider(ctx, c.Param("idpID"))
+		if httpErr != nil {
+			return httpErr
+		}
+		if identityProvider.Type != api.IdentityProviderTypeSAML {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Identity provider %q is not a SAML identity provider", identityProvider.Name))
+		}
+		// Only the logins started by Bytebase are accepted, and each of them can only be used once.
+		login, httpErr := s.popIdentityProviderLogin(c.FormValue("RelayState"), identityProvider.UID)
+		if httpErr != nil {
+			return httpErr
+		}
+
+		samlIdentityProvider, err := saml.NewIdentityProvider(*config.SAML)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Invalid identity provider %q", identityProvider.Name)).SetInternal(err)
+		}
+		userInfo, err := samlIdentityProvider.GetUserInfo(s.getSAMLServiceProvider(identityProvider.UID), c.FormValue("SAMLResponse"), login.requestID, time.Now())
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("Failed to sign in with %q", identityProvider.Name)).SetInternal(err)
+		}
+		return s.signInWithIdentityProvider(c, config, userInfo)
+	})
+
+	// The SAML service provider metadata for configuring the identity provider.
+	g.GET("/auth/idp/:idpID/metadata", func(c echo.Context) error {
+		id, err := strconv.Atoi(c.Param("idpID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", c.Param("idpID"))).SetInternal(err)
+		}
+		metadata, err := saml.Metadata(s.getSAMLServiceProvider(id))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate SAML metadata").SetInternal(err)
+		}
+		return c.Blob(http.StatusOK, echo.MIMEApplicationXMLCharsetUTF8, metadata)
+	})
+}
+
+func (s *Server) getActiveIdentityProvider(ctx context.Context, idpID string) (*store.IdentityProviderMessage, *api.IdentityProviderConfig, *echo.HTTPError) {
+	if !s.licenseService.IsFeatureEnabled(api.FeatureSSO) {
+		return nil, nil, echo.NewHTTPError(http.StatusForbidden, api.FeatureSSO.AccessErrorMessage())
+	}
+	id, err := strconv.Atoi(idpID)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", idpID)).SetInternal(err)
+	}
+	identityProvider, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{UID: &id})
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch identity provider ID: %v", id)).SetInternal(err)
+	}
+	if identityProvider == nil {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Identity provider not found: %d", id))
+	}
+	config, err := api.UnmarshalIdentityProviderConfig(identityProvider.Type, identityProvider.Config)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to unmarshal identity provider %d config", id)).SetInternal(err)
+	}
+	return identityProvider, config, nil
+}
+
+func (s *Server) popIdentityProviderLogin(state string, identityProviderID int) (*identityProviderLogin, *echo.HTTPError) {
+	value, ok := s.stateCfg.IdentityProviderLogins.LoadAndDelete(state)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Login request not found, please sign in again")
+	}
+	login := value.(*identityProviderLogin)
+	if login.identityProviderID != identityProviderID {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Login request mismatches the identity provider, please sign in again")
+	}
+	if time.Now().After(login.expireTime) {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Login request expired, please sign in again")
+	}
+	return login, nil
+}
+
+// getIdentityProviderURL returns the URL of the identity provider endpoint such as "callback" or "acs".
+func (s *Server) getIdentityProviderURL(identityProviderID int, endpoint string) string {
+	return fmt.Sprintf("%s%s/auth/idp/%d/%s", oauthRedirectURL(s.profile.ExternalURL), internalAPIPrefix, identityProviderID, endpoint)
+}
+
+func (s *Server) getSAMLServiceProvider(identityProviderID int) saml.ServiceProvider {
+	return saml.ServiceProvider{
+		EntityID: s.getIdentityProviderURL(identityProviderID, "metadata"),
+		ACSURL:   s.getIdentityProviderURL(identityProviderID, "acs"),
+	}
+}
+
+// signInWithIdentityProvider signs in the user authenticated by the identity provider.
+// The user is bound to the issuer and the subject of the identity provider user on the first sign in, and the group
+// mappings are applied on each sign in.
+func (s *Server) signInWithIdentityProvider(c echo.Context, config *api.IdentityProviderConfig, userInfo *idp.UserInfo) error {
+	ctx := c.Request().Context()
+	user, httpErr := s.getIdentityProviderUser(ctx, config, userInfo)
+	if httpErr != nil {
+		return httpErr
+	}
+	if user.MemberDeleted {
+		return echo.NewHTTPError(http.StatusUnauthorized, "This user has been deactivated by the admin")
+	}
+
+	user, err := s.applyIdentityProviderGroupMapping(ctx, user, config.GroupMappingList, userInfo.Groups)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to apply identity provider group mappings").SetInternal(err)
+	}
+
+	if err := GenerateTokensAndSetCookies(c, user, s.profile.Mode, s.secret); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate access token").SetInternal(err)
+	}
+	return c.Redirect(http.StatusFound, oauthRedirectURL(s.profile.ExternalURL)+"/")
+}
+
+// getIdentityProviderUser returns the user bound to the identity provider user, and binds one if there is none.
+// A new user is created if the email isn't used, and the existing user with the email is only bound if the admin allows it.
+func (s *Server) getIdentityProviderUser(ctx context.Context, config *api.IdentityProviderConfig, userInfo *idp.UserInfo) (*store.UserMessage, *echo.HTTPError) {
+	identityProviderUser, err := s.store.GetIdentityProviderUser(ctx, &store.FindIdentityProviderUserMessage{
+		Issuer:  &userInfo.Issuer,
+		Subject: &userInfo.Subject,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to authenticate user").SetInternal(err)
+	}
+	if identityProviderUser != nil {
+		user, err := s.store.GetUserByID(ctx, identityProviderUser.PrincipalID)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to authenticate user").SetInternal(err)
+		}
+		if user == nil {
+			return nil, echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("User %d bound to %q is not found", identityProviderUser.PrincipalID, userInfo.Subject))
+		}
+		return user, nil
+	}
+
+	user, err := s.store.GetUserByEmail(ctx, userInfo.Email)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to authenticate user").SetInternal(err)
+	}
+	if user != nil {
+		if !config.AllowLinkingExistingUser {
+			return nil, echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("User %s already exists, please ask the admin to allow linking existing users to the identity provider", userInfo.Email))
+		}
+		// The existing user could only be bound to one user of each identity provider.
+		bound, err := s.store.GetIdentityProviderUser(ctx, &store.FindIdentityProviderUserMessage{
+			PrincipalID: &user.ID,
+			Issuer:      &userInfo.Issuer,
+		})
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to authenticate user").SetInternal(err)
+		}
+		if bound != nil {
+			return nil, echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("User %s is bound to another user of the identity provider", userInfo.Email))
+		}
+	} else {
+		// Same as signing in via VCS, the random password is supposed to be not guessable.
+		password, err := common.RandomString(20)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate random password").SetInternal(err)
+		}
+		name := userInfo.DisplayName
+		if name == "" {
+			name = userInfo.Email
+		}
+		var httpErr *echo.HTTPError
+		user, httpErr = trySignUp(ctx, s, &api.SignUp{
+			Email:    userInfo.Email,
+			Password: password,
+			Name:     name,
+		}, api.SystemBotID)
+		if httpErr != nil {
+			return nil, httpErr
+		}
+	}
+
+	if _, err := s.store.CreateIdentityProviderUser(ctx, &store.IdentityProviderUserMessage{
+		PrincipalID: user.ID,
+		Issuer:      userInfo.Issuer,
+		Subject:     userInfo.Subject,
+	}); err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to bind user to the identity provider").SetInternal(err)
+	}
+	return user, nil
+}
+
+// applyIdentityProviderGroupMapping grants the workspace role and the project memberships mapped from the user groups.
+// The workspace role is set to the highest mapped role, and the memberships are never revoked.
+func (s *Server) applyIdentityProviderGroupMapping(ctx context.Context, user *store.UserMessage, groupMappingList []*api.GroupMapping, groups []string) (*store.UserMessage, error) {
+	role, projectRoles := api.GetMappedRoles(groupMappingList, groups)
+
+	if role != "" && role != user.Role {
+		// Make sure there are other active owners before demoting an owner.
+		if user.Role == api.Owner {
+			countResult, err := s.store.CountMemberGroupByRoleAndStatus(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, count := range countResult {
+				if count.Role == api.Owner && count.RowStatus == api.Normal && count.Count == 1 {
+					role = api.Owner
+				}
+			}
+		}
+		if role != user.Role {
+			updatedUser, err := s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Role: &role}, api.SystemBotID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to update the role of user %d", user.ID)
+			}
+			user = updatedUser
+		}
+	}
+
+	for projectID, projectRole := range projectRoles {
+		project, err := s.store.GetProjectByID(ctx, projectID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get project %d", projectID)
+		}
+		if project == nil || project.RowStatus == api.Archived {
+			continue
+		}
+		roleProvider := api.ProjectRoleProviderBytebase
+		member, err := s.store.GetProjectMember(ctx, &api.ProjectMemberFind{
+			ProjectID:    &projectID,
+			PrincipalID:  &user.ID,
+			RoleProvider: &roleProvider,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the membership of user %d in project %d", user.ID, projectID)
+		}
+		if member == nil {
+			if _, err := s.store.CreateProjectMember(ctx, &api.ProjectMemberCreate{
+				CreatorID:    api.SystemBotID,
+				ProjectID:    projectID,
+				Role:         projectRole,
+				PrincipalID:  user.ID,
+				RoleProvider: api.ProjectRoleProviderBytebase,
+			}); err != nil {
+				return nil, errors.Wrapf(err, "failed to add user %d to project %d", user.ID, projectID)
+			}
+			continue
+		}
+		if member.Role != string(projectRole) {
+			role := string(projectRole)
+			if _, err := s.store.PatchProjectMember(ctx, &api.ProjectMemberPatch{
+				ID:        member.ID,
+				UpdaterID: api.SystemBotID,
+				Role:      &role,
+			}); err != nil {
+				return nil, errors.Wrapf(err, "failed to update the role of user %d in project %d", user.ID, projectID)
+			}
+		}
+	}
+	return user, nil
+}
+
+func validateIdentityProviderConfig(identityProviderType api.IdentityProviderType, s string) (*api.IdentityProviderConfig, error) {
+	config, err := api.UnmarshalIdentityProviderConfig(identityProviderType, s)
+	if err != nil {
+		return nil, err
+	}
+	if config.SAML != nil {
+		if _, err := saml.NewIdentityProvider(*config.SAML); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+func composeIdentityProvider(identityProvider *store.IdentityProviderMessage) (*api.IdentityProvider, error) {
+	config, err := api.UnmarshalIdentityProviderConfig(identityProvider.Type, identityProvider.Config)
+	if err != nil {
+		return nil, err
+	}
+	redactedConfig, err := config.Redact()
+	if err != nil {
+		return nil, err
+	}
+	rowStatus := api.Normal
+	if identityProvider.Deleted {
+		rowStatus = api.Archived
+	}
+	return &api.IdentityProvider{
+		ID:        identityProvider.UID,
+		RowStatus: rowStatus,
+		Name:      identityProvider.Name,
+		Type:      identityProvider.Type,
+		Config:    redactedConfig,
+	}, nil
+}
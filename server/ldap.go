@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common/log"
+	"github.com/bytebase/bytebase/plugin/ldap"
+	"github.com/bytebase/bytebase/store"
+)
+
+func (s *Server) registerLDAPRoutes(g *echo.Group) {
+	g.POST("/ldap/sync", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if !s.licenseService.IsFeatureEnabled(api.FeatureLDAP) {
+			return echo.NewHTTPError(http.StatusForbidden, api.FeatureLDAP.AccessErrorMessage())
+		}
+		if s.LDAPSyncer == nil {
+			return echo.NewHTTPError(http.StatusMethodNotAllowed, "LDAP directory sync is not available in readonly mode")
+		}
+		result, err := s.LDAPSyncer.Sync(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to sync LDAP directory").SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, result)
+	})
+}
+
+// getLDAPSetting returns the LDAP setting, or nil if it's not configured.
+func (s *Server) getLDAPSetting(ctx context.Context) (*api.SettingWorkspaceLDAPValue, error) {
+	settingName := api.SettingWorkspaceLDAP
+	setting, err := s.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil || setting.Value == "" {
+		return nil, nil
+	}
+	value := &api.SettingWorkspaceLDAPValue{}
+	if err := json.Unmarshal([]byte(setting.Value), value); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal LDAP setting")
+	}
+	return value, nil
+}
+
+// authenticateLDAPUser authenticates the user synced from the LDAP directory with the LDAP password.
+// Returns false if the user isn't synced from the LDAP directory or the LDAP directory sync is disabled, in which case
+// the user signs in with the Bytebase password. The synced user never falls back to the Bytebase password, which may be
+// set before the user is linked with the directory.
+func (s *Server) authenticateLDAPUser(ctx context.Context, user *store.UserMessage, password string) (bool, *echo.HTTPError) {
+	if !s.licenseService.IsFeatureEnabled(api.FeatureLDAP) {
+		return false, nil
+	}
+	value, err := s.getLDAPSetting(ctx)
+	if err != nil {
+		return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to authenticate user").SetInternal(err)
+	}
+	if value == nil || !value.Enabled {
+		return false, nil
+	}
+	ldapUser, err := s.store.GetLDAPUser(ctx, &store.FindLDAPUserMessage{PrincipalID: &user.ID})
+	if err != nil {
+		return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to authenticate user").SetInternal(err)
+	}
+	if ldapUser == nil {
+		return false, nil
+	}
+	if err := ldap.Authenticate(value.Config, ldapUser.DN, password); err != nil {
+		log.Debug("Failed to authenticate LDAP user", zap.String("dn", ldapUser.DN), zap.Error(err))
+		return true, echo.NewHTTPError(http.StatusUnauthorized, "Incorrect password").SetInternal(err)
+	}
+	return true, nil
+}
+
+// redactLDAPSetting removes the bind password from the LDAP setting value.
+func redactLDAPSetting(settingValue string) (string, error) {
+	if settingValue == "" {
+		return "", nil
+	}
+	var value api.SettingWorkspaceLDAPValue
+	if err := json.Unmarshal([]byte(settingValue), &value); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal LDAP setting")
+	}
+	value.Config.BindPassword = ""
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal LDAP setting")
+	}
+	return string(b), nil
+}
@@ -26,6 +26,7 @@ func (s *Server) registerOAuthRoutes(g *echo.Group) {
 
 		var vcsType vcsPlugin.Type
 		var instanceURL string
+		var caCert string
 		var oauthExchange *common.OAuthExchange
 		if req.ID > 0 {
 			vcs, err := s.store.GetVCSByID(c.Request().Context(), req.ID)
@@ -38,6 +39,7 @@ func (s *Server) registerOAuthRoutes(g *echo.Group) {
 
 			vcsType = vcs.Type
 			instanceURL = vcs.InstanceURL
+			caCert = vcs.CACert
 			clientID := req.ClientID
 			clientSecret := req.ClientSecret
 			// Since we may not pass in ClientID and ClientSecret in the request, we will use the client ID and secret from VCS store even if it's stale.
@@ -55,11 +57,14 @@ func (s *Server) registerOAuthRoutes(g *echo.Group) {
 			}
 		} else {
 			vcsType = req.Type
-			if vcsType != vcsPlugin.GitLabSelfHost && vcsType != vcsPlugin.GitHubCom {
+			switch vcsType {
+			case vcsPlugin.GitLabSelfHost, vcsPlugin.GitHubCom, vcsPlugin.GitHubEnterprise, vcsPlugin.Gitea, vcsPlugin.Bitbucket:
+			default:
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unexpected VCS type: %s", vcsType))
 			}
 
 			instanceURL = req.InstanceURL
+			caCert = req.CACert
 			oauthExchange = &common.OAuthExchange{
 				ClientID:     req.ClientID,
 				ClientSecret: req.ClientSecret,
@@ -68,7 +73,7 @@ func (s *Server) registerOAuthRoutes(g *echo.Group) {
 		}
 
 		oauthExchange.RedirectURL = fmt.Sprintf("%s/oauth/callback", oauthRedirectURL(s.profile.ExternalURL))
-		oauthToken, err := vcsPlugin.Get(vcsType, vcsPlugin.ProviderConfig{}).
+		oauthToken, err := vcsPlugin.Get(vcsType, vcsPlugin.ProviderConfig{CACert: caCert}).
 			ExchangeOAuthToken(
 				c.Request().Context(),
 				instanceURL,
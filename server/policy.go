@@ -48,6 +48,10 @@ func (s *Server) hasAccessToUpsertPolicy(policyUpsert *api.PolicyUpsert) error {
 		if !s.licenseService.IsFeatureEnabled(api.FeatureSensitiveData) {
 			return errors.Errorf(api.FeatureSensitiveData.AccessErrorMessage())
 		}
+	case api.PolicyTypeSchemaDrift:
+		if !s.licenseService.IsFeatureEnabled(api.FeatureSchemaDrift) {
+			return errors.Errorf(api.FeatureSchemaDrift.AccessErrorMessage())
+		}
 	}
 	return nil
 }
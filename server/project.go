@@ -22,6 +22,8 @@ import (
 	"github.com/bytebase/bytebase/common"
 	"github.com/bytebase/bytebase/common/log"
 	vcsPlugin "github.com/bytebase/bytebase/plugin/vcs"
+	"github.com/bytebase/bytebase/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/plugin/vcs/github"
 	"github.com/bytebase/bytebase/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/server/utils"
@@ -319,7 +321,7 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 			}
 			repositoryCreate.WebhookSecretToken = secretToken
 
-			webhookID, err := s.createVCSWebhook(ctx, vcs.Type, repositoryCreate.WebhookEndpointID, secretToken, repositoryCreate.AccessToken, vcs.InstanceURL, repositoryCreate.ExternalID)
+			webhookID, err := s.createVCSWebhook(ctx, vcs, repositoryCreate.WebhookEndpointID, secretToken, repositoryCreate.AccessToken, repositoryCreate.ExternalID)
 			if err != nil {
 				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to create webhook for project ID: %v", repositoryCreate.ProjectID)).SetInternal(err)
 			}
@@ -616,7 +618,7 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 			// Delete the webhook after we successfully delete the repository.
 			// This is because in case the webhook deletion fails, we can still have a cleanup process to cleanup the orphaned webhook.
 			// If we delete it before we delete the repository, then if the repository deletion fails, we will have a broken repository with no webhook.
-			if err = vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).DeleteWebhook(
+			if err = vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).DeleteWebhook(
 				ctx,
 				// Need to get ApplicationID, Secret from vcs instead of repository.vcs since the latter is not composed.
 				common.OauthContext{
@@ -745,7 +747,7 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 
 		basePath := filepath.Dir(repo.SheetPathTemplate)
 		// TODO(Steven): The repo.branchFilter could be `test/*` which cannot be the ref value.
-		fileList, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).FetchRepositoryFileList(ctx,
+		fileList, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).FetchRepositoryFileList(ctx,
 			common.OauthContext{
 				ClientID:     vcs.ApplicationID,
 				ClientSecret: vcs.Secret,
@@ -771,7 +773,7 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("sheet name cannot be empty from sheet path %s with template %s", file.Path, repo.SheetPathTemplate)).SetInternal(err)
 			}
 
-			fileContent, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).ReadFileContent(ctx,
+			fileContent, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).ReadFileContent(ctx,
 				common.OauthContext{
 					ClientID:     vcs.ApplicationID,
 					ClientSecret: vcs.Secret,
@@ -788,7 +790,7 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch file content from VCS, instance URL: %s, repo ID: %s, file path: %s, branch: %s", vcs.InstanceURL, repo.ExternalID, file.Path, repo.BranchFilter)).SetInternal(err)
 			}
 
-			fileMeta, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).ReadFileMeta(ctx,
+			fileMeta, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).ReadFileMeta(ctx,
 				common.OauthContext{
 					ClientID:     vcs.ApplicationID,
 					ClientSecret: vcs.Secret,
@@ -805,7 +807,7 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch file meta from VCS, instance URL: %s, repo ID: %s, file path: %s, branch: %s", vcs.InstanceURL, repo.ExternalID, file.Path, repo.BranchFilter)).SetInternal(err)
 			}
 
-			lastCommit, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).FetchCommitByID(ctx,
+			lastCommit, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).FetchCommitByID(ctx,
 				common.OauthContext{
 					ClientID:     vcs.ApplicationID,
 					ClientSecret: vcs.Secret,
@@ -859,6 +861,12 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 				sheetSource = api.SheetFromGitLabSelfHost
 			case vcsPlugin.GitHubCom:
 				sheetSource = api.SheetFromGitHubCom
+			case vcsPlugin.GitHubEnterprise:
+				sheetSource = api.SheetFromGitHubEnterprise
+			case vcsPlugin.Gitea:
+				sheetSource = api.SheetFromGitea
+			case vcsPlugin.Bitbucket:
+				sheetSource = api.SheetFromBitbucket
 			}
 			vscSheetType := api.SheetForSQL
 			sheetFind := &api.SheetFind{
@@ -914,12 +922,19 @@ func (s *Server) registerProjectRoutes(g *echo.Group) {
 }
 
 func (s *Server) setupVCSSQLReviewCI(ctx context.Context, repository *api.Repository) (*vcsPlugin.PullRequest, error) {
+	// Bitbucket Data Center has no built-in CI, thus the SQL review runs on the
+	// pull request webhook events and comments the result to the pull request,
+	// which requires no change to the repository.
+	if repository.VCS.Type == vcsPlugin.Bitbucket && !bitbucket.IsCloud(repository.VCS.InstanceURL) {
+		return &vcsPlugin.PullRequest{}, nil
+	}
+
 	branch, err := s.setupVCSSQLReviewBranch(ctx, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).UpsertEnvironmentVariable(
+	if err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).UpsertEnvironmentVariable(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -939,17 +954,25 @@ func (s *Server) setupVCSSQLReviewCI(ctx context.Context, repository *api.Reposi
 	sqlReviewEndpoint := fmt.Sprintf("%s/hook/sql-review/%s", s.profile.ExternalURL, repository.WebhookEndpointID)
 
 	switch repository.VCS.Type {
-	case vcsPlugin.GitHubCom:
-		if err := s.setupVCSSQLReviewCIForGitHub(ctx, repository, branch, sqlReviewEndpoint); err != nil {
+	case vcsPlugin.GitHubCom, vcsPlugin.GitHubEnterprise:
+		if err := s.setupVCSSQLReviewAction(ctx, repository, branch, github.SQLReviewActionFilePath, github.SetupSQLReviewCI(sqlReviewEndpoint)); err != nil {
+			return nil, err
+		}
+	case vcsPlugin.Gitea:
+		if err := s.setupVCSSQLReviewAction(ctx, repository, branch, gitea.SQLReviewActionFilePath, gitea.SetupSQLReviewCI(sqlReviewEndpoint)); err != nil {
 			return nil, err
 		}
 	case vcsPlugin.GitLabSelfHost:
 		if err := s.setupVCSSQLReviewCIForGitLab(ctx, repository, branch, sqlReviewEndpoint); err != nil {
 			return nil, err
 		}
+	case vcsPlugin.Bitbucket:
+		if err := s.setupVCSSQLReviewCIForBitbucket(ctx, repository, branch, sqlReviewEndpoint); err != nil {
+			return nil, err
+		}
 	}
 
-	return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).CreatePullRequest(
+	return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).CreatePullRequest(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -972,7 +995,7 @@ func (s *Server) setupVCSSQLReviewCI(ctx context.Context, repository *api.Reposi
 
 // setupVCSSQLReviewBranch will create a new branch to setup SQL review CI.
 func (s *Server) setupVCSSQLReviewBranch(ctx context.Context, repository *api.Repository) (*vcsPlugin.BranchInfo, error) {
-	branch, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).GetBranch(
+	branch, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).GetBranch(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -994,7 +1017,7 @@ func (s *Server) setupVCSSQLReviewBranch(ctx context.Context, repository *api.Re
 		Name:         fmt.Sprintf("bytebase-vcs-%d", time.Now().Unix()),
 		LastCommitID: branch.LastCommitID,
 	}
-	if err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).CreateBranch(
+	if err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).CreateBranch(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -1013,12 +1036,11 @@ func (s *Server) setupVCSSQLReviewBranch(ctx context.Context, repository *api.Re
 	return branchCreate, nil
 }
 
-// setupVCSSQLReviewCIForGitHub will create the pull request in GitHub to setup SQL review action.
-func (s *Server) setupVCSSQLReviewCIForGitHub(ctx context.Context, repository *api.Repository, branch *vcsPlugin.BranchInfo, sqlReviewEndpoint string) error {
-	sqlReviewConfig := github.SetupSQLReviewCI(sqlReviewEndpoint)
+// setupVCSSQLReviewAction will create or update the SQL review action in GitHub, or Gitea whose Actions is compatible with GitHub Actions.
+func (s *Server) setupVCSSQLReviewAction(ctx context.Context, repository *api.Repository, branch *vcsPlugin.BranchInfo, actionFilePath, sqlReviewConfig string) error {
 	fileLastCommitID := ""
 
-	fileMeta, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).ReadFileMeta(
+	fileMeta, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).ReadFileMeta(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -1029,13 +1051,13 @@ func (s *Server) setupVCSSQLReviewCIForGitHub(ctx context.Context, repository *a
 		},
 		repository.VCS.InstanceURL,
 		repository.ExternalID,
-		github.SQLReviewActionFilePath,
+		actionFilePath,
 		branch.Name,
 	)
 	if err != nil {
 		log.Debug(
 			"Failed to get file meta",
-			zap.String("file", github.SQLReviewActionFilePath),
+			zap.String("file", actionFilePath),
 			zap.String("last_commit", branch.LastCommitID),
 			zap.Int("code", common.ErrorCode(err).Int()),
 			zap.Error(err),
@@ -1044,35 +1066,47 @@ func (s *Server) setupVCSSQLReviewCIForGitHub(ctx context.Context, repository *a
 		fileLastCommitID = fileMeta.LastCommitID
 	}
 
-	return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).CreateFile(
+	fileCommitCreate := vcsPlugin.FileCommitCreate{
+		Branch:        branch.Name,
+		CommitMessage: sqlReviewInVCSPRTitle,
+		Content:       sqlReviewConfig,
+		LastCommitID:  fileLastCommitID,
+	}
+	oauthCtx := common.OauthContext{
+		ClientID:     repository.VCS.ApplicationID,
+		ClientSecret: repository.VCS.Secret,
+		AccessToken:  repository.AccessToken,
+		RefreshToken: repository.RefreshToken,
+		Refresher:    utils.RefreshToken(ctx, s.store, repository.WebURL),
+	}
+	if fileLastCommitID != "" {
+		return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).OverwriteFile(
+			ctx,
+			oauthCtx,
+			repository.VCS.InstanceURL,
+			repository.ExternalID,
+			actionFilePath,
+			fileCommitCreate,
+		)
+	}
+	return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).CreateFile(
 		ctx,
-		common.OauthContext{
-			ClientID:     repository.VCS.ApplicationID,
-			ClientSecret: repository.VCS.Secret,
-			AccessToken:  repository.AccessToken,
-			RefreshToken: repository.RefreshToken,
-			Refresher:    utils.RefreshToken(ctx, s.store, repository.WebURL),
-		},
+		oauthCtx,
 		repository.VCS.InstanceURL,
 		repository.ExternalID,
-		github.SQLReviewActionFilePath,
-		vcsPlugin.FileCommitCreate{
-			Branch:        branch.Name,
-			CommitMessage: sqlReviewInVCSPRTitle,
-			Content:       sqlReviewConfig,
-			LastCommitID:  fileLastCommitID,
-		},
+		actionFilePath,
+		fileCommitCreate,
 	)
 }
 
 // setupVCSSQLReviewCIForGitLab will create or update SQL review related files in GitLab to setup SQL review CI.
 func (s *Server) setupVCSSQLReviewCIForGitLab(ctx context.Context, repository *api.Repository, branch *vcsPlugin.BranchInfo, sqlReviewEndpoint string) error {
 	// create or update the .gitlab-ci.yml
-	if err := s.createOrUpdateVCSSQLReviewFileForGitLab(ctx, repository, branch, gitlab.CIFilePath, func(fileMeta *vcsPlugin.FileMeta) (string, error) {
+	if err := s.createOrUpdateVCSSQLReviewFile(ctx, repository, branch, gitlab.CIFilePath, func(fileMeta *vcsPlugin.FileMeta) (string, error) {
 		content := make(map[string]interface{})
 
 		if fileMeta != nil {
-			ciFileContent, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).ReadFileContent(
+			ciFileContent, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).ReadFileContent(
 				ctx,
 				common.OauthContext{
 					ClientID:     repository.VCS.ApplicationID,
@@ -1105,13 +1139,45 @@ func (s *Server) setupVCSSQLReviewCIForGitLab(ctx context.Context, repository *a
 	}
 
 	// create or update the SQL review CI.
-	return s.createOrUpdateVCSSQLReviewFileForGitLab(ctx, repository, branch, gitlab.SQLReviewCIFilePath, func(_ *vcsPlugin.FileMeta) (string, error) {
+	return s.createOrUpdateVCSSQLReviewFile(ctx, repository, branch, gitlab.SQLReviewCIFilePath, func(_ *vcsPlugin.FileMeta) (string, error) {
 		return gitlab.SetupSQLReviewCI(sqlReviewEndpoint), nil
 	})
 }
 
-// createOrUpdateVCSSQLReviewFileForGitLab will create or update SQL review file for GitLab CI.
-func (s *Server) createOrUpdateVCSSQLReviewFileForGitLab(
+// setupVCSSQLReviewCIForBitbucket will create or update the bitbucket-pipelines.yml in Bitbucket Cloud to setup SQL review CI.
+func (s *Server) setupVCSSQLReviewCIForBitbucket(ctx context.Context, repository *api.Repository, branch *vcsPlugin.BranchInfo, sqlReviewEndpoint string) error {
+	return s.createOrUpdateVCSSQLReviewFile(ctx, repository, branch, bitbucket.PipelinesFilePath, func(fileMeta *vcsPlugin.FileMeta) (string, error) {
+		content := make(map[string]interface{})
+
+		if fileMeta != nil {
+			pipelinesFileContent, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).ReadFileContent(
+				ctx,
+				common.OauthContext{
+					ClientID:     repository.VCS.ApplicationID,
+					ClientSecret: repository.VCS.Secret,
+					AccessToken:  repository.AccessToken,
+					RefreshToken: repository.RefreshToken,
+					Refresher:    utils.RefreshToken(ctx, s.store, repository.WebURL),
+				},
+				repository.VCS.InstanceURL,
+				repository.ExternalID,
+				bitbucket.PipelinesFilePath,
+				branch.Name,
+			)
+			if err != nil {
+				return "", err
+			}
+			if err := yaml.Unmarshal([]byte(pipelinesFileContent), &content); err != nil {
+				return "", err
+			}
+		}
+
+		return bitbucket.SetupBitbucketPipelines(content, sqlReviewEndpoint)
+	})
+}
+
+// createOrUpdateVCSSQLReviewFile will create or update SQL review file for GitLab CI or Bitbucket Pipelines.
+func (s *Server) createOrUpdateVCSSQLReviewFile(
 	ctx context.Context,
 	repository *api.Repository,
 	branch *vcsPlugin.BranchInfo,
@@ -1119,7 +1185,7 @@ func (s *Server) createOrUpdateVCSSQLReviewFileForGitLab(
 	getNewContent func(meta *vcsPlugin.FileMeta) (string, error),
 ) error {
 	fileExisted := true
-	fileMeta, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).ReadFileMeta(
+	fileMeta, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).ReadFileMeta(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -1153,7 +1219,7 @@ func (s *Server) createOrUpdateVCSSQLReviewFileForGitLab(
 	}
 
 	if fileExisted {
-		return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).OverwriteFile(
+		return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).OverwriteFile(
 			ctx,
 			common.OauthContext{
 				ClientID:     repository.VCS.ApplicationID,
@@ -1174,7 +1240,7 @@ func (s *Server) createOrUpdateVCSSQLReviewFileForGitLab(
 		)
 	}
 
-	return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).CreateFile(
+	return vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).CreateFile(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -1194,11 +1260,11 @@ func (s *Server) createOrUpdateVCSSQLReviewFileForGitLab(
 	)
 }
 
-func (s *Server) createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, webhookEndpointID, secretToken, accessToken, instanceURL, externalRepoID string) (string, error) {
+func (s *Server) createVCSWebhook(ctx context.Context, vcs *api.VCS, webhookEndpointID, secretToken, accessToken, externalRepoID string) (string, error) {
 	// Create a new webhook and retrieve the created webhook ID
 	var webhookCreatePayload []byte
 	var err error
-	switch vcsType {
+	switch vcs.Type {
 	case vcsPlugin.GitLabSelfHost:
 		webhookCreate := gitlab.WebhookCreate{
 			URL:                   fmt.Sprintf("%s/hook/gitlab/%s", s.profile.ExternalURL, webhookEndpointID),
@@ -1210,7 +1276,7 @@ func (s *Server) createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, w
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
-	case vcsPlugin.GitHubCom:
+	case vcsPlugin.GitHubCom, vcsPlugin.GitHubEnterprise:
 		webhookPost := github.WebhookCreateOrUpdate{
 			Config: github.WebhookConfig{
 				URL:         fmt.Sprintf("%s/hook/github/%s", s.profile.ExternalURL, webhookEndpointID),
@@ -1224,15 +1290,41 @@ func (s *Server) createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, w
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
+	case vcsPlugin.Gitea:
+		webhookPost := gitea.WebhookCreateOrUpdate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/gitea/%s", s.profile.ExternalURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      secretToken,
+			},
+			Events: []string{string(gitea.WebhookPush)},
+			Active: true,
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+	case vcsPlugin.Bitbucket:
+		webhookPost := bitbucket.WebhookCreateOrUpdate{
+			Name:   "Bytebase GitOps",
+			URL:    fmt.Sprintf("%s/hook/bitbucket/%s", s.profile.ExternalURL, webhookEndpointID),
+			Secret: secretToken,
+			Active: true,
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
 	}
-	webhookID, err := vcsPlugin.Get(vcsType, vcsPlugin.ProviderConfig{}).CreateWebhook(
+	webhookID, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).CreateWebhook(
 		ctx,
 		common.OauthContext{
 			AccessToken: accessToken,
 			// We use refreshTokenNoop() because the repository isn't created yet.
 			Refresher: refreshTokenNoop(),
 		},
-		instanceURL,
+		vcs.InstanceURL,
 		externalRepoID,
 		webhookCreatePayload,
 	)
@@ -1250,7 +1342,7 @@ func refreshTokenNoop() common.TokenRefresher {
 }
 
 func isBranchNotFound(ctx context.Context, vcs *api.VCS, accessToken, refreshToken, externalID, branch string) (bool, error) {
-	_, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).GetBranch(ctx,
+	_, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).GetBranch(ctx,
 		common.OauthContext{
 			ClientID:     vcs.ApplicationID,
 			ClientSecret: vcs.Secret,
@@ -52,7 +52,7 @@ func (s *Server) registerProjectMemberRoutes(g *echo.Group) {
 		if vcs == nil {
 			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("VCS not found with ID: %d", repo.VCSID))
 		}
-		vcsProjectMemberList, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{}).FetchRepositoryActiveMemberList(ctx,
+		vcsProjectMemberList, err := vcsPlugin.Get(vcs.Type, vcsPlugin.ProviderConfig{CACert: vcs.CACert}).FetchRepositoryActiveMemberList(ctx,
 			common.OauthContext{
 				ClientID:     vcs.ApplicationID,
 				ClientSecret: vcs.Secret,
@@ -127,6 +127,12 @@ func (s *Server) registerProjectMemberRoutes(g *echo.Group) {
 			roleProvider = api.ProjectRoleProviderGitLabSelfHost
 		case vcsPlugin.GitHubCom:
 			roleProvider = api.ProjectRoleProviderGitHubCom
+		case vcsPlugin.GitHubEnterprise:
+			roleProvider = api.ProjectRoleProviderGitHubEnterprise
+		case vcsPlugin.Gitea:
+			roleProvider = api.ProjectRoleProviderGitea
+		case vcsPlugin.Bitbucket:
+			roleProvider = api.ProjectRoleProviderBitbucket
 		default:
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Unrecognized VCS type %q", vcs.Type))
 		}
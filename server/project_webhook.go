@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -192,6 +193,7 @@ func (s *Server) registerProjectWebhookRoutes(g *echo.Group) {
 			webhook.Type,
 			webhookPlugin.Context{
 				URL:          webhook.URL,
+				Secret:       webhook.Secret,
 				Level:        webhookPlugin.WebhookInfo,
 				ActivityType: string(api.ActivityIssueCreate),
 				Title:        fmt.Sprintf("Test webhook %q", webhook.Name),
@@ -215,6 +217,127 @@ func (s *Server) registerProjectWebhookRoutes(g *echo.Group) {
 		}
 		return nil
 	})
+
+	g.GET("/project/:projectID/webhook/:webhookID/delivery", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		projectID, err := strconv.Atoi(c.Param("projectID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Project ID is not a number: %s", c.Param("projectID"))).SetInternal(err)
+		}
+
+		id, err := strconv.Atoi(c.Param("webhookID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Project webhook ID is not a number: %s", c.Param("webhookID"))).SetInternal(err)
+		}
+
+		// The route ACL only checks the project in the path, so the webhook must belong to it.
+		webhook, err := s.store.GetProjectWebhookByID(ctx, id)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch project webhook ID: %v", id)).SetInternal(err)
+		}
+		if webhook == nil || webhook.ProjectID != projectID {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Project webhook ID not found: %d", id))
+		}
+
+		limit := projectWebhookDeliveryListLimit
+		deliveries, err := s.store.ListProjectWebhookDeliveries(ctx, &store.FindProjectWebhookDeliveryMessage{
+			ProjectWebhookID: &id,
+			Limit:            &limit,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch deliveries for project webhook ID: %v", id)).SetInternal(err)
+		}
+		deliveryList := []*api.ProjectWebhookDelivery{}
+		for _, delivery := range deliveries {
+			deliveryList = append(deliveryList, convertProjectWebhookDelivery(delivery))
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, deliveryList); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to marshal project webhook delivery list response: %v", id)).SetInternal(err)
+		}
+		return nil
+	})
+
+	g.POST("/project/:projectID/webhook/:webhookID/delivery/:deliveryID/redeliver", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		projectID, err := strconv.Atoi(c.Param("projectID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Project ID is not a number: %s", c.Param("projectID"))).SetInternal(err)
+		}
+
+		webhookID, err := strconv.Atoi(c.Param("webhookID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Project webhook ID is not a number: %s", c.Param("webhookID"))).SetInternal(err)
+		}
+
+		// The route ACL only checks the project in the path, so the webhook must belong to it.
+		webhook, err := s.store.GetProjectWebhookByID(ctx, webhookID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch project webhook ID: %v", webhookID)).SetInternal(err)
+		}
+		if webhook == nil || webhook.ProjectID != projectID {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Project webhook ID not found: %d", webhookID))
+		}
+
+		id, err := strconv.Atoi(c.Param("deliveryID"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Project webhook delivery ID is not a number: %s", c.Param("deliveryID"))).SetInternal(err)
+		}
+
+		delivery, err := s.store.GetProjectWebhookDelivery(ctx, &store.FindProjectWebhookDeliveryMessage{
+			ID:               &id,
+			ProjectWebhookID: &webhookID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch project webhook delivery ID: %v", id)).SetInternal(err)
+		}
+		if delivery == nil {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Project webhook delivery ID not found: %d", id))
+		}
+
+		// Redelivering creates a new delivery with the same payload, so the original one is kept in the delivery log.
+		redelivery, err := s.store.CreateProjectWebhookDelivery(ctx, &store.ProjectWebhookDeliveryMessage{
+			ProjectWebhookID: delivery.ProjectWebhookID,
+			Status:           api.ProjectWebhookDeliveryPending,
+			Payload:          delivery.Payload,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to redeliver project webhook delivery ID: %v", id)).SetInternal(err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		if err := jsonapi.MarshalPayload(c.Response().Writer, convertProjectWebhookDelivery(redelivery)); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to marshal project webhook redelivery response: %v", id)).SetInternal(err)
+		}
+		return nil
+	})
+}
+
+// projectWebhookDeliveryListLimit is the maximum number of the deliveries returned in the delivery log.
+const projectWebhookDeliveryListLimit = 100
+
+func convertProjectWebhookDelivery(delivery *store.ProjectWebhookDeliveryMessage) *api.ProjectWebhookDelivery {
+	result := &api.ProjectWebhookDelivery{
+		ID:               delivery.ID,
+		CreatedTs:        delivery.CreatedTs,
+		UpdatedTs:        delivery.UpdatedTs,
+		ProjectWebhookID: delivery.ProjectWebhookID,
+		Status:           delivery.Status,
+		Attempt:          delivery.Attempt,
+		NextAttemptTs:    delivery.NextAttemptTs,
+		Request:          delivery.Request,
+		ResponseCode:     delivery.ResponseCode,
+		ResponseBody:     delivery.ResponseBody,
+		LatencyMs:        delivery.LatencyMs,
+		Error:            delivery.Error,
+	}
+	var webhookCtx webhookPlugin.Context
+	if err := json.Unmarshal([]byte(delivery.Payload), &webhookCtx); err == nil {
+		result.ActivityType = webhookCtx.ActivityType
+		result.Title = webhookCtx.Title
+	}
+	return result
 }
 
 // getProjectSlug is the slug formatter for Project.
@@ -0,0 +1,348 @@
+package anomaly
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytebase/bytebase/api"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type tableKey struct {
+	schema string
+	table  string
+}
+
+func getSortedTableKeys(expect, actual map[tableKey]string) []tableKey {
+	keySet := make(map[tableKey]bool)
+	for key := range expect {
+		keySet[key] = true
+	}
+	for key := range actual {
+		keySet[key] = true
+	}
+	var keys []tableKey
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].schema != keys[j].schema {
+			return keys[i].schema < keys[j].schema
+		}
+		return keys[i].table < keys[j].table
+	})
+	return keys
+}
+
+func getSortedNames(expect, actual map[string]string) []string {
+	nameSet := make(map[string]bool)
+	for name := range expect {
+		nameSet[name] = true
+	}
+	for name := range actual {
+		nameSet[name] = true
+	}
+	var names []string
+	for name := range nameSet {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// schemaObjects is the flattened view of the objects in database metadata that schema drift detection cares about.
+// The values are the definition summaries of the objects.
+// The schema level objects such as functions are keyed by the schema with an empty table name.
+type schemaObjects struct {
+	schemas     map[tableKey]string
+	tables      map[tableKey]string
+	views       map[tableKey]string
+	columns     map[tableKey]map[string]string
+	indexes     map[tableKey]map[string]string
+	foreignKeys map[tableKey]map[string]string
+	triggers    map[tableKey]map[string]string
+	functions   map[tableKey]map[string]string
+	procedures  map[tableKey]map[string]string
+	sequences   map[tableKey]map[string]string
+	enumTypes   map[tableKey]map[string]string
+}
+
+func getSchemaObjects(metadata *storepb.DatabaseMetadata) *schemaObjects {
+	objects := &schemaObjects{
+		schemas:     make(map[tableKey]string),
+		tables:      make(map[tableKey]string),
+		views:       make(map[tableKey]string),
+		columns:     make(map[tableKey]map[string]string),
+		indexes:     make(map[tableKey]map[string]string),
+		foreignKeys: make(map[tableKey]map[string]string),
+		triggers:    make(map[tableKey]map[string]string),
+		functions:   make(map[tableKey]map[string]string),
+		procedures:  make(map[tableKey]map[string]string),
+		sequences:   make(map[tableKey]map[string]string),
+		enumTypes:   make(map[tableKey]map[string]string),
+	}
+	if metadata == nil {
+		return objects
+	}
+	for _, schema := range metadata.Schemas {
+		schemaKey := tableKey{schema: schema.Name}
+		objects.schemas[schemaKey] = ""
+		objects.functions[schemaKey] = make(map[string]string)
+		for _, function := range schema.Functions {
+			objects.functions[schemaKey][getRoutineName(function.Name, function.Arguments)] = getFunctionDefinition(function)
+		}
+		objects.procedures[schemaKey] = make(map[string]string)
+		for _, procedure := range schema.Procedures {
+			objects.procedures[schemaKey][getRoutineName(procedure.Name, procedure.Arguments)] = getProcedureDefinition(procedure)
+		}
+		objects.sequences[schemaKey] = make(map[string]string)
+		for _, sequence := range schema.Sequences {
+			objects.sequences[schemaKey][sequence.Name] = getSequenceDefinition(sequence)
+		}
+		objects.enumTypes[schemaKey] = make(map[string]string)
+		for _, enumType := range schema.EnumTypes {
+			objects.enumTypes[schemaKey][enumType.Name] = getEnumTypeDefinition(enumType)
+		}
+		for _, table := range schema.Tables {
+			key := tableKey{schema: schema.Name, table: table.Name}
+			objects.tables[key] = getTableDefinition(table)
+			objects.columns[key] = make(map[string]string)
+			for _, column := range table.Columns {
+				objects.columns[key][column.Name] = getColumnDefinition(column)
+			}
+			objects.indexes[key] = make(map[string]string)
+			for _, index := range table.Indexes {
+				objects.indexes[key][index.Name] = getIndexDefinition(index)
+			}
+			objects.foreignKeys[key] = make(map[string]string)
+			for _, foreignKey := range table.ForeignKeys {
+				objects.foreignKeys[key][foreignKey.Name] = getForeignKeyDefinition(foreignKey)
+			}
+			objects.triggers[key] = make(map[string]string)
+			for _, trigger := range table.Triggers {
+				objects.triggers[key][trigger.Name] = getTriggerDefinition(trigger)
+			}
+		}
+		for _, view := range schema.Views {
+			objects.views[tableKey{schema: schema.Name, table: view.Name}] = strings.TrimSpace(view.Definition)
+		}
+	}
+	return objects
+}
+
+// getTableDefinition summarizes the table options.
+// Statistics such as row count and data size are left out since they change without schema changes.
+func getTableDefinition(table *storepb.TableMetadata) string {
+	var parts []string
+	if table.Engine != "" {
+		parts = append(parts, fmt.Sprintf("ENGINE=%s", table.Engine))
+	}
+	if table.Collation != "" {
+		parts = append(parts, fmt.Sprintf("COLLATE=%s", table.Collation))
+	}
+	if table.Comment != "" {
+		parts = append(parts, fmt.Sprintf("COMMENT=%q", table.Comment))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getColumnDefinition(column *storepb.ColumnMetadata) string {
+	parts := []string{column.Type}
+	if column.CharacterSet != "" {
+		parts = append(parts, fmt.Sprintf("CHARACTER SET %s", column.CharacterSet))
+	}
+	if column.Collation != "" {
+		parts = append(parts, fmt.Sprintf("COLLATE %s", column.Collation))
+	}
+	if !column.Nullable {
+		parts = append(parts, "NOT NULL")
+	}
+	if column.Default != nil {
+		parts = append(parts, fmt.Sprintf("DEFAULT %s", column.Default.Value))
+	}
+	if column.Comment != "" {
+		parts = append(parts, fmt.Sprintf("COMMENT %q", column.Comment))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getIndexDefinition(index *storepb.IndexMetadata) string {
+	var parts []string
+	switch {
+	case index.Primary:
+		parts = append(parts, "PRIMARY KEY")
+	case index.Unique:
+		parts = append(parts, "UNIQUE INDEX")
+	default:
+		parts = append(parts, "INDEX")
+	}
+	parts = append(parts, fmt.Sprintf("(%s)", strings.Join(index.Expressions, ", ")))
+	if index.Type != "" {
+		parts = append(parts, fmt.Sprintf("USING %s", index.Type))
+	}
+	if !index.Visible {
+		parts = append(parts, "INVISIBLE")
+	}
+	if index.Comment != "" {
+		parts = append(parts, fmt.Sprintf("COMMENT %q", index.Comment))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getForeignKeyDefinition(foreignKey *storepb.ForeignKeyMetadata) string {
+	referencedTable := foreignKey.ReferencedTable
+	if foreignKey.ReferencedSchema != "" {
+		referencedTable = fmt.Sprintf("%s.%s", foreignKey.ReferencedSchema, foreignKey.ReferencedTable)
+	}
+	parts := []string{
+		fmt.Sprintf("(%s) REFERENCES %s (%s)", strings.Join(foreignKey.Columns, ", "), referencedTable, strings.Join(foreignKey.ReferencedColumns, ", ")),
+	}
+	if foreignKey.OnDelete != "" {
+		parts = append(parts, fmt.Sprintf("ON DELETE %s", foreignKey.OnDelete))
+	}
+	if foreignKey.OnUpdate != "" {
+		parts = append(parts, fmt.Sprintf("ON UPDATE %s", foreignKey.OnUpdate))
+	}
+	if foreignKey.MatchType != "" {
+		parts = append(parts, fmt.Sprintf("MATCH %s", foreignKey.MatchType))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getTriggerDefinition(trigger *storepb.TriggerMetadata) string {
+	return fmt.Sprintf("%s %s %s", trigger.Timing, trigger.Event, strings.TrimSpace(trigger.Definition))
+}
+
+// getRoutineName returns the name of a function or procedure with its arguments, so that the overloaded ones are told apart.
+func getRoutineName(name, arguments string) string {
+	if arguments == "" {
+		return name
+	}
+	return fmt.Sprintf("%s(%s)", name, arguments)
+}
+
+func getFunctionDefinition(function *storepb.FunctionMetadata) string {
+	var parts []string
+	if function.ReturnType != "" {
+		parts = append(parts, fmt.Sprintf("RETURNS %s", function.ReturnType))
+	}
+	parts = append(parts, strings.TrimSpace(function.Definition))
+	if function.Comment != "" {
+		parts = append(parts, fmt.Sprintf("COMMENT %q", function.Comment))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getProcedureDefinition(procedure *storepb.ProcedureMetadata) string {
+	parts := []string{strings.TrimSpace(procedure.Definition)}
+	if procedure.Comment != "" {
+		parts = append(parts, fmt.Sprintf("COMMENT %q", procedure.Comment))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getSequenceDefinition(sequence *storepb.SequenceMetadata) string {
+	parts := []string{
+		fmt.Sprintf("AS %s START %d INCREMENT %d MINVALUE %d MAXVALUE %d", sequence.DataType, sequence.Start, sequence.Increment, sequence.MinValue, sequence.MaxValue),
+	}
+	if sequence.Cycle {
+		parts = append(parts, "CYCLE")
+	}
+	if sequence.OwnerTable != "" {
+		parts = append(parts, fmt.Sprintf("OWNED BY %s.%s", sequence.OwnerTable, sequence.OwnerColumn))
+	}
+	return strings.Join(parts, " ")
+}
+
+func getEnumTypeDefinition(enumType *storepb.EnumTypeMetadata) string {
+	var values []string
+	for _, value := range enumType.Values {
+		values = append(values, fmt.Sprintf("%q", value))
+	}
+	return fmt.Sprintf("ENUM (%s)", strings.Join(values, ", "))
+}
+
+func newSchemaDriftItem(objectType api.SchemaDriftObjectType, key tableKey, name string, expect, actual string, hasExpect, hasActual bool) *api.SchemaDriftItem {
+	item := &api.SchemaDriftItem{
+		ObjectType: objectType,
+		Schema:     key.schema,
+		Table:      key.table,
+		Name:       name,
+		Expect:     expect,
+		Actual:     actual,
+	}
+	switch {
+	case !hasExpect:
+		item.Action = api.SchemaDriftAdded
+	case !hasActual:
+		item.Action = api.SchemaDriftRemoved
+	default:
+		item.Action = api.SchemaDriftChanged
+	}
+	return item
+}
+
+// compareNamedObjects compares the columns, indexes, foreign keys or triggers of a table,
+// or the functions, procedures, sequences or enum types of a schema.
+func compareNamedObjects(objectType api.SchemaDriftObjectType, key tableKey, expect, actual map[string]string, policy *api.SchemaDriftPolicy) []*api.SchemaDriftItem {
+	var driftList []*api.SchemaDriftItem
+	for _, name := range getSortedNames(expect, actual) {
+		expectDefinition, hasExpect := expect[name]
+		actualDefinition, hasActual := actual[name]
+		if hasExpect && hasActual && expectDefinition == actualDefinition {
+			continue
+		}
+		if policy.IsIgnored(key.schema, key.table, name) {
+			continue
+		}
+		driftList = append(driftList, newSchemaDriftItem(objectType, key, name, expectDefinition, actualDefinition, hasExpect, hasActual))
+	}
+	return driftList
+}
+
+// compareSchemaDrift compares the expected database metadata recorded after the latest migration with the actual one.
+// Returns the object level drifts except for the ones ignored by the schema drift policy.
+func compareSchemaDrift(expect, actual *storepb.DatabaseMetadata, policy *api.SchemaDriftPolicy) []*api.SchemaDriftItem {
+	var driftList []*api.SchemaDriftItem
+	expectObjects := getSchemaObjects(expect)
+	actualObjects := getSchemaObjects(actual)
+
+	for _, key := range getSortedTableKeys(expectObjects.tables, actualObjects.tables) {
+		expectDefinition, hasExpect := expectObjects.tables[key]
+		actualDefinition, hasActual := actualObjects.tables[key]
+		if !hasExpect || !hasActual {
+			if !policy.IsIgnored(key.schema, key.table, "") {
+				driftList = append(driftList, newSchemaDriftItem(api.SchemaDriftObjectTable, key, "", expectDefinition, actualDefinition, hasExpect, hasActual))
+			}
+			continue
+		}
+		if expectDefinition != actualDefinition && !policy.IsIgnored(key.schema, key.table, "") {
+			driftList = append(driftList, newSchemaDriftItem(api.SchemaDriftObjectTable, key, "", expectDefinition, actualDefinition, hasExpect, hasActual))
+		}
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectColumn, key, expectObjects.columns[key], actualObjects.columns[key], policy)...)
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectIndex, key, expectObjects.indexes[key], actualObjects.indexes[key], policy)...)
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectForeignKey, key, expectObjects.foreignKeys[key], actualObjects.foreignKeys[key], policy)...)
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectTrigger, key, expectObjects.triggers[key], actualObjects.triggers[key], policy)...)
+	}
+
+	for _, key := range getSortedTableKeys(expectObjects.views, actualObjects.views) {
+		expectDefinition, hasExpect := expectObjects.views[key]
+		actualDefinition, hasActual := actualObjects.views[key]
+		if hasExpect && hasActual && expectDefinition == actualDefinition {
+			continue
+		}
+		if policy.IsIgnored(key.schema, key.table, "") {
+			continue
+		}
+		driftList = append(driftList, newSchemaDriftItem(api.SchemaDriftObjectView, key, "", expectDefinition, actualDefinition, hasExpect, hasActual))
+	}
+
+	for _, key := range getSortedTableKeys(expectObjects.schemas, actualObjects.schemas) {
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectFunction, key, expectObjects.functions[key], actualObjects.functions[key], policy)...)
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectProcedure, key, expectObjects.procedures[key], actualObjects.procedures[key], policy)...)
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectSequence, key, expectObjects.sequences[key], actualObjects.sequences[key], policy)...)
+		driftList = append(driftList, compareNamedObjects(api.SchemaDriftObjectEnumType, key, expectObjects.enumTypes[key], actualObjects.enumTypes[key], policy)...)
+	}
+	return driftList
+}
@@ -0,0 +1,218 @@
+package anomaly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/api"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCompareSchemaDrift(t *testing.T) {
+	expect := &storepb.DatabaseMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "name", Type: "text", Nullable: true},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "t1_pkey", Expressions: []string{"id"}, Primary: true, Unique: true, Visible: true},
+						},
+						RowCount: 10,
+					},
+					{
+						Name: "t2",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "t1_id", Type: "integer"},
+						},
+						ForeignKeys: []*storepb.ForeignKeyMetadata{
+							{Name: "t2_t1_fk", Columns: []string{"t1_id"}, ReferencedSchema: "public", ReferencedTable: "t1", ReferencedColumns: []string{"id"}},
+						},
+					},
+					{
+						Name: "tmp_cache",
+					},
+				},
+				Views: []*storepb.ViewMetadata{
+					{Name: "v1", Definition: "SELECT id FROM t1"},
+				},
+			},
+		},
+	}
+	actual := &storepb.DatabaseMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "name", Type: "varchar(64)", Nullable: true},
+							{Name: "created_ts", Type: "bigint"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "t1_pkey", Expressions: []string{"id"}, Primary: true, Unique: true, Visible: true},
+							{Name: "t1_name_idx", Expressions: []string{"name"}, Visible: true},
+						},
+						RowCount: 20,
+						DataSize: 8192,
+					},
+					{
+						Name: "t2",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "t1_id", Type: "integer"},
+						},
+					},
+					{
+						Name: "t3",
+					},
+				},
+				Views: []*storepb.ViewMetadata{
+					{Name: "v1", Definition: "SELECT id, name FROM t1"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		policy *api.SchemaDriftPolicy
+		want   []*api.SchemaDriftItem
+	}{
+		{
+			policy: nil,
+			want: []*api.SchemaDriftItem{
+				{Action: api.SchemaDriftAdded, ObjectType: api.SchemaDriftObjectColumn, Schema: "public", Table: "t1", Name: "created_ts", Actual: "bigint NOT NULL"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectColumn, Schema: "public", Table: "t1", Name: "name", Expect: "text", Actual: "varchar(64)"},
+				{Action: api.SchemaDriftAdded, ObjectType: api.SchemaDriftObjectIndex, Schema: "public", Table: "t1", Name: "t1_name_idx", Actual: "INDEX (name)"},
+				{Action: api.SchemaDriftRemoved, ObjectType: api.SchemaDriftObjectForeignKey, Schema: "public", Table: "t2", Name: "t2_t1_fk", Expect: "(t1_id) REFERENCES public.t1 (id)"},
+				{Action: api.SchemaDriftAdded, ObjectType: api.SchemaDriftObjectTable, Schema: "public", Table: "t3"},
+				{Action: api.SchemaDriftRemoved, ObjectType: api.SchemaDriftObjectTable, Schema: "public", Table: "tmp_cache"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectView, Schema: "public", Table: "v1", Expect: "SELECT id FROM t1", Actual: "SELECT id, name FROM t1"},
+			},
+		},
+		{
+			policy: &api.SchemaDriftPolicy{
+				IgnoreList: []api.SchemaDriftIgnore{
+					{Table: "tmp_*"},
+					{Table: "t1", Object: "*_idx"},
+					{Schema: "public", Table: "t2"},
+					{Table: "v1"},
+				},
+			},
+			want: []*api.SchemaDriftItem{
+				{Action: api.SchemaDriftAdded, ObjectType: api.SchemaDriftObjectColumn, Schema: "public", Table: "t1", Name: "created_ts", Actual: "bigint NOT NULL"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectColumn, Schema: "public", Table: "t1", Name: "name", Expect: "text", Actual: "varchar(64)"},
+				{Action: api.SchemaDriftAdded, ObjectType: api.SchemaDriftObjectTable, Schema: "public", Table: "t3"},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, compareSchemaDrift(expect, actual, test.policy))
+	}
+	a.Empty(compareSchemaDrift(expect, expect, nil))
+}
+
+func TestCompareSchemaDriftSchemaObjects(t *testing.T) {
+	expect := &storepb.DatabaseMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Triggers: []*storepb.TriggerMetadata{
+							{Name: "t1_audit", Timing: "AFTER", Event: "INSERT", Definition: "EXECUTE FUNCTION audit()"},
+						},
+					},
+				},
+				Functions: []*storepb.FunctionMetadata{
+					{Name: "add", Arguments: "a integer, b integer", ReturnType: "integer", Definition: "SELECT a + b"},
+					{Name: "audit", ReturnType: "trigger", Definition: "BEGIN RETURN NEW; END"},
+				},
+				Procedures: []*storepb.ProcedureMetadata{
+					{Name: "cleanup", Definition: "DELETE FROM t1"},
+				},
+				Sequences: []*storepb.SequenceMetadata{
+					{Name: "t1_id_seq", DataType: "integer", Start: 1, Increment: 1, MinValue: 1, MaxValue: 2147483647, OwnerTable: "t1", OwnerColumn: "id"},
+				},
+				EnumTypes: []*storepb.EnumTypeMetadata{
+					{Name: "mood", Values: []string{"sad", "happy"}},
+				},
+			},
+		},
+	}
+	actual := &storepb.DatabaseMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Triggers: []*storepb.TriggerMetadata{
+							{Name: "t1_audit", Timing: "BEFORE", Event: "INSERT", Definition: "EXECUTE FUNCTION audit()"},
+						},
+					},
+				},
+				Functions: []*storepb.FunctionMetadata{
+					{Name: "add", Arguments: "a integer, b integer", ReturnType: "integer", Definition: "SELECT a + b"},
+					{Name: "add", Arguments: "a bigint, b bigint", ReturnType: "bigint", Definition: "SELECT a + b"},
+					{Name: "audit", ReturnType: "trigger", Definition: "BEGIN RETURN NULL; END"},
+				},
+				Sequences: []*storepb.SequenceMetadata{
+					{Name: "t1_id_seq", DataType: "integer", Start: 1, Increment: 2, MinValue: 1, MaxValue: 2147483647, OwnerTable: "t1", OwnerColumn: "id"},
+				},
+				EnumTypes: []*storepb.EnumTypeMetadata{
+					{Name: "mood", Values: []string{"sad", "ok", "happy"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		policy *api.SchemaDriftPolicy
+		want   []*api.SchemaDriftItem
+	}{
+		{
+			policy: nil,
+			want: []*api.SchemaDriftItem{
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectTrigger, Schema: "public", Table: "t1", Name: "t1_audit", Expect: "AFTER INSERT EXECUTE FUNCTION audit()", Actual: "BEFORE INSERT EXECUTE FUNCTION audit()"},
+				{Action: api.SchemaDriftAdded, ObjectType: api.SchemaDriftObjectFunction, Schema: "public", Name: "add(a bigint, b bigint)", Actual: "RETURNS bigint SELECT a + b"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectFunction, Schema: "public", Name: "audit", Expect: "RETURNS trigger BEGIN RETURN NEW; END", Actual: "RETURNS trigger BEGIN RETURN NULL; END"},
+				{Action: api.SchemaDriftRemoved, ObjectType: api.SchemaDriftObjectProcedure, Schema: "public", Name: "cleanup", Expect: "DELETE FROM t1"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectSequence, Schema: "public", Name: "t1_id_seq", Expect: "AS integer START 1 INCREMENT 1 MINVALUE 1 MAXVALUE 2147483647 OWNED BY t1.id", Actual: "AS integer START 1 INCREMENT 2 MINVALUE 1 MAXVALUE 2147483647 OWNED BY t1.id"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectEnumType, Schema: "public", Name: "mood", Expect: `ENUM ("sad", "happy")`, Actual: `ENUM ("sad", "ok", "happy")`},
+			},
+		},
+		{
+			policy: &api.SchemaDriftPolicy{
+				IgnoreList: []api.SchemaDriftIgnore{
+					{Object: "*audit"},
+					{Object: "add(*)"},
+					{Table: "t*", Object: "mood"},
+				},
+			},
+			want: []*api.SchemaDriftItem{
+				{Action: api.SchemaDriftRemoved, ObjectType: api.SchemaDriftObjectProcedure, Schema: "public", Name: "cleanup", Expect: "DELETE FROM t1"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectSequence, Schema: "public", Name: "t1_id_seq", Expect: "AS integer START 1 INCREMENT 1 MINVALUE 1 MAXVALUE 2147483647 OWNED BY t1.id", Actual: "AS integer START 1 INCREMENT 2 MINVALUE 1 MAXVALUE 2147483647 OWNED BY t1.id"},
+				{Action: api.SchemaDriftChanged, ObjectType: api.SchemaDriftObjectEnumType, Schema: "public", Name: "mood", Expect: `ENUM ("sad", "happy")`, Actual: `ENUM ("sad", "ok", "happy")`},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, compareSchemaDrift(expect, actual, test.policy))
+	}
+	a.Empty(compareSchemaDrift(actual, actual, nil))
+}
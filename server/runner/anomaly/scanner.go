@@ -314,11 +314,20 @@ func (s *Scanner) checkDatabaseAnomaly(ctx context.Context, instance *store.Inst
 			return
 		}
 		if len(list) > 0 {
-			if list[0].Schema != schemaBuf.String() {
+			drifted, driftList, err := s.checkSchemaDrift(ctx, driver, database, list[0].Version, list[0].Schema, schemaBuf.String())
+			if err != nil {
+				log.Error("Failed to check anomaly",
+					zap.String("instance", instance.ResourceID),
+					zap.String("database", database.DatabaseName),
+					zap.String("type", string(api.AnomalyDatabaseSchemaDrift)),
+					zap.Error(err))
+				return
+			}
+			if drifted {
 				anomalyPayload := api.AnomalyDatabaseSchemaDriftPayload{
-					Version: list[0].Version,
-					Expect:  list[0].Schema,
-					Actual:  schemaBuf.String(),
+					Version:   list[0].Version,
+					IssueID:   s.getSchemaDriftIssueID(ctx, database.UID),
+					DriftList: driftList,
 				}
 				payload, err := json.Marshal(anomalyPayload)
 				if err != nil {
@@ -359,6 +368,62 @@ func (s *Scanner) checkDatabaseAnomaly(ctx context.Context, instance *store.Inst
 	}
 }
 
+// checkSchemaDrift checks whether the database schema drifts from the one of the latest migration.
+// The database metadata is compared structurally against the expected metadata recorded after the latest migration,
+// and the drifts ignored by the schema drift policy of the database are excluded.
+// If no expected metadata has been recorded yet, it falls back to comparing the schema dumps.
+func (s *Scanner) checkSchemaDrift(ctx context.Context, driver db.Driver, database *store.DatabaseMessage, version, expectSchema, actualSchema string) (bool, []*api.SchemaDriftItem, error) {
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return false, nil, err
+	}
+	actualMetadata, err := driver.SyncDBSchema(ctx, database.DatabaseName)
+	if err != nil {
+		return false, nil, err
+	}
+	// The expected metadata is stale if the latest migration isn't recorded by Bytebase, such as the ones applied by bb CLI.
+	// Compare the schema dumps instead in that case.
+	if dbSchema == nil || dbSchema.ExpectedMetadata == nil || dbSchema.ExpectedMetadataVersion != version {
+		if expectSchema != actualSchema {
+			return true, nil, nil
+		}
+		// Record the synced metadata as the expected one if it's up to date, so that the later checks are structural.
+		if dbSchema != nil && len(compareSchemaDrift(dbSchema.Metadata, actualMetadata, nil /* policy */)) == 0 {
+			if err := s.store.UpdateDBSchemaExpectedMetadata(ctx, database.UID, version); err != nil {
+				return false, nil, err
+			}
+		}
+		return false, nil, nil
+	}
+
+	policy, err := s.store.GetSchemaDriftPolicy(ctx, database.UID)
+	if err != nil {
+		return false, nil, err
+	}
+	driftList := compareSchemaDrift(dbSchema.ExpectedMetadata, actualMetadata, policy)
+	return len(driftList) > 0, driftList, nil
+}
+
+// getSchemaDriftIssueID gets the ID of the issue reconciling the active schema drift anomaly of the database.
+// It's kept when the anomaly is refreshed, so that the anomaly stays linked to the issue.
+func (s *Scanner) getSchemaDriftIssueID(ctx context.Context, databaseID int) int {
+	normalRowStatus := api.Normal
+	anomalyType := api.AnomalyDatabaseSchemaDrift
+	anomalyList, err := s.store.FindAnomaly(ctx, &api.AnomalyFind{
+		RowStatus:  &normalRowStatus,
+		DatabaseID: &databaseID,
+		Type:       &anomalyType,
+	})
+	if err != nil || len(anomalyList) == 0 {
+		return 0
+	}
+	payload := &api.AnomalyDatabaseSchemaDriftPayload{}
+	if err := json.Unmarshal([]byte(anomalyList[0].Payload), payload); err != nil {
+		return 0
+	}
+	return payload.IssueID
+}
+
 func (s *Scanner) checkBackupAnomaly(ctx context.Context, environment *store.EnvironmentMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, policyMap map[int]*api.BackupPlanPolicy) {
 	schedule := api.BackupPlanPolicyScheduleUnset
 	backupSetting, err := s.store.GetBackupSettingByDatabaseID(ctx, database.UID)
@@ -1,10 +1,11 @@
-// Package apprun is an application runner for scanning Feishu approval instances.
+// Package apprun is an application runner for scanning the approval instances on the IM applications.
 package apprun
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -13,7 +14,7 @@ import (
 
 	"github.com/bytebase/bytebase/api"
 	"github.com/bytebase/bytebase/common/log"
-	"github.com/bytebase/bytebase/plugin/app/feishu"
+	"github.com/bytebase/bytebase/plugin/app"
 	"github.com/bytebase/bytebase/server/component/activity"
 	"github.com/bytebase/bytebase/server/component/config"
 	"github.com/bytebase/bytebase/server/utils"
@@ -21,12 +22,12 @@ import (
 )
 
 // NewRunner returns a runner.
-func NewRunner(store *store.Store, activityManager *activity.Manager, feishuProvider *feishu.Provider, profile config.Profile) *Runner {
+func NewRunner(store *store.Store, activityManager *activity.Manager, profile config.Profile) *Runner {
 	return &Runner{
 		store:           store,
 		activityManager: activityManager,
-		p:               feishuProvider,
 		profile:         profile,
+		providers:       make(map[api.IMType]app.Provider),
 	}
 }
 
@@ -34,8 +35,42 @@ func NewRunner(store *store.Store, activityManager *activity.Manager, feishuProv
 type Runner struct {
 	store           *store.Store
 	activityManager *activity.Manager
-	p               *feishu.Provider
 	profile         config.Profile
+
+	providerMu sync.Mutex
+	// providers caches the providers by IM type, because providers such as Feishu cache the access tokens.
+	providers map[api.IMType]app.Provider
+}
+
+// GetProvider returns the IM application provider of the IM type.
+func (r *Runner) GetProvider(imType api.IMType) (app.Provider, error) {
+	r.providerMu.Lock()
+	defer r.providerMu.Unlock()
+	if p, ok := r.providers[imType]; ok {
+		return p, nil
+	}
+	providerConfig := app.ProviderConfig{}
+	if imType == api.IMTypeFeishu {
+		providerConfig.APIURL = r.profile.FeishuAPIURL
+	}
+	p, err := app.Get(app.Type(imType), providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	r.providers[imType] = p
+	return p, nil
+}
+
+// GetAppConfig returns the IM application config of the IM setting.
+func (r *Runner) GetAppConfig(value *api.SettingAppIMValue) app.Config {
+	return app.Config{
+		AppID:                value.AppID,
+		AppSecret:            value.AppSecret,
+		ApprovalDefinitionID: value.ExternalApproval.ApprovalDefinitionID,
+		SigningSecret:        value.SigningSecret,
+		URL:                  value.URL,
+		CallbackURL:          fmt.Sprintf("%s/hook/app/%s", r.profile.ExternalURL, value.IMType),
+	}
 }
 
 // Run runs the ApplicationRunner.
@@ -52,27 +87,24 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 		select {
 		case <-ticker.C:
 			func() {
-				settingName := api.SettingAppIM
-				setting, err := r.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+				value, err := r.getIMSetting(ctx)
 				if err != nil {
 					if !errors.Is(err, context.Canceled) {
-						log.Error("failed to get IM setting", zap.String("settingName", string(settingName)), zap.Error(err))
+						log.Error("failed to get IM setting", zap.Error(err))
 					}
 					return
 				}
-				if setting == nil {
-					log.Error("cannot find IM setting")
+				if value == nil || !value.ExternalApproval.Enabled {
 					return
 				}
-				if setting.Value == "" {
+				approvalType, ok := api.ExternalApprovalTypeOf(value.IMType)
+				if !ok {
+					log.Error("unknown IM type", zap.String("imType", string(value.IMType)))
 					return
 				}
-				var value api.SettingAppIMValue
-				if err := json.Unmarshal([]byte(setting.Value), &value); err != nil {
-					log.Error("failed to unmarshal IM setting value", zap.String("settingName", string(settingName)), zap.Any("settingValue", setting.Value), zap.Error(err))
-					return
-				}
-				if !value.ExternalApproval.Enabled {
+				p, err := r.GetProvider(value.IMType)
+				if err != nil {
+					log.Error("failed to get IM application provider", zap.String("imType", string(value.IMType)), zap.Error(err))
 					return
 				}
 
@@ -90,7 +122,7 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 				}
 				for _, issue := range issues {
 					issueByID[issue.ID] = issue
-					r.scheduleApproval(ctx, issue, &value)
+					r.scheduleApproval(ctx, issue, value)
 				}
 
 				externalApprovalList, err := r.store.FindExternalApproval(ctx, &api.ExternalApprovalFind{})
@@ -100,38 +132,42 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 				}
 
 				for _, externalApproval := range externalApprovalList {
-					switch externalApproval.Type {
-					case api.ExternalApprovalTypeFeishu:
-						var payload api.ExternalApprovalPayloadFeishu
-						if err := json.Unmarshal([]byte(externalApproval.Payload), &payload); err != nil {
-							log.Error("failed to unmarshal to ExternalApprovalPayloadFeishu", zap.String("payload", externalApproval.Payload), zap.Error(err))
-							continue
-						}
+					var payload api.ExternalApprovalPayload
+					if err := json.Unmarshal([]byte(externalApproval.Payload), &payload); err != nil {
+						log.Error("failed to unmarshal to ExternalApprovalPayload", zap.String("payload", externalApproval.Payload), zap.Error(err))
+						continue
+					}
 
-						if payload.Rejected {
-							continue
-						}
+					if payload.Rejected {
+						continue
+					}
 
-						issue, ok := issueByID[externalApproval.IssueID]
-						if !ok {
-							log.Error("expect to have found issue in application runner", zap.Int("issue_id", externalApproval.IssueID))
-							continue
-						}
-						stage := utils.GetActiveStage(issue.Pipeline)
-						if stage == nil {
-							stage = issue.Pipeline.StageList[len(issue.Pipeline.StageList)-1]
-						}
-						if issue.Status != api.IssueOpen {
-							if err := r.CancelExternalApproval(ctx, issue.ID, api.ExternalApprovalCancelReasonIssueNotOpen); err != nil {
-								log.Error("failed to cancel external approval", zap.Error(err))
-							}
-							continue
+					issue, ok := issueByID[externalApproval.IssueID]
+					if !ok {
+						log.Error("expect to have found issue in application runner", zap.Int("issue_id", externalApproval.IssueID))
+						continue
+					}
+					stage := utils.GetActiveStage(issue.Pipeline)
+					if stage == nil {
+						stage = issue.Pipeline.StageList[len(issue.Pipeline.StageList)-1]
+					}
+					if issue.Status != api.IssueOpen {
+						if err := r.CancelExternalApproval(ctx, issue.ID, api.ExternalApprovalCancelReasonIssueNotOpen); err != nil {
+							log.Error("failed to cancel external approval", zap.Error(err))
 						}
+						continue
+					}
+					// The approvals created by the previous IM application are canceled by scheduleApproval.
+					if externalApproval.Type != approvalType {
+						continue
+					}
 
-						status, err := r.p.GetExternalApprovalStatus(ctx, feishu.TokenCtx{
-							AppID:     value.AppID,
-							AppSecret: value.AppSecret,
-						}, payload.InstanceCode)
+					status := app.ApprovalStatusApproved
+					if !payload.Approved {
+						status, err = p.GetApprovalStatus(ctx, r.GetAppConfig(value), &app.Approval{
+							InstanceID:  payload.InstanceCode,
+							RequesterID: payload.RequesterID,
+						})
 						if err != nil {
 							if errors.Is(err, context.Canceled) {
 								break
@@ -139,97 +175,47 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 							log.Error("failed to get external approval", zap.String("instanceCode", payload.InstanceCode), zap.Error(err))
 							continue
 						}
+					}
 
-						switch status {
-						case feishu.ApprovalStatusApproved:
-							// double check
-							if stage.ID == payload.StageID && payload.AssigneeID == issue.AssigneeID {
-								// approve stage
-								if err := func() error {
-									var taskIDList []int
-									var tasks []*api.Task
-									for _, task := range stage.TaskList {
-										if task.Status == api.TaskPendingApproval {
-											taskIDList = append(taskIDList, task.ID)
-											tasks = append(tasks, task)
-										}
-									}
-									if err := r.store.BatchPatchTaskStatus(ctx, taskIDList, api.TaskPending, externalApproval.ApproverID); err != nil {
-										return errors.Wrapf(err, "failed to update task status, task id list: %+v", taskIDList)
-									}
-									if err := r.activityManager.BatchCreateTaskStatusUpdateApprovalActivity(ctx, tasks, externalApproval.ApproverID, issue, stage); err != nil {
-										return errors.Wrapf(err, "failed to create task status update activity")
-									}
-									return nil
-								}(); err != nil {
-									log.Error("failed to approve stage", zap.Error(err))
-									continue
-								}
-
-								if _, err := r.store.PatchExternalApproval(ctx, &api.ExternalApprovalPatch{
-									// Archive external approval.
-									ID:        externalApproval.ID,
-									RowStatus: api.Archived,
-								}); err != nil {
-									log.Error("failed to archive external apporval", zap.Error(err))
-									continue
-								}
-							}
-						case feishu.ApprovalStatusRejected:
+					switch status {
+					case app.ApprovalStatusApproved:
+						// double check
+						if stage.ID == payload.StageID && payload.AssigneeID == issue.AssigneeID {
+							// approve stage
 							if err := func() error {
-								payload := payload
-								payload.Rejected = true
-								bytes, err := json.Marshal(payload)
-								if err != nil {
-									return errors.Wrapf(err, "failed to marshal payload %+v", payload)
-								}
-								payloadString := string(bytes)
-
-								if _, err := r.store.PatchExternalApproval(ctx, &api.ExternalApprovalPatch{
-									ID:        externalApproval.ID,
-									RowStatus: api.Normal,
-									Payload:   &payloadString,
-								}); err != nil {
-									return errors.Wrap(err, "failed to patch external approval")
-								}
-
-								stageName := "UNKNOWN"
-								for _, stage := range issue.Pipeline.StageList {
-									if stage.ID == payload.StageID {
-										stageName = stage.Name
-										break
+								var taskIDList []int
+								var tasks []*api.Task
+								for _, task := range stage.TaskList {
+									if task.Status == api.TaskPendingApproval {
+										taskIDList = append(taskIDList, task.ID)
+										tasks = append(tasks, task)
 									}
 								}
-								activityPayload, err := json.Marshal(api.ActivityIssueCommentCreatePayload{
-									ExternalApprovalEvent: &api.ExternalApprovalEvent{
-										Type:      api.ExternalApprovalTypeFeishu,
-										Action:    api.ExternalApprovalEventActionReject,
-										StageName: stageName,
-									},
-								})
-								if err != nil {
-									return errors.Wrap(err, "failed to marshal ActivityIssueExternalApprovalRejectPayload")
+								if err := r.store.BatchPatchTaskStatus(ctx, taskIDList, api.TaskPending, externalApproval.ApproverID); err != nil {
+									return errors.Wrapf(err, "failed to update task status, task id list: %+v", taskIDList)
 								}
-
-								activityCreate := &api.ActivityCreate{
-									CreatorID:   payload.AssigneeID,
-									ContainerID: issue.ID,
-									Type:        api.ActivityIssueCommentCreate,
-									Level:       api.ActivityInfo,
-									Comment:     "",
-									Payload:     string(activityPayload),
-								}
-
-								if _, err = r.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{}); err != nil {
-									return errors.Wrap(err, "failed to create activity after external approval rejected")
+								if err := r.activityManager.BatchCreateTaskStatusUpdateApprovalActivity(ctx, tasks, externalApproval.ApproverID, issue, stage); err != nil {
+									return errors.Wrapf(err, "failed to create task status update activity")
 								}
 								return nil
 							}(); err != nil {
-								log.Error("failed to handle rejected feishu approval", zap.Error(err))
+								log.Error("failed to approve stage", zap.Error(err))
+								continue
 							}
+
+							if _, err := r.store.PatchExternalApproval(ctx, &api.ExternalApprovalPatch{
+								// Archive external approval.
+								ID:        externalApproval.ID,
+								RowStatus: api.Archived,
+							}); err != nil {
+								log.Error("failed to archive external apporval", zap.Error(err))
+								continue
+							}
+						}
+					case app.ApprovalStatusRejected:
+						if err := r.rejectExternalApproval(ctx, issue, externalApproval, payload); err != nil {
+							log.Error("failed to handle rejected external approval", zap.String("type", string(externalApproval.Type)), zap.Error(err))
 						}
-					default:
-						log.Error("Unknown ExternalApproval.Type", zap.Any("ExternalApproval", externalApproval))
 					}
 				}
 			}()
@@ -239,6 +225,133 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	}
 }
 
+// HandleCallback handles the approval status change pushed by the IM application.
+// The approval is approved or rejected on the next run of the runner.
+func (r *Runner) HandleCallback(ctx context.Context, imType api.IMType, header http.Header, body []byte) error {
+	value, err := r.getIMSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if value == nil || !value.ExternalApproval.Enabled || value.IMType != imType {
+		return errors.Errorf("external approval of IM type %s is not enabled", imType)
+	}
+	approvalType, ok := api.ExternalApprovalTypeOf(imType)
+	if !ok {
+		return errors.Errorf("unknown IM type %s", imType)
+	}
+	p, err := r.GetProvider(imType)
+	if err != nil {
+		return err
+	}
+	receiver, ok := p.(app.CallbackReceiver)
+	if !ok {
+		return errors.Errorf("IM type %s doesn't support callbacks", imType)
+	}
+	event, err := receiver.ParseCallback(r.GetAppConfig(value), header, body)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return nil
+	}
+
+	externalApprovalList, err := r.store.FindExternalApproval(ctx, &api.ExternalApprovalFind{Type: &approvalType})
+	if err != nil {
+		return err
+	}
+	for _, externalApproval := range externalApprovalList {
+		var payload api.ExternalApprovalPayload
+		if err := json.Unmarshal([]byte(externalApproval.Payload), &payload); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal external approval payload %q", externalApproval.Payload)
+		}
+		if payload.InstanceCode != event.InstanceID {
+			continue
+		}
+		switch event.Status {
+		case app.ApprovalStatusApproved:
+			payload.Approved = true
+			b, err := json.Marshal(payload)
+			if err != nil {
+				return errors.Wrapf(err, "failed to marshal payload %+v", payload)
+			}
+			payloadString := string(b)
+			if _, err := r.store.PatchExternalApproval(ctx, &api.ExternalApprovalPatch{
+				ID:        externalApproval.ID,
+				RowStatus: api.Normal,
+				Payload:   &payloadString,
+			}); err != nil {
+				return errors.Wrap(err, "failed to patch external approval")
+			}
+		case app.ApprovalStatusRejected:
+			if payload.Rejected {
+				return nil
+			}
+			issue, err := r.store.GetIssueByID(ctx, externalApproval.IssueID)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get issue %d", externalApproval.IssueID)
+			}
+			if issue == nil {
+				return errors.Errorf("issue %d not found", externalApproval.IssueID)
+			}
+			return r.rejectExternalApproval(ctx, issue, externalApproval, payload)
+		}
+		return nil
+	}
+	// The approval may have been canceled.
+	log.Debug("external approval not found for the callback", zap.String("type", string(approvalType)), zap.String("instanceID", event.InstanceID))
+	return nil
+}
+
+// rejectExternalApproval marks the external approval as rejected and creates an activity to notify the issue subscribers.
+func (r *Runner) rejectExternalApproval(ctx context.Context, issue *api.Issue, externalApproval *api.ExternalApproval, payload api.ExternalApprovalPayload) error {
+	payload.Rejected = true
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal payload %+v", payload)
+	}
+	payloadString := string(bytes)
+
+	if _, err := r.store.PatchExternalApproval(ctx, &api.ExternalApprovalPatch{
+		ID:        externalApproval.ID,
+		RowStatus: api.Normal,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrap(err, "failed to patch external approval")
+	}
+
+	stageName := "UNKNOWN"
+	for _, stage := range issue.Pipeline.StageList {
+		if stage.ID == payload.StageID {
+			stageName = stage.Name
+			break
+		}
+	}
+	activityPayload, err := json.Marshal(api.ActivityIssueCommentCreatePayload{
+		ExternalApprovalEvent: &api.ExternalApprovalEvent{
+			Type:      externalApproval.Type,
+			Action:    api.ExternalApprovalEventActionReject,
+			StageName: stageName,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal ActivityIssueExternalApprovalRejectPayload")
+	}
+
+	activityCreate := &api.ActivityCreate{
+		CreatorID:   payload.AssigneeID,
+		ContainerID: issue.ID,
+		Type:        api.ActivityIssueCommentCreate,
+		Level:       api.ActivityInfo,
+		Comment:     "",
+		Payload:     string(activityPayload),
+	}
+
+	if _, err = r.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{}); err != nil {
+		return errors.Wrap(err, "failed to create activity after external approval rejected")
+	}
+	return nil
+}
+
 func (r *Runner) cancelOldExternalApprovalIfNeeded(ctx context.Context, issue *api.Issue, stage *api.Stage, settingValue *api.SettingAppIMValue) (*api.ExternalApproval, error) {
 	approval, err := r.store.GetExternalApprovalByIssueID(ctx, issue.ID)
 	if err != nil {
@@ -247,11 +360,19 @@ func (r *Runner) cancelOldExternalApprovalIfNeeded(ctx context.Context, issue *a
 	if approval == nil {
 		return nil, nil
 	}
-	var payload api.ExternalApprovalPayloadFeishu
+	var payload api.ExternalApprovalPayload
 	if err := json.Unmarshal([]byte(approval.Payload), &payload); err != nil {
 		return nil, err
 	}
 
+	// the approval was created by the previous IM application, so we cancel it and create a new one.
+	if approvalType, _ := api.ExternalApprovalTypeOf(settingValue.IMType); approval.Type != approvalType {
+		if err := r.cancelExternalApproval(ctx, approval, &payload, settingValue, api.ExternalApprovalCancelReasonGeneral); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
 	reason := api.ExternalApprovalCancelReasonGeneral
 	cancelOld := func() bool {
 		if payload.StageID != stage.ID {
@@ -276,37 +397,7 @@ func (r *Runner) cancelOldExternalApprovalIfNeeded(ctx context.Context, issue *a
 	}()
 
 	if cancelOld {
-		if _, err := r.store.PatchExternalApproval(ctx, &api.ExternalApprovalPatch{ID: approval.ID, RowStatus: api.Archived}); err != nil {
-			return nil, err
-		}
-		botID, err := r.p.GetBotID(ctx,
-			feishu.TokenCtx{
-				AppID:     settingValue.AppID,
-				AppSecret: settingValue.AppSecret,
-			})
-		if err != nil {
-			return nil, err
-		}
-		if err := r.p.CancelExternalApproval(ctx,
-			feishu.TokenCtx{
-				AppID:     settingValue.AppID,
-				AppSecret: settingValue.AppSecret,
-			},
-			settingValue.ExternalApproval.ApprovalDefinitionID,
-			payload.InstanceCode,
-			payload.RequesterID,
-		); err != nil {
-			return nil, err
-		}
-		if err := r.p.CreateExternalApprovalComment(ctx,
-			feishu.TokenCtx{
-				AppID:     settingValue.AppID,
-				AppSecret: settingValue.AppSecret,
-			},
-			payload.InstanceCode,
-			botID,
-			string(reason),
-		); err != nil {
+		if err := r.cancelExternalApproval(ctx, approval, &payload, settingValue, reason); err != nil {
 			return nil, err
 		}
 	}
@@ -315,22 +406,11 @@ func (r *Runner) cancelOldExternalApprovalIfNeeded(ctx context.Context, issue *a
 
 // CancelExternalApproval cancels the active external approval of an issue.
 func (r *Runner) CancelExternalApproval(ctx context.Context, issueID int, reason string) error {
-	settingName := api.SettingAppIM
-	setting, err := r.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	value, err := r.getIMSetting(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get IM setting by settingName %s", string(settingName))
-	}
-	if setting == nil {
-		return errors.New("cannot find IM setting")
-	}
-	if setting.Value == "" {
-		return nil
-	}
-	var value api.SettingAppIMValue
-	if err := json.Unmarshal([]byte(setting.Value), &value); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal IM setting, settingName %s", string(settingName))
+		return err
 	}
-	if !value.ExternalApproval.Enabled {
+	if value == nil || !value.ExternalApproval.Enabled {
 		return nil
 	}
 	approval, err := r.store.GetExternalApprovalByIssueID(ctx, issueID)
@@ -340,41 +420,50 @@ func (r *Runner) CancelExternalApproval(ctx context.Context, issueID int, reason
 	if approval == nil {
 		return nil
 	}
-	var payload api.ExternalApprovalPayloadFeishu
+	var payload api.ExternalApprovalPayload
 	if err := json.Unmarshal([]byte(approval.Payload), &payload); err != nil {
 		return err
 	}
+	return r.cancelExternalApproval(ctx, approval, &payload, value, reason)
+}
+
+// cancelExternalApproval archives the external approval and cancels it on the IM application.
+// The approvals created by the previous IM application are only archived.
+func (r *Runner) cancelExternalApproval(ctx context.Context, approval *api.ExternalApproval, payload *api.ExternalApprovalPayload, settingValue *api.SettingAppIMValue, reason string) error {
 	if _, err := r.store.PatchExternalApproval(ctx, &api.ExternalApprovalPatch{ID: approval.ID, RowStatus: api.Archived}); err != nil {
 		return err
 	}
-	botID, err := r.p.GetBotID(ctx,
-		feishu.TokenCtx{
-			AppID:     value.AppID,
-			AppSecret: value.AppSecret,
-		})
+	if approvalType, _ := api.ExternalApprovalTypeOf(settingValue.IMType); approval.Type != approvalType {
+		return nil
+	}
+	p, err := r.GetProvider(settingValue.IMType)
 	if err != nil {
 		return err
 	}
-	if err := r.p.CancelExternalApproval(ctx,
-		feishu.TokenCtx{
-			AppID:     value.AppID,
-			AppSecret: value.AppSecret,
-		},
-		value.ExternalApproval.ApprovalDefinitionID,
-		payload.InstanceCode,
-		payload.RequesterID,
-	); err != nil {
-		return err
+	return p.CancelApproval(ctx, r.GetAppConfig(settingValue), &app.Approval{
+		InstanceID:  payload.InstanceCode,
+		RequesterID: payload.RequesterID,
+	}, reason)
+}
+
+// getIMSetting returns the IM setting value, or nil if the IM setting is empty.
+func (r *Runner) getIMSetting(ctx context.Context) (*api.SettingAppIMValue, error) {
+	settingName := api.SettingAppIM
+	setting, err := r.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get IM setting by settingName %s", string(settingName))
 	}
-	return r.p.CreateExternalApprovalComment(ctx,
-		feishu.TokenCtx{
-			AppID:     value.AppID,
-			AppSecret: value.AppSecret,
-		},
-		payload.InstanceCode,
-		botID,
-		reason,
-	)
+	if setting == nil {
+		return nil, errors.New("cannot find IM setting")
+	}
+	if setting.Value == "" {
+		return nil, nil
+	}
+	var value api.SettingAppIMValue
+	if err := json.Unmarshal([]byte(setting.Value), &value); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal IM setting, settingName %s", string(settingName))
+	}
+	return &value, nil
 }
 
 func (r *Runner) shouldCreateExternalApproval(ctx context.Context, issue *api.Issue, stage *api.Stage, oldApproval *api.ExternalApproval) (bool, error) {
@@ -387,7 +476,7 @@ func (r *Runner) shouldCreateExternalApproval(ctx context.Context, issue *api.Is
 		return false, nil
 	}
 	if oldApproval != nil {
-		var oldPayload api.ExternalApprovalPayloadFeishu
+		var oldPayload api.ExternalApprovalPayload
 		if err := json.Unmarshal([]byte(oldApproval.Payload), &oldPayload); err != nil {
 			return false, err
 		}
@@ -435,72 +524,45 @@ func (r *Runner) shouldCreateExternalApproval(ctx context.Context, issue *api.Is
 }
 
 func (r *Runner) createExternalApproval(ctx context.Context, issue *api.Issue, stage *api.Stage, settingValue *api.SettingAppIMValue) error {
-	users, err := r.p.GetIDByEmail(ctx,
-		feishu.TokenCtx{
-			AppID:     settingValue.AppID,
-			AppSecret: settingValue.AppSecret,
-		},
-		[]string{issue.Creator.Email, issue.Assignee.Email})
+	approvalType, ok := api.ExternalApprovalTypeOf(settingValue.IMType)
+	if !ok {
+		return errors.Errorf("unknown IM type %s", settingValue.IMType)
+	}
+	p, err := r.GetProvider(settingValue.IMType)
 	if err != nil {
 		return err
 	}
-	// assignee who approves the external approval is a must-have, so we returns error if we failed to find the user id.
-	if _, ok := users[issue.Assignee.Email]; !ok {
-		return errors.Errorf("failed to get user_id for issue assignee, email: %s", issue.Assignee.Email)
-	}
-	// if the creator is not found, the application bot will represent the creator.
-	if _, ok := users[issue.Creator.Email]; !ok {
-		botID, err := r.p.GetBotID(ctx, feishu.TokenCtx{
-			AppID:     settingValue.AppID,
-			AppSecret: settingValue.AppSecret,
-		})
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		users[issue.Creator.Email] = botID
-	}
 
-	var taskList []feishu.Task
+	var taskList []app.Task
 	for _, task := range stage.TaskList {
-		taskList = append(taskList, feishu.Task{
-			Name:   task.Name,
-			Status: string(task.Status),
-		})
-	}
-
-	for i, task := range stage.TaskList {
 		statement, err := utils.GetTaskStatement(task)
 		if err != nil {
 			return err
 		}
-		if statement == "" {
-			continue
-		}
-		taskList[i].Statement = statement
+		taskList = append(taskList, app.Task{
+			Name:      task.Name,
+			Status:    string(task.Status),
+			Statement: statement,
+		})
 	}
 
-	instanceCode, err := r.p.CreateExternalApproval(ctx,
-		feishu.TokenCtx{
-			AppID:     settingValue.AppID,
-			AppSecret: settingValue.AppSecret,
-		},
-		feishu.Content{
+	approval, err := p.CreateApproval(ctx, r.GetAppConfig(settingValue),
+		app.Content{
 			Issue:    fmt.Sprintf("#%d %s", issue.ID, issue.Name),
 			Stage:    stage.Name,
 			Link:     fmt.Sprintf("%s/issue/%s", r.profile.ExternalURL, api.IssueSlug(issue)),
 			TaskList: taskList,
 		},
-		settingValue.ExternalApproval.ApprovalDefinitionID,
-		users[issue.Creator.Email],
-		users[issue.Assignee.Email])
+		issue.Creator.Email,
+		issue.Assignee.Email)
 	if err != nil {
 		return err
 	}
-	payload := api.ExternalApprovalPayloadFeishu{
+	payload := api.ExternalApprovalPayload{
 		StageID:      stage.ID,
 		AssigneeID:   issue.AssigneeID,
-		InstanceCode: instanceCode,
-		RequesterID:  users[issue.Creator.Email],
+		InstanceCode: approval.InstanceID,
+		RequesterID:  approval.RequesterID,
 		Rejected:     false,
 	}
 	b, err := json.Marshal(payload)
@@ -511,7 +573,7 @@ func (r *Runner) createExternalApproval(ctx context.Context, issue *api.Issue, s
 		IssueID:     issue.ID,
 		ApproverID:  issue.AssigneeID,
 		RequesterID: issue.CreatorID,
-		Type:        api.ExternalApprovalTypeFeishu,
+		Type:        approvalType,
 		Payload:     string(b),
 	}); err != nil {
 		return err
@@ -564,32 +626,22 @@ func (r *Runner) scheduleApproval(ctx context.Context, issue *api.Issue, setting
 // tryUpdateApprovalDefinition is run on application runner start.
 // The approval definition may have changed so we make idempotent POST request to patch the definition.
 func (r *Runner) tryUpdateApprovalDefinition(ctx context.Context) error {
-	settingName := api.SettingAppIM
-	setting, err := r.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	value, err := r.getIMSetting(ctx)
 	if err != nil {
 		if !errors.Is(err, context.Canceled) {
-			return errors.Wrapf(err, "failed to get IM setting")
+			return err
 		}
 		return nil
 	}
-	if setting == nil {
-		return errors.New("cannot find IM setting")
-	}
-	if setting.Value == "" {
+	if value == nil || !value.ExternalApproval.Enabled {
 		return nil
 	}
-	var value api.SettingAppIMValue
-	if err := json.Unmarshal([]byte(setting.Value), &value); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal setting value %+v", setting.Value)
-	}
-	if !value.ExternalApproval.Enabled {
-		return nil
+	p, err := r.GetProvider(value.IMType)
+	if err != nil {
+		return err
 	}
 	// pass in ApprovalDefinitionID so that this would be a PATCH.
-	if _, err := r.p.CreateApprovalDefinition(ctx, feishu.TokenCtx{
-		AppID:     value.AppID,
-		AppSecret: value.AppSecret,
-	}, value.ExternalApproval.ApprovalDefinitionID); err != nil {
+	if _, err := p.Setup(ctx, r.GetAppConfig(value)); err != nil {
 		return errors.Wrap(err, "failed to update approval definition")
 	}
 	return nil
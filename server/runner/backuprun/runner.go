@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
 	"os"
@@ -22,6 +23,8 @@ import (
 	"github.com/bytebase/bytebase/common/log"
 	"github.com/bytebase/bytebase/plugin/db"
 	"github.com/bytebase/bytebase/plugin/db/mysql"
+	"github.com/bytebase/bytebase/plugin/db/pg"
+	"github.com/bytebase/bytebase/plugin/storage/backupfile"
 	"github.com/bytebase/bytebase/plugin/storage/s3"
 	"github.com/bytebase/bytebase/server/component/config"
 	"github.com/bytebase/bytebase/server/component/dbfactory"
@@ -127,24 +130,32 @@ func (r *Runner) purgeExpiredBackupData(ctx context.Context) {
 	}
 
 	for _, instance := range instanceList {
-		if instance.Engine != db.MySQL {
+		if instance.Engine != db.MySQL && instance.Engine != db.Postgres {
 			continue
 		}
-		maxRetentionPeriodTs, err := r.getMaxRetentionPeriodTsForMySQLInstance(ctx, instance)
+		maxRetentionPeriodTs, err := r.getMaxRetentionPeriodTsForInstance(ctx, instance)
 		if err != nil {
-			log.Error("Failed to get max retention period for MySQL instance", zap.String("instance", instance.Name), zap.Error(err))
+			log.Error("Failed to get max retention period for instance", zap.String("instance", instance.Name), zap.Error(err))
 			continue
 		}
 		if maxRetentionPeriodTs == math.MaxInt {
 			continue
 		}
-		if err := r.purgeBinlogFiles(ctx, instance.ID, maxRetentionPeriodTs); err != nil {
-			log.Error("Failed to purge binlog files for instance", zap.String("instance", instance.Name), zap.Int("retentionPeriodTs", maxRetentionPeriodTs), zap.Error(err))
+		switch instance.Engine {
+		case db.MySQL:
+			if err := r.purgeBinlogFiles(ctx, instance.ID, maxRetentionPeriodTs); err != nil {
+				log.Error("Failed to purge binlog files for instance", zap.String("instance", instance.Name), zap.Int("retentionPeriodTs", maxRetentionPeriodTs), zap.Error(err))
+			}
+		case db.Postgres:
+			walArchiveDir := common.GetWALArchiveAbsDir(r.profile.DataDir, instance.ID)
+			if err := pg.PurgeWALArchive(ctx, walArchiveDir, maxRetentionPeriodTs, r.s3Client); err != nil {
+				log.Error("Failed to purge WAL archive for instance", zap.String("instance", instance.Name), zap.Int("retentionPeriodTs", maxRetentionPeriodTs), zap.Error(err))
+			}
 		}
 	}
 }
 
-func (r *Runner) getMaxRetentionPeriodTsForMySQLInstance(ctx context.Context, instance *api.Instance) (int, error) {
+func (r *Runner) getMaxRetentionPeriodTsForInstance(ctx context.Context, instance *api.Instance) (int, error) {
 	backupSettingList, err := r.store.FindBackupSetting(ctx, api.BackupSettingFind{InstanceID: &instance.ID})
 	if err != nil {
 		log.Error("Failed to find backup settings for instance.", zap.String("instance", instance.Name), zap.Error(err))
@@ -164,7 +175,7 @@ func (r *Runner) purgeBinlogFiles(ctx context.Context, instanceID, retentionPeri
 	switch r.profile.BackupStorageBackend {
 	case api.BackupStorageBackendLocal:
 		return r.purgeBinlogFilesLocal(binlogDir, retentionPeriodTs)
-	case api.BackupStorageBackendS3:
+	case api.BackupStorageBackendS3, api.BackupStorageBackendGCS, api.BackupStorageBackendOSS:
 		return r.purgeBinlogFilesOnCloud(ctx, binlogDir, retentionPeriodTs)
 	default:
 		return errors.Errorf("purge binlog files not implemented for storage backend %s", r.profile.BackupStorageBackend)
@@ -186,7 +197,7 @@ func (r *Runner) purgeBinlogFilesOnCloud(ctx context.Context, binlogDir string,
 	}
 	if len(purgeBinlogPathList) > 0 {
 		log.Debug(fmt.Sprintf("Deleting %d expired binlog files from the cloud storage.", len(purgeBinlogPathList)))
-		if _, err := r.s3Client.DeleteObjects(ctx, purgeBinlogPathList...); err != nil {
+		if err := r.s3Client.DeleteObjects(ctx, purgeBinlogPathList...); err != nil {
 			return errors.Wrapf(err, "failed to delete %d expired binlog files from the cloud storage", len(purgeBinlogPathList))
 		}
 	}
@@ -241,9 +252,12 @@ func (r *Runner) purgeBackup(ctx context.Context, backup *api.Backup) error {
 			return errors.Wrapf(err, "failed to delete an expired backup file %q", backupFilePath)
 		}
 		log.Debug(fmt.Sprintf("Deleted expired local backup file %s", backupFilePath))
-	case api.BackupStorageBackendS3:
+	case api.BackupStorageBackendS3, api.BackupStorageBackendGCS, api.BackupStorageBackendOSS:
+		if r.s3Client == nil {
+			return errors.Errorf("failed to delete backup %q, storage backend %s is not configured", backup.Name, backup.StorageBackend)
+		}
 		backupFilePath := getBackupRelativeFilePath(backup.DatabaseID, backup.Name)
-		if _, err := r.s3Client.DeleteObjects(ctx, backupFilePath); err != nil {
+		if err := r.s3Client.DeleteObjects(ctx, backupFilePath); err != nil {
 			return errors.Wrapf(err, "failed to delete backup file %s in the cloud storage", backupFilePath)
 		}
 		log.Debug(fmt.Sprintf("Deleted expired backup file %s in the cloud storage", backupFilePath))
@@ -252,17 +266,34 @@ func (r *Runner) purgeBackup(ctx context.Context, backup *api.Backup) error {
 	return nil
 }
 
+// getBackupStorageBackend returns the storage backend for the backups in the environment.
+// The backup plan policy of the environment takes precedence over the workspace default storage backend.
+func (r *Runner) getBackupStorageBackend(policy *api.BackupPlanPolicy) (api.BackupStorageBackend, error) {
+	storageBackend := policy.StorageBackend
+	if storageBackend == "" {
+		storageBackend = r.profile.BackupStorageBackend
+	}
+	if storageBackend == api.BackupStorageBackendLocal {
+		return storageBackend, nil
+	}
+	// Only one cloud storage backend could be configured by the --backup-bucket flag.
+	if r.s3Client == nil || storageBackend != r.profile.BackupStorageBackend {
+		return "", errors.Errorf("backup storage backend %s is not configured, please start Bytebase with the --backup-bucket flag", storageBackend)
+	}
+	return storageBackend, nil
+}
+
 func (r *Runner) downloadBinlogFiles(ctx context.Context) {
 	instances, err := r.store.FindInstanceWithDatabaseBackupEnabled(ctx)
 	if err != nil {
-		log.Error("Failed to retrieve MySQL instance list with at least one database backup enabled", zap.Error(err))
+		log.Error("Failed to retrieve instance list with at least one database backup enabled", zap.Error(err))
 		return
 	}
 
 	r.downloadBinlogMu.Lock()
 	defer r.downloadBinlogMu.Unlock()
 	for _, instance := range instances {
-		if instance.Engine != db.MySQL {
+		if instance.Engine != db.MySQL && instance.Engine != db.Postgres {
 			continue
 		}
 		if _, ok := r.downloadBinlogInstanceIDs[instance.UID]; !ok {
@@ -286,20 +317,48 @@ func (r *Runner) downloadBinlogFilesForInstance(ctx context.Context, instance *s
 			log.Debug("Cannot connect to instance", zap.String("instance", instance.ResourceID), zap.Error(err))
 			return
 		}
-		log.Error("Failed to get driver for MySQL instance when downloading binlog", zap.String("instance", instance.ResourceID), zap.Error(err))
+		log.Error("Failed to get driver for instance when downloading binlog", zap.String("instance", instance.ResourceID), zap.Error(err))
 		return
 	}
 	defer driver.Close(ctx)
 
-	mysqlDriver, ok := driver.(*mysql.Driver)
-	if !ok {
-		log.Error("Failed to cast driver to mysql.Driver", zap.String("instance", instance.ResourceID))
-		return
+	switch d := driver.(type) {
+	case *mysql.Driver:
+		if err := d.FetchAllBinlogFiles(ctx, false /* downloadLatestBinlogFile */, r.s3Client); err != nil {
+			log.Error("Failed to download all binlog files for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+			return
+		}
+	case *pg.Driver:
+		if err := r.archiveWALFiles(ctx, d); err != nil {
+			log.Error("Failed to archive WAL files for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+			return
+		}
+	default:
+		log.Error("Unexpected driver type when downloading binlog", zap.String("instance", instance.ResourceID), zap.String("engine", string(instance.Engine)))
 	}
-	if err := mysqlDriver.FetchAllBinlogFiles(ctx, false /* downloadLatestBinlogFile */, r.s3Client); err != nil {
-		log.Error("Failed to download all binlog files for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
-		return
+}
+
+// archiveWALFiles archives the WAL files of the PostgreSQL instance, and takes a new base backup if the latest one is older than pg.BaseBackupInterval.
+func (r *Runner) archiveWALFiles(ctx context.Context, driver *pg.Driver) error {
+	if err := driver.FetchWALFiles(ctx, r.s3Client); err != nil {
+		return err
+	}
+	if err := driver.CheckPITRSettings(ctx); err != nil {
+		// The instance is not ready for PITR, such as the WAL archive slot does not exist.
+		log.Debug("Skip taking base backup", zap.Error(err))
+		return nil
+	}
+	baseBackupList, err := driver.ListBaseBackups(ctx, r.s3Client)
+	if err != nil {
+		return err
 	}
+	if len(baseBackupList) > 0 && time.Since(time.Unix(baseBackupList[len(baseBackupList)-1].StopTs, 0)) < pg.BaseBackupInterval {
+		return nil
+	}
+	if _, err := driver.CreateBaseBackup(ctx, r.s3Client); err != nil {
+		return errors.Wrap(err, "failed to create base backup")
+	}
+	return nil
 }
 
 func (r *Runner) startAutoBackups(ctx context.Context) {
@@ -423,15 +482,25 @@ func (r *Runner) ScheduleBackupTask(ctx context.Context, database *store.Databas
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get migration history for database %q", database.DatabaseName)
 	}
+	policy, err := r.store.GetBackupPlanPolicyByEnvID(ctx, environment.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get backup plan policy for environment %q", environment.Title)
+	}
+	storageBackend, err := r.getBackupStorageBackend(policy)
+	if err != nil {
+		return nil, err
+	}
 	path := getBackupRelativeFilePath(database.UID, backupName)
-	if err := createBackupDirectory(r.profile.DataDir, database.UID); err != nil {
-		return nil, errors.Wrap(err, "failed to create backup directory")
+	if storageBackend == api.BackupStorageBackendLocal {
+		if err := createBackupDirectory(r.profile.DataDir, database.UID); err != nil {
+			return nil, errors.Wrap(err, "failed to create backup directory")
+		}
 	}
 	backupCreate := &api.BackupCreate{
 		CreatorID:               creatorID,
 		DatabaseID:              database.UID,
 		Name:                    backupName,
-		StorageBackend:          r.profile.BackupStorageBackend,
+		StorageBackend:          storageBackend,
 		Type:                    backupType,
 		Path:                    path,
 		MigrationHistoryVersion: migrationHistoryVersion,
@@ -447,7 +516,9 @@ func (r *Runner) ScheduleBackupTask(ctx context.Context, database *store.Databas
 	}
 
 	payload := api.TaskDatabaseBackupPayload{
-		BackupID: backupNew.ID,
+		BackupID:    backupNew.ID,
+		Compression: policy.Compression,
+		Encryption:  policy.Encryption,
 	}
 	bytes, err := json.Marshal(payload)
 	if err != nil {
@@ -504,6 +575,88 @@ func getBackupRelativeFilePath(databaseID int, name string) string {
 	return filepath.Join(dir, fmt.Sprintf("%s.sql", name))
 }
 
+// OpenBackupFile opens the backup file for reading.
+// The backup in the cloud storage is streamed without downloading to a local file.
+func OpenBackupFile(ctx context.Context, s3Client *s3.Client, dataDir string, backup *api.Backup) (io.ReadCloser, error) {
+	switch backup.StorageBackend {
+	case api.BackupStorageBackendLocal:
+		backupAbsPathLocal := filepath.Join(dataDir, backup.Path)
+		backupFile, err := os.Open(backupAbsPathLocal)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open backup file at %s", backupAbsPathLocal)
+		}
+		return backupFile, nil
+	case api.BackupStorageBackendS3, api.BackupStorageBackendGCS, api.BackupStorageBackendOSS:
+		if s3Client == nil {
+			return nil, errors.Errorf("backup storage backend %s is not configured", backup.StorageBackend)
+		}
+		backupFile, err := s3Client.ReadObject(ctx, backup.Path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read backup %q from %s", backup.Path, backup.StorageBackend)
+		}
+		return backupFile, nil
+	default:
+		return nil, errors.Errorf("restore from %s not implemented yet", backup.StorageBackend)
+	}
+}
+
+// OpenDecodedBackupFile opens the backup file for reading, which decrypts and decompresses the backup file if needed.
+func OpenDecodedBackupFile(ctx context.Context, stores *store.Store, s3Client *s3.Client, dataDir string, backup *api.Backup) (io.ReadCloser, error) {
+	backupFile, err := OpenBackupFile(ctx, s3Client, dataDir, backup)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := GetBackupDecoder(ctx, stores, backup, backupFile)
+	if err != nil {
+		backupFile.Close()
+		return nil, err
+	}
+	return &decodedBackupFile{ReadCloser: decoder, backupFile: backupFile}, nil
+}
+
+// GetBackupDecoder returns the reader decoding the backup file according to the backup payload.
+func GetBackupDecoder(ctx context.Context, stores *store.Store, backup *api.Backup, backupFile io.Reader) (io.ReadCloser, error) {
+	var key []byte
+	if backup.Payload.Encrypted {
+		var err error
+		if key, err = GetBackupEncryptionKey(ctx, stores); err != nil {
+			return nil, err
+		}
+	}
+	decoder, err := backupfile.NewReader(backupFile, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode backup %q", backup.Name)
+	}
+	return decoder, nil
+}
+
+// decodedBackupFile closes both the decoder and the underlying backup file.
+type decodedBackupFile struct {
+	io.ReadCloser
+	backupFile io.Closer
+}
+
+func (f *decodedBackupFile) Close() error {
+	err := f.ReadCloser.Close()
+	if closeErr := f.backupFile.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// GetBackupEncryptionKey returns the workspace key to encrypt and decrypt the backups.
+func GetBackupEncryptionKey(ctx context.Context, store *store.Store) ([]byte, error) {
+	settingName := api.SettingBackupEncryptionKey
+	setting, err := store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %q", settingName)
+	}
+	if setting == nil {
+		return nil, errors.Errorf("setting %q not found", settingName)
+	}
+	return backupfile.DecodeKey(setting.Value)
+}
+
 // GetBackupAbsFilePath returns backup absolute file path for a database.
 func GetBackupAbsFilePath(dataDir string, databaseID int, name string) string {
 	path := getBackupRelativeFilePath(databaseID, name)
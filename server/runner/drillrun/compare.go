@@ -0,0 +1,116 @@
+package drillrun
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytebase/bytebase/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type tableKey struct {
+	schema string
+	table  string
+}
+
+func (k tableKey) String() string {
+	if k.schema == "" {
+		return k.table
+	}
+	return fmt.Sprintf("%s.%s", k.schema, k.table)
+}
+
+func getTableMap(metadata *storepb.DatabaseMetadata) map[tableKey]*storepb.TableMetadata {
+	tableMap := make(map[tableKey]*storepb.TableMetadata)
+	if metadata == nil {
+		return tableMap
+	}
+	for _, schema := range metadata.Schemas {
+		for _, table := range schema.Tables {
+			tableMap[tableKey{schema: schema.Name, table: table.Name}] = table
+		}
+	}
+	return tableMap
+}
+
+func getSortedTableKeys(tableMap map[tableKey]*storepb.TableMetadata) []tableKey {
+	var keys []tableKey
+	for key := range tableMap {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].schema != keys[j].schema {
+			return keys[i].schema < keys[j].schema
+		}
+		return keys[i].table < keys[j].table
+	})
+	return keys
+}
+
+// compareDatabaseMetadata compares the tables and columns of the restored database with the expected schema.
+// Returns the list of the differences.
+func compareDatabaseMetadata(expected, restored *storepb.DatabaseMetadata) []string {
+	var detailList []string
+	expectedTableMap := getTableMap(expected)
+	restoredTableMap := getTableMap(restored)
+	for _, key := range getSortedTableKeys(expectedTableMap) {
+		restoredTable, ok := restoredTableMap[key]
+		if !ok {
+			detailList = append(detailList, fmt.Sprintf("table %q is missing in the restored database", key.String()))
+			continue
+		}
+		restoredColumnMap := make(map[string]*storepb.ColumnMetadata)
+		for _, column := range restoredTable.Columns {
+			restoredColumnMap[column.Name] = column
+		}
+		for _, column := range expectedTableMap[key].Columns {
+			restoredColumn, ok := restoredColumnMap[column.Name]
+			if !ok {
+				detailList = append(detailList, fmt.Sprintf("column %q of table %q is missing in the restored database", column.Name, key.String()))
+				continue
+			}
+			if restoredColumn.Type != column.Type {
+				detailList = append(detailList, fmt.Sprintf("column %q of table %q has type %q in the restored database, expecting %q", column.Name, key.String(), restoredColumn.Type, column.Type))
+			}
+		}
+	}
+	for _, key := range getSortedTableKeys(restoredTableMap) {
+		if _, ok := expectedTableMap[key]; !ok {
+			detailList = append(detailList, fmt.Sprintf("table %q is unexpected in the restored database", key.String()))
+		}
+	}
+	return detailList
+}
+
+// compareRowCounts compares the exact row counts of the restored tables with the row counts of the source tables.
+// The row counts of the source tables are estimated by the database statistics, so we only report the tables having rows
+// in the source database but restored as empty.
+func compareRowCounts(source *storepb.DatabaseMetadata, rowCounts map[tableKey]int64) []string {
+	var detailList []string
+	sourceTableMap := getTableMap(source)
+	for _, key := range getSortedTableKeys(sourceTableMap) {
+		count, ok := rowCounts[key]
+		if !ok {
+			continue
+		}
+		if sourceTableMap[key].RowCount > 0 && count == 0 {
+			detailList = append(detailList, fmt.Sprintf("table %q is empty in the restored database, expecting about %d rows", key.String(), sourceTableMap[key].RowCount))
+		}
+	}
+	return detailList
+}
+
+func quoteIdentifier(engine db.Type, identifier string) string {
+	if engine == db.Postgres {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+func quoteTable(engine db.Type, schema, table string) string {
+	if schema == "" {
+		return quoteIdentifier(engine, table)
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(engine, schema), quoteIdentifier(engine, table))
+}
@@ -0,0 +1,92 @@
+package drillrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCompareDatabaseMetadata(t *testing.T) {
+	source := &storepb.DatabaseMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "name", Type: "text"},
+						},
+						RowCount: 10,
+					},
+					{
+						Name: "t2",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		restored *storepb.DatabaseMetadata
+		want     []string
+	}{
+		{
+			name:     "identical",
+			restored: source,
+			want:     nil,
+		},
+		{
+			name: "different",
+			restored: &storepb.DatabaseMetadata{
+				Schemas: []*storepb.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []*storepb.TableMetadata{
+							{
+								Name: "t1",
+								Columns: []*storepb.ColumnMetadata{
+									{Name: "id", Type: "bigint"},
+								},
+							},
+							{
+								Name: "t3",
+							},
+						},
+					},
+				},
+			},
+			want: []string{
+				`column "id" of table "public.t1" has type "bigint" in the restored database, expecting "integer"`,
+				`column "name" of table "public.t1" is missing in the restored database`,
+				`table "public.t2" is missing in the restored database`,
+				`table "public.t3" is unexpected in the restored database`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, compareDatabaseMetadata(source, test.restored), test.name)
+	}
+
+	rowCounts := map[tableKey]int64{
+		{schema: "public", table: "t1"}: 0,
+		{schema: "public", table: "t2"}: 0,
+	}
+	require.Equal(t, []string{`table "public.t1" is empty in the restored database, expecting about 10 rows`}, compareRowCounts(source, rowCounts))
+	rowCounts[tableKey{schema: "public", table: "t1"}] = 3
+	require.Nil(t, compareRowCounts(source, rowCounts))
+}
+
+func TestQuoteTable(t *testing.T) {
+	require.Equal(t, "`t``1`", quoteTable(db.MySQL, "", "t`1"))
+	require.Equal(t, `"public"."t""1"`, quoteTable(db.Postgres, "public", `t"1`))
+}
@@ -0,0 +1,410 @@
+// Package drillrun is the runner restoring the latest backups into scratch databases to verify that the backups are restorable.
+package drillrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/common/log"
+	"github.com/bytebase/bytebase/plugin/db"
+	"github.com/bytebase/bytebase/plugin/storage/s3"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	"github.com/bytebase/bytebase/server/component/config"
+	"github.com/bytebase/bytebase/server/component/dbfactory"
+	"github.com/bytebase/bytebase/server/runner/backuprun"
+	"github.com/bytebase/bytebase/store"
+)
+
+const (
+	drillRunnerInterval = 10 * time.Minute
+	// defaultDrillIntervalTs is the default interval between two drills of a database, which is one week.
+	defaultDrillIntervalTs = 7 * 24 * 60 * 60
+)
+
+// NewRunner creates a new backup restore drill runner.
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *s3.Client, profile *config.Profile) *Runner {
+	return &Runner{
+		store:     store,
+		dbFactory: dbFactory,
+		s3Client:  s3Client,
+		profile:   profile,
+	}
+}
+
+// Runner is the runner restoring the latest backups of the selected databases into scratch databases on a designated instance.
+// The restored databases are compared with the schema at the migration version of the backups, and an anomaly is raised if the drill fails.
+type Runner struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	s3Client  *s3.Client
+	profile   *config.Profile
+}
+
+// Run is the runner for the backup restore drills.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(drillRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Backup restore drill runner started and will run every %v", drillRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						log.Error("Backup restore drill runner PANIC RECOVER", zap.Error(err), zap.Stack("panic-stack"))
+					}
+				}()
+				r.runDrills(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) runDrills(ctx context.Context) {
+	settingName := api.SettingBackupRestoreDrill
+	setting, err := r.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	if err != nil {
+		log.Error("Failed to get backup restore drill setting", zap.Error(err))
+		return
+	}
+	if setting == nil || setting.Value == "" {
+		return
+	}
+	var value api.SettingBackupRestoreDrillValue
+	if err := json.Unmarshal([]byte(setting.Value), &value); err != nil {
+		log.Error("Failed to unmarshal backup restore drill setting", zap.String("value", setting.Value), zap.Error(err))
+		return
+	}
+	if value.InstanceID == 0 {
+		return
+	}
+	intervalTs := value.IntervalTs
+	if intervalTs <= 0 {
+		intervalTs = defaultDrillIntervalTs
+	}
+
+	drillInstance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &value.InstanceID})
+	if err != nil {
+		log.Error("Failed to get backup restore drill instance", zap.Int("instanceID", value.InstanceID), zap.Error(err))
+		return
+	}
+	if drillInstance == nil || drillInstance.Deleted {
+		log.Warn("Backup restore drill instance not found", zap.Int("instanceID", value.InstanceID))
+		return
+	}
+
+	drills, err := r.store.ListBackupRestoreDrills(ctx)
+	if err != nil {
+		log.Error("Failed to list backup restore drills", zap.Error(err))
+		return
+	}
+	lastDrillTs := make(map[int]int64)
+	for _, drill := range drills {
+		lastDrillTs[drill.DatabaseID] = drill.LastDrillTs
+	}
+
+	for _, databaseID := range value.DatabaseIDList {
+		if time.Now().Unix() < lastDrillTs[databaseID]+int64(intervalTs) {
+			continue
+		}
+		databaseID := databaseID
+		database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &databaseID})
+		if err != nil {
+			log.Error("Failed to get database", zap.Int("databaseID", databaseID), zap.Error(err))
+			continue
+		}
+		if database == nil {
+			continue
+		}
+		// Drills run one by one to avoid overloading the drill instance.
+		if err := r.drill(ctx, database, drillInstance); err != nil {
+			log.Error("Failed to run backup restore drill",
+				zap.String("database", database.DatabaseName),
+				zap.Error(err))
+			continue
+		}
+		if _, err := r.store.UpsertBackupRestoreDrill(ctx, &store.BackupRestoreDrillMessage{
+			DatabaseID:  databaseID,
+			LastDrillTs: time.Now().Unix(),
+		}); err != nil {
+			log.Error("Failed to record backup restore drill", zap.String("database", database.DatabaseName), zap.Error(err))
+		}
+	}
+}
+
+// drill restores the latest backup of the database into a scratch database on the drill instance.
+// The returned error is for the errors unrelated to the backup itself, such as failing to connect the drill instance,
+// while the drill failures are recorded as anomalies.
+func (r *Runner) drill(ctx context.Context, database *store.DatabaseMessage, drillInstance *store.InstanceMessage) error {
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{EnvironmentID: &database.EnvironmentID, ResourceID: &database.InstanceID})
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return errors.Errorf("instance %q not found", database.InstanceID)
+	}
+	switch instance.Engine {
+	case db.MySQL, db.TiDB, db.Postgres:
+	default:
+		return errors.Errorf("backup restore drill is not supported for engine %s", instance.Engine)
+	}
+	if instance.Engine != drillInstance.Engine {
+		return errors.Errorf("the engine %s of database %q mismatches the engine %s of the drill instance %q", instance.Engine, database.DatabaseName, drillInstance.Engine, drillInstance.Title)
+	}
+
+	backup, err := r.getLatestBackup(ctx, database.UID)
+	if err != nil {
+		return err
+	}
+	if backup == nil {
+		// The missing backup is reported by the anomaly scanner.
+		return nil
+	}
+	dbSchema, err := r.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the schema of database %q", database.DatabaseName)
+	}
+	if dbSchema == nil || dbSchema.Metadata == nil {
+		return errors.Errorf("the schema of database %q is not synced yet", database.DatabaseName)
+	}
+	expectedSchema, err := r.getMigrationSchema(ctx, instance, database, backup.MigrationHistoryVersion)
+	if err != nil {
+		return err
+	}
+	// The row counts of the source database are only comparable if the schema hasn't been migrated after the backup is taken.
+	compareRowCount := backup.MigrationHistoryVersion == database.SchemaVersion
+
+	log.Debug("Start backup restore drill",
+		zap.String("database", database.DatabaseName),
+		zap.String("backup", backup.Name),
+		zap.String("drillInstance", drillInstance.Title))
+	detailList, err := r.restoreAndCompare(ctx, backup, drillInstance, dbSchema, expectedSchema, compareRowCount)
+	if err != nil {
+		return err
+	}
+
+	if len(detailList) == 0 {
+		if err := r.store.ArchiveAnomaly(ctx, &api.AnomalyArchive{
+			DatabaseID: &database.UID,
+			Type:       api.AnomalyDatabaseBackupRestoreDrillFailure,
+		}); err != nil && common.ErrorCode(err) != common.NotFound {
+			log.Error("Failed to close anomaly",
+				zap.String("database", database.DatabaseName),
+				zap.String("type", string(api.AnomalyDatabaseBackupRestoreDrillFailure)),
+				zap.Error(err))
+		}
+		log.Debug("Backup restore drill succeeded", zap.String("database", database.DatabaseName), zap.String("backup", backup.Name))
+		return nil
+	}
+
+	payload, err := json.Marshal(api.AnomalyDatabaseBackupRestoreDrillFailurePayload{
+		BackupID:        backup.ID,
+		BackupName:      backup.Name,
+		DrillInstanceID: drillInstance.UID,
+		DetailList:      detailList,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal anomaly payload")
+	}
+	if _, err := r.store.UpsertActiveAnomaly(ctx, &api.AnomalyUpsert{
+		CreatorID:  api.SystemBotID,
+		InstanceID: instance.UID,
+		DatabaseID: &database.UID,
+		Type:       api.AnomalyDatabaseBackupRestoreDrillFailure,
+		Payload:    string(payload),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create anomaly for database %q", database.DatabaseName)
+	}
+	return nil
+}
+
+func (r *Runner) getLatestBackup(ctx context.Context, databaseID int) (*api.Backup, error) {
+	statusDone := api.BackupStatusDone
+	statusNormal := api.Normal
+	backupList, err := r.store.FindBackup(ctx, &api.BackupFind{
+		DatabaseID: &databaseID,
+		RowStatus:  &statusNormal,
+		Status:     &statusDone,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find backups for database %d", databaseID)
+	}
+	var latest *api.Backup
+	for _, backup := range backupList {
+		if latest == nil || backup.CreatedTs > latest.CreatedTs {
+			latest = backup
+		}
+	}
+	return latest, nil
+}
+
+// getMigrationSchema returns the schema recorded by the migration history of the version, which is the schema of the backups
+// taken at the version. Returns empty if the migration history isn't found.
+func (r *Runner) getMigrationSchema(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, version string) (string, error) {
+	if version == "" {
+		return "", nil
+	}
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, database.DatabaseName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to connect the database %q", database.DatabaseName)
+	}
+	defer driver.Close(ctx)
+	list, err := driver.FindMigrationHistoryList(ctx, &db.MigrationHistoryFind{
+		Database: &database.DatabaseName,
+		Version:  &version,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find the migration history of version %q", version)
+	}
+	if len(list) == 0 {
+		return "", nil
+	}
+	return list[0].Schema, nil
+}
+
+// restoreAndCompare restores the backup into a scratch database and compares it with the expected schema, and the row counts
+// with the source database if compareRowCount is true. The expected schema is restored into another scratch database to get
+// its metadata, the comparison is skipped if it's empty.
+// Returns the list of the drill failure details.
+func (r *Runner) restoreAndCompare(ctx context.Context, backup *api.Backup, drillInstance *store.InstanceMessage, dbSchema *store.DBSchema, expectedSchema string, compareRowCount bool) ([]string, error) {
+	adminDriver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, drillInstance, "" /* databaseName */)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect the drill instance %q", drillInstance.Title)
+	}
+	defer adminDriver.Close(ctx)
+	adminConn, err := getAdminConnection(ctx, adminDriver)
+	if err != nil {
+		return nil, err
+	}
+
+	scratchDatabaseName := fmt.Sprintf("bytebase_drill_%d_%d", backup.DatabaseID, time.Now().Unix())
+	dropScratchDatabase, err := createScratchDatabase(ctx, adminConn, drillInstance.Engine, scratchDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	defer dropScratchDatabase()
+
+	var expected *storepb.DatabaseMetadata
+	if expectedSchema != "" {
+		expectedDatabaseName := scratchDatabaseName + "_expected"
+		dropExpectedDatabase, err := createScratchDatabase(ctx, adminConn, drillInstance.Engine, expectedDatabaseName)
+		if err != nil {
+			return nil, err
+		}
+		defer dropExpectedDatabase()
+		if expected, err = r.restoreExpectedSchema(ctx, drillInstance, expectedDatabaseName, expectedSchema); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.restoreToScratchDatabase(ctx, backup, drillInstance, scratchDatabaseName, dbSchema, expected, compareRowCount)
+}
+
+// createScratchDatabase creates the scratch database on the drill instance, and returns the function to drop it.
+func createScratchDatabase(ctx context.Context, adminConn *sql.DB, engine db.Type, name string) (func(), error) {
+	if _, err := adminConn.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE %s;", quoteIdentifier(engine, name))); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the scratch database %q", name)
+	}
+	return func() {
+		// The scratch database must be disconnected before dropping it.
+		if _, err := adminConn.ExecContext(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s;", quoteIdentifier(engine, name))); err != nil {
+			log.Warn("Failed to drop the scratch database of the backup restore drill", zap.String("database", name), zap.Error(err))
+		}
+	}, nil
+}
+
+// restoreExpectedSchema restores the expected schema into the scratch database, and returns its metadata.
+func (r *Runner) restoreExpectedSchema(ctx context.Context, drillInstance *store.InstanceMessage, databaseName, schema string) (*storepb.DatabaseMetadata, error) {
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, drillInstance, databaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect the scratch database %q", databaseName)
+	}
+	defer driver.Close(ctx)
+	if err := driver.Restore(ctx, strings.NewReader(schema)); err != nil {
+		return nil, errors.Wrap(err, "failed to restore the expected schema")
+	}
+	metadata, err := driver.SyncDBSchema(ctx, databaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sync the schema of the scratch database %q", databaseName)
+	}
+	return metadata, nil
+}
+
+func (r *Runner) restoreToScratchDatabase(ctx context.Context, backup *api.Backup, drillInstance *store.InstanceMessage, scratchDatabaseName string, dbSchema *store.DBSchema, expected *storepb.DatabaseMetadata, compareRowCount bool) ([]string, error) {
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, drillInstance, scratchDatabaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect the scratch database %q", scratchDatabaseName)
+	}
+	defer driver.Close(ctx)
+
+	backupFile, err := backuprun.OpenDecodedBackupFile(ctx, r.store, r.s3Client, r.profile.DataDir, backup)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	defer backupFile.Close()
+	if err := driver.Restore(ctx, backupFile); err != nil {
+		return []string{fmt.Sprintf("failed to restore backup: %v", err)}, nil
+	}
+	if expected == nil && !compareRowCount {
+		return nil, nil
+	}
+
+	restored, err := driver.SyncDBSchema(ctx, scratchDatabaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sync the schema of the scratch database %q", scratchDatabaseName)
+	}
+	var detailList []string
+	if expected != nil {
+		detailList = compareDatabaseMetadata(expected, restored)
+	}
+	if !compareRowCount {
+		return detailList, nil
+	}
+
+	conn, err := driver.GetDBConnection(ctx, scratchDatabaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection for the scratch database %q", scratchDatabaseName)
+	}
+	rowCounts := make(map[tableKey]int64)
+	for _, schema := range restored.Schemas {
+		for _, table := range schema.Tables {
+			var count int64
+			query := fmt.Sprintf("SELECT COUNT(*) FROM %s;", quoteTable(drillInstance.Engine, schema.Name, table.Name))
+			if err := conn.QueryRowContext(ctx, query).Scan(&count); err != nil {
+				return nil, errors.Wrapf(err, "failed to count rows of table %q", table.Name)
+			}
+			rowCounts[tableKey{schema: schema.Name, table: table.Name}] = count
+		}
+	}
+	detailList = append(detailList, compareRowCounts(dbSchema.Metadata, rowCounts)...)
+	return detailList, nil
+}
+
+func getAdminConnection(ctx context.Context, driver db.Driver) (*sql.DB, error) {
+	database := ""
+	if driver.GetType() == db.Postgres {
+		database = db.BytebaseDatabase
+	}
+	conn, err := driver.GetDBConnection(ctx, database)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get connection for the drill instance")
+	}
+	return conn, nil
+}
@@ -0,0 +1,24 @@
+package ldapsync
+
+import (
+	"sort"
+
+	"github.com/bytebase/bytebase/common"
+)
+
+// diffProjectRoles returns the sorted IDs of the projects to grant the mapped roles in, and the ones to revoke the previously granted roles from.
+// The mapped roles are always granted again so that the memberships removed by others are restored in the next sync.
+func diffProjectRoles(granted, mapped map[int]common.ProjectRole) ([]int, []int) {
+	var grants, revokes []int
+	for projectID := range mapped {
+		grants = append(grants, projectID)
+	}
+	for projectID := range granted {
+		if _, ok := mapped[projectID]; !ok {
+			revokes = append(revokes, projectID)
+		}
+	}
+	sort.Ints(grants)
+	sort.Ints(revokes)
+	return grants, revokes
+}
@@ -0,0 +1,45 @@
+package ldapsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/common"
+)
+
+func TestDiffProjectRoles(t *testing.T) {
+	tests := []struct {
+		name        string
+		granted     map[int]common.ProjectRole
+		mapped      map[int]common.ProjectRole
+		wantGrants  []int
+		wantRevokes []int
+	}{
+		{
+			name:       "first sync",
+			granted:    nil,
+			mapped:     map[int]common.ProjectRole{102: common.ProjectDeveloper, 101: common.ProjectOwner},
+			wantGrants: []int{101, 102},
+		},
+		{
+			name:        "role changed and project removed",
+			granted:     map[int]common.ProjectRole{101: common.ProjectDeveloper, 102: common.ProjectDeveloper, 103: common.ProjectOwner},
+			mapped:      map[int]common.ProjectRole{101: common.ProjectOwner},
+			wantGrants:  []int{101},
+			wantRevokes: []int{102, 103},
+		},
+		{
+			name:        "user removed",
+			granted:     map[int]common.ProjectRole{101: common.ProjectDeveloper},
+			mapped:      nil,
+			wantRevokes: []int{101},
+		},
+	}
+
+	for _, test := range tests {
+		grants, revokes := diffProjectRoles(test.granted, test.mapped)
+		require.Equal(t, test.wantGrants, grants, test.name)
+		require.Equal(t, test.wantRevokes, revokes, test.name)
+	}
+}
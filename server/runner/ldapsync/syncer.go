@@ -0,0 +1,415 @@
+// Package ldapsync is the runner syncing the members and their roles from the LDAP directory.
+package ldapsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/common/log"
+	enterpriseAPI "github.com/bytebase/bytebase/enterprise/api"
+	"github.com/bytebase/bytebase/plugin/ldap"
+	"github.com/bytebase/bytebase/server/component/activity"
+	"github.com/bytebase/bytebase/store"
+)
+
+const (
+	ldapSyncInterval = 5 * time.Minute
+	// defaultSyncIntervalTs is the default interval between two syncs, which is one hour.
+	defaultSyncIntervalTs = 60 * 60
+)
+
+// NewSyncer creates a new LDAP syncer.
+func NewSyncer(store *store.Store, activityManager *activity.Manager, licenseService enterpriseAPI.LicenseService) *Syncer {
+	return &Syncer{
+		store:           store,
+		activityManager: activityManager,
+		licenseService:  licenseService,
+	}
+}
+
+// Syncer is the runner syncing the users from the LDAP directory into the workspace members.
+// The users removed from the directory are deactivated, and the LDAP groups are mapped to the workspace roles and project memberships.
+type Syncer struct {
+	store           *store.Store
+	activityManager *activity.Manager
+	licenseService  enterpriseAPI.LicenseService
+	// mu serializes the periodic syncs and the manual syncs.
+	mu         sync.Mutex
+	lastSyncTs int64
+}
+
+// Run is the runner for the LDAP directory sync.
+func (s *Syncer) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(ldapSyncInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("LDAP syncer started and will run every %v", ldapSyncInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						log.Error("LDAP syncer PANIC RECOVER", zap.Error(err), zap.Stack("panic-stack"))
+					}
+				}()
+				value, err := s.getSetting(ctx)
+				if err != nil {
+					log.Error("Failed to get LDAP setting", zap.Error(err))
+					return
+				}
+				if value == nil || !value.Enabled || !s.licenseService.IsFeatureEnabled(api.FeatureLDAP) {
+					return
+				}
+				intervalTs := value.IntervalTs
+				if intervalTs <= 0 {
+					intervalTs = defaultSyncIntervalTs
+				}
+				if time.Now().Unix() < s.lastSyncTs+int64(intervalTs) {
+					return
+				}
+				result, err := s.Sync(ctx)
+				if err != nil {
+					log.Error("Failed to sync LDAP directory", zap.Error(err))
+					return
+				}
+				log.Debug("LDAP directory synced",
+					zap.Int("created", result.CreatedCount),
+					zap.Int("updated", result.UpdatedCount),
+					zap.Int("deactivated", result.DeactivatedCount))
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+// Sync syncs the users from the LDAP directory.
+func (s *Syncer) Sync(ctx context.Context) (*api.LDAPSyncResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, err := s.getSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil || !value.Enabled {
+		return nil, errors.New("LDAP directory sync is not enabled")
+	}
+	directoryUsers, err := ldap.Fetch(ctx, value.Config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch users from the LDAP directory")
+	}
+	ldapUsers, err := s.store.ListLDAPUsers(ctx, &store.FindLDAPUserMessage{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list LDAP users")
+	}
+	linkedUsers := make(map[int]*store.LDAPUserMessage)
+	for _, ldapUser := range ldapUsers {
+		linkedUsers[ldapUser.PrincipalID] = ldapUser
+	}
+
+	result := &api.LDAPSyncResult{}
+	syncedUsers := make(map[int]bool)
+	for _, directoryUser := range directoryUsers {
+		userID, err := s.syncUser(ctx, directoryUser, linkedUsers, value.GroupMappingList, result)
+		if err != nil {
+			log.Error("Failed to sync LDAP user", zap.String("dn", directoryUser.DN), zap.Error(err))
+			continue
+		}
+		if userID != 0 {
+			syncedUsers[userID] = true
+		}
+	}
+
+	// An empty directory is more likely a misconfigured filter or base DN than that everyone has left,
+	// so we don't deactivate anyone in this case.
+	if len(directoryUsers) == 0 {
+		log.Warn("No user is found in the LDAP directory, skip deactivating the users")
+	} else {
+		for _, ldapUser := range ldapUsers {
+			if syncedUsers[ldapUser.PrincipalID] {
+				continue
+			}
+			deactivated, err := s.deactivateUser(ctx, ldapUser)
+			if err != nil {
+				log.Error("Failed to deactivate LDAP user", zap.Int("userID", ldapUser.PrincipalID), zap.Error(err))
+				continue
+			}
+			if deactivated {
+				result.DeactivatedCount++
+			}
+		}
+	}
+	s.lastSyncTs = time.Now().Unix()
+	return result, nil
+}
+
+func (s *Syncer) getSetting(ctx context.Context) (*api.SettingWorkspaceLDAPValue, error) {
+	settingName := api.SettingWorkspaceLDAP
+	setting, err := s.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil || setting.Value == "" {
+		return nil, nil
+	}
+	value := &api.SettingWorkspaceLDAPValue{}
+	if err := json.Unmarshal([]byte(setting.Value), value); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal LDAP setting")
+	}
+	return value, nil
+}
+
+// syncUser creates or updates the user from the directory user, and returns the ID of the synced user.
+// The returned ID is 0 if the user is skipped.
+func (s *Syncer) syncUser(ctx context.Context, directoryUser *ldap.User, linkedUsers map[int]*store.LDAPUserMessage, groupMappingList []*api.GroupMapping, result *api.LDAPSyncResult) (int, error) {
+	user, err := s.store.GetUserByEmail(ctx, directoryUser.Email)
+	if err != nil {
+		return 0, err
+	}
+	updated := false
+	if user == nil {
+		if user, err = s.createUser(ctx, directoryUser); err != nil {
+			return 0, err
+		}
+		result.CreatedCount++
+	} else {
+		if user.Type != api.EndUser {
+			return 0, nil
+		}
+		linkedUser := linkedUsers[user.ID]
+		// Only the users deactivated by the sync are reactivated, the users deactivated by the admin stay deactivated.
+		if user.MemberDeleted {
+			if linkedUser == nil || !linkedUser.DeactivatedBySync {
+				return 0, nil
+			}
+			deleted := false
+			if user, err = s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Delete: &deleted}, api.SystemBotID); err != nil {
+				return 0, errors.Wrapf(err, "failed to reactivate user %d", user.ID)
+			}
+			updated = true
+		}
+	}
+
+	role, projectRoles := api.GetMappedRoles(groupMappingList, directoryUser.Groups)
+	if role != "" && role != user.Role {
+		lastOwner, err := s.isLastOwner(ctx, user)
+		if err != nil {
+			return 0, err
+		}
+		if !lastOwner {
+			if user, err = s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Role: &role}, api.SystemBotID); err != nil {
+				return 0, errors.Wrapf(err, "failed to update the role of user %d", user.ID)
+			}
+			updated = true
+		}
+	}
+
+	var grantedProjectRoles map[int]common.ProjectRole
+	if linkedUser := linkedUsers[user.ID]; linkedUser != nil {
+		grantedProjectRoles = linkedUser.ProjectRoles
+	}
+	projectRolesUpdated, err := s.syncProjectRoles(ctx, user.ID, grantedProjectRoles, projectRoles)
+	if err != nil {
+		return 0, err
+	}
+	if projectRolesUpdated {
+		updated = true
+	}
+	if _, err := s.store.UpsertLDAPUser(ctx, &store.LDAPUserMessage{
+		PrincipalID:  user.ID,
+		DN:           directoryUser.DN,
+		ProjectRoles: projectRoles,
+	}); err != nil {
+		return 0, errors.Wrapf(err, "failed to link user %d with %q", user.ID, directoryUser.DN)
+	}
+	if updated {
+		result.UpdatedCount++
+	}
+	return user.ID, nil
+}
+
+func (s *Syncer) createUser(ctx context.Context, directoryUser *ldap.User) (*store.UserMessage, error) {
+	// The random password is supposed to be not guessable, the user signs in with the LDAP password instead.
+	password, err := common.RandomString(20)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate random password")
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate password hash")
+	}
+	user, err := s.store.CreateUser(ctx, &store.UserMessage{
+		Email:        directoryUser.Email,
+		Name:         directoryUser.Name,
+		Type:         api.EndUser,
+		PasswordHash: string(passwordHash),
+	}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create user %q", directoryUser.Email)
+	}
+
+	bytes, err := json.Marshal(api.ActivityMemberCreatePayload{
+		PrincipalID:    user.ID,
+		PrincipalName:  user.Name,
+		PrincipalEmail: user.Email,
+		MemberStatus:   api.Active,
+		Role:           user.Role,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct activity payload")
+	}
+	if _, err := s.activityManager.CreateActivity(ctx, &api.ActivityCreate{
+		CreatorID:   api.SystemBotID,
+		ContainerID: user.ID,
+		Type:        api.ActivityMemberCreate,
+		Level:       api.ActivityInfo,
+		Payload:     string(bytes),
+	}, &activity.Metadata{}); err != nil {
+		log.Warn("Failed to create activity after creating LDAP user", zap.Int("userID", user.ID), zap.Error(err))
+	}
+	return user, nil
+}
+
+// deactivateUser deactivates the user removed from the directory and revokes the project roles granted by the sync.
+// Returns false if the user is already deactivated or is the last owner.
+func (s *Syncer) deactivateUser(ctx context.Context, ldapUser *store.LDAPUserMessage) (bool, error) {
+	user, err := s.store.GetUserByID(ctx, ldapUser.PrincipalID)
+	if err != nil {
+		return false, err
+	}
+	if user == nil || user.MemberDeleted {
+		return false, nil
+	}
+	lastOwner, err := s.isLastOwner(ctx, user)
+	if err != nil {
+		return false, err
+	}
+	if lastOwner {
+		log.Warn("Skip deactivating the last owner removed from the LDAP directory", zap.String("email", user.Email))
+		return false, nil
+	}
+
+	if _, err := s.syncProjectRoles(ctx, user.ID, ldapUser.ProjectRoles, nil); err != nil {
+		return false, err
+	}
+	if _, err := s.store.UpsertLDAPUser(ctx, &store.LDAPUserMessage{
+		PrincipalID:       user.ID,
+		DN:                ldapUser.DN,
+		DeactivatedBySync: true,
+	}); err != nil {
+		return false, errors.Wrapf(err, "failed to update LDAP user %d", user.ID)
+	}
+	deleted := true
+	if _, err := s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Delete: &deleted}, api.SystemBotID); err != nil {
+		return false, errors.Wrapf(err, "failed to deactivate user %d", user.ID)
+	}
+	return true, nil
+}
+
+// isLastOwner returns whether the user is the only active owner, who must not be demoted or deactivated.
+func (s *Syncer) isLastOwner(ctx context.Context, user *store.UserMessage) (bool, error) {
+	if user.Role != api.Owner {
+		return false, nil
+	}
+	countResult, err := s.store.CountMemberGroupByRoleAndStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, count := range countResult {
+		if count.Role == api.Owner && count.RowStatus == api.Normal && count.Count == 1 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// syncProjectRoles grants the mapped project roles and revokes the ones granted previously but no longer mapped.
+// Returns whether any membership is changed.
+func (s *Syncer) syncProjectRoles(ctx context.Context, userID int, granted, mapped map[int]common.ProjectRole) (bool, error) {
+	grants, revokes := diffProjectRoles(granted, mapped)
+	updated := false
+	roleProvider := api.ProjectRoleProviderBytebase
+	for _, projectID := range revokes {
+		projectID := projectID
+		member, err := s.store.GetProjectMember(ctx, &api.ProjectMemberFind{
+			ProjectID:    &projectID,
+			PrincipalID:  &userID,
+			RoleProvider: &roleProvider,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get the membership of user %d in project %d", userID, projectID)
+		}
+		// Keep the membership if it's changed by others after granted.
+		if member == nil || member.Role != string(granted[projectID]) {
+			continue
+		}
+		if err := s.store.DeleteProjectMember(ctx, &api.ProjectMemberDelete{
+			ID:        member.ID,
+			ProjectID: projectID,
+			DeleterID: api.SystemBotID,
+		}); err != nil {
+			return false, errors.Wrapf(err, "failed to remove user %d from project %d", userID, projectID)
+		}
+		updated = true
+	}
+
+	for _, projectID := range grants {
+		projectID := projectID
+		projectRole := mapped[projectID]
+		project, err := s.store.GetProjectByID(ctx, projectID)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get project %d", projectID)
+		}
+		if project == nil || project.RowStatus == api.Archived || project.RoleProvider != api.ProjectRoleProviderBytebase {
+			continue
+		}
+		member, err := s.store.GetProjectMember(ctx, &api.ProjectMemberFind{
+			ProjectID:    &projectID,
+			PrincipalID:  &userID,
+			RoleProvider: &roleProvider,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get the membership of user %d in project %d", userID, projectID)
+		}
+		if member == nil {
+			if _, err := s.store.CreateProjectMember(ctx, &api.ProjectMemberCreate{
+				CreatorID:    api.SystemBotID,
+				ProjectID:    projectID,
+				Role:         projectRole,
+				PrincipalID:  userID,
+				RoleProvider: api.ProjectRoleProviderBytebase,
+			}); err != nil {
+				return false, errors.Wrapf(err, "failed to add user %d to project %d", userID, projectID)
+			}
+			updated = true
+			continue
+		}
+		if member.Role != string(projectRole) {
+			role := string(projectRole)
+			if _, err := s.store.PatchProjectMember(ctx, &api.ProjectMemberPatch{
+				ID:        member.ID,
+				UpdaterID: api.SystemBotID,
+				Role:      &role,
+			}); err != nil {
+				return false, errors.Wrapf(err, "failed to update the role of user %d in project %d", userID, projectID)
+			}
+			updated = true
+		}
+	}
+	return updated, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/bytebase/bytebase/common/log"
 	"github.com/bytebase/bytebase/plugin/db"
 	"github.com/bytebase/bytebase/plugin/db/mysql"
+	"github.com/bytebase/bytebase/plugin/db/pg"
 	"github.com/bytebase/bytebase/server/component/dbfactory"
 	"github.com/bytebase/bytebase/server/component/state"
 	"github.com/bytebase/bytebase/store"
@@ -29,6 +30,9 @@ func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *sta
 	}
 }
 
+// rollbackSlotSweepInterval is the interval to drop the orphaned logical replication slots for PostgreSQL rollback SQL.
+const rollbackSlotSweepInterval = 1 * time.Hour
+
 // Runner is the rollback runner generating rollback SQL statements.
 type Runner struct {
 	store     *store.Store
@@ -40,10 +44,15 @@ type Runner struct {
 func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
+	sweepTicker := time.NewTicker(rollbackSlotSweepInterval)
+	defer sweepTicker.Stop()
 	defer wg.Done()
 	r.retryGenerateRollbackSQL(ctx)
+	r.sweepRollbackSlots(ctx)
 	for {
 		select {
+		case <-sweepTicker.C:
+			r.sweepRollbackSlots(ctx)
 		case <-ticker.C:
 			r.stateCfg.RollbackGenerateMap.Range(func(key, value any) bool {
 				task := value.(*api.Task)
@@ -64,7 +73,7 @@ func (r *Runner) retryGenerateRollbackSQL(ctx context.Context) {
 	find := &api.TaskFind{
 		StatusList: &[]api.TaskStatus{api.TaskDone},
 		TypeList:   &[]api.TaskType{api.TaskDatabaseDataUpdate},
-		Payload:    "payload->>'threadID'!='' AND payload->>'rollbackError' IS NULL AND payload->>'rollbackStatement' IS NULL",
+		Payload:    "(payload->>'threadID'!='' OR payload->>'replicationSlot'!='') AND payload->>'rollbackError' IS NULL AND payload->>'rollbackStatement' IS NULL",
 	}
 	taskList, err := r.store.FindTask(ctx, find, true)
 	if err != nil {
@@ -128,6 +137,9 @@ func (r *Runner) generateRollbackSQLImpl(ctx context.Context, task *api.Task, pa
 	if payload.SheetID > 0 {
 		return "", errors.Errorf("rollback SQL isn't supported for large sheet")
 	}
+	if instance.Engine == db.Postgres {
+		return r.generatePostgresRollbackSQL(ctx, task, instance, payload)
+	}
 	basename, seqStart, err := mysql.ParseBinlogName(payload.BinlogFileStart)
 	if err != nil {
 		return "", errors.WithMessagef(err, "invalid start binlog file name %s", payload.BinlogFileStart)
@@ -170,3 +182,125 @@ func (r *Runner) generateRollbackSQLImpl(ctx context.Context, task *api.Task, pa
 
 	return rollbackSQL, nil
 }
+
+func (r *Runner) generatePostgresRollbackSQL(ctx context.Context, task *api.Task, instance *store.InstanceMessage, payload *api.TaskDatabaseDataUpdatePayload) (string, error) {
+	if payload.ReplicationSlot == "" {
+		return "", errors.Errorf("rollback SQL requires wal_level=logical and the privilege to create replication slots")
+	}
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return "", err
+	}
+	if database == nil {
+		return "", errors.Errorf("database with ID %d not found", *task.DatabaseID)
+	}
+	// The logical replication slot can only be decoded in the database where it's created.
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, database.DatabaseName)
+	if err != nil {
+		return "", errors.WithMessage(err, "failed to get admin database driver")
+	}
+	defer driver.Close(ctx)
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return "", errors.Errorf("failed to cast driver to pg.Driver")
+	}
+	// Drop the slot no matter whether the generation succeeds, otherwise the slot retains the WAL files on the server forever.
+	defer func() {
+		if err := pgDriver.DropRollbackSlot(ctx, payload.ReplicationSlot); err != nil {
+			log.Error("Failed to drop the logical replication slot", zap.String("slot", payload.ReplicationSlot), zap.Error(err))
+		}
+	}()
+	if payload.TransactionID == "" || payload.LSNEnd == "" {
+		return "", errors.Errorf("no transaction is recorded for the task")
+	}
+
+	rollbackSQL, err := pgDriver.GenerateRollbackSQL(ctx, payload.ReplicationSlot, payload.LSNEnd, payload.TransactionID)
+	if err != nil {
+		return "", errors.WithMessage(err, "failed to generate rollback SQL statement")
+	}
+	return rollbackSQL, nil
+}
+
+// sweepRollbackSlots drops the orphaned logical replication slots for PostgreSQL rollback SQL, which are left if Bytebase
+// crashes or fails to record the slot in the task. Otherwise the slots retain the WAL files on the server forever.
+func (r *Runner) sweepRollbackSlots(ctx context.Context) {
+	instances, err := r.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
+	if err != nil {
+		log.Error("Failed to list instances", zap.Error(err))
+		return
+	}
+	for _, instance := range instances {
+		if instance.Engine != db.Postgres {
+			continue
+		}
+		if err := r.sweepInstanceRollbackSlots(ctx, instance); err != nil {
+			log.Error("Failed to drop the orphaned logical replication slots", zap.String("instance", instance.ResourceID), zap.Error(err))
+		}
+	}
+}
+
+func (r *Runner) sweepInstanceRollbackSlots(ctx context.Context, instance *store.InstanceMessage) error {
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, "")
+	if err != nil {
+		return errors.WithMessage(err, "failed to get admin database driver")
+	}
+	defer driver.Close(ctx)
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return errors.Errorf("failed to cast driver to pg.Driver")
+	}
+	slots, err := pgDriver.ListRollbackSlots(ctx)
+	if err != nil {
+		return err
+	}
+
+	for slotName, databaseName := range slots {
+		taskID, _ := pg.ParseRollbackSlotName(slotName)
+		task, err := r.store.GetTaskByID(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if !isOrphanedRollbackSlot(task, instance, slotName) {
+			continue
+		}
+		// The logical replication slot can only be dropped in the database where it's created.
+		if err := r.dropRollbackSlot(ctx, instance, databaseName, slotName); err != nil {
+			log.Error("Failed to drop the orphaned logical replication slot", zap.String("instance", instance.ResourceID), zap.String("slot", slotName), zap.Error(err))
+			continue
+		}
+		log.Info("Dropped the orphaned logical replication slot", zap.String("instance", instance.ResourceID), zap.String("slot", slotName))
+	}
+	return nil
+}
+
+func (r *Runner) dropRollbackSlot(ctx context.Context, instance *store.InstanceMessage, databaseName, slotName string) error {
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, databaseName)
+	if err != nil {
+		return errors.WithMessage(err, "failed to get admin database driver")
+	}
+	defer driver.Close(ctx)
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return errors.Errorf("failed to cast driver to pg.Driver")
+	}
+	return pgDriver.DropRollbackSlot(ctx, slotName)
+}
+
+// isOrphanedRollbackSlot returns true if the task doesn't need the logical replication slot any more.
+// The slot is in use if the task is running, or the task is done and its rollback SQL is not generated yet.
+func isOrphanedRollbackSlot(task *api.Task, instance *store.InstanceMessage, slotName string) bool {
+	if task == nil || task.InstanceID != instance.UID {
+		return true
+	}
+	if task.Status == api.TaskRunning {
+		return false
+	}
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true
+	}
+	if payload.ReplicationSlot != slotName {
+		return true
+	}
+	return task.Status != api.TaskDone || payload.RollbackStatement != "" || payload.RollbackError != ""
+}
@@ -19,6 +19,7 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	"github.com/bytebase/bytebase/server/component/config"
 	"github.com/bytebase/bytebase/server/component/dbfactory"
+	"github.com/bytebase/bytebase/server/component/monitor"
 	"github.com/bytebase/bytebase/server/component/state"
 	"github.com/bytebase/bytebase/server/utils"
 	"github.com/bytebase/bytebase/store"
@@ -90,6 +91,7 @@ func (s *Syncer) syncAllInstances(ctx context.Context) {
 		go func(instance *store.InstanceMessage) {
 			defer instanceWG.Done()
 			log.Debug("Sync instance schema", zap.String("instance", instance.ResourceID))
+			defer monitor.ObserveSince(monitor.SchemaSyncDuration.WithLabelValues(instance.ResourceID, "instance"), time.Now())
 			if _, err := s.SyncInstance(ctx, instance); err != nil {
 				log.Debug("Failed to sync instance",
 					zap.String("instance", instance.ResourceID),
@@ -142,6 +144,7 @@ func (s *Syncer) syncAllDatabases(ctx context.Context, instance *api.Instance) {
 				return
 			}
 			instanceID := databaseList[0].InstanceID
+			defer monitor.ObserveSince(monitor.SchemaSyncDuration.WithLabelValues(instanceID, "database"), time.Now())
 			for _, database := range databaseList {
 				log.Debug("Sync database schema",
 					zap.String("instance", instanceID),
@@ -0,0 +1,78 @@
+package taskcheck
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/plugin/db/pg"
+	"github.com/bytebase/bytebase/server/component/dbfactory"
+	"github.com/bytebase/bytebase/store"
+)
+
+// NewPITRPostgresExecutor creates a task check PostgreSQL PITR executor.
+func NewPITRPostgresExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &PITRPostgresExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// PITRPostgresExecutor is the task check PostgreSQL PITR executor.
+type PITRPostgresExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run will run the task check PostgreSQL PITR executor once.
+func (e *PITRPostgresExecutor) Run(ctx context.Context, _ *api.TaskCheckRun, task *api.Task) (result []api.TaskCheckResult, err error) {
+	payload := api.TaskDatabasePITRRestorePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), &payload); err != nil {
+		return nil, errors.Wrapf(err, "invalid PITR restore payload: %s", task.Payload)
+	}
+
+	if payload.BackupID != nil {
+		return []api.TaskCheckResult{
+			{
+				Status:    api.TaskCheckStatusSuccess,
+				Namespace: api.BBNamespace,
+				Code:      common.Ok.Int(),
+				Title:     "OK",
+				Content:   "Ready to do backup restore",
+			},
+		}, nil
+	}
+
+	// Unlike MySQL, PostgreSQL PITR replays the WAL files archived from the source instance, so we check the source instance.
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance by ID %d", task.InstanceID)
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, "" /* databaseName */)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return nil, errors.Errorf("Failed to cast driver to pg.Driver")
+	}
+
+	if err := pgDriver.CheckPITRSettings(ctx); err != nil {
+		return wrapTaskCheckError(err), nil
+	}
+
+	return []api.TaskCheckResult{
+		{
+			Status:    api.TaskCheckStatusSuccess,
+			Namespace: api.BBNamespace,
+			Code:      common.Ok.Int(),
+			Title:     "OK",
+			Content:   "Ready to do PITR",
+		},
+	}, nil
+}
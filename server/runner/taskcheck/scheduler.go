@@ -15,6 +15,8 @@ import (
 	"github.com/bytebase/bytebase/common"
 	"github.com/bytebase/bytebase/common/log"
 	enterpriseAPI "github.com/bytebase/bytebase/enterprise/api"
+	"github.com/bytebase/bytebase/plugin/db"
+	"github.com/bytebase/bytebase/server/component/monitor"
 	"github.com/bytebase/bytebase/server/component/state"
 	"github.com/bytebase/bytebase/server/utils"
 	"github.com/bytebase/bytebase/store"
@@ -133,6 +135,9 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 						checkResultList, err := executor.Run(ctx, taskCheckRun, task)
 
 						if err == nil {
+							for _, checkResult := range checkResultList {
+								monitor.TaskCheckResultCount.WithLabelValues(string(taskCheckRun.Type), string(checkResult.Status)).Inc()
+							}
 							bytes, err := json.Marshal(api.TaskCheckRunResultPayload{
 								ResultList: checkResultList,
 							})
@@ -162,6 +167,7 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 								)
 							}
 						} else {
+							monitor.TaskCheckResultCount.WithLabelValues(string(taskCheckRun.Type), string(api.TaskCheckRunFailed)).Inc()
 							log.Warn("Failed to run task check",
 								zap.Int("id", taskCheckRun.ID),
 								zap.Int("task_id", taskCheckRun.TaskID),
@@ -404,15 +410,23 @@ func (*Scheduler) getGhostTaskCheck(_ context.Context, task *api.Task, creatorID
 	}, nil
 }
 
-func (*Scheduler) getPITRTaskCheck(_ context.Context, task *api.Task, creatorID int) ([]*api.TaskCheckRunCreate, error) {
+func (s *Scheduler) getPITRTaskCheck(ctx context.Context, task *api.Task, creatorID int) ([]*api.TaskCheckRunCreate, error) {
 	if task.Type != api.TaskDatabaseRestorePITRRestore {
 		return nil, nil
 	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance by ID %d", task.InstanceID)
+	}
+	checkType := api.TaskCheckPITRMySQL
+	if instance.Engine == db.Postgres {
+		checkType = api.TaskCheckPITRPostgres
+	}
 	return []*api.TaskCheckRunCreate{
 		{
 			CreatorID: creatorID,
 			TaskID:    task.ID,
-			Type:      api.TaskCheckPITRMySQL,
+			Type:      checkType,
 		},
 	}, nil
 }
@@ -84,12 +84,13 @@ func (e *StatementAdvisorCompositeExecutor) Run(ctx context.Context, taskCheckRu
 	}
 
 	adviceList, err := advisor.SQLReviewCheck(payload.Statement, policy.RuleList, advisor.SQLReviewCheckContext{
-		Charset:   payload.Charset,
-		Collation: payload.Collation,
-		DbType:    dbType,
-		Catalog:   catalog,
-		Driver:    connection,
-		Context:   ctx,
+		Charset:            payload.Charset,
+		Collation:          payload.Collation,
+		DbType:             dbType,
+		Catalog:            catalog,
+		Driver:             connection,
+		Context:            ctx,
+		DisableSuppression: policy.DisableSuppression,
 	})
 	if err != nil {
 		return nil, err
@@ -97,6 +98,19 @@ func (e *StatementAdvisorCompositeExecutor) Run(ctx context.Context, taskCheckRu
 
 	result = []api.TaskCheckResult{}
 	for _, advice := range adviceList {
+		// Record the suppressed advice so that the reviewers could see what was suppressed and why.
+		if advice.Suppressed {
+			result = append(result, api.TaskCheckResult{
+				Status:         api.TaskCheckStatusSuccess,
+				Namespace:      api.AdvisorNamespace,
+				Code:           advice.Code.Int(),
+				Title:          advice.Title,
+				Content:        advice.Content,
+				Suppressed:     true,
+				SuppressReason: advice.SuppressReason,
+			})
+			continue
+		}
 		status := api.TaskCheckStatusSuccess
 		switch advice.Status {
 		case advisor.Success:
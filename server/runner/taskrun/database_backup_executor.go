@@ -2,10 +2,14 @@ package taskrun
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -14,9 +18,11 @@ import (
 	"github.com/bytebase/bytebase/api"
 	"github.com/bytebase/bytebase/common/log"
 	"github.com/bytebase/bytebase/plugin/db"
+	"github.com/bytebase/bytebase/plugin/storage/backupfile"
 	bbs3 "github.com/bytebase/bytebase/plugin/storage/s3"
 	"github.com/bytebase/bytebase/server/component/config"
 	"github.com/bytebase/bytebase/server/component/dbfactory"
+	"github.com/bytebase/bytebase/server/component/monitor"
 	"github.com/bytebase/bytebase/server/runner/backuprun"
 	"github.com/bytebase/bytebase/store"
 )
@@ -74,16 +80,19 @@ func (exec *DatabaseBackupExecutor) RunOnce(ctx context.Context, task *api.Task)
 		return true, nil, err
 	}
 	log.Debug("Start database backup.", zap.String("instance", task.Instance.Name), zap.String("database", task.Database.Name), zap.String("backup", backup.Name))
-	backupPayload, backupErr := exec.backupDatabase(ctx, exec.dbFactory, exec.s3Client, exec.profile, instance, task.Database.Name, backup)
+	start := time.Now()
+	backupPayload, backupErr := exec.backupDatabase(ctx, exec.dbFactory, exec.s3Client, exec.profile, instance, task.Database.Name, backup, payload)
 	backupStatus := string(api.BackupStatusDone)
 	comment := ""
 	if backupErr != nil {
 		backupStatus = string(api.BackupStatusFailed)
+		monitor.BackupFailureCount.WithLabelValues(string(backup.StorageBackend)).Inc()
 		comment = backupErr.Error()
-		if err := removeLocalBackupFile(exec.profile.DataDir, backup); err != nil {
+		if err := removeBackupFile(ctx, exec.s3Client, exec.profile.DataDir, backup); err != nil {
 			log.Warn(err.Error())
 		}
 	}
+	monitor.ObserveSince(monitor.BackupDuration.WithLabelValues(string(backup.StorageBackend), backupStatus), start)
 	backupPatch := api.BackupPatch{
 		ID:        backup.ID,
 		Status:    &backupStatus,
@@ -105,8 +114,15 @@ func (exec *DatabaseBackupExecutor) RunOnce(ctx context.Context, task *api.Task)
 	}, nil
 }
 
-func removeLocalBackupFile(dataDir string, backup *api.Backup) error {
+func removeBackupFile(ctx context.Context, s3Client *bbs3.Client, dataDir string, backup *api.Backup) error {
 	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		if s3Client == nil {
+			return nil
+		}
+		// The backup file may be uploaded before failing the verification.
+		if err := s3Client.DeleteObjects(ctx, backup.Path); err != nil {
+			return errors.Wrapf(err, "failed to delete the backup file %s in the cloud storage", backup.Path)
+		}
 		return nil
 	}
 	backupFilePath := backuprun.GetBackupAbsFilePath(dataDir, backup.DatabaseID, backup.Name)
@@ -131,56 +147,157 @@ func getAvailableFSSpace(path string) (uint64, error) {
 	return stat.Bavail * uint64(stat.Bsize), nil
 }
 
-func dumpBackupFile(ctx context.Context, driver db.Driver, databaseName, backupFilePath string) (string, error) {
+func dumpBackupFile(ctx context.Context, driver db.Driver, databaseName, backupFilePath string, opts backupfile.Options) (string, string, error) {
 	backupFile, err := os.Create(backupFilePath)
 	if err != nil {
-		return "", errors.Errorf("failed to open backup path %q", backupFilePath)
+		return "", "", errors.Errorf("failed to open backup path %q", backupFilePath)
 	}
 	defer backupFile.Close()
-	payload, err := driver.Dump(ctx, databaseName, backupFile, false /* schemaOnly */)
+	payload, checksum, err := dumpBackup(ctx, driver, databaseName, backupFile, opts)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to dump database %q to local backup file %q", databaseName, backupFilePath)
+	}
+	if err := backupFile.Close(); err != nil {
+		return "", "", errors.Wrapf(err, "failed to close local backup file %q", backupFilePath)
+	}
+	return payload, checksum, nil
+}
+
+// dumpBackup dumps the database to w with the encoding options.
+// Returns the dump payload and the hex encoded SHA-256 checksum of the backup file.
+func dumpBackup(ctx context.Context, driver db.Driver, databaseName string, w io.Writer, opts backupfile.Options) (string, string, error) {
+	hash := sha256.New()
+	encoder, err := backupfile.NewWriter(io.MultiWriter(w, hash), opts)
+	if err != nil {
+		return "", "", err
+	}
+	payload, err := driver.Dump(ctx, databaseName, encoder, false /* schemaOnly */)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to dump database %q to local backup file %q", databaseName, backupFilePath)
+		return "", "", err
 	}
-	return payload, nil
+	if err := encoder.Close(); err != nil {
+		return "", "", errors.Wrap(err, "failed to flush backup file")
+	}
+	return payload, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// verifyBackup re-reads the backup file to verify the checksum and that it can be decoded.
+func verifyBackup(ctx context.Context, s3Client *bbs3.Client, profile config.Profile, backup *api.Backup, key []byte, checksum string) error {
+	backupFile, err := backuprun.OpenBackupFile(ctx, s3Client, profile.DataDir, backup)
+	if err != nil {
+		return err
+	}
+	defer backupFile.Close()
+
+	hash := sha256.New()
+	r := io.TeeReader(backupFile, hash)
+	decoder, err := backupfile.NewReader(r, key)
+	if err != nil {
+		return err
+	}
+	defer decoder.Close()
+	if _, err := io.Copy(io.Discard, decoder); err != nil {
+		return errors.Wrap(err, "failed to decode backup file")
+	}
+	// Drain the rest of the backup file not consumed by the decoder.
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return errors.Wrap(err, "failed to read backup file")
+	}
+	if got := hex.EncodeToString(hash.Sum(nil)); got != checksum {
+		return errors.Errorf("backup file checksum mismatch, expecting %s but got %s", checksum, got)
+	}
+	return nil
 }
 
 // backupDatabase will take a backup of a database.
-func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, databaseName string, backup *api.Backup) (string, error) {
+func (exec *DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, databaseName string, backup *api.Backup, taskPayload *api.TaskDatabaseBackupPayload) (string, error) {
 	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, databaseName)
 	if err != nil {
 		return "", err
 	}
 	defer driver.Close(ctx)
 
-	backupFilePathLocal := filepath.Join(profile.DataDir, backup.Path)
-	payload, err := dumpBackupFile(ctx, driver, databaseName, backupFilePathLocal)
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to dump backup file %q", backupFilePathLocal)
+	opts := backupfile.Options{
+		Compression: taskPayload.Compression,
+	}
+	if taskPayload.Encryption {
+		key, err := backuprun.GetBackupEncryptionKey(ctx, exec.store)
+		if err != nil {
+			return "", err
+		}
+		opts.Key = key
 	}
 
+	var dumpPayload, checksum string
 	switch backup.StorageBackend {
 	case api.BackupStorageBackendLocal:
-		return payload, nil
-	case api.BackupStorageBackendS3:
-		log.Debug("Uploading backup to s3 bucket.", zap.String("bucket", s3Client.GetBucket()), zap.String("path", backupFilePathLocal))
-		bucketFileToUpload, err := os.Open(backupFilePathLocal)
+		backupFilePathLocal := filepath.Join(profile.DataDir, backup.Path)
+		dumpPayload, checksum, err = dumpBackupFile(ctx, driver, databaseName, backupFilePathLocal, opts)
 		if err != nil {
-			return "", errors.Wrapf(err, "failed to open backup file %q for uploading to s3 bucket", backupFilePathLocal)
+			return "", errors.Wrapf(err, "failed to dump backup file %q", backupFilePathLocal)
 		}
-		defer bucketFileToUpload.Close()
-
-		if _, err := s3Client.UploadObject(ctx, backup.Path, bucketFileToUpload); err != nil {
-			return "", errors.Wrapf(err, "failed to upload backup to AWS S3")
+	case api.BackupStorageBackendS3, api.BackupStorageBackendGCS, api.BackupStorageBackendOSS:
+		if s3Client == nil {
+			return "", errors.Errorf("backup storage backend %s is not configured", backup.StorageBackend)
 		}
-		log.Debug("Successfully uploaded backup to s3 bucket.")
-
-		if err := os.Remove(backupFilePathLocal); err != nil {
-			log.Warn("Failed to remove the local backup file after uploading to s3 bucket.", zap.String("path", backupFilePathLocal), zap.Error(err))
-		} else {
-			log.Debug("Successfully removed the local backup file after uploading to s3 bucket.", zap.String("path", backupFilePathLocal))
+		log.Debug("Uploading backup to the cloud storage.", zap.String("backend", string(backup.StorageBackend)), zap.String("bucket", s3Client.GetBucket()), zap.String("path", backup.Path))
+		dumpPayload, checksum, err = dumpBackupToCloud(ctx, driver, s3Client, databaseName, backup.Path, opts)
+		if err != nil {
+			return "", err
 		}
-		return payload, nil
+		log.Debug("Successfully uploaded backup to the cloud storage.", zap.String("path", backup.Path))
 	default:
 		return "", errors.Errorf("backup to %s not implemented yet", backup.StorageBackend)
 	}
+
+	if err := verifyBackup(ctx, s3Client, profile, backup, opts.Key, checksum); err != nil {
+		return "", errors.Wrapf(err, "failed to verify backup %q", backup.Name)
+	}
+
+	backupPayload := api.BackupPayload{}
+	if dumpPayload != "" {
+		if err := json.Unmarshal([]byte(dumpPayload), &backupPayload); err != nil {
+			return "", errors.Wrapf(err, "failed to unmarshal backup payload %q", dumpPayload)
+		}
+	}
+	backupPayload.Compression = opts.Compression
+	backupPayload.Encrypted = opts.Key != nil
+	backupPayload.Checksum = checksum
+	bytes, err := json.Marshal(backupPayload)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal backup payload")
+	}
+	return string(bytes), nil
+}
+
+// dumpBackupToCloud streams the dump of the database to the cloud storage without writing a local file.
+func dumpBackupToCloud(ctx context.Context, driver db.Driver, s3Client *bbs3.Client, databaseName, backupFilePathOnCloud string, opts backupfile.Options) (string, string, error) {
+	type dumpResult struct {
+		payload  string
+		checksum string
+		err      error
+	}
+	pr, pw := io.Pipe()
+	resultChan := make(chan dumpResult, 1)
+	go func() {
+		payload, checksum, err := dumpBackup(ctx, driver, databaseName, pw, opts)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to dump database %q", databaseName)
+		}
+		resultChan <- dumpResult{payload: payload, checksum: checksum, err: err}
+		// Closing the pipe with a nil error is the same as Close.
+		pw.CloseWithError(err)
+	}()
+
+	_, err := s3Client.UploadObject(ctx, backupFilePathOnCloud, pr)
+	// Unblock the dump goroutine if the upload exits early.
+	pr.Close()
+	result := <-resultChan
+	if result.err != nil {
+		return "", "", result.err
+	}
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to upload backup %q to the cloud storage", backupFilePathOnCloud)
+	}
+	return result.payload, result.checksum, nil
 }
@@ -200,13 +200,7 @@ func (exec *DatabaseCreateExecutor) RunOnce(ctx context.Context, task *api.Task)
 		return true, nil, err
 	}
 
-	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
-		log.Error("failed to sync database schema",
-			zap.String("instanceName", instance.Name),
-			zap.String("databaseName", database.DatabaseName),
-			zap.Error(err),
-		)
-	}
+	syncExpectedDatabaseSchema(ctx, exec.store, exec.schemaSyncer, database)
 
 	return true, &api.TaskRunResultPayload{
 		Detail:      fmt.Sprintf("Created database %q", payload.DatabaseName),
@@ -16,6 +16,7 @@ import (
 	"github.com/bytebase/bytebase/common/log"
 	"github.com/bytebase/bytebase/plugin/db"
 	"github.com/bytebase/bytebase/plugin/db/mysql"
+	"github.com/bytebase/bytebase/plugin/db/pg"
 	"github.com/bytebase/bytebase/plugin/parser"
 	"github.com/bytebase/bytebase/plugin/parser/transform"
 	vcsPlugin "github.com/bytebase/bytebase/plugin/vcs"
@@ -23,6 +24,7 @@ import (
 	"github.com/bytebase/bytebase/server/component/config"
 	"github.com/bytebase/bytebase/server/component/dbfactory"
 	"github.com/bytebase/bytebase/server/component/state"
+	"github.com/bytebase/bytebase/server/runner/schemasync"
 	"github.com/bytebase/bytebase/server/utils"
 	"github.com/bytebase/bytebase/store"
 )
@@ -165,9 +167,19 @@ func executeMigration(ctx context.Context, stores *store.Store, dbFactory *dbfac
 		}
 		task = updatedTask
 	}
+	if task.Type == api.TaskDatabaseDataUpdate && task.Instance.Engine == db.Postgres {
+		updatedTask, err := setReplicationSlot(ctx, driver, task, stores)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to update the task payload for PostgreSQL rollback SQL")
+		}
+		task = updatedTask
+	}
 
 	migrationID, schema, err = driver.ExecuteMigration(ctx, mi, statement)
 	if err != nil {
+		if task.Type == api.TaskDatabaseDataUpdate && task.Instance.Engine == db.Postgres {
+			dropReplicationSlot(ctx, driver, task)
+		}
 		return "", "", err
 	}
 
@@ -179,6 +191,14 @@ func executeMigration(ctx context.Context, stores *store.Store, dbFactory *dbfac
 		// The runner will periodically scan the map to generate rollback SQL asynchronously.
 		stateCfg.RollbackGenerateMap.Store(updatedTask.ID, updatedTask)
 	}
+	if task.Type == api.TaskDatabaseDataUpdate && task.Instance.Engine == db.Postgres {
+		updatedTask, err := setMigrationIDAndTransactionID(ctx, driver, task, stores, migrationID)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to update the task payload for PostgreSQL rollback SQL")
+		}
+		// The runner will periodically scan the map to generate rollback SQL asynchronously.
+		stateCfg.RollbackGenerateMap.Store(updatedTask.ID, updatedTask)
+	}
 
 	return migrationID, schema, nil
 }
@@ -269,6 +289,111 @@ func setMigrationIDAndEndBinlogCoordinate(ctx context.Context, driver db.Driver,
 	return updatedTask, nil
 }
 
+// setReplicationSlot creates the logical replication slot to capture the changes of the migration transaction for PostgreSQL rollback SQL.
+// The migration is not blocked if the instance cannot decode the WAL, and the rollback SQL generation will fail with the reason.
+func setReplicationSlot(ctx context.Context, driver db.Driver, task *api.Task, store *store.Store) (*api.Task, error) {
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return nil, errors.Errorf("failed to cast driver to pg.Driver")
+	}
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "invalid database data update payload")
+	}
+	// We don't generate rollback SQL for sheets, so there's no need to capture the changes.
+	if payload.SheetID > 0 {
+		return task, nil
+	}
+	enabled, err := pgDriver.IsLogicalDecodingEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		log.Warn("logical decoding is not enabled", zap.Int("task", task.ID))
+		return task, nil
+	}
+	slotName := pg.GetRollbackSlotName(task.ID)
+	if err := pgDriver.CreateRollbackSlot(ctx, task.Database.Name, slotName); err != nil {
+		log.Warn("failed to create the logical replication slot", zap.Int("task", task.ID), zap.String("slot", slotName), zap.Error(err))
+		return task, nil
+	}
+	payload.ReplicationSlot = slotName
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	patch := &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}
+	updatedTask, err := store.PatchTask(ctx, patch)
+	if err != nil {
+		// Nothing refers to the slot if the patch fails, drop it so that it doesn't retain the WAL files on the server.
+		if err := pgDriver.DropRollbackSlot(ctx, slotName); err != nil {
+			log.Error("failed to drop the logical replication slot", zap.Int("task", task.ID), zap.String("slot", slotName), zap.Error(err))
+		}
+		return nil, errors.Wrapf(err, "failed to patch task %d with the replication slot", task.ID)
+	}
+	pgDriver.EnableTransactionIDCapture()
+	return updatedTask, nil
+}
+
+func setMigrationIDAndTransactionID(ctx context.Context, driver db.Driver, task *api.Task, store *store.Store, migrationID string) (*api.Task, error) {
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return nil, errors.Errorf("failed to cast driver to pg.Driver")
+	}
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "invalid database data update payload")
+	}
+	if payload.ReplicationSlot == "" {
+		return task, nil
+	}
+
+	payload.MigrationID = migrationID
+	payload.TransactionID = pgDriver.GetLastTransactionID()
+	lsn, err := pgDriver.GetCurrentWALLSN(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the WAL location after executing the migration transaction")
+	}
+	payload.LSNEnd = lsn
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	patch := &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}
+	updatedTask, err := store.PatchTask(ctx, patch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to patch task %d with the transaction ID", task.ID)
+	}
+	return updatedTask, nil
+}
+
+// dropReplicationSlot drops the logical replication slot of the failed migration, otherwise the slot retains the WAL files on the server forever.
+func dropReplicationSlot(ctx context.Context, driver db.Driver, task *api.Task) {
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return
+	}
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil || payload.ReplicationSlot == "" {
+		return
+	}
+	if err := pgDriver.DropRollbackSlot(ctx, payload.ReplicationSlot); err != nil {
+		log.Error("failed to drop the logical replication slot", zap.Int("task", task.ID), zap.String("slot", payload.ReplicationSlot), zap.Error(err))
+	}
+}
+
 func postMigration(ctx context.Context, stores *store.Store, activityManager *activity.Manager, profile config.Profile, task *api.Task, vcsPushEvent *vcsPlugin.PushEvent, mi *db.MigrationInfo, migrationID string, schema string) (bool, *api.TaskRunResultPayload, error) {
 	databaseName := task.Database.Name
 	issue, err := findIssueByTask(ctx, stores, task)
@@ -447,6 +572,36 @@ func runMigration(ctx context.Context, store *store.Store, dbFactory *dbfactory.
 	return postMigration(ctx, store, activityManager, profile, task, vcsPushEvent, mi, migrationID, schema)
 }
 
+// syncExpectedDatabaseSchema syncs the database schema after a migration and records it as the expected schema,
+// against which the anomaly scanner detects schema drift.
+func syncExpectedDatabaseSchema(ctx context.Context, stores *store.Store, schemaSyncer *schemasync.Syncer, database *store.DatabaseMessage) {
+	if err := schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+		log.Error("failed to sync database schema",
+			zap.String("instanceName", database.InstanceID),
+			zap.String("databaseName", database.DatabaseName),
+			zap.Error(err),
+		)
+		return
+	}
+	// The forced sync updates the schema version to the latest migration history version.
+	updatedDatabase, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &database.UID})
+	if err != nil || updatedDatabase == nil {
+		log.Error("failed to get database",
+			zap.String("instanceName", database.InstanceID),
+			zap.String("databaseName", database.DatabaseName),
+			zap.Error(err),
+		)
+		return
+	}
+	if err := stores.UpdateDBSchemaExpectedMetadata(ctx, database.UID, updatedDatabase.SchemaVersion); err != nil {
+		log.Error("failed to record expected database schema",
+			zap.String("instanceName", database.InstanceID),
+			zap.String("databaseName", database.DatabaseName),
+			zap.Error(err),
+		)
+	}
+}
+
 func findIssueByTask(ctx context.Context, store *store.Store, task *api.Task) (*api.Issue, error) {
 	issue, err := store.GetIssueByPipelineID(ctx, task.PipelineID)
 	if err != nil {
@@ -461,7 +616,7 @@ func findIssueByTask(ctx context.Context, store *store.Store, task *api.Task) (*
 // Writes back the latest schema to the repository after migration
 // Returns the commit id on success.
 func writeBackLatestSchema(ctx context.Context, store *store.Store, repository *api.Repository, pushEvent *vcsPlugin.PushEvent, mi *db.MigrationInfo, branch string, latestSchemaFile string, schema string, bytebaseURL string) (string, error) {
-	schemaFileMeta, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{}).ReadFileMeta(
+	schemaFileMeta, err := vcsPlugin.Get(repository.VCS.Type, vcsPlugin.ProviderConfig{CACert: repository.VCS.CACert}).ReadFileMeta(
 		ctx,
 		common.OauthContext{
 			ClientID:     repository.VCS.ApplicationID,
@@ -534,7 +689,7 @@ func writeBackLatestSchema(ctx context.Context, store *store.Store, repository *
 			zap.String("schema_file", latestSchemaFile),
 		)
 
-		err := vcsPlugin.Get(repo2.VCS.Type, vcsPlugin.ProviderConfig{}).CreateFile(
+		err := vcsPlugin.Get(repo2.VCS.Type, vcsPlugin.ProviderConfig{CACert: repo2.VCS.CACert}).CreateFile(
 			ctx,
 			common.OauthContext{
 				ClientID:     repo2.VCS.ApplicationID,
@@ -557,7 +712,7 @@ func writeBackLatestSchema(ctx context.Context, store *store.Store, repository *
 		)
 
 		schemaFileCommit.LastCommitID = schemaFileMeta.LastCommitID
-		err := vcsPlugin.Get(repo2.VCS.Type, vcsPlugin.ProviderConfig{}).OverwriteFile(
+		err := vcsPlugin.Get(repo2.VCS.Type, vcsPlugin.ProviderConfig{CACert: repo2.VCS.CACert}).OverwriteFile(
 			ctx,
 			common.OauthContext{
 				ClientID:     repo2.VCS.ApplicationID,
@@ -587,7 +742,7 @@ func writeBackLatestSchema(ctx context.Context, store *store.Store, repository *
 		return "", errors.Errorf("repository not found after schema write-back: %v", repository.ID)
 	}
 	// VCS such as GitLab API doesn't return the commit on write, so we have to call ReadFileMeta again
-	schemaFileMeta, err = vcsPlugin.Get(repo2.VCS.Type, vcsPlugin.ProviderConfig{}).ReadFileMeta(
+	schemaFileMeta, err = vcsPlugin.Get(repo2.VCS.Type, vcsPlugin.ProviderConfig{CACert: repo2.VCS.CACert}).ReadFileMeta(
 		ctx,
 		common.OauthContext{
 			ClientID:     repo2.VCS.ApplicationID,
@@ -143,13 +143,7 @@ func (exec *PITRCutoverExecutor) pitrCutover(ctx context.Context, dbFactory *dbf
 	}
 
 	// Sync database schema after restore is completed.
-	if err := schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
-		log.Error("failed to sync database schema",
-			zap.String("instanceName", task.Database.Instance.Name),
-			zap.String("databaseName", task.Database.Name),
-			zap.Error(err),
-		)
-	}
+	syncExpectedDatabaseSchema(ctx, exec.store, schemaSyncer, database)
 
 	return true, &api.TaskRunResultPayload{
 		Detail: fmt.Sprintf("Swapped PITR database for target database %q", task.Database.Name),
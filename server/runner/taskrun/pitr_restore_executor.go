@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
@@ -106,7 +107,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 			if err != nil {
 				return nil, err
 			}
-			return exec.doRestoreInPlacePostgres(ctx, stores, dbFactory, profile, issue, task, payload)
+			return exec.doRestoreInPlacePostgres(ctx, stores, dbFactory, s3Client, profile, issue, task, payload)
 		}
 		return nil, errors.Errorf("we only support backup restore replace for PostgreSQL now")
 	}
@@ -158,13 +159,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 	}
 
 	// Sync database schema after restore is completed.
-	if err := schemaSyncer.SyncDatabaseSchema(ctx, targetDatabase, true /* force */); err != nil {
-		log.Error("failed to sync database schema",
-			zap.String("instanceName", targetDatabase.InstanceID),
-			zap.String("databaseName", targetDatabase.DatabaseName),
-			zap.Error(err),
-		)
-	}
+	syncExpectedDatabaseSchema(ctx, stores, schemaSyncer, targetDatabase)
 
 	return &api.TaskRunResultPayload{
 		Detail:      fmt.Sprintf("Restored database %q from backup %q", targetDatabase.DatabaseName, backup.Name),
@@ -202,6 +197,10 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		return nil, err
 	}
 
+	if pgSourceDriver, ok := sourceDriver.(*pg.Driver); ok {
+		return exec.doPITRRestorePostgres(ctx, dbFactory, s3Client, pgSourceDriver, issue, task, payload)
+	}
+
 	backupStatus := api.BackupStatusDone
 	backupList, err := exec.store.FindBackup(ctx, &api.BackupFind{DatabaseID: task.DatabaseID, Status: &backupStatus})
 	if err != nil {
@@ -237,14 +236,15 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	log.Debug("Got latest backup before or equal to targetTs", zap.String("backup", backup.Name))
 
 	backupAbsPathLocal := backuprun.GetBackupAbsFilePath(profile.DataDir, backup.DatabaseID, backup.Name)
-	if backup.StorageBackend == api.BackupStorageBackendS3 {
+	// The progress of the MySQL PITR is computed from the size of the backup file, so we download the backup file from the cloud storage.
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
 		if err := downloadBackupFileFromCloud(ctx, s3Client, backup.Path, backupAbsPathLocal); err != nil {
-			return nil, errors.Wrapf(err, "failed to download backup %q from S3", backup.Path)
+			return nil, errors.Wrapf(err, "failed to download backup %q from %s", backup.Path, backup.StorageBackend)
 		}
 		defer os.Remove(backupAbsPathLocal)
 		replayBinlogPathList, err := downloadBinlogFilesFromCloud(ctx, s3Client, startBinlogInfo, *targetBinlogInfo, binlogDir)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to download binlog files from %s to %s from S3", startBinlogInfo.FileName, targetBinlogInfo.FileName)
+			return nil, errors.Wrapf(err, "failed to download binlog files from %s to %s from %s", startBinlogInfo.FileName, targetBinlogInfo.FileName, backup.StorageBackend)
 		}
 		defer func() {
 			for _, binlogPath := range replayBinlogPathList {
@@ -261,6 +261,11 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}
 	defer backupFile.Close()
 	log.Debug("Successfully opened backup file", zap.String("filename", backupAbsPathLocal))
+	backupReader, err := backuprun.GetBackupDecoder(ctx, exec.store, backup, backupFile)
+	if err != nil {
+		return nil, err
+	}
+	defer backupReader.Close()
 
 	log.Debug("Start creating and restoring PITR database",
 		zap.String("instance", task.Instance.Name),
@@ -273,7 +278,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 
 	if payload.DatabaseName != nil {
 		// case 1: PITR to a new database.
-		if err := mysqlTargetDriver.RestoreBackupToDatabase(ctx, backupFile, *payload.DatabaseName); err != nil {
+		if err := mysqlTargetDriver.RestoreBackupToDatabase(ctx, backupReader, *payload.DatabaseName); err != nil {
 			log.Error("failed to restore full backup in the new database",
 				zap.Int("issueID", issue.ID),
 				zap.String("databaseName", *payload.DatabaseName),
@@ -289,7 +294,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		}
 	} else {
 		// case 2: in-place PITR.
-		if err := mysqlTargetDriver.RestoreBackupToPITRDatabase(ctx, backupFile, task.Database.Name, issue.CreatedTs); err != nil {
+		if err := mysqlTargetDriver.RestoreBackupToPITRDatabase(ctx, backupReader, task.Database.Name, issue.CreatedTs); err != nil {
 			log.Error("failed to restore full backup in the PITR database",
 				zap.Int("issueID", issue.ID),
 				zap.String("databaseName", task.Database.Name),
@@ -315,6 +320,99 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}, nil
 }
 
+// doPITRRestorePostgres recovers the database to the point in time by replaying the archived WAL files, and restores the dump of the recovered database to the target database.
+// Unlike MySQL, the logical backups are not used, because the WAL files can only be replayed on a physical base backup.
+func (exec *PITRRestoreExecutor) doPITRRestorePostgres(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, sourceDriver *pg.Driver, issue *api.Issue, task *api.Task, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+	targetInstanceID := task.InstanceID
+	if payload.TargetInstanceID != nil {
+		targetInstanceID = *payload.TargetInstanceID
+	}
+	targetInstance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &targetInstanceID})
+	if err != nil {
+		return nil, err
+	}
+
+	var targetDriver db.Driver
+	targetDatabaseName := task.Database.Name
+	if payload.DatabaseName != nil {
+		// case 1: PITR to a new database.
+		targetDatabaseName = *payload.DatabaseName
+		if targetDriver, err = dbFactory.GetAdminDatabaseDriver(ctx, targetInstance, targetDatabaseName); err != nil {
+			return nil, err
+		}
+		defer targetDriver.Close(ctx)
+	} else {
+		// case 2: in-place PITR.
+		if targetDriver, err = dbFactory.GetAdminDatabaseDriver(ctx, targetInstance, task.Database.Name); err != nil {
+			return nil, err
+		}
+		defer targetDriver.Close(ctx)
+		targetDatabaseName, err = createPostgresPITRDatabase(ctx, targetDriver, task.Database.Name, issue.CreatedTs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targetTs := *payload.PointInTimeTs
+	log.Debug("Start recovering and restoring PITR database",
+		zap.String("instance", task.Instance.Name),
+		zap.String("database", task.Database.Name),
+		zap.String("targetDatabase", targetDatabaseName),
+		zap.Int64("targetTs", targetTs),
+	)
+	reader, writer := io.Pipe()
+	go func() {
+		err := sourceDriver.DumpDatabaseAtTs(ctx, task.Database.Name, targetTs, s3Client, writer)
+		writer.CloseWithError(err)
+	}()
+	if err := targetDriver.Restore(ctx, reader); err != nil {
+		// Stop the dumping as well.
+		reader.CloseWithError(err)
+		log.Error("failed to perform a PITR restore",
+			zap.Int("issueID", issue.ID),
+			zap.String("databaseName", targetDatabaseName),
+			zap.Error(err))
+		return nil, errors.Wrapf(err, "failed to restore database %q to %s", task.Database.Name, time.Unix(targetTs, 0).Format(time.RFC822))
+	}
+
+	log.Info("PITR restore success", zap.String("target database", targetDatabaseName))
+	return &api.TaskRunResultPayload{
+		Detail: fmt.Sprintf("PITR restore success for target database %q", targetDatabaseName),
+	}, nil
+}
+
+// createPostgresPITRDatabase creates the temporary PITR database with the same owner as the original database, and switches the driver connection to it.
+func createPostgresPITRDatabase(ctx context.Context, driver db.Driver, databaseName string, issueCreatedTs int64) (string, error) {
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		log.Error("Failed to cast driver to pg.Driver")
+		return "", errors.Errorf("[internal] cast driver to pg.Driver failed")
+	}
+	originalOwner, err := pgDriver.GetCurrentDatabaseOwner()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the OWNER of database %q", databaseName)
+	}
+
+	conn, err := driver.GetDBConnection(ctx, db.BytebaseDatabase)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get connection for PostgreSQL")
+	}
+	pitrDatabaseName := util.GetPITRDatabaseName(databaseName, issueCreatedTs)
+	// If there's already a PITR database, it means there's a failed trial before this task execution.
+	// We need to clean up the dirty state and start clean for idempotent task execution.
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s;", pitrDatabaseName)); err != nil {
+		return "", errors.Wrapf(err, "failed to drop the dirty PITR database %q left from a former task execution", pitrDatabaseName)
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE %s WITH OWNER %s;", pitrDatabaseName, originalOwner)); err != nil {
+		return "", errors.Wrapf(err, "failed to create the PITR database %q", pitrDatabaseName)
+	}
+	// Switch to the PITR database.
+	if _, err := driver.GetDBConnection(ctx, pitrDatabaseName); err != nil {
+		return "", errors.Wrapf(err, "failed to switch connection to database %q", pitrDatabaseName)
+	}
+	return pitrDatabaseName, nil
+}
+
 func downloadBinlogFilesFromCloud(ctx context.Context, client *bbs3.Client, startBinlogInfo, targetBinlogInfo api.BinlogInfo, binlogDir string) ([]string, error) {
 	replayBinlogPathList, err := mysql.GetBinlogReplayList(startBinlogInfo, targetBinlogInfo, binlogDir)
 	if err != nil {
@@ -330,11 +428,7 @@ func downloadBinlogFilesFromCloud(ctx context.Context, client *bbs3.Client, star
 	return replayBinlogPathList, nil
 }
 
-func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, profile config.Profile, issue *api.Issue, task *api.Task, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
-	if payload.BackupID == nil {
-		return nil, errors.Errorf("PITR for Postgres is not implemented")
-	}
-
+func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, issue *api.Issue, task *api.Task, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
 	backup, err := stores.GetBackupByID(ctx, *payload.BackupID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find backup with ID %d", *payload.BackupID)
@@ -342,10 +436,9 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 	if backup == nil {
 		return nil, errors.Errorf("backup with ID %d not found", *payload.BackupID)
 	}
-	backupFileName := backuprun.GetBackupAbsFilePath(profile.DataDir, backup.DatabaseID, backup.Name)
-	backupFile, err := os.Open(backupFileName)
+	backupFile, err := backuprun.OpenDecodedBackupFile(ctx, stores, s3Client, profile.DataDir, backup)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to open backup file %q", backupFileName)
+		return nil, err
 	}
 	defer backupFile.Close()
 
@@ -359,33 +452,9 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 	}
 	defer driver.Close(ctx)
 
-	pgDriver, ok := driver.(*pg.Driver)
-	if !ok {
-		log.Error("Failed to cast driver to pg.Driver")
-		return nil, errors.Errorf("[internal] cast driver to pg.Driver failed")
-	}
-	originalOwner, err := pgDriver.GetCurrentDatabaseOwner()
+	pitrDatabaseName, err := createPostgresPITRDatabase(ctx, driver, task.Database.Name, issue.CreatedTs)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get the OWNER of database %q", task.Database.Name)
-	}
-
-	db, err := driver.GetDBConnection(ctx, db.BytebaseDatabase)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get connection for PostgreSQL")
-	}
-	pitrDatabaseName := util.GetPITRDatabaseName(task.Database.Name, issue.CreatedTs)
-	// If there's already a PITR database, it means there's a failed trial before this task execution.
-	// We need to clean up the dirty state and start clean for idempotent task execution.
-	if _, err := db.ExecContext(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s;", pitrDatabaseName)); err != nil {
-		return nil, errors.Wrapf(err, "failed to drop the dirty PITR database %q left from a former task execution", pitrDatabaseName)
-	}
-	if _, err := db.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE %s WITH OWNER %s;", pitrDatabaseName, originalOwner)); err != nil {
-		return nil, errors.Wrapf(err, "failed to create the PITR database %q", pitrDatabaseName)
-	}
-	// Switch to the PITR database.
-	// TODO(dragonly): This is a trick, needs refactor.
-	if _, err := driver.GetDBConnection(ctx, pitrDatabaseName); err != nil {
-		return nil, errors.Wrapf(err, "failed to switch connection to database %q", pitrDatabaseName)
+		return nil, err
 	}
 	if err := driver.Restore(ctx, backupFile); err != nil {
 		return nil, errors.Wrapf(err, "failed to restore backup to the PITR database %q", pitrDatabaseName)
@@ -453,29 +522,20 @@ func getIssueByPipelineID(ctx context.Context, store *store.Store, pid int) (*ap
 }
 
 // restoreDatabase will restore the database to the instance from the backup.
-func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, databaseName string, backup *api.Backup) error {
+func (exec *PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, databaseName string, backup *api.Backup) error {
 	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, databaseName)
 	if err != nil {
 		return err
 	}
 	defer driver.Close(ctx)
 
-	backupAbsPathLocal := filepath.Join(profile.DataDir, backup.Path)
-
-	if backup.StorageBackend == api.BackupStorageBackendS3 {
-		if err := downloadBackupFileFromCloud(ctx, s3Client, backup.Path, backupAbsPathLocal); err != nil {
-			return errors.Wrapf(err, "failed to download backup %q from S3", backup.Path)
-		}
-		defer os.Remove(backupAbsPathLocal)
-	}
-
-	backupFileLocal, err := os.Open(backupAbsPathLocal)
+	backupFile, err := backuprun.OpenDecodedBackupFile(ctx, exec.store, s3Client, profile.DataDir, backup)
 	if err != nil {
-		return errors.Wrapf(err, "failed to open backup file at %s", backupAbsPathLocal)
+		return err
 	}
-	defer backupFileLocal.Close()
+	defer backupFile.Close()
 
-	if err := driver.Restore(ctx, backupFileLocal); err != nil {
+	if err := driver.Restore(ctx, backupFile); err != nil {
 		return errors.Wrap(err, "failed to restore backup")
 	}
 
@@ -484,6 +544,13 @@ func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfa
 
 func downloadBackupFileFromCloud(ctx context.Context, s3Client *bbs3.Client, backupPath, backupAbsPathLocal string) error {
 	log.Debug("Downloading backup file from s3 bucket.", zap.String("path", backupPath))
+	if s3Client == nil {
+		return errors.New("cloud backup storage is not configured")
+	}
+	// The local backup directory is not created for the backups in the cloud storage.
+	if err := os.MkdirAll(filepath.Dir(backupAbsPathLocal), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to create local backup directory for %q", backupAbsPathLocal)
+	}
 	backupFileDownload, err := os.Create(backupAbsPathLocal)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create local backup file %q for downloading from s3 bucket", backupAbsPathLocal)
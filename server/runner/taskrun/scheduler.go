@@ -23,6 +23,7 @@ import (
 	"github.com/bytebase/bytebase/plugin/db"
 	"github.com/bytebase/bytebase/server/component/activity"
 	"github.com/bytebase/bytebase/server/component/config"
+	"github.com/bytebase/bytebase/server/component/monitor"
 	"github.com/bytebase/bytebase/server/component/state"
 	"github.com/bytebase/bytebase/server/runner/apprun"
 	"github.com/bytebase/bytebase/server/runner/schemasync"
@@ -158,6 +159,8 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 					databaseRunningTasks[*task.DatabaseID] = task.ID
 				}
 
+				// queueDepth is the number of running tasks that are not being executed after this round.
+				queueDepth := 0
 				for _, task := range taskList {
 					// Skip task belongs to archived instances
 					if i := task.Instance; i == nil || i.RowStatus == api.Archived {
@@ -167,6 +170,7 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 					if _, ok := s.stateCfg.RunningTasks.Load(task.ID); ok {
 						continue
 					}
+					queueDepth++
 					// Skip the task that is not the earliest task of the database.
 					// earliestTaskID is the one that should be executed.
 					if task.DatabaseID != nil {
@@ -193,6 +197,7 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 					s.stateCfg.InstanceOutstandingConnections[task.InstanceID]++
 					s.stateCfg.Unlock()
 
+					queueDepth--
 					s.stateCfg.RunningTasks.Store(task.ID, true)
 					go func(ctx context.Context, task *api.Task, executor Executor) {
 						defer func() {
@@ -207,7 +212,15 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 						executorCtx, cancel := context.WithCancel(ctx)
 						s.stateCfg.RunningTasksCancel.Store(task.ID, cancel)
 
+						start := time.Now()
 						done, result, err := RunExecutorOnce(executorCtx, executor, task)
+						if done {
+							status := api.TaskDone
+							if err != nil {
+								status = api.TaskFailed
+							}
+							monitor.ObserveSince(monitor.TaskRunDuration.WithLabelValues(string(task.Type), string(status)), start)
+						}
 
 						select {
 						case <-executorCtx.Done():
@@ -332,6 +345,7 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 						}
 					}(ctx, task, executor)
 				}
+				monitor.TaskSchedulerQueueDepth.Set(float64(queueDepth))
 			}()
 		case <-ctx.Done(): // if cancel() execute
 			return
@@ -676,7 +690,7 @@ func (s *Scheduler) ClearRunningTasks(ctx context.Context) error {
 			return errors.Wrapf(err, "failed to patch backup %d's status from %s to %s", payload.BackupID, api.BackupStatusPendingCreate, api.BackupStatusFailed)
 		}
 		log.Debug(fmt.Sprintf("Changed backup %d's status from %s to %s", payload.BackupID, api.BackupStatusPendingCreate, api.BackupStatusFailed))
-		if err := removeLocalBackupFile(s.profile.DataDir, backup); err != nil {
+		if err := removeBackupFile(ctx, nil /* s3Client */, s.profile.DataDir, backup); err != nil {
 			log.Warn(err.Error())
 		}
 	}
@@ -57,7 +57,9 @@ func (exec *SchemaBaselineExecutor) RunOnce(ctx context.Context, task *api.Task)
 	}
 
 	terminated, result, err := runMigration(ctx, exec.store, exec.dbFactory, exec.activityManager, exec.stateCfg, exec.profile, task, db.Baseline, "" /* statement */, payload.SchemaVersion, nil /* vcsPushEvent */)
-	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+	if err == nil {
+		syncExpectedDatabaseSchema(ctx, exec.store, exec.schemaSyncer, database)
+	} else if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
 		log.Error("failed to sync database schema",
 			zap.String("instanceName", task.Instance.Name),
 			zap.String("databaseName", task.Database.Name),
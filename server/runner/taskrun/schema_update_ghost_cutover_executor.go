@@ -91,7 +91,9 @@ func (exec *SchemaUpdateGhostCutoverExecutor) RunOnce(ctx context.Context, task
 	sharedGhost := value.(sharedGhostState)
 
 	terminated, result, err := cutover(ctx, exec.store, exec.dbFactory, exec.activityManager, exec.profile, task, payload.Statement, payload.SchemaVersion, payload.VCSPushEvent, postponeFilename, sharedGhost.migrationContext, sharedGhost.errCh)
-	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+	if err == nil {
+		syncExpectedDatabaseSchema(ctx, exec.store, exec.schemaSyncer, database)
+	} else if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
 		log.Error("failed to sync database schema",
 			zap.String("instanceName", task.Instance.Name),
 			zap.String("databaseName", task.Database.Name),
@@ -0,0 +1,160 @@
+// Package webhookrun is the runner delivering the project webhooks with retries.
+package webhookrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/common/log"
+	"github.com/bytebase/bytebase/plugin/webhook"
+	"github.com/bytebase/bytebase/store"
+)
+
+const (
+	runnerInterval = 5 * time.Second
+	// batchSize is the maximum number of the deliveries attempted in a run.
+	batchSize = 100
+	// maxAttempts is the maximum number of attempts before a delivery fails.
+	maxAttempts = 8
+	// initialBackoff is the wait after the first failed attempt, doubled after each failed attempt.
+	initialBackoff = 30 * time.Second
+	maxBackoff     = 2 * time.Hour
+	// retention is how long the finished deliveries are kept.
+	retention = 30 * 24 * time.Hour
+	// maxResponseBodySize is the maximum size of the response body kept in the delivery.
+	maxResponseBodySize = 4096
+)
+
+// NewRunner creates a new webhook delivery runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the runner delivering the pending project webhook deliveries.
+type Runner struct {
+	store *store.Store
+}
+
+// Run starts the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Webhook delivery runner started and will run every %v", runnerInterval))
+	lastCleanup := time.Time{}
+	for {
+		select {
+		case <-ticker.C:
+			r.deliverPending(ctx)
+			if time.Since(lastCleanup) > time.Hour {
+				if err := r.store.DeleteProjectWebhookDeliveries(ctx, time.Now().Add(-retention).Unix()); err != nil {
+					log.Error("Failed to delete the expired webhook deliveries", zap.Error(err))
+				}
+				lastCleanup = time.Now()
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) deliverPending(ctx context.Context) {
+	status := api.ProjectWebhookDeliveryPending
+	now := time.Now().Unix()
+	limit := batchSize
+	deliveries, err := r.store.ListProjectWebhookDeliveries(ctx, &store.FindProjectWebhookDeliveryMessage{
+		Status:              &status,
+		NextAttemptBeforeTs: &now,
+		Limit:               &limit,
+	})
+	if err != nil {
+		log.Error("Failed to list pending webhook deliveries", zap.Error(err))
+		return
+	}
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := r.deliver(ctx, delivery); err != nil {
+			log.Error("Failed to deliver webhook", zap.Int("delivery", delivery.ID), zap.Error(err))
+		}
+	}
+}
+
+// deliver makes an attempt of the delivery and records the result.
+// The returned error is about Bytebase itself, the delivery failures are recorded in the delivery.
+func (r *Runner) deliver(ctx context.Context, delivery *store.ProjectWebhookDeliveryMessage) error {
+	hook, err := r.store.GetProjectWebhookByID(ctx, delivery.ProjectWebhookID)
+	if err != nil {
+		return err
+	}
+	var webhookCtx webhook.Context
+	if err := json.Unmarshal([]byte(delivery.Payload), &webhookCtx); err != nil {
+		return r.finish(ctx, delivery, api.ProjectWebhookDeliveryFailed, nil, fmt.Sprintf("malformed webhook payload: %v", err))
+	}
+	if hook == nil {
+		return r.finish(ctx, delivery, api.ProjectWebhookDeliveryFailed, nil, "webhook not found")
+	}
+	// Use the latest URL and secret in case they have been changed since the delivery is created.
+	webhookCtx.URL = hook.URL
+	webhookCtx.Secret = hook.Secret
+
+	result, err := webhook.Deliver(hook.Type, webhookCtx)
+	delivery.Attempt++
+	if err == nil {
+		return r.finish(ctx, delivery, api.ProjectWebhookDeliverySucceeded, result, "")
+	}
+	// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning.
+	log.Warn("Failed to post webhook event on activity",
+		zap.String("webhook type", hook.Type),
+		zap.String("webhook name", hook.Name),
+		zap.String("activity type", webhookCtx.ActivityType),
+		zap.String("title", webhookCtx.Title),
+		zap.Int("attempt", delivery.Attempt),
+		zap.Error(err))
+	if delivery.Attempt >= maxAttempts {
+		return r.finish(ctx, delivery, api.ProjectWebhookDeliveryFailed, result, err.Error())
+	}
+	delivery.NextAttemptTs = time.Now().Add(backoff(delivery.Attempt)).Unix()
+	return r.finish(ctx, delivery, api.ProjectWebhookDeliveryPending, result, err.Error())
+}
+
+func (r *Runner) finish(ctx context.Context, delivery *store.ProjectWebhookDeliveryMessage, status api.ProjectWebhookDeliveryStatus, result *webhook.Result, errMessage string) error {
+	update := &store.UpdateProjectWebhookDeliveryMessage{
+		ID:            delivery.ID,
+		Status:        &status,
+		Attempt:       &delivery.Attempt,
+		NextAttemptTs: &delivery.NextAttemptTs,
+		Error:         &errMessage,
+	}
+	if result != nil {
+		latencyMs := int(result.Latency.Milliseconds())
+		responseBody, _ := common.TruncateString(result.ResponseBody, maxResponseBodySize)
+		update.Request = &result.Request
+		update.ResponseCode = &result.ResponseCode
+		update.ResponseBody = &responseBody
+		update.LatencyMs = &latencyMs
+	}
+	return r.store.UpdateProjectWebhookDelivery(ctx, update)
+}
+
+// backoff returns the wait before the next attempt after the given number of attempts.
+func backoff(attempt int) time.Duration {
+	d := initialBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
@@ -0,0 +1,27 @@
+package webhookrun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: 30 * time.Second},
+		{attempt: 2, want: time.Minute},
+		{attempt: 3, want: 2 * time.Minute},
+		{attempt: 7, want: 32 * time.Minute},
+		{attempt: 9, want: 2 * time.Hour},
+		{attempt: 100, want: 2 * time.Hour},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, backoff(test.attempt), "attempt %d", test.attempt)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
+	prom "github.com/prometheus/client_golang/prometheus"
 	scas "github.com/qiangmzsx/string-adapter/v2"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"go.uber.org/zap"
@@ -40,7 +41,7 @@ import (
 	enterpriseService "github.com/bytebase/bytebase/enterprise/service"
 	"github.com/bytebase/bytebase/metric"
 	metricCollector "github.com/bytebase/bytebase/metric/collector"
-	"github.com/bytebase/bytebase/plugin/app/feishu"
+	"github.com/bytebase/bytebase/plugin/storage/backupfile"
 	bbs3 "github.com/bytebase/bytebase/plugin/storage/s3"
 	"github.com/bytebase/bytebase/resources/mongoutil"
 	"github.com/bytebase/bytebase/resources/mysqlutil"
@@ -50,17 +51,28 @@ import (
 	"github.com/bytebase/bytebase/server/component/activity"
 	"github.com/bytebase/bytebase/server/component/config"
 	"github.com/bytebase/bytebase/server/component/dbfactory"
+	"github.com/bytebase/bytebase/server/component/monitor"
 	"github.com/bytebase/bytebase/server/component/state"
 	"github.com/bytebase/bytebase/server/runner/anomaly"
 	"github.com/bytebase/bytebase/server/runner/apprun"
 	"github.com/bytebase/bytebase/server/runner/backuprun"
+	"github.com/bytebase/bytebase/server/runner/drillrun"
+	"github.com/bytebase/bytebase/server/runner/ldapsync"
 	"github.com/bytebase/bytebase/server/runner/metricreport"
 	"github.com/bytebase/bytebase/server/runner/rollbackrun"
 	"github.com/bytebase/bytebase/server/runner/schemasync"
 	"github.com/bytebase/bytebase/server/runner/taskcheck"
 	"github.com/bytebase/bytebase/server/runner/taskrun"
+	"github.com/bytebase/bytebase/server/runner/webhookrun"
 	"github.com/bytebase/bytebase/store"
 
+	// Register IM application providers.
+	_ "github.com/bytebase/bytebase/plugin/app/dingtalk"
+	_ "github.com/bytebase/bytebase/plugin/app/feishu"
+	_ "github.com/bytebase/bytebase/plugin/app/httpcallback"
+	_ "github.com/bytebase/bytebase/plugin/app/slack"
+	_ "github.com/bytebase/bytebase/plugin/app/wecom"
+
 	// Register clickhouse driver.
 	_ "github.com/bytebase/bytebase/plugin/db/clickhouse"
 	// Register mysql driver.
@@ -116,9 +128,12 @@ type Server struct {
 	MetricReporter     *metricreport.Reporter
 	SchemaSyncer       *schemasync.Syncer
 	BackupRunner       *backuprun.Runner
+	DrillRunner        *drillrun.Runner
+	LDAPSyncer         *ldapsync.Syncer
 	AnomalyScanner     *anomaly.Scanner
 	ApplicationRunner  *apprun.Runner
 	RollbackRunner     *rollbackrun.Runner
+	WebhookRunner      *webhookrun.Runner
 	runnerWG           sync.WaitGroup
 
 	ActivityManager *activity.Manager
@@ -146,8 +161,7 @@ type Server struct {
 	// Postgres utility binaries
 	pgBinDir string
 
-	s3Client       *bbs3.Client
-	feishuProvider *feishu.Provider
+	s3Client *bbs3.Client
 
 	// stateCfg is the shared in-momory state within the server.
 	stateCfg *state.State
@@ -306,24 +320,21 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	s.e = e
 
 	if profile.BackupBucket != "" {
-		credentials, err := bbs3.GetCredentialsFromFile(ctx, profile.BackupCredentialFile)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get credentials from file")
-		}
-		s3Client, err := bbs3.NewClient(ctx, profile.BackupRegion, profile.BackupBucket, credentials)
+		s3Client, err := newBackupStorageClient(ctx, profile)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to create AWS S3 client")
+			return nil, err
 		}
 		s.s3Client = s3Client
 	}
 
 	if !profile.Readonly {
 		s.SchemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
-		// TODO(p0ny): enable Feishu provider only when it is needed.
-		s.feishuProvider = feishu.NewProvider(profile.FeishuAPIURL)
-		s.ApplicationRunner = apprun.NewRunner(storeInstance, s.ActivityManager, s.feishuProvider, profile)
+		s.ApplicationRunner = apprun.NewRunner(storeInstance, s.ActivityManager, profile)
 		s.BackupRunner = backuprun.NewRunner(storeInstance, s.dbFactory, s.s3Client, s.stateCfg, &profile)
+		s.DrillRunner = drillrun.NewRunner(storeInstance, s.dbFactory, s.s3Client, &profile)
+		s.LDAPSyncer = ldapsync.NewSyncer(storeInstance, s.ActivityManager, s.licenseService)
 		s.RollbackRunner = rollbackrun.NewRunner(storeInstance, s.dbFactory, s.stateCfg)
+		s.WebhookRunner = webhookrun.NewRunner(storeInstance)
 
 		s.TaskScheduler = taskrun.NewScheduler(storeInstance, s.ApplicationRunner, s.SchemaSyncer, s.ActivityManager, s.licenseService, s.stateCfg, profile)
 		s.TaskScheduler.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
@@ -356,6 +367,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.TaskCheckScheduler.Register(api.TaskCheckIssueLGTM, checkLGTMExecutor)
 		pitrMySQLExecutor := taskcheck.NewPITRMySQLExecutor(storeInstance, s.dbFactory)
 		s.TaskCheckScheduler.Register(api.TaskCheckPITRMySQL, pitrMySQLExecutor)
+		pitrPostgresExecutor := taskcheck.NewPITRPostgresExecutor(storeInstance, s.dbFactory)
+		s.TaskCheckScheduler.Register(api.TaskCheckPITRPostgres, pitrPostgresExecutor)
 
 		// Anomaly scanner
 		s.AnomalyScanner = anomaly.NewScanner(storeInstance, s.dbFactory, s.licenseService)
@@ -407,6 +420,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	s.registerSettingRoutes(apiGroup)
 	s.registerActuatorRoutes(apiGroup)
 	s.registerAuthRoutes(apiGroup)
+	s.registerIdentityProviderAuthRoutes(apiGroup)
 	s.registerOAuthRoutes(apiGroup)
 	s.registerPrincipalRoutes(apiGroup)
 	s.registerMemberRoutes(apiGroup)
@@ -426,6 +440,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	s.registerBookmarkRoutes(apiGroup)
 	s.registerSQLRoutes(apiGroup)
 	s.registerVCSRoutes(apiGroup)
+	s.registerIdentityProviderRoutes(apiGroup)
+	s.registerLDAPRoutes(apiGroup)
 	s.registerSubscriptionRoutes(apiGroup)
 	s.registerSheetRoutes(apiGroup)
 	s.registerSheetOrganizerRoutes(apiGroup)
@@ -497,6 +513,12 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	// Register prometheus metrics endpoint.
 	p := prometheus.NewPrometheus("api", nil)
 	p.Use(e)
+	// The server and runner health metrics are opt-in.
+	if profile.EnablePrometheus {
+		if err := monitor.Register(prom.DefaultRegisterer, s.store, s.stateCfg); err != nil {
+			return nil, errors.Wrap(err, "failed to register the Prometheus metrics")
+		}
+	}
 
 	serverStarted = true
 	return s, nil
@@ -587,6 +609,40 @@ func getInitSetting(ctx context.Context, store *store.Store) (*workspaceConfig,
 	}
 	conf.workspaceID = workspaceSetting.Value
 
+	// initial backup encryption key
+	backupEncryptionKey, err := backupfile.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := store.CreateSettingIfNotExist(ctx, &api.SettingCreate{
+		CreatorID:   api.SystemBotID,
+		Name:        api.SettingBackupEncryptionKey,
+		Value:       backupEncryptionKey,
+		Description: "Base64 encoded AES-256 key used to encrypt the backups.",
+	}); err != nil {
+		return nil, err
+	}
+
+	// initial backup restore drill
+	if _, _, err := store.CreateSettingIfNotExist(ctx, &api.SettingCreate{
+		CreatorID:   api.SystemBotID,
+		Name:        api.SettingBackupRestoreDrill,
+		Value:       "",
+		Description: "The backup restore drill configuration.",
+	}); err != nil {
+		return nil, err
+	}
+
+	// initial LDAP directory sync
+	if _, _, err := store.CreateSettingIfNotExist(ctx, &api.SettingCreate{
+		CreatorID:   api.SystemBotID,
+		Name:        api.SettingWorkspaceLDAP,
+		Value:       "",
+		Description: "The LDAP directory sync configuration.",
+	}); err != nil {
+		return nil, err
+	}
+
 	// initial license
 	if _, _, err = store.CreateSettingIfNotExist(ctx, &api.SettingCreate{
 		CreatorID:   api.SystemBotID,
@@ -597,7 +653,7 @@ func getInitSetting(ctx context.Context, store *store.Store) (*workspaceConfig,
 		return nil, err
 	}
 
-	// initial feishu app
+	// initial IM app
 	if _, _, err := store.CreateSettingIfNotExist(ctx, &api.SettingCreate{
 		CreatorID:   api.SystemBotID,
 		Name:        api.SettingAppIM,
@@ -628,9 +684,15 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		s.runnerWG.Add(1)
 		go s.BackupRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
+		go s.DrillRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.LDAPSyncer.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
 		go s.AnomalyScanner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.ApplicationRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.WebhookRunner.Run(ctx, &s.runnerWG)
 		if s.profile.Mode == common.ReleaseModeDev {
 			s.runnerWG.Add(1)
 			go s.RollbackRunner.Run(ctx, &s.runnerWG)
@@ -710,3 +772,45 @@ func (s *Server) GetEcho() *echo.Echo {
 func (s *Server) GetWorkspaceID() string {
 	return s.workspaceID
 }
+
+// newBackupStorageClient creates the client for the cloud backup storage backend.
+// Google Cloud Storage and AliCloud OSS are accessed by their S3 compatible APIs.
+func newBackupStorageClient(ctx context.Context, profile config.Profile) (*bbs3.Client, error) {
+	credentials, err := bbs3.GetCredentialsFromFile(ctx, profile.BackupCredentialFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get credentials from file")
+	}
+	endpoint := profile.BackupEndpoint
+	region := profile.BackupRegion
+	switch profile.BackupStorageBackend {
+	case api.BackupStorageBackendS3:
+		if endpoint == "" {
+			client, err := bbs3.NewClient(ctx, region, profile.BackupBucket, credentials)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to create AWS S3 client")
+			}
+			return client, nil
+		}
+	case api.BackupStorageBackendGCS:
+		if endpoint == "" {
+			endpoint = "https://storage.googleapis.com"
+		}
+		if region == "" {
+			region = "auto"
+		}
+	case api.BackupStorageBackendOSS:
+		if endpoint == "" {
+			endpoint = fmt.Sprintf("https://%s.aliyuncs.com", region)
+		}
+	default:
+		return nil, errors.Errorf("unsupported backup storage backend %s", profile.BackupStorageBackend)
+	}
+	// The customized endpoints are usually self-hosted services such as MinIO, which only support the path-style bucket addressing.
+	// AliCloud OSS only supports the virtual hosted-style.
+	usePathStyle := profile.BackupEndpoint != "" && profile.BackupStorageBackend != api.BackupStorageBackendOSS
+	client, err := bbs3.NewS3CompatibleClient(ctx, region, profile.BackupBucket, endpoint, usePathStyle, credentials)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s client", profile.BackupStorageBackend)
+	}
+	return client, nil
+}
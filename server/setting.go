@@ -1,22 +1,26 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"github.com/google/jsonapi"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/api"
 	"github.com/bytebase/bytebase/common"
-	"github.com/bytebase/bytebase/plugin/app/feishu"
+	"github.com/bytebase/bytebase/store"
 )
 
 // Some settings contain secret info so we only return settings that are needed by the client.
 var whitelistSettings = []api.SettingName{
 	api.SettingBrandingLogo,
 	api.SettingAppIM,
+	api.SettingBackupRestoreDrill,
+	api.SettingWorkspaceLDAP,
 }
 
 func (s *Server) registerSettingRoutes(g *echo.Group) {
@@ -37,6 +41,11 @@ func (s *Server) registerSettingRoutes(g *echo.Group) {
 				}
 			}
 		}
+		for _, setting := range filteredList {
+			if err := redactSetting(setting); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to redact setting %s", setting.Name)).SetInternal(err)
+			}
+		}
 
 		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
 		if err := jsonapi.MarshalPayload(c.Response().Writer, filteredList); err != nil {
@@ -65,44 +74,94 @@ func (s *Server) registerSettingRoutes(g *echo.Group) {
 			if err := json.Unmarshal([]byte(settingPatch.Value), &value); err != nil {
 				return echo.NewHTTPError(http.StatusBadRequest, "Malformed setting value for IM").SetInternal(err)
 			}
-			if value.IMType != api.IMTypeFeishu {
+			if _, ok := api.ExternalApprovalTypeOf(value.IMType); !ok {
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown IM Type %s", value.IMType))
 			}
 			if value.ExternalApproval.Enabled && !s.licenseService.IsFeatureEnabled(api.FeatureIMApproval) {
 				return echo.NewHTTPError(http.StatusBadRequest, api.FeatureIMApproval.AccessErrorMessage())
 			}
-			if value.ExternalApproval.Enabled {
-				if value.AppID == "" || value.AppSecret == "" {
-					return echo.NewHTTPError(http.StatusBadRequest, "Application ID and secret cannot be empty")
+			// The secrets are redacted in the responses, so the empty ones mean keeping the existing ones of the same IM.
+			existing, err := s.getIMSetting(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get IM setting").SetInternal(err)
+			}
+			if existing != nil && existing.IMType == value.IMType {
+				if value.AppSecret == "" {
+					value.AppSecret = existing.AppSecret
 				}
-				p := s.feishuProvider
-				// clear token cache so that we won't use the previous token.
-				p.ClearTokenCache()
-
-				// check bot info
-				if _, err := p.GetBotID(ctx, feishu.TokenCtx{
-					AppID:     value.AppID,
-					AppSecret: value.AppSecret,
-				}); err != nil {
-					return echo.NewHTTPError(http.StatusBadRequest, "Failed to get bot id. Hint: check if bot is enabled.").SetInternal(err)
+				if value.SigningSecret == "" {
+					value.SigningSecret = existing.SigningSecret
 				}
-
-				// create approval definition
-				approvalDefinitionID, err := p.CreateApprovalDefinition(ctx, feishu.TokenCtx{
-					AppID:     value.AppID,
-					AppSecret: value.AppSecret,
-				}, "")
+			}
+			if value.ExternalApproval.Enabled {
+				p, err := s.ApplicationRunner.GetProvider(value.IMType)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to get provider for IM Type %s", value.IMType)).SetInternal(err)
+				}
+				// validate the config and create the approval definition if the IM application supports it.
+				approvalDefinitionID, err := p.Setup(ctx, s.ApplicationRunner.GetAppConfig(&value))
 				if err != nil {
-					return echo.NewHTTPError(http.StatusBadRequest, "Failed to create approval definition").SetInternal(err)
+					return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Failed to set up the IM application: %v", err)).SetInternal(err)
 				}
 
 				value.ExternalApproval.ApprovalDefinitionID = approvalDefinitionID
-				b, err := json.Marshal(value)
+			}
+			b, err := json.Marshal(value)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal updated setting value").SetInternal(err)
+			}
+			settingPatch.Value = string(b)
+		}
+
+		if settingPatch.Name == api.SettingBackupRestoreDrill && settingPatch.Value != "" {
+			var value api.SettingBackupRestoreDrillValue
+			if err := json.Unmarshal([]byte(settingPatch.Value), &value); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Malformed setting value for backup restore drill").SetInternal(err)
+			}
+			if value.IntervalTs < 0 {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid backup restore drill interval %d", value.IntervalTs))
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &value.InstanceID})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to find instance %d", value.InstanceID)).SetInternal(err)
+			}
+			if instance == nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Instance %d not found", value.InstanceID))
+			}
+		}
+
+		if settingPatch.Name == api.SettingWorkspaceLDAP && settingPatch.Value != "" {
+			var value api.SettingWorkspaceLDAPValue
+			if err := json.Unmarshal([]byte(settingPatch.Value), &value); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Malformed setting value for LDAP").SetInternal(err)
+			}
+			if value.Enabled && !s.licenseService.IsFeatureEnabled(api.FeatureLDAP) {
+				return echo.NewHTTPError(http.StatusForbidden, api.FeatureLDAP.AccessErrorMessage())
+			}
+			if value.IntervalTs < 0 {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid LDAP sync interval %d", value.IntervalTs))
+			}
+			if err := value.Config.Validate(); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid LDAP config: %v", err)).SetInternal(err)
+			}
+			if err := api.ValidateGroupMappingList(value.GroupMappingList); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid LDAP group mappings: %v", err)).SetInternal(err)
+			}
+			// The bind password is redacted in the responses, so an empty one means keeping the existing one.
+			if value.Config.BindPassword == "" {
+				existing, err := s.getLDAPSetting(ctx)
 				if err != nil {
-					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal updated setting value").SetInternal(err)
+					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get LDAP setting").SetInternal(err)
+				}
+				if existing != nil {
+					value.Config.BindPassword = existing.Config.BindPassword
 				}
-				settingPatch.Value = string(b)
 			}
+			b, err := json.Marshal(value)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal updated setting value").SetInternal(err)
+			}
+			settingPatch.Value = string(b)
 		}
 
 		setting, err := s.store.PatchSetting(ctx, settingPatch)
@@ -112,6 +171,9 @@ func (s *Server) registerSettingRoutes(g *echo.Group) {
 			}
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to update setting: %v", settingPatch.Name)).SetInternal(err)
 		}
+		if err := redactSetting(setting); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to redact setting %s", setting.Name)).SetInternal(err)
+		}
 
 		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
 		if err := jsonapi.MarshalPayload(c.Response().Writer, setting); err != nil {
@@ -120,3 +182,56 @@ func (s *Server) registerSettingRoutes(g *echo.Group) {
 		return nil
 	})
 }
+
+// redactSetting removes the secrets from the setting value returned to the client.
+func redactSetting(setting *api.Setting) error {
+	var value string
+	var err error
+	switch setting.Name {
+	case api.SettingWorkspaceLDAP:
+		value, err = redactLDAPSetting(setting.Value)
+	case api.SettingAppIM:
+		value, err = redactIMSetting(setting.Value)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	setting.Value = value
+	return nil
+}
+
+func (s *Server) getIMSetting(ctx context.Context) (*api.SettingAppIMValue, error) {
+	settingName := api.SettingAppIM
+	setting, err := s.store.GetSetting(ctx, &api.SettingFind{Name: &settingName})
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil || setting.Value == "" {
+		return nil, nil
+	}
+	value := &api.SettingAppIMValue{}
+	if err := json.Unmarshal([]byte(setting.Value), value); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal IM setting")
+	}
+	return value, nil
+}
+
+// redactIMSetting removes the app secret and the signing secret, which authenticate the approval callbacks.
+func redactIMSetting(settingValue string) (string, error) {
+	if settingValue == "" {
+		return "", nil
+	}
+	var value api.SettingAppIMValue
+	if err := json.Unmarshal([]byte(settingValue), &value); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal IM setting")
+	}
+	value.AppSecret = ""
+	value.SigningSecret = ""
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal IM setting")
+	}
+	return string(b), nil
+}
@@ -2,7 +2,10 @@ package server
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -351,7 +354,8 @@ func (s *Server) registerSQLRoutes(g *echo.Group) {
 		}
 
 		var sensitiveSchemaInfo *db.SensitiveSchemaInfo
-		if instance.Engine == db.MySQL || instance.Engine == db.TiDB {
+		switch instance.Engine {
+		case db.MySQL, db.TiDB:
 			databaseList, err := parser.ExtractDatabaseList(parser.MySQL, exec.Statement)
 			if err != nil {
 				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to get database list: %s", exec.Statement)).SetInternal(err)
@@ -361,6 +365,12 @@ func (s *Server) registerSQLRoutes(g *echo.Group) {
 			if err != nil {
 				return err
 			}
+		case db.Postgres:
+			// PostgreSQL doesn't support cross-database queries, so we only need the current database.
+			sensitiveSchemaInfo, err = s.getSensitiveSchemaInfo(ctx, instance, []string{exec.DatabaseName}, exec.DatabaseName)
+			if err != nil {
+				return err
+			}
 		}
 
 		start := time.Now().UnixNano()
@@ -373,12 +383,10 @@ func (s *Server) registerSQLRoutes(g *echo.Group) {
 			defer driver.Close(ctx)
 
 			rowSet, err := driver.Query(ctx, exec.Statement, &db.QueryContext{
-				Limit:           exec.Limit,
-				ReadOnly:        true,
-				CurrentDatabase: exec.DatabaseName,
-				// TODO(rebelice): we cannot deal with multi-SensitiveDataMaskType now. Fix it.
-				SensitiveDataMaskType: db.SensitiveDataMaskTypeDefault,
-				SensitiveSchemaInfo:   sensitiveSchemaInfo,
+				Limit:               exec.Limit,
+				ReadOnly:            true,
+				CurrentDatabase:     exec.DatabaseName,
+				SensitiveSchemaInfo: sensitiveSchemaInfo,
 			})
 			if err != nil {
 				return nil, err
@@ -655,12 +663,13 @@ func (s *Server) sqlCheck(
 	}
 
 	res, err := advisor.SQLReviewCheck(statement, policy.RuleList, advisor.SQLReviewCheckContext{
-		Charset:   dbCharacterSet,
-		Collation: dbCollation,
-		DbType:    dbType,
-		Catalog:   catalog,
-		Driver:    driver,
-		Context:   ctx,
+		Charset:            dbCharacterSet,
+		Collation:          dbCollation,
+		DbType:             dbType,
+		Catalog:            catalog,
+		Driver:             driver,
+		Context:            ctx,
+		DisableSuppression: policy.DisableSuppression,
 	})
 	if err != nil {
 		return advisor.Error, nil, err
@@ -713,8 +722,10 @@ func checkPostgreSQLIndexHit(statement string, plan string) []advisor.Advice {
 }
 
 func (s *Server) getSensitiveSchemaInfo(ctx context.Context, instance *store.InstanceMessage, databaseList []string, currentDatabase string) (*db.SensitiveSchemaInfo, error) {
-	type sensitiveDataMap map[api.SensitiveData]api.SensitiveDataMaskType
+	type sensitiveDataMap map[api.SensitiveData]api.SensitiveData
 	isEmpty := true
+	hasSensitiveColumn := false
+	hashKey := s.getSensitiveDataHashKey()
 	result := &db.SensitiveSchemaInfo{
 		DatabaseList: []db.DatabaseSchema{},
 	}
@@ -745,10 +756,16 @@ func (s *Server) getSensitiveSchemaInfo(ctx context.Context, instance *store.Ins
 			return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to find sensitive data policy for database %q in instance %q", databaseName, instance.Title))
 		}
 		for _, data := range policy.SensitiveDataList {
+			schemaName := data.Schema
+			if instance.Engine == db.Postgres && schemaName == "" {
+				// The sensitive data without schema name refers to the public schema for PostgreSQL.
+				schemaName = "public"
+			}
 			columnMap[api.SensitiveData{
+				Schema: schemaName,
 				Table:  data.Table,
 				Column: data.Column,
-			}] = data.Type
+			}] = data
 		}
 
 		dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
@@ -761,26 +778,40 @@ func (s *Server) getSensitiveSchemaInfo(ctx context.Context, instance *store.Ins
 			TableList: []db.TableSchema{},
 		}
 		for _, schema := range dbSchema.Metadata.Schemas {
+			schemaSchema := db.SchemaSchema{
+				Name:      schema.Name,
+				TableList: []db.TableSchema{},
+			}
 			for _, table := range schema.Tables {
 				tableSchema := db.TableSchema{
 					Name:       table.Name,
 					ColumnList: []db.ColumnInfo{},
 				}
 				for _, column := range table.Columns {
-					_, sensitive := columnMap[api.SensitiveData{
+					data, sensitive := columnMap[api.SensitiveData{
+						Schema: schema.Name,
 						Table:  table.Name,
 						Column: column.Name,
 					}]
+					if sensitive {
+						hasSensitiveColumn = true
+					}
 					tableSchema.ColumnList = append(tableSchema.ColumnList, db.ColumnInfo{
 						Name:      column.Name,
 						Sensitive: sensitive,
+						Mask:      convertToSensitiveDataMask(data, hashKey),
 					})
 				}
-				databaseSchema.TableList = append(databaseSchema.TableList, tableSchema)
+				schemaSchema.TableList = append(schemaSchema.TableList, tableSchema)
+			}
+			if instance.Engine == db.Postgres {
+				databaseSchema.SchemaList = append(databaseSchema.SchemaList, schemaSchema)
+			} else {
+				databaseSchema.TableList = append(databaseSchema.TableList, schemaSchema.TableList...)
+			}
+			if len(schemaSchema.TableList) > 0 {
+				isEmpty = false
 			}
-		}
-		if len(databaseSchema.TableList) > 0 {
-			isEmpty = false
 		}
 		result.DatabaseList = append(result.DatabaseList, databaseSchema)
 	}
@@ -790,9 +821,37 @@ func (s *Server) getSensitiveSchemaInfo(ctx context.Context, instance *store.Ins
 		// Skip to extract sensitive column for this query.
 		result = nil
 	}
+	if !hasSensitiveColumn {
+		// Skip to extract sensitive column if there is no sensitive column at all, so that
+		// the queries unsupported by the extractor still work on the databases without sensitive data.
+		result = nil
+	}
 	return result, nil
 }
 
+// getSensitiveDataHashKey returns the key for the HASH mask type. It's derived from the workspace secret, so that the masked
+// values are deterministic in the workspace but cannot be reversed outside of it.
+func (s *Server) getSensitiveDataHashKey() string {
+	h := hmac.New(sha256.New, []byte(s.secret))
+	h.Write([]byte("bytebase-sensitive-data-hash"))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func convertToSensitiveDataMask(data api.SensitiveData, hashKey string) db.SensitiveDataMask {
+	mask := db.SensitiveDataMask{
+		Type:    db.SensitiveDataMaskType(data.Type),
+		HashKey: hashKey,
+	}
+	if data.MaskOption != nil {
+		mask.Option = db.SensitiveDataMaskOption{
+			PrefixLength: data.MaskOption.PrefixLength,
+			SuffixLength: data.MaskOption.SuffixLength,
+			RangeWidth:   data.MaskOption.RangeWidth,
+		}
+	}
+	return mask
+}
+
 func isExcludeDatabase(dbType db.Type, database string) bool {
 	switch dbType {
 	case db.MySQL:
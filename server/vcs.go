@@ -25,6 +25,11 @@ func (s *Server) registerVCSRoutes(g *echo.Group) {
 		}
 		// Trim ending "/"
 		vcsCreate.InstanceURL = strings.TrimRight(vcsCreate.InstanceURL, "/")
+		if vcsCreate.CACert != "" {
+			if _, err := vcs.NewHTTPClient(vcsCreate.CACert); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Invalid CA certificate").SetInternal(err)
+			}
+		}
 		vcsCreate.APIURL = vcs.Get(vcsCreate.Type, vcs.ProviderConfig{}).APIURL(vcsCreate.InstanceURL)
 
 		vcs, err := s.store.CreateVCS(ctx, vcsCreate)
@@ -87,6 +92,11 @@ func (s *Server) registerVCSRoutes(g *echo.Group) {
 		if err := jsonapi.UnmarshalPayload(c.Request().Body, vcsPatch); err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, "Malformed change VCS request").SetInternal(err)
 		}
+		if v := vcsPatch.CACert; v != nil && *v != "" {
+			if _, err := vcs.NewHTTPClient(*v); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Invalid CA certificate").SetInternal(err)
+			}
+		}
 
 		vcs, err := s.store.PatchVCS(ctx, vcsPatch)
 		if err != nil {
@@ -162,7 +172,7 @@ func (s *Server) registerVCSRoutes(g *echo.Group) {
 			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Failed to find VCS, ID: %v", id))
 		}
 
-		repoList, err := vcs.Get(vcsFound.Type, vcs.ProviderConfig{}).FetchAllRepositoryList(
+		repoList, err := vcs.Get(vcsFound.Type, vcs.ProviderConfig{CACert: vcsFound.CACert}).FetchAllRepositoryList(
 			ctx,
 			common.OauthContext{
 				ClientID:     vcsFound.ApplicationID,
@@ -28,6 +28,8 @@ import (
 	advisorDB "github.com/bytebase/bytebase/plugin/advisor/db"
 	"github.com/bytebase/bytebase/plugin/db"
 	"github.com/bytebase/bytebase/plugin/vcs"
+	"github.com/bytebase/bytebase/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/plugin/vcs/github"
 	"github.com/bytebase/bytebase/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/server/component/activity"
@@ -145,6 +147,164 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
 	})
 
+	g.POST("/gitea/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		// This shouldn't happen as we only setup webhook to receive push event, just in case.
+		eventType := gitea.WebhookType(c.Request().Header.Get("X-Gitea-Event"))
+		if eventType != gitea.WebhookPush {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event type, got %s, want %s", eventType, gitea.WebhookPush))
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		var pushEvent gitea.WebhookPushEvent
+		if err := json.Unmarshal(body, &pushEvent); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
+		}
+		repositoryID := pushEvent.Repository.FullName
+
+		filter := func(repo *api.Repository) (bool, error) {
+			// Gitea signs the payload the same way as GitHub but without the "sha256=" prefix.
+			ok, err := validateGitHubWebhookSignature256(c.Request().Header.Get("X-Gitea-Signature"), repo.WebhookSecretToken, body)
+			if err != nil {
+				return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate Gitea webhook signature").SetInternal(err)
+			}
+			if !ok {
+				return false, nil
+			}
+
+			return s.isWebhookEventBranch(pushEvent.Ref, repo.BranchFilter)
+		}
+		repositoryList, err := s.filterRepository(ctx, c.Param("id"), repositoryID, filter)
+		if err != nil {
+			return err
+		}
+		if len(repositoryList) == 0 {
+			log.Debug("Empty handle repo list. Ignore this push event.")
+			return c.String(http.StatusOK, "OK")
+		}
+
+		baseVCSPushEvent := pushEvent.ToVCS()
+
+		createdMessages, err := s.processPushEvent(ctx, repositoryList, baseVCSPushEvent)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+	})
+
+	g.POST("/bitbucket/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		eventKey := bitbucket.WebhookEventKey(c.Request().Header.Get("X-Event-Key"))
+		// Bitbucket Data Center sends a ping event when testing the connection of the webhook.
+		if eventKey == bitbucket.WebhookEventDataCenterPing {
+			return c.String(http.StatusOK, "OK")
+		}
+		if !eventKey.IsPushEvent() && !eventKey.IsPullRequestEvent() {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event key, got %s", eventKey))
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		validateSignature := func(repo *api.Repository) (bool, error) {
+			// Bitbucket signs the payload the same way as GitHub.
+			ok, err := validateGitHubWebhookSignature256(c.Request().Header.Get("X-Hub-Signature"), repo.WebhookSecretToken, body)
+			if err != nil {
+				return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate Bitbucket webhook signature").SetInternal(err)
+			}
+			return ok, nil
+		}
+
+		if eventKey.IsPullRequestEvent() {
+			return s.handleBitbucketPullRequestEvent(c, eventKey, body, validateSignature)
+		}
+
+		pushEvent, err := bitbucket.ParsePushEvent(eventKey, body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
+		}
+
+		filter := func(repo *api.Repository) (bool, error) {
+			ok, err := validateSignature(repo)
+			if err != nil || !ok {
+				return false, err
+			}
+
+			for _, refChange := range pushEvent.RefChanges {
+				ok, err := s.isWebhookEventBranch(refChange.Ref, repo.BranchFilter)
+				if err != nil {
+					return false, err
+				}
+				if ok {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		repositoryList, err := s.filterRepository(ctx, c.Param("id"), pushEvent.RepositoryID, filter)
+		if err != nil {
+			return err
+		}
+		if len(repositoryList) == 0 {
+			log.Debug("Empty handle repo list. Ignore this push event.")
+			return c.String(http.StatusOK, "OK")
+		}
+		// The payloads of Bitbucket Data Center do not contain any link of the repository.
+		if pushEvent.RepositoryURL == "" {
+			pushEvent.RepositoryURL = repositoryList[0].WebURL
+		}
+
+		var createdMessages []string
+		for _, refChange := range pushEvent.RefChanges {
+			var refRepositoryList []*api.Repository
+			for _, repo := range repositoryList {
+				ok, err := s.isWebhookEventBranch(refChange.Ref, repo.BranchFilter)
+				if err != nil {
+					return err
+				}
+				if ok {
+					refRepositoryList = append(refRepositoryList, repo)
+				}
+			}
+			if len(refRepositoryList) == 0 {
+				continue
+			}
+
+			// The payloads do not contain the changed files, thus we need to
+			// fetch the head commit and the changed files of the push.
+			repo := refRepositoryList[0]
+			oauthContext := common.OauthContext{
+				ClientID:     repo.VCS.ApplicationID,
+				ClientSecret: repo.VCS.Secret,
+				AccessToken:  repo.AccessToken,
+				RefreshToken: repo.RefreshToken,
+				Refresher:    utils.RefreshToken(ctx, s.store, repo.WebURL),
+			}
+			provider := vcs.Get(vcs.Bitbucket, vcs.ProviderConfig{CACert: repo.VCS.CACert})
+			fileDiffList, err := provider.GetDiffFileList(ctx, oauthContext, repo.VCS.InstanceURL, repo.ExternalID, refChange.Before, refChange.After)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to get diff file list between commits %q and %q", refChange.Before, refChange.After)).SetInternal(err)
+			}
+			headCommit, err := provider.FetchCommitByID(ctx, oauthContext, repo.VCS.InstanceURL, repo.ExternalID, refChange.After)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to fetch commit %q", refChange.After)).SetInternal(err)
+			}
+
+			messages, err := s.processPushEvent(ctx, refRepositoryList, pushEvent.ToVCS(refChange, headCommit, fileDiffList))
+			if err != nil {
+				return err
+			}
+			createdMessages = append(createdMessages, messages...)
+		}
+		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+	})
+
 	// id is the webhookEndpointID in repository
 	// This endpoint is generated and injected into GitHub action & GitLab CI during the VCS setup.
 	g.POST("/sql-review/:id", func(c echo.Context) error {
@@ -201,68 +361,19 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		}
 
 		repo := repositoryList[0]
-		prFiles, err := vcs.Get(repo.VCS.Type, vcs.ProviderConfig{}).ListPullRequestFile(
-			ctx,
-			common.OauthContext{
-				ClientID:     repo.VCS.ApplicationID,
-				ClientSecret: repo.VCS.Secret,
-				AccessToken:  repo.AccessToken,
-				RefreshToken: repo.RefreshToken,
-				Refresher:    utils.RefreshToken(ctx, s.store, repo.WebURL),
-			},
-			repo.VCS.InstanceURL,
-			request.RepositoryID,
-			request.PullRequestID,
-		)
+		sqlCheckAdvice, err := s.sqlAdviceForPullRequest(ctx, repositoryList, request.RepositoryID, request.PullRequestID)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list pull request file").SetInternal(err)
 		}
 
-		distinctFileList := []vcs.DistinctFileItem{}
-		for _, prFile := range prFiles {
-			if prFile.IsDeleted {
-				continue
-			}
-			distinctFileList = append(distinctFileList, vcs.DistinctFileItem{
-				FileName: prFile.Path,
-				Commit: vcs.Commit{
-					ID: prFile.LastCommitID,
-				},
-			})
-		}
-
-		sqlCheckAdvice := map[string][]advisor.Advice{}
-		var wg sync.WaitGroup
-
-		repoID2FileItemList := groupFileInfoByRepo(distinctFileList, repositoryList)
-		for _, fileInfoListInRepo := range repoID2FileItemList {
-			for _, file := range fileInfoListInRepo {
-				wg.Add(1)
-				go func(file fileInfo) {
-					defer wg.Done()
-					adviceList, err := s.sqlAdviceForFile(ctx, file)
-					if err != nil {
-						log.Debug(
-							"Failed to take SQL review for file",
-							zap.String("file", file.item.FileName),
-							zap.String("external_id", file.repository.ExternalID),
-							zap.Error(err),
-						)
-					} else if adviceList != nil {
-						sqlCheckAdvice[file.item.FileName] = adviceList
-					}
-				}(file)
-			}
-		}
-
-		wg.Wait()
-
 		response := &api.VCSSQLReviewResult{}
 		switch repo.VCS.Type {
-		case vcs.GitHubCom:
+		case vcs.GitHubCom, vcs.GitHubEnterprise, vcs.Gitea:
 			response = convertSQLAdviceToGitHubActionResult(sqlCheckAdvice)
 		case vcs.GitLabSelfHost:
 			response = convertSQLAdviceToGitLabCIResult(sqlCheckAdvice)
+		case vcs.Bitbucket:
+			response = convertSQLAdviceToBitbucketResult(sqlCheckAdvice)
 		}
 
 		log.Debug("SQL review finished",
@@ -275,6 +386,158 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 
 		return c.JSON(http.StatusOK, response)
 	})
+
+	// imType is the IM type of the application pushing the approval status, e.g. im.slack.
+	g.POST("/app/:imType", func(c echo.Context) error {
+		if s.ApplicationRunner == nil {
+			return echo.NewHTTPError(http.StatusServiceUnavailable, "External approval is not available in readonly mode")
+		}
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read IM application callback").SetInternal(err)
+		}
+		imType := api.IMType(c.Param("imType"))
+		if err := s.ApplicationRunner.HandleCallback(c.Request().Context(), imType, c.Request().Header, body); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Failed to handle callback from IM type %s", imType)).SetInternal(err)
+		}
+		return c.String(http.StatusOK, "OK")
+	})
+}
+
+// handleBitbucketPullRequestEvent takes SQL review for the pull request of
+// Bitbucket Data Center and posts the result as a pull request comment, as
+// Bitbucket Data Center has no built-in CI to run the SQL review.
+func (s *Server) handleBitbucketPullRequestEvent(c echo.Context, eventKey bitbucket.WebhookEventKey, body []byte, validateSignature repositoryFilter) error {
+	ctx := c.Request().Context()
+	pullRequestEvent, err := bitbucket.ParsePullRequestEvent(eventKey, body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Malformed pull request event").SetInternal(err)
+	}
+
+	filter := func(repo *api.Repository) (bool, error) {
+		if !repo.EnableSQLReviewCI {
+			log.Debug("Skip repository as the SQL review CI is not enabled.",
+				zap.Int("repository_id", repo.ID),
+				zap.String("repository_external_id", repo.ExternalID),
+			)
+			return false, nil
+		}
+		return validateSignature(repo)
+	}
+	repositoryList, err := s.filterRepository(ctx, c.Param("id"), pullRequestEvent.RepositoryID, filter)
+	if err != nil {
+		return err
+	}
+	if len(repositoryList) == 0 {
+		log.Debug("Empty handle repo list. Ignore this pull request event.")
+		return c.String(http.StatusOK, "OK")
+	}
+
+	sqlCheckAdvice, err := s.sqlAdviceForPullRequest(ctx, repositoryList, pullRequestEvent.RepositoryID, pullRequestEvent.PullRequestID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list pull request file").SetInternal(err)
+	}
+	response := convertSQLAdviceToBitbucketResult(sqlCheckAdvice)
+	if len(response.Content) == 0 {
+		return c.String(http.StatusOK, "OK")
+	}
+
+	repo := repositoryList[0]
+	provider, ok := vcs.Get(vcs.Bitbucket, vcs.ProviderConfig{CACert: repo.VCS.CACert}).(*bitbucket.Provider)
+	if !ok {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Unexpected Bitbucket provider")
+	}
+	if err := provider.CreatePullRequestComment(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.VCS.ApplicationID,
+			ClientSecret: repo.VCS.Secret,
+			AccessToken:  repo.AccessToken,
+			RefreshToken: repo.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.WebURL),
+		},
+		repo.VCS.InstanceURL,
+		pullRequestEvent.RepositoryID,
+		pullRequestEvent.PullRequestID,
+		fmt.Sprintf("**SQL Review: %s**\n\n%s", response.Status, strings.Join(response.Content, "\n\n")),
+	); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create pull request comment").SetInternal(err)
+	}
+
+	log.Debug("SQL review finished",
+		zap.String("pull_request", pullRequestEvent.PullRequestID),
+		zap.String("status", string(response.Status)),
+		zap.String("repository_id", pullRequestEvent.RepositoryID),
+		zap.String("vcs", string(repo.VCS.Type)),
+	)
+	return c.String(http.StatusOK, "OK")
+}
+
+// sqlAdviceForPullRequest takes SQL review for the changed files in the pull
+// request, and returns the advice list of each file. The first repository in the
+// list is used to fetch the changed files.
+func (s *Server) sqlAdviceForPullRequest(ctx context.Context, repositoryList []*api.Repository, repositoryID, pullRequestID string) (map[string][]advisor.Advice, error) {
+	repo := repositoryList[0]
+	prFiles, err := vcs.Get(repo.VCS.Type, vcs.ProviderConfig{CACert: repo.VCS.CACert}).ListPullRequestFile(
+		ctx,
+		common.OauthContext{
+			ClientID:     repo.VCS.ApplicationID,
+			ClientSecret: repo.VCS.Secret,
+			AccessToken:  repo.AccessToken,
+			RefreshToken: repo.RefreshToken,
+			Refresher:    utils.RefreshToken(ctx, s.store, repo.WebURL),
+		},
+		repo.VCS.InstanceURL,
+		repositoryID,
+		pullRequestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	distinctFileList := []vcs.DistinctFileItem{}
+	for _, prFile := range prFiles {
+		if prFile.IsDeleted {
+			continue
+		}
+		distinctFileList = append(distinctFileList, vcs.DistinctFileItem{
+			FileName: prFile.Path,
+			Commit: vcs.Commit{
+				ID: prFile.LastCommitID,
+			},
+		})
+	}
+
+	sqlCheckAdvice := map[string][]advisor.Advice{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	repoID2FileItemList := groupFileInfoByRepo(distinctFileList, repositoryList)
+	for _, fileInfoListInRepo := range repoID2FileItemList {
+		for _, file := range fileInfoListInRepo {
+			wg.Add(1)
+			go func(file fileInfo) {
+				defer wg.Done()
+				adviceList, err := s.sqlAdviceForFile(ctx, file)
+				if err != nil {
+					log.Debug(
+						"Failed to take SQL review for file",
+						zap.String("file", file.item.FileName),
+						zap.String("external_id", file.repository.ExternalID),
+						zap.Error(err),
+					)
+				} else if adviceList != nil {
+					mu.Lock()
+					sqlCheckAdvice[file.item.FileName] = adviceList
+					mu.Unlock()
+				}
+			}(file)
+		}
+	}
+
+	wg.Wait()
+
+	return sqlCheckAdvice, nil
 }
 
 func (s *Server) sqlAdviceForFile(
@@ -313,7 +576,7 @@ func (s *Server) sqlAdviceForFile(
 		return nil, errors.Errorf("Failed to list databse with error: %v", err)
 	}
 
-	fileContent, err := vcs.Get(fileInfo.repository.VCS.Type, vcs.ProviderConfig{}).ReadFileContent(
+	fileContent, err := vcs.Get(fileInfo.repository.VCS.Type, vcs.ProviderConfig{CACert: fileInfo.repository.VCS.CACert}).ReadFileContent(
 		ctx,
 		common.OauthContext{
 			ClientID:     fileInfo.repository.VCS.ApplicationID,
@@ -373,12 +636,13 @@ func (s *Server) sqlAdviceForFile(
 		}
 
 		adviceList, err := advisor.SQLReviewCheck(fileContent, policy.RuleList, advisor.SQLReviewCheckContext{
-			Charset:   database.CharacterSet,
-			Collation: database.Collation,
-			DbType:    dbType,
-			Catalog:   catalog,
-			Driver:    connection,
-			Context:   ctx,
+			Charset:            database.CharacterSet,
+			Collation:          database.Collation,
+			DbType:             dbType,
+			Catalog:            catalog,
+			Driver:             connection,
+			Context:            ctx,
+			DisableSuppression: policy.DisableSuppression,
 		})
 		driver.Close(ctx)
 		if err != nil {
@@ -564,7 +828,7 @@ func (s *Server) processPushEvent(ctx context.Context, repositoryList []*api.Rep
 // We use the compare API to get the file diffs and filter files by the diffs.
 // TODO(dragonly): generate distinct file change list from the commits diff instead of filter.
 func (s *Server) filterFilesByCommitsDiff(ctx context.Context, repo *api.Repository, distinctFileList []vcs.DistinctFileItem, beforeCommit, afterCommit string) ([]vcs.DistinctFileItem, error) {
-	fileDiffList, err := vcs.Get(repo.VCS.Type, vcs.ProviderConfig{}).GetDiffFileList(
+	fileDiffList, err := vcs.Get(repo.VCS.Type, vcs.ProviderConfig{CACert: repo.VCS.CACert}).GetDiffFileList(
 		ctx,
 		common.OauthContext{
 			ClientID:     repo.VCS.ApplicationID,
@@ -988,7 +1252,7 @@ func (s *Server) readFileContent(ctx context.Context, pushEvent vcs.PushEvent, r
 	}
 
 	repo = repos[0]
-	content, err := vcs.Get(repo.VCS.Type, vcs.ProviderConfig{}).ReadFileContent(
+	content, err := vcs.Get(repo.VCS.Type, vcs.ProviderConfig{CACert: repo.VCS.CACert}).ReadFileContent(
 		ctx,
 		common.OauthContext{
 			ClientID:     repo.VCS.ApplicationID,
@@ -1220,7 +1484,8 @@ func convertSQLAdviceToGitLabCIResult(adviceMap map[string][]advisor.Advice) *ap
 		adviceList := adviceMap[filePath]
 		testcaseList := []string{}
 		for _, advice := range adviceList {
-			if advice.Code == 0 {
+			// Skip the suppressed advice, whose status is SUCCESS.
+			if advice.Code == 0 || advice.Status == advisor.Success {
 				continue
 			}
 
@@ -1328,3 +1593,53 @@ func convertSQLAdviceToGitHubActionResult(adviceMap map[string][]advisor.Advice)
 		Content: messageList,
 	}
 }
+
+// convertSQLAdviceToBitbucketResult will convert SQL advice map to plain text
+// messages, which are printed in Bitbucket Pipelines or posted as the pull
+// request comment for Bitbucket Data Center.
+func convertSQLAdviceToBitbucketResult(adviceMap map[string][]advisor.Advice) *api.VCSSQLReviewResult {
+	messageList := []string{}
+	status := advisor.Success
+
+	fileList := []string{}
+	for filePath := range adviceMap {
+		fileList = append(fileList, filePath)
+	}
+	sort.Strings(fileList)
+
+	for _, filePath := range fileList {
+		adviceList := adviceMap[filePath]
+		for _, advice := range adviceList {
+			if advice.Code == 0 || advice.Status == advisor.Success {
+				continue
+			}
+
+			line := advice.Line
+			if line <= 0 {
+				line = 1
+			}
+
+			if advice.Status == advisor.Error {
+				status = advice.Status
+			} else if status != advisor.Error {
+				status = advice.Status
+			}
+
+			messageList = append(messageList, fmt.Sprintf(
+				"[%s] %s:%d %s (%d)\n%s\nDoc: %s#%d",
+				advice.Status,
+				filePath,
+				line,
+				advice.Title,
+				advice.Code,
+				advice.Content,
+				sqlReviewDocs,
+				advice.Code,
+			))
+		}
+	}
+	return &api.VCSSQLReviewResult{
+		Status:  status,
+		Content: messageList,
+	}
+}
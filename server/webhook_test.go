@@ -184,6 +184,18 @@ func TestVCSSQLReview_ConvertSQLAdviceToGitHubActionResult(t *testing.T) {
 	assert.Equal(t, expect, res.Content)
 }
 
+func TestVCSSQLReview_ConvertSQLAdviceToBitbucketResult(t *testing.T) {
+	expect := []string{
+		"[WARN] file1.sql:1 column.no-null (402)\nColumn \"id\" in \"public\".\"book\" cannot have NULL value\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#402",
+		"[ERROR] file1.sql:2 naming.index.idx (303)\nIndex in table \"tech_book\" mismatches the naming convention, expect \"^$|^idx_tech_book_id_name$\" but found \"tech_book_id_name\"\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#303",
+		"[WARN] file2.sql:1 naming.table (301)\n\"techBook\" mismatches table naming convention, naming format should be \"^[a-z]+(_[a-z]+)*$\"\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#301",
+		"[ERROR] file2.sql:4 naming.index.uk (304)\nUnique key in table \"tech_book\" mismatches the naming convention, expect \"^$|^uk_tech_book_id_name$\" but found \"tech_book_id_name\"\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#304",
+	}
+	res := convertSQLAdviceToBitbucketResult(mockSQLAdviceMap)
+	assert.Equal(t, advisor.Error, res.Status)
+	assert.Equal(t, expect, res.Content)
+}
+
 func TestGetFileInfo(t *testing.T) {
 	t.Run("a SQL format DDL", func(t *testing.T) {
 		mi, fileType, repo, err := getFileInfo(
@@ -10,12 +10,20 @@ import (
 	metricAPI "github.com/bytebase/bytebase/metric"
 
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/yaml.v3"
 
 	"github.com/bytebase/bytebase/plugin/advisor"
 	"github.com/bytebase/bytebase/plugin/advisor/catalog"
 	advisorDB "github.com/bytebase/bytebase/plugin/advisor/db"
 	"github.com/bytebase/bytebase/plugin/metric"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	defaultCharset   = "utf8mb4"
+	defaultCollation = "utf8mb4_general_ci"
 )
 
 var (
@@ -27,9 +35,30 @@ type catalogService struct {
 	finder *catalog.Finder
 }
 
-func newCatalogService(dbType advisorDB.Type) *catalogService {
-	return &catalogService{
-		finder: catalog.NewEmptyFinder(&catalog.FinderContext{CheckIntegrity: false, EngineType: dbType}),
+// newCatalogService creates the catalog from the schema context of the request.
+// The catalog is empty if neither the schema nor the metadata is specified.
+func newCatalogService(dbType advisorDB.Type, schema string, metadata string) (*catalogService, error) {
+	if schema != "" && metadata != "" {
+		return nil, errors.New("only one of schema and metadata can be specified")
+	}
+	ctx := &catalog.FinderContext{CheckIntegrity: true, EngineType: dbType}
+	switch {
+	case schema != "":
+		finder, err := catalog.NewFinderFromSchema(schema, ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the schema")
+		}
+		return &catalogService{finder: finder}, nil
+	case metadata != "":
+		database := &storepb.DatabaseMetadata{}
+		if err := protojson.Unmarshal([]byte(metadata), database); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the metadata")
+		}
+		return &catalogService{finder: catalog.NewFinder(database, ctx)}, nil
+	default:
+		return &catalogService{
+			finder: catalog.NewEmptyFinder(&catalog.FinderContext{CheckIntegrity: false, EngineType: dbType}),
+		}, nil
 	}
 }
 
@@ -43,6 +72,10 @@ type sqlCheckRequestBody struct {
 	DatabaseType string                      `json:"databaseType"`
 	TemplateID   advisor.SQLReviewTemplateID `json:"templateId"`
 	Override     string                      `json:"override"`
+	Charset      string                      `json:"charset"`
+	Collation    string                      `json:"collation"`
+	Schema       string                      `json:"schema"`
+	Metadata     string                      `json:"metadata"`
 }
 
 func (s *Server) registerAdvisorRoutes(g *echo.Group) {
@@ -59,6 +92,10 @@ func (s *Server) registerAdvisorRoutes(g *echo.Group) {
 // @Param  databaseType  body  string  true   "The database type."  Enums(MYSQL, POSTGRES, TIDB)
 // @Param  templateId    body  string  false  "The SQL check template id. Required if the config is not specified." Enums(bb.sql-review.prod, bb.sql-review.dev)
 // @Param  override      body  string  false  "The SQL check config override string in YAML format. Check https://github.com/bytebase/bytebase/tree/main/plugin/advisor/config/sql-review.override.yaml for example. Required if the template is not specified."
+// @Param  charset       body  string  false  "The default charset of the database. Default to utf8mb4."
+// @Param  collation     body  string  false  "The default collation of the database. Default to utf8mb4_general_ci."
+// @Param  schema        body  string  false  "The schema dump of the database, which is loaded into the catalog for the rules depending on the existing schema. Cannot be specified with the metadata."
+// @Param  metadata      body  string  false  "The database metadata in JSON format, which is loaded into the catalog for the rules depending on the existing schema. Cannot be specified with the schema."
 // @Success  200  {array}   advisor.Advice
 // @Failure  400  {object}  echo.HTTPError
 // @Failure  500  {object}  echo.HTTPError
@@ -103,13 +140,26 @@ func (s *Server) sqlCheckController(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Cannot merge the config for template: %s", ruleOverride.Template)).SetInternal(err)
 	}
 
+	catalogService, err := newCatalogService(advisorDBType, request.Schema, request.Metadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid schema context: %v", err)).SetInternal(err)
+	}
+	charset := request.Charset
+	if charset == "" {
+		charset = defaultCharset
+	}
+	collation := request.Collation
+	if collation == "" {
+		collation = defaultCollation
+	}
+
 	adviceList, err := sqlCheck(
 		advisorDBType,
-		"utf8mb4",
-		"utf8mb4_general_ci",
+		charset,
+		collation,
 		request.Statement,
 		ruleList,
-		newCatalogService(advisorDBType),
+		catalogService,
 	)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to run sql check").SetInternal(err)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/bytebase/bytebase/api"
 	"github.com/bytebase/bytebase/common"
+	"github.com/bytebase/bytebase/metric"
 )
 
 // anomalyRaw is the store model for an Anomaly.
@@ -88,6 +89,44 @@ func (s *Store) FindAnomaly(ctx context.Context, find *api.AnomalyFind) ([]*api.
 	return anomalyList, nil
 }
 
+// CountAnomalyGroupByType counts the number of active anomalies and group by type.
+// Used by the Prometheus exporter.
+func (s *Store) CountAnomalyGroupByType(ctx context.Context) ([]*metric.AnomalyCountMetric, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT type, COUNT(*)
+		FROM anomaly
+		WHERE row_status = $1
+		GROUP BY type`,
+		api.Normal,
+	)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer rows.Close()
+
+	var res []*metric.AnomalyCountMetric
+	for rows.Next() {
+		var metric metric.AnomalyCountMetric
+		if err := rows.Scan(&metric.Type, &metric.Count); err != nil {
+			return nil, FormatError(err)
+		}
+		res = append(res, &metric)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, FormatError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 //
 // private functions
 //
@@ -266,6 +305,9 @@ func createAnomalyImpl(ctx context.Context, tx *Tx, upsert *api.AnomalyUpsert) (
 func findAnomalyListImpl(ctx context.Context, tx *Tx, find *api.AnomalyFind) ([]*anomalyRaw, error) {
 	// Build WHERE clause.
 	where, args := []string{"1 = 1"}, []interface{}{}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
 	if v := find.InstanceID; v != nil {
 		where, args = append(where, fmt.Sprintf("instance_id = $%d", len(args)+1)), append(args, *v)
 		if find.InstanceOnly {
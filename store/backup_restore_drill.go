@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+)
+
+// BackupRestoreDrillMessage is the message for the last backup restore drill of a database.
+type BackupRestoreDrillMessage struct {
+	DatabaseID  int
+	LastDrillTs int64
+}
+
+// ListBackupRestoreDrills lists the last backup restore drills of the databases.
+func (s *Store) ListBackupRestoreDrills(ctx context.Context) ([]*BackupRestoreDrillMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			database_id,
+			last_drill_ts
+		FROM backup_restore_drill
+		ORDER BY database_id ASC`,
+	)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer rows.Close()
+
+	var drills []*BackupRestoreDrillMessage
+	for rows.Next() {
+		drill := &BackupRestoreDrillMessage{}
+		if err := rows.Scan(
+			&drill.DatabaseID,
+			&drill.LastDrillTs,
+		); err != nil {
+			return nil, FormatError(err)
+		}
+		drills = append(drills, drill)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return drills, nil
+}
+
+// UpsertBackupRestoreDrill records the last backup restore drill of the database.
+func (s *Store) UpsertBackupRestoreDrill(ctx context.Context, upsert *BackupRestoreDrillMessage) (*BackupRestoreDrillMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+			INSERT INTO backup_restore_drill (
+				database_id,
+				last_drill_ts
+			)
+			VALUES ($1, $2)
+			ON CONFLICT (database_id) DO UPDATE SET
+				last_drill_ts = EXCLUDED.last_drill_ts
+		`,
+		upsert.DatabaseID,
+		upsert.LastDrillTs,
+	); err != nil {
+		return nil, FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return upsert, nil
+}
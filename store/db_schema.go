@@ -15,6 +15,11 @@ import (
 type DBSchema struct {
 	Metadata *storepb.DatabaseMetadata
 	Schema   []byte
+	// ExpectedMetadata is the metadata recorded right after the latest migration.
+	// It's nil if no migration has been recorded yet.
+	ExpectedMetadata *storepb.DatabaseMetadata
+	// ExpectedMetadataVersion is the migration history version when the expected metadata is recorded.
+	ExpectedMetadataVersion string
 }
 
 // GetDBSchema gets the schema for a database.
@@ -34,17 +39,21 @@ func (s *Store) GetDBSchema(ctx context.Context, databaseID int) (*DBSchema, err
 	defer tx.Rollback()
 
 	dbSchema := &DBSchema{}
-	var metadata []byte
+	var metadata, expectedMetadata []byte
 	if err := tx.QueryRowContext(ctx, `
 		SELECT
 			metadata,
-			raw_dump
+			raw_dump,
+			expected_metadata,
+			expected_metadata_version
 		FROM db_schema
 		WHERE `+strings.Join(where, " AND "),
 		args...,
 	).Scan(
 		&metadata,
 		&dbSchema.Schema,
+		&expectedMetadata,
+		&dbSchema.ExpectedMetadataVersion,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -61,6 +70,9 @@ func (s *Store) GetDBSchema(ctx context.Context, databaseID int) (*DBSchema, err
 		return nil, err
 	}
 	dbSchema.Metadata = &databaseSchema
+	if dbSchema.ExpectedMetadata, err = unmarshalExpectedMetadata(expectedMetadata); err != nil {
+		return nil, err
+	}
 
 	s.dbSchemaCache.Store(databaseID, dbSchema)
 	return dbSchema, nil
@@ -85,7 +97,7 @@ func (s *Store) UpsertDBSchema(ctx context.Context, databaseID int, dbSchema *DB
 		ON CONFLICT(database_id) DO UPDATE SET
 			metadata = EXCLUDED.metadata,
 			raw_dump = EXCLUDED.raw_dump
-		RETURNING metadata, raw_dump
+		RETURNING expected_metadata, expected_metadata_version
 	`
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -93,13 +105,18 @@ func (s *Store) UpsertDBSchema(ctx context.Context, databaseID int, dbSchema *DB
 	}
 	defer tx.Rollback()
 
-	if _, err := tx.ExecContext(ctx, query,
+	var expectedMetadata []byte
+	var expectedMetadataVersion string
+	if err := tx.QueryRowContext(ctx, query,
 		updaterID,
 		updaterID,
 		databaseID,
 		metadataBytes,
 		// Convert to string because []byte{} is null which violates db schema constraints.
 		string(dbSchema.Schema),
+	).Scan(
+		&expectedMetadata,
+		&expectedMetadataVersion,
 	); err != nil {
 		return FormatError(err)
 	}
@@ -107,6 +124,50 @@ func (s *Store) UpsertDBSchema(ctx context.Context, databaseID int, dbSchema *DB
 		return FormatError(err)
 	}
 
-	s.dbSchemaCache.Store(databaseID, dbSchema)
+	cached := &DBSchema{
+		Metadata:                dbSchema.Metadata,
+		Schema:                  dbSchema.Schema,
+		ExpectedMetadataVersion: expectedMetadataVersion,
+	}
+	if cached.ExpectedMetadata, err = unmarshalExpectedMetadata(expectedMetadata); err != nil {
+		return err
+	}
+	s.dbSchemaCache.Store(databaseID, cached)
+	return nil
+}
+
+// UpdateDBSchemaExpectedMetadata records the current metadata of a database as the expected metadata at the migration history version.
+// It's called after migrations so that later schema drift can be detected against it.
+func (s *Store) UpdateDBSchemaExpectedMetadata(ctx context.Context, databaseID int, version string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return FormatError(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE db_schema
+		SET expected_metadata = metadata, expected_metadata_version = $2
+		WHERE database_id = $1
+	`, databaseID, version); err != nil {
+		return FormatError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return FormatError(err)
+	}
+
+	s.dbSchemaCache.Delete(databaseID)
 	return nil
 }
+
+func unmarshalExpectedMetadata(expectedMetadata []byte) (*storepb.DatabaseMetadata, error) {
+	if len(expectedMetadata) == 0 || string(expectedMetadata) == "{}" {
+		return nil, nil
+	}
+	var databaseSchema storepb.DatabaseMetadata
+	decoder := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err := decoder.Unmarshal(expectedMetadata, &databaseSchema); err != nil {
+		return nil, err
+	}
+	return &databaseSchema, nil
+}
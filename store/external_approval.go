@@ -225,6 +225,9 @@ func (*Store) findExternalApprovalImpl(ctx context.Context, tx *Tx, find *api.Ex
 	if v := find.IssueID; v != nil {
 		where, args = append(where, fmt.Sprintf("issue_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.Type; v != nil {
+		where, args = append(where, fmt.Sprintf("type = $%d", len(args)+1)), append(args, *v)
+	}
 	rows, err := tx.QueryContext(ctx, `
     SELECT
       id,
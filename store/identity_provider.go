@@ -0,0 +1,224 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
+)
+
+// IdentityProviderMessage is the message for identity provider.
+type IdentityProviderMessage struct {
+	Name string
+	Type api.IdentityProviderType
+	// Config is the JSON encoded api.IdentityProviderConfig.
+	Config string
+
+	// The following fields are output only and not used for create().
+	UID     int
+	Deleted bool
+}
+
+// FindIdentityProviderMessage is the message to find identity providers.
+type FindIdentityProviderMessage struct {
+	UID         *int
+	ShowDeleted bool
+}
+
+// UpdateIdentityProviderMessage is the message for updating an identity provider.
+type UpdateIdentityProviderMessage struct {
+	Name   *string
+	Config *string
+	Delete *bool
+}
+
+// GetIdentityProvider gets an identity provider.
+func (s *Store) GetIdentityProvider(ctx context.Context, find *FindIdentityProviderMessage) (*IdentityProviderMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	identityProviders, err := listIdentityProviderImpl(ctx, tx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(identityProviders) == 0 {
+		return nil, nil
+	}
+	if len(identityProviders) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d identity providers with filter %+v, expect 1", len(identityProviders), find)}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return identityProviders[0], nil
+}
+
+// ListIdentityProviders lists identity providers.
+func (s *Store) ListIdentityProviders(ctx context.Context, find *FindIdentityProviderMessage) ([]*IdentityProviderMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	identityProviders, err := listIdentityProviderImpl(ctx, tx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return identityProviders, nil
+}
+
+// CreateIdentityProvider creates an identity provider.
+func (s *Store) CreateIdentityProvider(ctx context.Context, create *IdentityProviderMessage, creatorID int) (*IdentityProviderMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	identityProvider := &IdentityProviderMessage{
+		Name:   create.Name,
+		Type:   create.Type,
+		Config: create.Config,
+	}
+	if err := tx.QueryRowContext(ctx, `
+			INSERT INTO idp (
+				creator_id,
+				updater_id,
+				name,
+				type,
+				config
+			)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id
+		`,
+		creatorID,
+		creatorID,
+		create.Name,
+		create.Type,
+		create.Config,
+	).Scan(
+		&identityProvider.UID,
+	); err != nil {
+		return nil, FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return identityProvider, nil
+}
+
+// UpdateIdentityProvider updates an identity provider.
+func (s *Store) UpdateIdentityProvider(ctx context.Context, uid int, patch *UpdateIdentityProviderMessage, updaterID int) (*IdentityProviderMessage, error) {
+	set, args := []string{"updater_id = $1"}, []interface{}{updaterID}
+	if v := patch.Name; v != nil {
+		set, args = append(set, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Config; v != nil {
+		set, args = append(set, fmt.Sprintf("config = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Delete; v != nil {
+		rowStatus := api.Normal
+		if *patch.Delete {
+			rowStatus = api.Archived
+		}
+		set, args = append(set, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, rowStatus)
+	}
+	args = append(args, uid)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	identityProvider := &IdentityProviderMessage{}
+	var rowStatus string
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
+			UPDATE idp
+			SET `+strings.Join(set, ", ")+`
+			WHERE id = $%d
+			RETURNING id, row_status, name, type, config
+		`, len(args)),
+		args...,
+	).Scan(
+		&identityProvider.UID,
+		&rowStatus,
+		&identityProvider.Name,
+		&identityProvider.Type,
+		&identityProvider.Config,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("identity provider %d not found", uid)}
+		}
+		return nil, FormatError(err)
+	}
+	identityProvider.Deleted = convertRowStatusToDeleted(rowStatus)
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return identityProvider, nil
+}
+
+func listIdentityProviderImpl(ctx context.Context, tx *Tx, find *FindIdentityProviderMessage) ([]*IdentityProviderMessage, error) {
+	where, args := []string{"1 = 1"}, []interface{}{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if !find.ShowDeleted {
+		where, args = append(where, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, api.Normal)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			id,
+			row_status,
+			name,
+			type,
+			config
+		FROM idp
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer rows.Close()
+
+	var identityProviders []*IdentityProviderMessage
+	for rows.Next() {
+		identityProvider := &IdentityProviderMessage{}
+		var rowStatus string
+		if err := rows.Scan(
+			&identityProvider.UID,
+			&rowStatus,
+			&identityProvider.Name,
+			&identityProvider.Type,
+			&identityProvider.Config,
+		); err != nil {
+			return nil, FormatError(err)
+		}
+		identityProvider.Deleted = convertRowStatusToDeleted(rowStatus)
+		identityProviders = append(identityProviders, identityProvider)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, FormatError(err)
+	}
+	return identityProviders, nil
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/common"
+)
+
+// IdentityProviderUserMessage is the message for the binding between a principal and a user of an identity provider.
+type IdentityProviderUserMessage struct {
+	PrincipalID int
+	// Issuer and Subject identify the user in the identity provider.
+	Issuer  string
+	Subject string
+}
+
+// FindIdentityProviderUserMessage is the message to find identity provider users.
+type FindIdentityProviderUserMessage struct {
+	PrincipalID *int
+	Issuer      *string
+	Subject     *string
+}
+
+// GetIdentityProviderUser gets an identity provider user.
+func (s *Store) GetIdentityProviderUser(ctx context.Context, find *FindIdentityProviderUserMessage) (*IdentityProviderUserMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	identityProviderUsers, err := listIdentityProviderUserImpl(ctx, tx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(identityProviderUsers) == 0 {
+		return nil, nil
+	}
+	if len(identityProviderUsers) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d identity provider users with filter %+v, expect 1", len(identityProviderUsers), find)}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return identityProviderUsers[0], nil
+}
+
+// CreateIdentityProviderUser binds the principal to the user of the identity provider.
+func (s *Store) CreateIdentityProviderUser(ctx context.Context, create *IdentityProviderUserMessage) (*IdentityProviderUserMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+			INSERT INTO idp_user (
+				principal_id,
+				issuer,
+				subject
+			)
+			VALUES ($1, $2, $3)
+		`,
+		create.PrincipalID,
+		create.Issuer,
+		create.Subject,
+	); err != nil {
+		return nil, FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return create, nil
+}
+
+func listIdentityProviderUserImpl(ctx context.Context, tx *Tx, find *FindIdentityProviderUserMessage) ([]*IdentityProviderUserMessage, error) {
+	where, args := []string{"1 = 1"}, []interface{}{}
+	if v := find.PrincipalID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Issuer; v != nil {
+		where, args = append(where, fmt.Sprintf("issuer = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Subject; v != nil {
+		where, args = append(where, fmt.Sprintf("subject = $%d", len(args)+1)), append(args, *v)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			principal_id,
+			issuer,
+			subject
+		FROM idp_user
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY principal_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer rows.Close()
+
+	var identityProviderUsers []*IdentityProviderUserMessage
+	for rows.Next() {
+		identityProviderUser := &IdentityProviderUserMessage{}
+		if err := rows.Scan(
+			&identityProviderUser.PrincipalID,
+			&identityProviderUser.Issuer,
+			&identityProviderUser.Subject,
+		); err != nil {
+			return nil, FormatError(err)
+		}
+		identityProviderUsers = append(identityProviderUsers, identityProviderUser)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, FormatError(err)
+	}
+	return identityProviderUsers, nil
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/common"
+)
+
+// LDAPUserMessage is the message for a user synced from the LDAP directory.
+type LDAPUserMessage struct {
+	PrincipalID int
+	// DN is the distinguished name of the user in the LDAP directory.
+	DN string
+	// ProjectRoles is the project roles granted by the LDAP group mappings, keyed by the project ID.
+	ProjectRoles map[int]common.ProjectRole
+	// DeactivatedBySync is true if the user is deactivated because of being removed from the LDAP directory.
+	// Only these users are reactivated once they're back, the users deactivated by the admin stay deactivated.
+	DeactivatedBySync bool
+}
+
+// FindLDAPUserMessage is the message to find LDAP users.
+type FindLDAPUserMessage struct {
+	PrincipalID *int
+}
+
+// ldapUserPayload is the payload of the ldap_user table.
+type ldapUserPayload struct {
+	ProjectRoles      map[int]common.ProjectRole `json:"projectRoles,omitempty"`
+	DeactivatedBySync bool                       `json:"deactivatedBySync,omitempty"`
+}
+
+// GetLDAPUser gets an LDAP user.
+func (s *Store) GetLDAPUser(ctx context.Context, find *FindLDAPUserMessage) (*LDAPUserMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	ldapUsers, err := listLDAPUserImpl(ctx, tx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(ldapUsers) == 0 {
+		return nil, nil
+	}
+	if len(ldapUsers) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d LDAP users with filter %+v, expect 1", len(ldapUsers), find)}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return ldapUsers[0], nil
+}
+
+// ListLDAPUsers lists LDAP users.
+func (s *Store) ListLDAPUsers(ctx context.Context, find *FindLDAPUserMessage) ([]*LDAPUserMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	ldapUsers, err := listLDAPUserImpl(ctx, tx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return ldapUsers, nil
+}
+
+// UpsertLDAPUser upserts an LDAP user.
+func (s *Store) UpsertLDAPUser(ctx context.Context, upsert *LDAPUserMessage) (*LDAPUserMessage, error) {
+	payload, err := json.Marshal(ldapUserPayload{
+		ProjectRoles:      upsert.ProjectRoles,
+		DeactivatedBySync: upsert.DeactivatedBySync,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal LDAP user payload")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+			INSERT INTO ldap_user (
+				principal_id,
+				dn,
+				payload
+			)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (principal_id) DO UPDATE SET
+				dn = EXCLUDED.dn,
+				payload = EXCLUDED.payload
+		`,
+		upsert.PrincipalID,
+		upsert.DN,
+		payload,
+	); err != nil {
+		return nil, FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return upsert, nil
+}
+
+func listLDAPUserImpl(ctx context.Context, tx *Tx, find *FindLDAPUserMessage) ([]*LDAPUserMessage, error) {
+	where, args := []string{"1 = 1"}, []interface{}{}
+	if v := find.PrincipalID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			principal_id,
+			dn,
+			payload
+		FROM ldap_user
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY principal_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer rows.Close()
+
+	var ldapUsers []*LDAPUserMessage
+	for rows.Next() {
+		ldapUser := &LDAPUserMessage{}
+		var payload []byte
+		if err := rows.Scan(
+			&ldapUser.PrincipalID,
+			&ldapUser.DN,
+			&payload,
+		); err != nil {
+			return nil, FormatError(err)
+		}
+		var p ldapUserPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal the payload of LDAP user %d", ldapUser.PrincipalID)
+		}
+		ldapUser.ProjectRoles = p.ProjectRoles
+		ldapUser.DeactivatedBySync = p.DeactivatedBySync
+		ldapUsers = append(ldapUsers, ldapUser)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, FormatError(err)
+	}
+	return ldapUsers, nil
+}
@@ -173,7 +173,7 @@ CREATE TABLE project (
     -- db_name_template is only used when a project is in tenant mode.
     -- Empty value means {{DB_NAME}}.
     db_name_template TEXT NOT NULL,
-    role_provider TEXT NOT NULL CHECK (role_provider IN ('BYTEBASE', 'GITLAB_SELF_HOST', 'GITHUB_COM')) DEFAULT 'BYTEBASE',
+    role_provider TEXT NOT NULL CHECK (role_provider IN ('BYTEBASE', 'GITLAB_SELF_HOST', 'GITHUB_COM', 'GITHUB_ENTERPRISE', 'GITEA', 'BITBUCKET')) DEFAULT 'BYTEBASE',
     schema_version_type TEXT NOT NULL CHECK (schema_version_type IN ('TIMESTAMP', 'SEMANTIC')) DEFAULT 'TIMESTAMP',
     schema_change_type TEXT NOT NULL CHECK (schema_change_type IN ('DDL', 'SDL')) DEFAULT 'DDL',
     lgtm_check JSONB NOT NULL DEFAULT '{}',
@@ -230,7 +230,7 @@ CREATE TABLE project_member (
     project_id INTEGER NOT NULL REFERENCES project (id),
     role TEXT NOT NULL CHECK (role IN ('OWNER', 'DEVELOPER')),
     principal_id INTEGER NOT NULL REFERENCES principal (id),
-    role_provider TEXT NOT NULL CHECK (role_provider IN ('BYTEBASE', 'GITLAB_SELF_HOST', 'GITHUB_COM')) DEFAULT 'BYTEBASE',
+    role_provider TEXT NOT NULL CHECK (role_provider IN ('BYTEBASE', 'GITLAB_SELF_HOST', 'GITHUB_COM', 'GITHUB_ENTERPRISE', 'GITEA', 'BITBUCKET')) DEFAULT 'BYTEBASE',
     -- payload is determined by the type of role_provider
     payload JSONB NOT NULL DEFAULT '{}'
 );
@@ -257,7 +257,8 @@ CREATE TABLE project_webhook (
     type TEXT NOT NULL CHECK (type LIKE 'bb.plugin.webhook.%'),
     name TEXT NOT NULL,
     url TEXT NOT NULL,
-    activity_list TEXT ARRAY NOT NULL
+    activity_list TEXT ARRAY NOT NULL,
+    secret TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_project_webhook_project_id ON project_webhook(project_id);
@@ -272,6 +273,36 @@ UPDATE
     ON project_webhook FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- project_webhook_delivery is the outbound queue and the delivery history of project webhooks.
+-- payload is the webhook context to deliver, and the other columns record the latest attempt.
+CREATE TABLE project_webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    project_webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    attempt INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    payload JSONB NOT NULL DEFAULT '{}',
+    request TEXT NOT NULL DEFAULT '',
+    response_code INTEGER NOT NULL DEFAULT 0,
+    response_body TEXT NOT NULL DEFAULT '',
+    latency_ms INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_project_webhook_delivery_project_webhook_id ON project_webhook_delivery(project_webhook_id);
+
+CREATE INDEX idx_project_webhook_delivery_status_next_attempt_ts ON project_webhook_delivery(status, next_attempt_ts);
+
+ALTER SEQUENCE project_webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_project_webhook_delivery_updated_ts
+BEFORE
+UPDATE
+    ON project_webhook_delivery FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -- Instance
 CREATE TABLE instance (
     id SERIAL PRIMARY KEY,
@@ -369,7 +400,9 @@ CREATE TABLE db_schema (
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
     metadata JSONB NOT NULL DEFAULT '{}',
-    raw_dump TEXT NOT NULL DEFAULT ''
+    raw_dump TEXT NOT NULL DEFAULT '',
+    expected_metadata JSONB NOT NULL DEFAULT '{}',
+    expected_metadata_version TEXT NOT NULL DEFAULT ''
 );
 
 CREATE UNIQUE INDEX idx_db_schema_unique_database_id ON db_schema(database_id);
@@ -635,6 +668,12 @@ UPDATE
     ON backup_setting FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- backup_restore_drill records the last backup restore drill of the databases, so that the drills are not rerun after the server restarts.
+CREATE TABLE backup_restore_drill (
+    database_id INTEGER PRIMARY KEY REFERENCES db (id),
+    last_drill_ts BIGINT NOT NULL
+);
+
 -----------------------
 -- Pipeline related BEGIN
 -- pipeline table
@@ -922,11 +961,12 @@ CREATE TABLE vcs (
     updater_id INTEGER NOT NULL REFERENCES principal (id),
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     name TEXT NOT NULL,
-    type TEXT NOT NULL CHECK (type IN ('GITLAB_SELF_HOST', 'GITHUB_COM')),
+    type TEXT NOT NULL CHECK (type IN ('GITLAB_SELF_HOST', 'GITHUB_COM', 'GITHUB_ENTERPRISE', 'GITEA', 'BITBUCKET')),
     instance_url TEXT NOT NULL CHECK ((instance_url LIKE 'http://%' OR instance_url LIKE 'https://%') AND instance_url = rtrim(instance_url, '/')),
     api_url TEXT NOT NULL CHECK ((api_url LIKE 'http://%' OR api_url LIKE 'https://%') AND api_url = rtrim(api_url, '/')),
     application_id TEXT NOT NULL,
-    secret TEXT NOT NULL
+    secret TEXT NOT NULL,
+    ca_cert TEXT NOT NULL DEFAULT ''
 );
 
 ALTER SEQUENCE vcs_id_seq RESTART WITH 101;
@@ -937,6 +977,56 @@ UPDATE
     ON vcs FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- idp stores the identity providers used for single sign-on.
+CREATE TABLE idp (
+    id SERIAL PRIMARY KEY,
+    row_status row_status NOT NULL DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('OIDC', 'SAML')),
+    config JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE idp_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_idp_updated_ts
+BEFORE
+UPDATE
+    ON idp FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- idp_user binds the principals to the users of the identity providers by the issuer and the subject,
+-- so that a user of the identity provider can only sign in as the principal bound to it.
+CREATE TABLE idp_user (
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_idp_user_unique_issuer_subject ON idp_user(issuer, subject);
+
+CREATE INDEX idx_idp_user_principal_id ON idp_user(principal_id);
+
+-- ldap_user links the principals to the LDAP directory entries they are synced from.
+-- payload tracks the project roles granted by the LDAP group mappings so that they could be revoked later.
+CREATE TABLE ldap_user (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    dn TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE TRIGGER update_ldap_user_updated_ts
+BEFORE
+UPDATE
+    ON ldap_user FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -- repository table stores the repository setting for a project
 -- A vcs is associated with many repositories.
 -- A project can only link one repository (at least for now).
@@ -1135,7 +1225,7 @@ CREATE TABLE sheet (
     name TEXT NOT NULL,
     statement TEXT NOT NULL,
     visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'PROJECT', 'PUBLIC')) DEFAULT 'PRIVATE',
-    source TEXT NOT NULL CHECK (source IN ('BYTEBASE', 'GITLAB_SELF_HOST', 'GITHUB_COM')) DEFAULT 'BYTEBASE',
+    source TEXT NOT NULL CHECK (source IN ('BYTEBASE', 'GITLAB_SELF_HOST', 'GITHUB_COM', 'GITHUB_ENTERPRISE', 'GITEA', 'BITBUCKET')) DEFAULT 'BYTEBASE',
     type TEXT NOT NULL CHECK (type IN ('SQL')) DEFAULT 'SQL',
     payload JSONB NOT NULL DEFAULT '{}'
 );
@@ -0,0 +1,20 @@
+-- idp stores the identity providers used for single sign-on.
+CREATE TABLE idp (
+    id SERIAL PRIMARY KEY,
+    row_status row_status NOT NULL DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('OIDC', 'SAML')),
+    config JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER SEQUENCE idp_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_idp_updated_ts
+BEFORE
+UPDATE
+    ON idp FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
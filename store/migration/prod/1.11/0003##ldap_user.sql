@@ -0,0 +1,15 @@
+-- ldap_user links the principals to the LDAP directory entries they are synced from.
+-- payload tracks the project roles granted by the LDAP group mappings so that they could be revoked later.
+CREATE TABLE ldap_user (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    dn TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE TRIGGER update_ldap_user_updated_ts
+BEFORE
+UPDATE
+    ON ldap_user FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -0,0 +1,2 @@
+-- expected_metadata is the database metadata recorded right after the latest migration, used to detect schema drift.
+ALTER TABLE db_schema ADD COLUMN expected_metadata JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,12 @@
+-- idp_user binds the principals to the users of the identity providers by the issuer and the subject,
+-- so that a user of the identity provider can only sign in as the principal bound to it.
+CREATE TABLE idp_user (
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_idp_user_unique_issuer_subject ON idp_user(issuer, subject);
+
+CREATE INDEX idx_idp_user_principal_id ON idp_user(principal_id);
@@ -0,0 +1,5 @@
+-- backup_restore_drill records the last backup restore drill of the databases, so that the drills are not rerun after the server restarts.
+CREATE TABLE backup_restore_drill (
+    database_id INTEGER PRIMARY KEY REFERENCES db (id),
+    last_drill_ts BIGINT NOT NULL
+);
@@ -0,0 +1,3 @@
+-- expected_metadata_version is the migration history version when the expected_metadata is recorded.
+-- The expected_metadata is stale if the version mismatches the latest migration history, such as the migrations recorded by bb CLI.
+ALTER TABLE db_schema ADD COLUMN expected_metadata_version TEXT NOT NULL DEFAULT '';
@@ -220,5 +220,5 @@ func TestMigrationCompatibility(t *testing.T) {
 func TestGetCutoffVersion(t *testing.T) {
 	releaseVersion, err := getProdCutoffVersion()
 	require.NoError(t, err)
-	require.Equal(t, semver.MustParse("1.11.1"), releaseVersion)
+	require.Equal(t, semver.MustParse("1.11.11"), releaseVersion)
 }
@@ -251,6 +251,20 @@ func (s *Store) GetSensitiveDataPolicy(ctx context.Context, databaseID int) (*ap
 	return api.UnmarshalSensitiveDataPolicy(policy.Payload)
 }
 
+// GetSchemaDriftPolicy will get the schema drift policy for database ID.
+func (s *Store) GetSchemaDriftPolicy(ctx context.Context, databaseID int) (*api.SchemaDriftPolicy, error) {
+	databaseResourceType := api.PolicyResourceTypeDatabase
+	policy, err := s.getPolicyRaw(ctx, &api.PolicyFind{
+		ResourceType: &databaseResourceType,
+		ResourceID:   &databaseID,
+		Type:         api.PolicyTypeSchemaDrift,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return api.UnmarshalSchemaDriftPolicy(policy.Payload)
+}
+
 // GetNormalAccessControlPolicy will get the normal access control polciy. Return nil if InheritFromParent is true.
 func (s *Store) GetNormalAccessControlPolicy(ctx context.Context, resourceType api.PolicyResourceType, resourceID int) (*api.AccessControlPolicy, bool, error) {
 	policy, err := s.getPolicyRaw(ctx, &api.PolicyFind{
@@ -26,6 +26,7 @@ type projectWebhookRaw struct {
 	Name         string
 	URL          string
 	ActivityList []string
+	Secret       string
 }
 
 // toProjectWebhook creates an instance of ProjectWebhook based on the projectWebhookRaw.
@@ -38,9 +39,11 @@ func (raw *projectWebhookRaw) toProjectWebhook() *api.ProjectWebhook {
 		ProjectID: raw.ProjectID,
 
 		// Domain specific fields
-		Type: raw.Type,
-		Name: raw.Name,
-		URL:  raw.URL,
+		Type:      raw.Type,
+		Name:      raw.Name,
+		URL:       raw.URL,
+		Secret:    raw.Secret,
+		HasSecret: raw.Secret != "",
 	}
 	projectWebhook.ActivityList = append(projectWebhook.ActivityList, raw.ActivityList...)
 	return &projectWebhook
@@ -207,10 +210,11 @@ func createProjectWebhookImpl(ctx context.Context, tx *Tx, create *api.ProjectWe
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			secret
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, project_id, type, name, url, activity_list
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, project_id, type, name, url, activity_list, secret
 	`
 	var projectWebhookRaw projectWebhookRaw
 	var txtArray pgtype.TextArray
@@ -222,6 +226,7 @@ func createProjectWebhookImpl(ctx context.Context, tx *Tx, create *api.ProjectWe
 		create.Name,
 		create.URL,
 		create.ActivityList,
+		create.Secret,
 	).Scan(
 		&projectWebhookRaw.ID,
 		&projectWebhookRaw.ProjectID,
@@ -229,6 +234,7 @@ func createProjectWebhookImpl(ctx context.Context, tx *Tx, create *api.ProjectWe
 		&projectWebhookRaw.Name,
 		&projectWebhookRaw.URL,
 		&txtArray,
+		&projectWebhookRaw.Secret,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, common.FormatDBErrorEmptyRowWithQuery(query)
@@ -258,7 +264,8 @@ func findProjectWebhookImpl(ctx context.Context, tx *Tx, find *api.ProjectWebhoo
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			secret
 		FROM project_webhook
 		WHERE `+strings.Join(where, " AND "),
 		args...,
@@ -281,6 +288,7 @@ func findProjectWebhookImpl(ctx context.Context, tx *Tx, find *api.ProjectWebhoo
 			&projectWebhookRaw.Name,
 			&projectWebhookRaw.URL,
 			&txtArray,
+			&projectWebhookRaw.Secret,
 		); err != nil {
 			return nil, FormatError(err)
 		}
@@ -321,6 +329,9 @@ func patchProjectWebhookImpl(ctx context.Context, tx *Tx, patch *api.ProjectWebh
 		activities := strings.Split(*v, ",")
 		set, args = append(set, fmt.Sprintf("activity_list = $%d", len(args)+1)), append(args, activities)
 	}
+	if v := patch.Secret; v != nil {
+		set, args = append(set, fmt.Sprintf("secret = $%d", len(args)+1)), append(args, *v)
+	}
 
 	args = append(args, patch.ID)
 
@@ -331,7 +342,7 @@ func patchProjectWebhookImpl(ctx context.Context, tx *Tx, patch *api.ProjectWebh
 		UPDATE project_webhook
 		SET `+strings.Join(set, ", ")+`
 		WHERE id = $%d
-		RETURNING id, project_id, type, name, url, activity_list
+		RETURNING id, project_id, type, name, url, activity_list, secret
 	`, len(args)),
 		args...,
 	).Scan(
@@ -341,6 +352,7 @@ func patchProjectWebhookImpl(ctx context.Context, tx *Tx, patch *api.ProjectWebh
 		&projectWebhookRaw.Name,
 		&projectWebhookRaw.URL,
 		&txtArray,
+		&projectWebhookRaw.Secret,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("project hook ID not found: %d", patch.ID)}
@@ -0,0 +1,276 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/api"
+	"github.com/bytebase/bytebase/common"
+)
+
+// ProjectWebhookDeliveryMessage is the message for a project webhook delivery.
+type ProjectWebhookDeliveryMessage struct {
+	ProjectWebhookID int
+	Status           api.ProjectWebhookDeliveryStatus
+	// Attempt is the number of the attempts made.
+	Attempt       int
+	NextAttemptTs int64
+	// Payload is the JSON encoded webhook context to deliver.
+	Payload string
+
+	// The result of the latest attempt.
+	Request      string
+	ResponseCode int
+	ResponseBody string
+	LatencyMs    int
+	Error        string
+
+	// Output only fields.
+	ID        int
+	CreatedTs int64
+	UpdatedTs int64
+}
+
+// FindProjectWebhookDeliveryMessage is the message to find project webhook deliveries.
+type FindProjectWebhookDeliveryMessage struct {
+	ID               *int
+	ProjectWebhookID *int
+	Status           *api.ProjectWebhookDeliveryStatus
+	// NextAttemptBeforeTs finds the deliveries whose next attempt is due before the timestamp.
+	// The deliveries are ordered by the next attempt time then, otherwise they are ordered from the latest.
+	NextAttemptBeforeTs *int64
+	Limit               *int
+}
+
+// UpdateProjectWebhookDeliveryMessage is the message to update a project webhook delivery.
+type UpdateProjectWebhookDeliveryMessage struct {
+	ID int
+
+	Status        *api.ProjectWebhookDeliveryStatus
+	Attempt       *int
+	NextAttemptTs *int64
+	Request       *string
+	ResponseCode  *int
+	ResponseBody  *string
+	LatencyMs     *int
+	Error         *string
+}
+
+// CreateProjectWebhookDelivery creates a project webhook delivery.
+func (s *Store) CreateProjectWebhookDelivery(ctx context.Context, create *ProjectWebhookDeliveryMessage) (*ProjectWebhookDeliveryMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	delivery := &ProjectWebhookDeliveryMessage{
+		ProjectWebhookID: create.ProjectWebhookID,
+		Status:           create.Status,
+		Payload:          create.Payload,
+	}
+	if err := tx.QueryRowContext(ctx, `
+			INSERT INTO project_webhook_delivery (
+				project_webhook_id,
+				status,
+				payload
+			)
+			VALUES ($1, $2, $3)
+			RETURNING id, created_ts, updated_ts, attempt, next_attempt_ts
+		`,
+		create.ProjectWebhookID,
+		create.Status,
+		create.Payload,
+	).Scan(
+		&delivery.ID,
+		&delivery.CreatedTs,
+		&delivery.UpdatedTs,
+		&delivery.Attempt,
+		&delivery.NextAttemptTs,
+	); err != nil {
+		return nil, FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return delivery, nil
+}
+
+// GetProjectWebhookDelivery gets a project webhook delivery.
+func (s *Store) GetProjectWebhookDelivery(ctx context.Context, find *FindProjectWebhookDeliveryMessage) (*ProjectWebhookDeliveryMessage, error) {
+	deliveries, err := s.ListProjectWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d project webhook deliveries with filter %+v, expect 1", len(deliveries), find)}
+	}
+	return deliveries[0], nil
+}
+
+// ListProjectWebhookDeliveries lists project webhook deliveries, the latest first.
+func (s *Store) ListProjectWebhookDeliveries(ctx context.Context, find *FindProjectWebhookDeliveryMessage) ([]*ProjectWebhookDeliveryMessage, error) {
+	where, args := []string{"1 = 1"}, []interface{}{}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ProjectWebhookID; v != nil {
+		where, args = append(where, fmt.Sprintf("project_webhook_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	orderBy := "id DESC"
+	if v := find.NextAttemptBeforeTs; v != nil {
+		where, args = append(where, fmt.Sprintf("next_attempt_ts <= $%d", len(args)+1)), append(args, *v)
+		orderBy = "next_attempt_ts ASC, id ASC"
+	}
+	query := `
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			project_webhook_id,
+			status,
+			attempt,
+			next_attempt_ts,
+			payload,
+			request,
+			response_code,
+			response_body,
+			latency_ms,
+			error
+		FROM project_webhook_delivery
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY ` + orderBy
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, FormatError(err)
+	}
+	defer rows.Close()
+
+	var deliveries []*ProjectWebhookDeliveryMessage
+	for rows.Next() {
+		delivery := &ProjectWebhookDeliveryMessage{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+			&delivery.ProjectWebhookID,
+			&delivery.Status,
+			&delivery.Attempt,
+			&delivery.NextAttemptTs,
+			&delivery.Payload,
+			&delivery.Request,
+			&delivery.ResponseCode,
+			&delivery.ResponseBody,
+			&delivery.LatencyMs,
+			&delivery.Error,
+		); err != nil {
+			return nil, FormatError(err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, FormatError(err)
+	}
+	return deliveries, nil
+}
+
+// UpdateProjectWebhookDelivery updates a project webhook delivery.
+func (s *Store) UpdateProjectWebhookDelivery(ctx context.Context, update *UpdateProjectWebhookDeliveryMessage) error {
+	set, args := []string{}, []interface{}{}
+	if v := update.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Attempt; v != nil {
+		set, args = append(set, fmt.Sprintf("attempt = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, fmt.Sprintf("next_attempt_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Request; v != nil {
+		set, args = append(set, fmt.Sprintf("request = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseCode; v != nil {
+		set, args = append(set, fmt.Sprintf("response_code = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, fmt.Sprintf("response_body = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.LatencyMs; v != nil {
+		set, args = append(set, fmt.Sprintf("latency_ms = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, fmt.Sprintf("error = $%d", len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return errors.New("no update field provided")
+	}
+	args = append(args, update.ID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return FormatError(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE project_webhook_delivery
+		SET `+strings.Join(set, ", ")+`
+		WHERE id = $%d`, len(args)),
+		args...,
+	); err != nil {
+		return FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return FormatError(err)
+	}
+	return nil
+}
+
+// DeleteProjectWebhookDeliveries deletes the finished project webhook deliveries created before the timestamp.
+func (s *Store) DeleteProjectWebhookDeliveries(ctx context.Context, createdBeforeTs int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return FormatError(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM project_webhook_delivery
+		WHERE status != $1 AND created_ts < $2`,
+		api.ProjectWebhookDeliveryPending,
+		createdBeforeTs,
+	); err != nil {
+		return FormatError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return FormatError(err)
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ type vcsRaw struct {
 	APIURL        string
 	ApplicationID string
 	Secret        string
+	CACert        string
 }
 
 // toVCS creates an instance of VCS based on the VCSRaw.
@@ -39,6 +40,7 @@ func (raw *vcsRaw) toVCS() *api.VCS {
 		APIURL:        raw.APIURL,
 		ApplicationID: raw.ApplicationID,
 		Secret:        raw.Secret,
+		CACert:        raw.CACert,
 	}
 }
 
@@ -214,10 +216,11 @@ func createVCSImpl(ctx context.Context, tx *Tx, create *api.VCSCreate) (*vcsRaw,
 			instance_url,
 			api_url,
 			application_id,
-			secret
+			secret,
+			ca_cert
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id, name, type, instance_url, api_url, application_id, secret
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, name, type, instance_url, api_url, application_id, secret, ca_cert
 	`
 	var vcs vcsRaw
 	if err := tx.QueryRowContext(ctx, query,
@@ -229,6 +232,7 @@ func createVCSImpl(ctx context.Context, tx *Tx, create *api.VCSCreate) (*vcsRaw,
 		create.APIURL,
 		create.ApplicationID,
 		create.Secret,
+		create.CACert,
 	).Scan(
 		&vcs.ID,
 		&vcs.Name,
@@ -237,6 +241,7 @@ func createVCSImpl(ctx context.Context, tx *Tx, create *api.VCSCreate) (*vcsRaw,
 		&vcs.APIURL,
 		&vcs.ApplicationID,
 		&vcs.Secret,
+		&vcs.CACert,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, common.FormatDBErrorEmptyRowWithQuery(query)
@@ -261,7 +266,8 @@ func findVCSImpl(ctx context.Context, tx *Tx, find *api.VCSFind) ([]*vcsRaw, err
 			instance_url,
 			api_url,
 			application_id,
-			secret
+			secret,
+			ca_cert
 		FROM vcs
 		WHERE `+strings.Join(where, " AND "),
 		args...,
@@ -283,6 +289,7 @@ func findVCSImpl(ctx context.Context, tx *Tx, find *api.VCSFind) ([]*vcsRaw, err
 			&vcs.APIURL,
 			&vcs.ApplicationID,
 			&vcs.Secret,
+			&vcs.CACert,
 		); err != nil {
 			return nil, FormatError(err)
 		}
@@ -309,6 +316,9 @@ func patchVCSImpl(ctx context.Context, tx *Tx, patch *api.VCSPatch) (*vcsRaw, er
 	if v := patch.Secret; v != nil {
 		set, args = append(set, fmt.Sprintf("secret = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := patch.CACert; v != nil {
+		set, args = append(set, fmt.Sprintf("ca_cert = $%d", len(args)+1)), append(args, *v)
+	}
 	args = append(args, patch.ID)
 
 	var vcs vcsRaw
@@ -317,7 +327,7 @@ func patchVCSImpl(ctx context.Context, tx *Tx, patch *api.VCSPatch) (*vcsRaw, er
 		UPDATE vcs
 		SET `+strings.Join(set, ", ")+`
 		WHERE id = $%d
-		RETURNING id, name, type, instance_url, api_url, application_id, secret
+		RETURNING id, name, type, instance_url, api_url, application_id, secret, ca_cert
 	`, len(args)),
 		args...,
 	).Scan(
@@ -328,6 +338,7 @@ func patchVCSImpl(ctx context.Context, tx *Tx, patch *api.VCSPatch) (*vcsRaw, er
 		&vcs.APIURL,
 		&vcs.ApplicationID,
 		&vcs.Secret,
+		&vcs.CACert,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("vcs ID not found: %d", patch.ID)}
@@ -0,0 +1,633 @@
+package fake
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/plugin/vcs"
+	"github.com/bytebase/bytebase/plugin/vcs/gitea"
+)
+
+// Gitea is a fake implementation of Gitea VCS provider.
+type Gitea struct {
+	port int
+	echo *echo.Echo
+
+	client *http.Client
+
+	nextWebhookID int
+	repositories  map[string]*giteaRepositoryData
+}
+
+type giteaRepositoryData struct {
+	webhooks []*gitea.WebhookCreateOrUpdate
+	// files is a map that the full file path is the key and the file content is the
+	// value.
+	files map[string]string
+	// branches is the map for repository branch.
+	// the map key is the branch name, like "main".
+	branches map[string]*gitea.Branch
+	// secrets is the map for repository Actions secret.
+	// the map key is the secret name.
+	secrets map[string]string
+	// pullRequests is the map for repository pull request.
+	// the map key is the pull request number.
+	pullRequests map[int]struct {
+		Files []*gitea.PullRequestFile
+		*gitea.PullRequest
+	}
+	// commitsDiff is the map for commits compare.
+	// The map key has the format "from...to" which is SHA or branch name.
+	commitsDiff map[string]*gitea.CommitsDiff
+}
+
+// NewGitea creates a new fake implementation of Gitea VCS provider.
+func NewGitea(port int) VCSProvider {
+	e := newEchoServer()
+	gt := &Gitea{
+		port:          port,
+		echo:          e,
+		client:        &http.Client{},
+		nextWebhookID: 20230113,
+		repositories:  make(map[string]*giteaRepositoryData),
+	}
+
+	g := e.Group("/api/v1")
+	g.POST("/repos/:owner/:repo/hooks", gt.createRepositoryWebhook)
+	g.GET("/repos/:owner/:repo/git/commits/:commitID", gt.getRepositoryCommit)
+	g.GET("/repos/:owner/:repo/git/trees/:ref", gt.getRepositoryTree)
+	g.GET("/repos/:owner/:repo/contents/*", gt.readRepositoryFile)
+	g.POST("/repos/:owner/:repo/contents/*", gt.createRepositoryFile)
+	g.PUT("/repos/:owner/:repo/contents/*", gt.updateRepositoryFile)
+	g.GET("/repos/:owner/:repo/branches/*", gt.getRepositoryBranch)
+	g.POST("/repos/:owner/:repo/branches", gt.createRepositoryBranch)
+	g.POST("/repos/:owner/:repo/pulls", gt.createRepositoryPullRequest)
+	g.PUT("/repos/:owner/:repo/actions/secrets/:secretName", gt.updateRepositorySecret)
+	g.GET("/repos/:owner/:repo/pulls/:prID/files", gt.listPullRequestFile)
+	g.GET("/repos/:owner/:repo/compare/:baseHead", gt.compareCommits)
+	return gt
+}
+
+func (gt *Gitea) createRepositoryWebhook(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read request body for creating repository webhook: %v", err))
+	}
+
+	var webhookCreate gitea.WebhookCreateOrUpdate
+	if err = json.Unmarshal(body, &webhookCreate); err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal request body for creating repository webhook: %v", err))
+	}
+	r.webhooks = append(r.webhooks, &webhookCreate)
+
+	buf, err := json.Marshal(gitea.WebhookInfo{ID: gt.nextWebhookID})
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body for creating repository webhook: %v", err))
+	}
+	gt.nextWebhookID++
+	return c.String(http.StatusCreated, string(buf))
+}
+
+func (gt *Gitea) getRepositoryCommit(c echo.Context) error {
+	if _, err := gt.validRepository(c); err != nil {
+		return err
+	}
+
+	commit := gitea.Commit{
+		SHA: "fake_gitea_commit_sha",
+	}
+	commit.Commit.Author = gitea.CommitUser{
+		Name: "fake_gitea_author",
+		Date: time.Now(),
+	}
+	buf, err := json.Marshal(commit)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body for getting repository commit: %v", err))
+	}
+	return c.String(http.StatusOK, string(buf))
+}
+
+func (gt *Gitea) getRepositoryTree(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	var treeNodes []gitea.RepositoryTreeNode
+	for filePath := range r.files {
+		treeNodes = append(treeNodes,
+			gitea.RepositoryTreeNode{
+				Path: filePath,
+				Type: "blob",
+			},
+		)
+	}
+	buf, err := json.Marshal(
+		gitea.RepositoryTree{
+			Tree:       treeNodes,
+			Page:       1,
+			TotalCount: len(treeNodes),
+		},
+	)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body for getting repository tree: %v", err))
+	}
+	return c.String(http.StatusOK, string(buf))
+}
+
+func (gt *Gitea) readRepositoryFile(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	filePath, err := filePathParam(c)
+	if err != nil {
+		return err
+	}
+
+	content, ok := r.files[filePath]
+	if !ok {
+		return c.String(http.StatusNotFound, fmt.Sprintf("file %q not found", filePath))
+	}
+
+	buf, err := json.Marshal(
+		gitea.File{
+			Type:     "file",
+			Encoding: "base64",
+			Size:     int64(len(content)),
+			Name:     path.Base(filePath),
+			Path:     filePath,
+			Content:  base64.StdEncoding.EncodeToString([]byte(content)),
+			SHA:      "fake_gitea_blob_sha",
+		},
+	)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body for getting repository file: %v", err))
+	}
+	return c.String(http.StatusOK, string(buf))
+}
+
+func (gt *Gitea) createRepositoryFile(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	filePath, err := filePathParam(c)
+	if err != nil {
+		return err
+	}
+	if _, ok := r.files[filePath]; ok {
+		return c.String(http.StatusUnprocessableEntity, fmt.Sprintf("repository file already exists [path: %s]", filePath))
+	}
+	if err := gt.commitRepositoryFile(c, r, filePath); err != nil {
+		return err
+	}
+	return c.String(http.StatusCreated, "{}")
+}
+
+func (gt *Gitea) updateRepositoryFile(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	filePath, err := filePathParam(c)
+	if err != nil {
+		return err
+	}
+	if _, ok := r.files[filePath]; !ok {
+		return c.String(http.StatusNotFound, fmt.Sprintf("file %q not found", filePath))
+	}
+	if err := gt.commitRepositoryFile(c, r, filePath); err != nil {
+		return err
+	}
+	return c.String(http.StatusOK, "{}")
+}
+
+func (*Gitea) commitRepositoryFile(c echo.Context, r *giteaRepositoryData, filePath string) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read request body for committing repository file: %v", err))
+	}
+
+	var fileCommit gitea.FileCommit
+	if err = json.Unmarshal(body, &fileCommit); err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal request body for committing repository file: %v", err))
+	}
+
+	content, err := base64.StdEncoding.DecodeString(fileCommit.Content)
+	if err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("failed to decode file content for %q: %v", filePath, err))
+	}
+	r.files[filePath] = string(content)
+	return nil
+}
+
+func (gt *Gitea) getRepositoryBranch(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	branchName, err := url.PathUnescape(c.Param("*"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("failed to unescape branch name %q: %v", c.Param("*"), err))
+	}
+	branch, ok := r.branches[branchName]
+	if !ok {
+		return c.String(http.StatusNotFound, fmt.Sprintf("branch not found: %v", branchName))
+	}
+
+	buf, err := json.Marshal(branch)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body for getting repository branch: %v", err))
+	}
+	return c.String(http.StatusOK, string(buf))
+}
+
+func (gt *Gitea) createRepositoryBranch(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read request body for creating repository branch: %v", err))
+	}
+
+	var branchCreate gitea.BranchCreate
+	if err = json.Unmarshal(body, &branchCreate); err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal request body for creating repository branch: %v", err))
+	}
+
+	if _, ok := r.branches[branchCreate.NewBranchName]; ok {
+		return c.String(http.StatusConflict, fmt.Sprintf("the branch already exists: %v", branchCreate.NewBranchName))
+	}
+
+	r.branches[branchCreate.NewBranchName] = &gitea.Branch{
+		Name: branchCreate.NewBranchName,
+		Commit: gitea.BranchCommit{
+			ID: branchCreate.OldRefName,
+		},
+	}
+
+	buf, err := json.Marshal(r.branches[branchCreate.NewBranchName])
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body for creating repository branch: %v", err))
+	}
+	return c.String(http.StatusCreated, string(buf))
+}
+
+func (gt *Gitea) createRepositoryPullRequest(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read request body for creating repository pull request: %v", err))
+	}
+
+	var pullRequestCreate vcs.PullRequestCreate
+	if err = json.Unmarshal(body, &pullRequestCreate); err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal request body for creating repository pull request: %v", err))
+	}
+
+	if _, ok := r.branches[pullRequestCreate.Head]; !ok {
+		return c.String(http.StatusNotFound, fmt.Sprintf("the head branch not exists: %v", pullRequestCreate.Head))
+	}
+
+	prID := len(r.pullRequests) + 1
+	r.pullRequests[prID] = struct {
+		Files []*gitea.PullRequestFile
+		*gitea.PullRequest
+	}{
+		Files: []*gitea.PullRequestFile{},
+		PullRequest: &gitea.PullRequest{
+			HTMLURL: fmt.Sprintf("%s/%s/%s/pulls/%d", gt.instanceURL(), c.Param("owner"), c.Param("repo"), prID),
+		},
+	}
+
+	buf, err := json.Marshal(
+		r.pullRequests[prID],
+	)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body for creating repository pull request: %v", err))
+	}
+	return c.String(http.StatusCreated, string(buf))
+}
+
+func (gt *Gitea) updateRepositorySecret(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read request body for updating repository secret: %v", err))
+	}
+
+	var secretUpdate gitea.RepositorySecretUpdate
+	if err = json.Unmarshal(body, &secretUpdate); err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal request body for updating repository secret: %v", err))
+	}
+
+	secretName := c.Param("secretName")
+	if _, ok := r.secrets[secretName]; ok {
+		r.secrets[secretName] = secretUpdate.Data
+		return c.NoContent(http.StatusNoContent)
+	}
+	r.secrets[secretName] = secretUpdate.Data
+	return c.NoContent(http.StatusCreated)
+}
+
+func (gt *Gitea) listPullRequestFile(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	prNumber, err := strconv.Atoi(c.Param("prID"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("The pull request id is invalid: %v", c.Param("prID")))
+	}
+
+	pullRequest, ok := r.pullRequests[prNumber]
+	if !ok {
+		return c.String(http.StatusNotFound, fmt.Sprintf("Cannot found the pull request: %v", c.Param("prID")))
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("Invalid page parameter %v", c.QueryParam("page")))
+	}
+
+	prFiles := []*gitea.PullRequestFile{}
+	if page == 1 {
+		prFiles = pullRequest.Files
+	}
+
+	buf, err := json.Marshal(prFiles)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body: %v", err))
+	}
+	return c.String(http.StatusOK, string(buf))
+}
+
+func (gt *Gitea) compareCommits(c echo.Context) error {
+	r, err := gt.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	key := c.Param("baseHead")
+	diff, ok := r.commitsDiff[key]
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Cannot find the diff key %s", key))
+	}
+
+	buf, err := json.Marshal(diff)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal response body").SetInternal(err)
+	}
+
+	return c.String(http.StatusOK, string(buf))
+}
+
+func (gt *Gitea) validRepository(c echo.Context) (*giteaRepositoryData, error) {
+	repositoryID := fmt.Sprintf("%s/%s", c.Param("owner"), c.Param("repo"))
+	r, ok := gt.repositories[repositoryID]
+	if !ok {
+		return nil, c.String(http.StatusNotFound, fmt.Sprintf("Gitea repository %q does not exist", repositoryID))
+	}
+
+	return r, nil
+}
+
+// filePathParam returns the unescaped file path from the wildcard path parameter.
+func filePathParam(c echo.Context) (string, error) {
+	filePathEscaped := c.Param("*")
+	filePath, err := url.PathUnescape(filePathEscaped)
+	if err != nil {
+		return "", c.String(http.StatusBadRequest, fmt.Sprintf("failed to unescape file path %q: %v", filePathEscaped, err))
+	}
+	return filePath, nil
+}
+
+func (gt *Gitea) instanceURL() string {
+	return fmt.Sprintf("http://localhost:%d", gt.port)
+}
+
+// Run starts the Gitea VCS provider server.
+func (gt *Gitea) Run() error {
+	return gt.echo.Start(fmt.Sprintf(":%d", gt.port))
+}
+
+// Close shuts down the Gitea VCS provider server.
+func (gt *Gitea) Close() error {
+	return gt.echo.Close()
+}
+
+// ListenerAddr returns the Gitea VCS provider server listener address.
+func (gt *Gitea) ListenerAddr() net.Addr {
+	return gt.echo.ListenerAddr()
+}
+
+// APIURL returns the Gitea VCS provider API URL.
+func (*Gitea) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/api/v1", instanceURL)
+}
+
+// CreateRepository creates a Gitea repository with given ID.
+func (gt *Gitea) CreateRepository(id string) {
+	gt.repositories[id] = &giteaRepositoryData{
+		files:    make(map[string]string),
+		secrets:  make(map[string]string),
+		branches: map[string]*gitea.Branch{},
+		pullRequests: map[int]struct {
+			Files []*gitea.PullRequestFile
+			*gitea.PullRequest
+		}{},
+		commitsDiff: map[string]*gitea.CommitsDiff{},
+	}
+}
+
+// CreateBranch creates a new branch with the given name.
+func (gt *Gitea) CreateBranch(id, branchName string) error {
+	r, ok := gt.repositories[id]
+	if !ok {
+		return errors.Errorf("gitea repository %q doesn't exist", id)
+	}
+
+	if _, ok := r.branches[branchName]; ok {
+		return errors.Errorf("branch %q already exists", branchName)
+	}
+
+	r.branches[branchName] = &gitea.Branch{
+		Name: branchName,
+		Commit: gitea.BranchCommit{
+			ID: "fake_gitea_commit_sha",
+		},
+	}
+
+	return nil
+}
+
+// AddCommitsDiff adds a commits diff.
+func (gt *Gitea) AddCommitsDiff(repositoryID, fromCommit, toCommit string, fileDiffList []vcs.FileDiff) error {
+	r, ok := gt.repositories[repositoryID]
+	if !ok {
+		return errors.Errorf("Gitea repository %s doesn't exist", repositoryID)
+	}
+	key := fmt.Sprintf("%s...%s", fromCommit, toCommit)
+	commit := gitea.Commit{
+		SHA: toCommit,
+	}
+	for _, fileDiff := range fileDiffList {
+		file := gitea.CommitAffectedFile{
+			FileName: fileDiff.Path,
+		}
+		switch fileDiff.Type {
+		case vcs.FileDiffTypeAdded:
+			file.Status = "added"
+		case vcs.FileDiffTypeModified:
+			file.Status = "modified"
+		case vcs.FileDiffTypeRemoved:
+			file.Status = "removed"
+		}
+		commit.Files = append(commit.Files, file)
+	}
+	r.commitsDiff[key] = &gitea.CommitsDiff{
+		Commits: []gitea.Commit{commit},
+	}
+	return nil
+}
+
+// SendWebhookPush sends out a webhook for a push event for the Gitea
+// repository using given payload.
+func (gt *Gitea) SendWebhookPush(repositoryID string, payload []byte) error {
+	r, ok := gt.repositories[repositoryID]
+	if !ok {
+		return errors.Errorf("Gitea repository %q does not exist", repositoryID)
+	}
+
+	// Trigger all webhooks
+	for _, webhook := range r.webhooks {
+		req, err := http.NewRequest("POST", webhook.Config.URL, bytes.NewReader(payload))
+		if err != nil {
+			return errors.Wrapf(err, "failed to create a new POST request to %q", webhook.Config.URL)
+		}
+
+		m := hmac.New(sha256.New, []byte(webhook.Config.Secret))
+		if _, err := m.Write(payload); err != nil {
+			return errors.Wrap(err, "failed to calculate SHA256 of the webhook secret")
+		}
+		req.Header.Set("X-Gitea-Signature", hex.EncodeToString(m.Sum(nil)))
+		req.Header.Set("X-Gitea-Event", string(gitea.WebhookPush))
+
+		resp, err := gt.client.Do(req)
+		if err != nil {
+			return errors.Wrapf(err, "failed to send POST request to %q", webhook.Config.URL)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+		if resp.StatusCode != http.StatusOK {
+			return errors.Errorf("unexpected response status code %d, body: %s", resp.StatusCode, body)
+		}
+		gt.echo.Logger.Infof("SendWebhookPush response body %s\n", body)
+	}
+	return nil
+}
+
+// AddFiles adds given files to the Gitea repository.
+func (gt *Gitea) AddFiles(repositoryID string, files map[string]string) error {
+	r, ok := gt.repositories[repositoryID]
+	if !ok {
+		return errors.Errorf("Gitea repository %q does not exist", repositoryID)
+	}
+
+	// Save or overwrite files
+	for path, content := range files {
+		r.files[path] = content
+	}
+	return nil
+}
+
+// GetFiles returns files with given paths from the Gitea repository.
+func (gt *Gitea) GetFiles(repositoryID string, filePaths ...string) (map[string]string, error) {
+	r, ok := gt.repositories[repositoryID]
+	if !ok {
+		return nil, errors.Errorf("Gitea repository %q does not exist", repositoryID)
+	}
+
+	// Get files
+	files := make(map[string]string)
+	for _, path := range filePaths {
+		if content, ok := r.files[path]; ok {
+			files[path] = content
+		}
+	}
+	return files, nil
+}
+
+// AddPullRequest creates a new pull request and add changed files to it.
+func (gt *Gitea) AddPullRequest(repositoryID string, prID int, files []*vcs.PullRequestFile) error {
+	r, ok := gt.repositories[repositoryID]
+	if !ok {
+		return errors.Errorf("Gitea repository %q does not exist", repositoryID)
+	}
+
+	pullRequestFiles := []*gitea.PullRequestFile{}
+	for _, file := range files {
+		status := "modified"
+		if file.IsDeleted {
+			status = "deleted"
+		}
+		pullRequestFiles = append(pullRequestFiles, &gitea.PullRequestFile{
+			FileName:    file.Path,
+			Status:      status,
+			ContentsURL: fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", gt.APIURL(gt.instanceURL()), repositoryID, strings.TrimPrefix(file.Path, "/"), file.LastCommitID),
+		})
+	}
+
+	r.pullRequests[prID] = struct {
+		Files []*gitea.PullRequestFile
+		*gitea.PullRequest
+	}{
+		Files: pullRequestFiles,
+		PullRequest: &gitea.PullRequest{
+			HTMLURL: fmt.Sprintf("%s/%s/pulls/%d", gt.instanceURL(), repositoryID, prID),
+		},
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/bytebase/bytebase/plugin/advisor"
 	"github.com/bytebase/bytebase/plugin/db"
 	"github.com/bytebase/bytebase/plugin/vcs"
+	"github.com/bytebase/bytebase/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/plugin/vcs/github"
 	"github.com/bytebase/bytebase/plugin/vcs/gitlab"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -345,6 +346,44 @@ func TestVCS(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:               "Gitea",
+			vcsProviderCreator: fake.NewGitea,
+			vcsType:            vcs.Gitea,
+			externalID:         "octocat/Hello-World",
+			repositoryFullPath: "octocat/Hello-World",
+			newWebhookPushEvent: func(added, modified [][]string, beforeSHA, afterSHA string) interface{} {
+				var commits []gitea.WebhookCommit
+				for i := range added {
+					commits = append(commits, gitea.WebhookCommit{
+						ID:        "fake_gitea_commit_id",
+						Message:   "Fake Gitea commit message",
+						Timestamp: time.Now(),
+						URL:       "https://gitea.com/octocat/Hello-World/commit/fake_gitea_commit_id",
+						Author: gitea.WebhookCommitAuthor{
+							Name:  "fake_gitea_author",
+							Email: "fake_gitea_author@localhost",
+						},
+						Added:    added[i],
+						Modified: modified[i],
+					})
+				}
+				return gitea.WebhookPushEvent{
+					Ref:    "refs/heads/feature/foo",
+					Before: beforeSHA,
+					After:  afterSHA,
+					Repository: gitea.WebhookRepository{
+						ID:       211,
+						FullName: "octocat/Hello-World",
+						HTMLURL:  "https://gitea.com/octocat/Hello-World",
+					},
+					Sender: gitea.WebhookSender{
+						Login: "fake_gitea_author",
+					},
+					Commits: commits,
+				}
+			},
+		},
 	}
 	for _, test := range tests {
 		// Fix the problem that closure in a for loop will always use the last element.
@@ -1440,6 +1479,74 @@ func TestVCS_SQL_Review(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:               "GitHub Enterprise",
+			vcsProviderCreator: fake.NewGitHub,
+			vcsType:            vcs.GitHubEnterprise,
+			externalID:         "octocat/Hello-World",
+			repositoryFullPath: "octocat/Hello-World",
+			getEmptySQLReviewResult: func(repo *api.Repository, filePath, rootURL string) *api.VCSSQLReviewResult {
+				return &api.VCSSQLReviewResult{
+					Status: advisor.Warn,
+					Content: []string{
+						fmt.Sprintf(
+							"::warning file=%s,line=1,col=1,endColumn=2,title=SQL review policy not found (2)::You can configure the SQL review policy on %s/setting/sql-review%%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#2",
+							filePath,
+							rootURL,
+						),
+					},
+				}
+			},
+			getSQLReviewResult: func(repo *api.Repository, filePath string) *api.VCSSQLReviewResult {
+				return &api.VCSSQLReviewResult{
+					Status: advisor.Warn,
+					Content: []string{
+						fmt.Sprintf(
+							"::warning file=%s,line=1,col=1,endColumn=2,title=column.required (401)::Table \"book\" requires columns: created_ts, creator_id, updated_ts, updater_id%%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#401",
+							filePath,
+						),
+						fmt.Sprintf(
+							"::warning file=%s,line=1,col=1,endColumn=2,title=column.no-null (402)::Column \"name\" in \"public\".\"book\" cannot have NULL value%%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#402",
+							filePath,
+						),
+					},
+				}
+			},
+		},
+		{
+			name:               "Gitea",
+			vcsProviderCreator: fake.NewGitea,
+			vcsType:            vcs.Gitea,
+			externalID:         "octocat/Hello-World",
+			repositoryFullPath: "octocat/Hello-World",
+			getEmptySQLReviewResult: func(repo *api.Repository, filePath, rootURL string) *api.VCSSQLReviewResult {
+				return &api.VCSSQLReviewResult{
+					Status: advisor.Warn,
+					Content: []string{
+						fmt.Sprintf(
+							"::warning file=%s,line=1,col=1,endColumn=2,title=SQL review policy not found (2)::You can configure the SQL review policy on %s/setting/sql-review%%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#2",
+							filePath,
+							rootURL,
+						),
+					},
+				}
+			},
+			getSQLReviewResult: func(repo *api.Repository, filePath string) *api.VCSSQLReviewResult {
+				return &api.VCSSQLReviewResult{
+					Status: advisor.Warn,
+					Content: []string{
+						fmt.Sprintf(
+							"::warning file=%s,line=1,col=1,endColumn=2,title=column.required (401)::Table \"book\" requires columns: created_ts, creator_id, updated_ts, updater_id%%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#401",
+							filePath,
+						),
+						fmt.Sprintf(
+							"::warning file=%s,line=1,col=1,endColumn=2,title=column.no-null (402)::Column \"name\" in \"public\".\"book\" cannot have NULL value%%0ADoc: https://www.bytebase.com/docs/reference/error-code/advisor#402",
+							filePath,
+						),
+					},
+				}
+			},
+		},
 	}
 
 	for _, test := range tests {